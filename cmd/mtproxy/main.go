@@ -0,0 +1,155 @@
+// Binary mtproxy is a standalone MTProxy server.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/server"
+	"github.com/gotd/td/tdsync"
+)
+
+// secretsFlag is a repeatable secret flag.
+type secretsFlag []string
+
+func (s *secretsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *secretsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+// parseSecret parses secret in `[name=]hex` format.
+func parseSecret(v string) (server.Secret, error) {
+	var name string
+	if idx := strings.IndexByte(v, '='); idx >= 0 {
+		name, v = v[:idx], v[idx+1:]
+	}
+
+	raw, err := hex.DecodeString(v)
+	if err != nil {
+		return server.Secret{}, errors.Wrap(err, "decode hex")
+	}
+	secret, err := mtproxy.ParseSecret(raw)
+	if err != nil {
+		return server.Secret{}, errors.Wrap(err, "parse")
+	}
+	return server.Secret{
+		Name:   name,
+		Secret: secret,
+	}, nil
+}
+
+func run(ctx context.Context) error {
+	var (
+		secrets   secretsFlag
+		addr      = flag.String("addr", "0.0.0.0:443", "address to listen")
+		adTag     = flag.String("tag", "", "hex-encoded ad tag from @MTProxybot")
+		mask      = flag.String("mask", "", "mask host to forward unrecognized clients, defaults to FakeTLS domain")
+		statsAddr = flag.String("stats", "", "address to serve JSON statistics, disabled if empty")
+		ip        = flag.String("ip", "", "external IP address, required for ad tag if proxy is behind NAT")
+		debug     = flag.Bool("debug", false, "enable debug logging")
+	)
+	flag.Var(&secrets, "secret", "secret to accept in [name=]hex format, can be repeated")
+	flag.Parse()
+
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Logger()
+	if !*debug {
+		logger = logger.Level(zerolog.InfoLevel)
+	}
+
+	opts := server.Options{
+		MaskHost: *mask,
+		Logger:   &logger,
+	}
+	if len(secrets) == 0 {
+		return errors.New("at least one secret is required")
+	}
+
+	var tag []byte
+	if *adTag != "" {
+		t, err := hex.DecodeString(*adTag)
+		if err != nil {
+			return errors.Wrap(err, "decode ad tag")
+		}
+		tag = t
+
+		fetchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+		middle, err := server.FetchMiddleProxy(fetchCtx, nil)
+		if err != nil {
+			return errors.Wrap(err, "fetch middle proxy config")
+		}
+		middle.ExternalIP = net.ParseIP(*ip)
+		opts.MiddleProxy = middle
+	}
+	for _, v := range secrets {
+		s, err := parseSecret(v)
+		if err != nil {
+			return errors.Wrapf(err, "secret %q", v)
+		}
+		s.AdTag = tag
+		opts.Secrets = append(opts.Secrets, s)
+	}
+
+	srv, err := server.New(opts)
+	if err != nil {
+		return errors.Wrap(err, "create server")
+	}
+
+	l, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return errors.Wrap(err, "listen")
+	}
+
+	grp := tdsync.NewCancellableGroup(ctx)
+	grp.Go(func(ctx context.Context) error {
+		return srv.Serve(ctx, l)
+	})
+	if *statsAddr != "" {
+		httpServer := &http.Server{
+			Addr: *statsAddr,
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(srv.Stats())
+			}),
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		grp.Go(func(ctx context.Context) error {
+			if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return errors.Wrap(err, "serve stats")
+			}
+			return nil
+		})
+		grp.Go(func(ctx context.Context) error {
+			<-ctx.Done()
+			return httpServer.Close()
+		})
+	}
+	return grp.Wait()
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := run(ctx); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(2)
+	}
+}
@@ -68,6 +68,68 @@ func (f FileID) asPhotoLocation() (tg.InputFileLocationClass, bool) {
 	return nil, false
 }
 
+func (f FileID) isDocument() bool {
+	switch f.Type {
+	case Video,
+		Voice,
+		Document,
+		Sticker,
+		Audio,
+		Animation,
+		VideoNote,
+		Background,
+		DocumentAsFile:
+		return true
+	default:
+		return false
+	}
+}
+
+// AsInputPhoto converts file ID to tg.InputPhoto.
+func (f FileID) AsInputPhoto() (*tg.InputPhoto, bool) {
+	if f.Type != Photo || f.URL != "" {
+		return nil, false
+	}
+
+	return &tg.InputPhoto{
+		ID:            f.ID,
+		AccessHash:    f.AccessHash,
+		FileReference: f.FileReference,
+	}, true
+}
+
+// AsInputDocument converts file ID to tg.InputDocument.
+func (f FileID) AsInputDocument() (*tg.InputDocument, bool) {
+	if !f.isDocument() || f.URL != "" {
+		return nil, false
+	}
+
+	return &tg.InputDocument{
+		ID:            f.ID,
+		AccessHash:    f.AccessHash,
+		FileReference: f.FileReference,
+	}, true
+}
+
+// AsInputMedia converts file ID to tg.InputMediaClass, which can be used
+// to send file again without downloading it.
+func (f FileID) AsInputMedia() (tg.InputMediaClass, bool) {
+	if f.URL != "" {
+		if f.Type == Photo {
+			return &tg.InputMediaPhotoExternal{URL: f.URL}, true
+		}
+		return &tg.InputMediaDocumentExternal{URL: f.URL}, true
+	}
+
+	if photo, ok := f.AsInputPhoto(); ok {
+		return &tg.InputMediaPhoto{ID: photo}, true
+	}
+	if doc, ok := f.AsInputDocument(); ok {
+		return &tg.InputMediaDocument{ID: doc}, true
+	}
+	return nil, false
+}
+
 // AsInputFileLocation converts file ID to tg.InputFileLocationClass.
 func (f FileID) AsInputFileLocation() (tg.InputFileLocationClass, bool) {
 	switch f.Type {
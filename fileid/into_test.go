@@ -289,3 +289,63 @@ func TestFileID_AsInputWebFileLocation(t *testing.T) {
 		AccessHash: 10,
 	}, loc)
 }
+
+func TestFileID_AsInputMedia(t *testing.T) {
+	tests := []struct {
+		name   string
+		fileID FileID
+		want   tg.InputMediaClass
+		wantOk bool
+	}{
+		{
+			"Photo",
+			FileID{Type: Photo, ID: 1, AccessHash: 2, FileReference: []byte{3}},
+			&tg.InputMediaPhoto{ID: &tg.InputPhoto{ID: 1, AccessHash: 2, FileReference: []byte{3}}},
+			true,
+		},
+		{
+			"Sticker",
+			FileID{Type: Sticker, ID: 1, AccessHash: 2, FileReference: []byte{3}},
+			&tg.InputMediaDocument{ID: &tg.InputDocument{ID: 1, AccessHash: 2, FileReference: []byte{3}}},
+			true,
+		},
+		{
+			"Video",
+			FileID{Type: Video, ID: 1, AccessHash: 2},
+			&tg.InputMediaDocument{ID: &tg.InputDocument{ID: 1, AccessHash: 2}},
+			true,
+		},
+		{
+			"WebPhoto",
+			FileID{Type: Photo, URL: "https://example.com/a.jpg"},
+			&tg.InputMediaPhotoExternal{URL: "https://example.com/a.jpg"},
+			true,
+		},
+		{
+			"WebDocument",
+			FileID{Type: Document, URL: "https://example.com/a.pdf"},
+			&tg.InputMediaDocumentExternal{URL: "https://example.com/a.pdf"},
+			true,
+		},
+		{
+			"Thumbnail",
+			FileID{Type: Thumbnail, ID: 1},
+			nil,
+			false,
+		},
+		{
+			"ProfilePhoto",
+			FileID{Type: ProfilePhoto, ID: 1},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+			got, ok := tt.fileID.AsInputMedia()
+			a.Equal(tt.wantOk, ok)
+			a.Equal(tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package fileid
+
+import (
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+)
+
+// uniqueType represents file_unique_id type.
+type uniqueType int32
+
+const (
+	uniqueWeb uniqueType = iota
+	uniquePhoto
+	uniqueDocument
+	uniqueSecure
+	uniqueEncrypted
+	uniqueTemp
+)
+
+func (f FileID) uniqueType() uniqueType {
+	if f.URL != "" {
+		return uniqueWeb
+	}
+
+	switch f.Type {
+	case Thumbnail, ProfilePhoto, Photo, EncryptedThumbnail, Wallpaper:
+		return uniquePhoto
+	case Secure, SecureRaw:
+		return uniqueSecure
+	case Encrypted:
+		return uniqueEncrypted
+	case Temp:
+		return uniqueTemp
+	default:
+		return uniqueDocument
+	}
+}
+
+// photoUniqueType returns one-byte photo size identifier.
+//
+// Sizes 'a' and 'c' share identifiers with small and big dialog photos.
+func photoUniqueType(src PhotoSizeSource) byte {
+	switch src.Type {
+	case PhotoSizeSourceDialogPhotoSmall:
+		return 0
+	case PhotoSizeSourceDialogPhotoBig:
+		return 1
+	}
+
+	switch src.ThumbnailType {
+	case 'a':
+		return 0
+	case 'c':
+		return 1
+	default:
+		return byte(src.ThumbnailType + 5)
+	}
+}
+
+func (f FileID) encodeUnique(b *bin.Buffer) error {
+	typ := f.uniqueType()
+	b.PutInt32(int32(typ))
+
+	switch typ {
+	case uniqueWeb:
+		b.PutString(f.URL)
+		return nil
+	case uniquePhoto:
+	default:
+		b.PutLong(f.ID)
+		return nil
+	}
+
+	switch src := f.PhotoSizeSource; src.Type {
+	case PhotoSizeSourceFullLegacy,
+		PhotoSizeSourceDialogPhotoSmallLegacy,
+		PhotoSizeSourceDialogPhotoBigLegacy,
+		PhotoSizeSourceStickerSetThumbnailLegacy:
+		// Legacy photos are identified by volume_id and local_id only.
+		b.PutLong(src.VolumeID)
+		b.PutInt(src.LocalID)
+	case PhotoSizeSourceStickerSetThumbnailVersion:
+		b.PutLong(f.ID)
+		b.PutInt32(src.StickerVersion)
+	case PhotoSizeSourceThumbnail,
+		PhotoSizeSourceDialogPhotoSmall,
+		PhotoSizeSourceDialogPhotoBig:
+		b.PutLong(f.ID)
+		b.Buf = append(b.Buf, photoUniqueType(src))
+	default:
+		return errors.Errorf("unsupported photo size source %s", src.Type)
+	}
+	return nil
+}
+
+// EncodeUniqueFileID computes Bot API file_unique_id of given FileID.
+//
+// Unlike file_id, file_unique_id is the same for the same file over time
+// and for different bots, but can't be used to download or reuse the file.
+func EncodeUniqueFileID(id FileID) (string, error) {
+	var buf bin.Buffer
+	if err := id.encodeUnique(&buf); err != nil {
+		return "", errors.Wrap(err, "encode")
+	}
+	return base64Encode(rleEncode(buf.Buf)), nil
+}
@@ -0,0 +1,58 @@
+package fileid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeUniqueFileID(t *testing.T) {
+	tests := map[string]string{
+		"Sticker":         "AgADJwADh1ePHg",
+		"AnimatedSticker": "AgADkQ4AAllDKUo",
+		"GIF":             "AgADtBAAArtesEg",
+		"GIFThumbnail":    "AQADtBAAArtesEhy",
+		"Photo":           "AQADhrsxG9182Uh9",
+		"Video":           "AgADShEAAkhgoUg",
+		"VideoThumbnail":  "AQADShEAAkhgoUhy",
+		"ChatPhoto":       "AQAD7a8xG75QcEkB",
+		"Voice":           "AgADWRIAAlK26Ug",
+		"Audio":           "AgAD8AIAAqgAAXhK",
+	}
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := require.New(t)
+			fileID, err := DecodeFileID(testData[name])
+			a.NoError(err)
+
+			got, err := EncodeUniqueFileID(fileID)
+			a.NoError(err)
+			a.Equal(want, got)
+
+			// Unique ID does not depend on file reference and access hash.
+			fileID.FileReference = nil
+			fileID.AccessHash = 0
+			got, err = EncodeUniqueFileID(fileID)
+			a.NoError(err)
+			a.Equal(want, got)
+		})
+	}
+
+	t.Run("Web", func(t *testing.T) {
+		a := require.New(t)
+		got, err := EncodeUniqueFileID(FileID{Type: Photo, URL: "https://example.com/a.jpg"})
+		a.NoError(err)
+		other, err := EncodeUniqueFileID(FileID{Type: Document, URL: "https://example.com/a.jpg"})
+		a.NoError(err)
+		a.Equal(got, other)
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := EncodeUniqueFileID(FileID{
+			Type: Photo,
+			PhotoSizeSource: PhotoSizeSource{
+				Type: PhotoSizeSourceLegacy,
+			},
+		})
+		require.Error(t, err)
+	})
+}
@@ -8,7 +8,7 @@ type GenerateFlags struct {
 	Client bool
 	// Registry enables type ID registry generation.
 	Registry bool
-	// Server enables experimental server generation.
+	// Server enables server interface and dispatcher generation.
 	Server bool
 	// Handlers enables update handler generation.
 	Handlers bool
@@ -28,7 +28,7 @@ type GenerateFlags struct {
 func (s *GenerateFlags) RegisterFlags(set *flag.FlagSet) {
 	set.BoolVar(&s.Client, "client", true, "Enables client generation")
 	set.BoolVar(&s.Registry, "registry", true, "Enables type ID registry generation")
-	set.BoolVar(&s.Server, "server", false, "Enables experimental server generation")
+	set.BoolVar(&s.Server, "server", false, "Enables server interface and dispatcher generation")
 	set.BoolVar(&s.Handlers, "handlers", false, "Enables update handler generation")
 	set.BoolVar(&s.UpdatesClassifier, "updates-classifier", true, "Enables updates classifier generation")
 	set.BoolVar(&s.GetSet, "getset", true, "Enables getters and setters generation")
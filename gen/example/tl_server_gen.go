@@ -31,11 +31,34 @@ var (
 	_ = tdjson.Encoder{}
 )
 
+// Server is a server-side interface of all RPC methods.
+//
+// Every method has the same signature as the corresponding Client method,
+// so Server can be implemented partially and registered using
+// ServerDispatcher.Register.
+type Server interface {
+	// Ping handles method ping#ce73048f.
+	Ping(ctx context.Context, id int32) error
+	// Send handles method send#f74488a.
+	Send(ctx context.Context, msg SMS) (*SMS, error)
+	// SendMultipleSMS handles method sendMultipleSMS#df18e5ca.
+	SendMultipleSMS(ctx context.Context, messages []SMS) error
+	// DoAuth handles method doAuth#fd2f6687.
+	DoAuth(ctx context.Context) (AuthClass, error)
+	// EchoVector handles method echoVector#d4785939.
+	EchoVector(ctx context.Context, ids []int) ([]int, error)
+}
+
+// ServerDispatcher dispatches RPC requests to typed handlers.
 type ServerDispatcher struct {
 	fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 	handlers map[uint32]func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 }
 
+// NewServerDispatcher creates new ServerDispatcher.
+//
+// Fallback is called for requests without handler. If fallback is nil,
+// an error is returned for such requests.
 func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)) *ServerDispatcher {
 	return &ServerDispatcher{
 		fallback: fallback,
@@ -43,6 +66,7 @@ func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.
 	}
 }
 
+// Handle decodes request from buffer and calls its handler.
 func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 	id, err := b.PeekID()
 	if err != nil {
@@ -51,12 +75,53 @@ func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encod
 
 	f, ok := s.handlers[id]
 	if !ok {
+		if s.fallback == nil {
+			return nil, fmt.Errorf("no handler for type %#x", id)
+		}
 		return s.fallback(ctx, b)
 	}
 
 	return f(ctx, b)
 }
 
+// Has reports whether handler for given type ID is registered.
+func (s *ServerDispatcher) Has(id uint32) bool {
+	_, ok := s.handlers[id]
+	return ok
+}
+
+// Register sets handlers for all Server methods implemented by impl.
+//
+// Methods are matched by name and signature, so impl may implement
+// only a subset of Server.
+func (s *ServerDispatcher) Register(impl interface{}) {
+	if h, ok := impl.(interface {
+		Ping(ctx context.Context, id int32) error
+	}); ok {
+		s.OnPing(h.Ping)
+	}
+	if h, ok := impl.(interface {
+		Send(ctx context.Context, msg SMS) (*SMS, error)
+	}); ok {
+		s.OnSend(h.Send)
+	}
+	if h, ok := impl.(interface {
+		SendMultipleSMS(ctx context.Context, messages []SMS) error
+	}); ok {
+		s.OnSendMultipleSMS(h.SendMultipleSMS)
+	}
+	if h, ok := impl.(interface {
+		DoAuth(ctx context.Context) (AuthClass, error)
+	}); ok {
+		s.OnDoAuth(h.DoAuth)
+	}
+	if h, ok := impl.(interface {
+		EchoVector(ctx context.Context, ids []int) ([]int, error)
+	}); ok {
+		s.OnEchoVector(h.EchoVector)
+	}
+}
+
 func (s *ServerDispatcher) OnPing(f func(ctx context.Context, id int32) error) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PingRequest
@@ -205,6 +205,11 @@ func (c *Conn) Run(ctx context.Context, f func(ctx context.Context) error) error
 	g.Go("saltsLoop", c.saltLoop)
 	g.Go("userCallback", f)
 	g.Go("readLoop", c.readLoop)
+	if p, ok := c.conn.(transport.Poller); ok {
+		g.Go("httpWaitLoop", func(ctx context.Context) error {
+			return c.httpWaitLoop(ctx, p)
+		})
+	}
 
 	if err := g.Wait(); err != nil {
 		return errors.Wrap(err, "group")
@@ -0,0 +1,34 @@
+package mtproto
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/mt"
+	"github.com/gotd/td/transport"
+)
+
+// httpMaxWait is maximum long polling request duration.
+const httpMaxWait = 25 * time.Second
+
+// httpWaitLoop keeps long polling request in flight for HTTP-like transports.
+//
+// See https://core.telegram.org/mtproto/service_messages#long-poll.
+func (c *Conn) httpWaitLoop(ctx context.Context, p transport.Poller) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "http_wait loop")
+		case <-p.Poll():
+			if err := c.writeServiceMessage(ctx, &mt.HTTPWaitRequest{
+				MaxDelay:  0,
+				WaitAfter: 0,
+				MaxWait:   int(httpMaxWait.Milliseconds()),
+			}); err != nil {
+				return errors.Wrap(err, "write http_wait")
+			}
+		}
+	}
+}
@@ -10,6 +10,9 @@ import (
 
 const maxTLSRecordDataLength = 16384 + 24
 
+// maxTLSRecordPayload is a maximum size of application data in a single record.
+const maxTLSRecordPayload = 16384
+
 type record struct {
 	Type    RecordType
 	Version [2]byte
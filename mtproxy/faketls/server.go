@@ -0,0 +1,225 @@
+package faketls
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/crypto"
+)
+
+// ClientHello is a received faketls ClientHello.
+type ClientHello struct {
+	// Raw is a raw ClientHello record, including header.
+	Raw []byte
+	// Random is a client random (digest) as it was sent.
+	Random [32]byte
+	// SessionID is a client session ID.
+	SessionID []byte
+	// Domain is a server name from SNI extension.
+	Domain string
+}
+
+// clientRandomOffset is an offset of random in the ClientHello record.
+//
+// `$record_header = type 1 byte + version 2 bytes + payload_length 2 bytes = 5 bytes`
+// `$client_hello_header = type 1 bytes + length 3 bytes + version 2 bytes = 6 bytes`
+const clientRandomOffset = 11
+
+// ReadClientHello reads and parses faketls ClientHello.
+func ReadClientHello(r io.Reader) (ClientHello, error) {
+	packetBuf := bytes.NewBuffer(nil)
+	rec, err := readRecord(io.TeeReader(r, packetBuf))
+	if err != nil {
+		return ClientHello{}, errors.Wrap(err, "read record")
+	}
+	if rec.Type != RecordTypeHandshake {
+		return ClientHello{}, errors.Errorf("unexpected record type %#x", rec.Type)
+	}
+
+	h := ClientHello{
+		Raw: packetBuf.Bytes(),
+	}
+	if err := h.parse(rec.Data); err != nil {
+		return ClientHello{}, errors.Wrap(err, "parse")
+	}
+	return h, nil
+}
+
+func (h *ClientHello) parse(data []byte) error {
+	b := &bin.Buffer{Buf: data}
+	next := func(n int) ([]byte, error) {
+		if b.Len() < n {
+			return nil, io.ErrUnexpectedEOF
+		}
+		v := b.Buf[:n]
+		b.Skip(n)
+		return v, nil
+	}
+
+	// Handshake type and length.
+	header, err := next(4)
+	if err != nil {
+		return errors.Wrap(err, "handshake header")
+	}
+	if HandshakeType(header[0]) != HandshakeTypeClient {
+		return errors.Errorf("unexpected handshake type %#x", header[0])
+	}
+	// Client version.
+	if _, err := next(2); err != nil {
+		return errors.Wrap(err, "version")
+	}
+	random, err := next(32)
+	if err != nil {
+		return errors.Wrap(err, "random")
+	}
+	copy(h.Random[:], random)
+
+	sessionIDLength, err := next(1)
+	if err != nil {
+		return errors.Wrap(err, "session id length")
+	}
+	sessionID, err := next(int(sessionIDLength[0]))
+	if err != nil {
+		return errors.Wrap(err, "session id")
+	}
+	h.SessionID = append([]byte(nil), sessionID...)
+
+	// Some clients (including ours) do not fill GREASE values, so lengths
+	// of cipher suites and extensions are not reliable. Search for SNI instead.
+	h.Domain = findServerName(b.Buf)
+	return nil
+}
+
+// findServerName searches server_name extension in the rest of ClientHello.
+func findServerName(data []byte) string {
+	// extension_type (2) + extension length (2) +
+	// server_name_list length (2) + name_type (1) + host_name length (2).
+	const headerLength = 9
+	for i := 0; i+headerLength <= len(data); i++ {
+		if data[i] != 0 || data[i+1] != 0 || data[i+6] != 0 {
+			continue
+		}
+		extLength := int(binary.BigEndian.Uint16(data[i+2:]))
+		listLength := int(binary.BigEndian.Uint16(data[i+4:]))
+		nameLength := int(binary.BigEndian.Uint16(data[i+7:]))
+		if nameLength == 0 || listLength != nameLength+3 || extLength != listLength+2 {
+			continue
+		}
+		if i+headerLength+nameLength > len(data) {
+			continue
+		}
+		return string(data[i+headerLength : i+headerLength+nameLength])
+	}
+	return ""
+}
+
+// Verify checks ClientHello digest using given secret and returns client time.
+func (h ClientHello) Verify(secret []byte) (time.Time, error) {
+	if len(h.Raw) < clientRandomOffset+32 {
+		return time.Time{}, errors.New("ClientHello is too short")
+	}
+
+	packet := append([]byte(nil), h.Raw...)
+	// Fill original digest by zeros.
+	var zeros [32]byte
+	copy(packet[clientRandomOffset:clientRandomOffset+32], zeros[:])
+
+	mac := hmac.New(sha256.New, secret)
+	if _, err := mac.Write(packet); err != nil {
+		return time.Time{}, errors.Wrap(err, "hmac write")
+	}
+	expected := mac.Sum(nil)
+
+	var xored [32]byte
+	for i := range xored {
+		xored[i] = expected[i] ^ h.Random[i]
+	}
+	// First 28 bytes must be equal, last 4 bytes contain timestamp.
+	if !hmac.Equal(xored[:28], zeros[:28]) {
+		return time.Time{}, errors.New("hmac digest mismatch")
+	}
+
+	ts := binary.LittleEndian.Uint32(xored[28:32])
+	return time.Unix(int64(ts), 0), nil
+}
+
+// WriteServerHello writes faketls ServerHello as response to given ClientHello.
+func WriteServerHello(w io.Writer, rand io.Reader, h ClientHello, secret []byte) error {
+	var key [32]byte
+	if _, err := io.ReadFull(rand, key[:]); err != nil {
+		return errors.Wrap(err, "generate key")
+	}
+	// Fake certificate length, like Telegram's MTProxy.
+	certLength, err := crypto.RandInt64n(rand, 4096-1024)
+	if err != nil {
+		return errors.Wrap(err, "generate cert length")
+	}
+	cert := make([]byte, 1024+certLength)
+	if _, err := io.ReadFull(rand, cert); err != nil {
+		return errors.Wrap(err, "generate cert")
+	}
+
+	hello := &bin.Buffer{}
+	hello.Put(Version12Bytes[:])
+	// Zero digest, will be filled later.
+	hello.Expand(32)
+	hello.Put([]byte{byte(len(h.SessionID))})
+	hello.Put(h.SessionID)
+	// TLS_AES_128_GCM_SHA256, no compression.
+	hello.Put([]byte("\x13\x01\x00"))
+	// Extensions: supported_versions (TLS 1.3) and key_share (x25519).
+	hello.Put([]byte("\x00\x2e\x00\x2b\x00\x02\x03\x04\x00\x33\x00\x24\x00\x1d\x00\x20"))
+	hello.Put(key[:])
+
+	packet := &bytes.Buffer{}
+	handshake := append([]byte{byte(HandshakeTypeServer), 0, 0, 0}, hello.Buf...)
+	binary.BigEndian.PutUint16(handshake[2:4], uint16(hello.Len()))
+	for _, rec := range []record{
+		{Type: RecordTypeHandshake, Version: Version12Bytes, Data: handshake},
+		{Type: RecordTypeChangeCipherSpec, Version: Version12Bytes, Data: []byte("\x01")},
+		{Type: RecordTypeApplication, Version: Version12Bytes, Data: cert},
+	} {
+		if _, err := writeRecord(packet, rec); err != nil {
+			return errors.Wrap(err, "write record")
+		}
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	if _, err := mac.Write(h.Random[:]); err != nil {
+		return errors.Wrap(err, "hmac write")
+	}
+	if _, err := mac.Write(packet.Bytes()); err != nil {
+		return errors.Wrap(err, "hmac write")
+	}
+	const serverRandomOffset = 11
+	b := packet.Bytes()
+	copy(b[serverRandomOffset:serverRandomOffset+32], mac.Sum(nil))
+
+	if _, err := w.Write(b); err != nil {
+		return errors.Wrap(err, "write ServerHello")
+	}
+	return nil
+}
+
+// NewServerFakeTLS creates new server-side FakeTLS record layer.
+//
+// Handshake should be done using ReadClientHello and WriteServerHello.
+func NewServerFakeTLS(r io.Reader, conn io.ReadWriter) *FakeTLS {
+	return &FakeTLS{
+		rand:    r,
+		clock:   clock.System,
+		conn:    conn,
+		version: Version12Bytes,
+		readBuf: bytes.Buffer{},
+		// ServerHello already contains ChangeCipherSpec.
+		firstPacket: true,
+	}
+}
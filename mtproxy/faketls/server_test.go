@@ -0,0 +1,77 @@
+package faketls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/gotd/td/mtproxy"
+)
+
+func TestServerHandshake(t *testing.T) {
+	a := require.New(t)
+	secret := bytes.Repeat([]byte{0x01}, 16)
+	client, server := net.Pipe()
+
+	var grp errgroup.Group
+	grp.Go(func() error {
+		defer func() {
+			_ = server.Close()
+		}()
+
+		hello, err := ReadClientHello(server)
+		if err != nil {
+			return err
+		}
+		a.Equal("example.com", hello.Domain)
+		a.Len(hello.SessionID, 32)
+
+		ts, err := hello.Verify(secret)
+		if err != nil {
+			return err
+		}
+		a.WithinDuration(time.Now(), ts, time.Minute)
+
+		_, err = hello.Verify(bytes.Repeat([]byte{0x02}, 16))
+		a.Error(err)
+
+		if err := WriteServerHello(server, rand.Reader, hello, secret); err != nil {
+			return err
+		}
+
+		conn := NewServerFakeTLS(rand.Reader, server)
+		buf := make([]byte, 5)
+		if _, err := conn.Read(buf); err != nil {
+			return err
+		}
+		a.Equal("hello", string(buf))
+
+		big := bytes.Repeat([]byte{0x42}, 3*maxTLSRecordPayload)
+		if _, err := conn.Write(big); err != nil {
+			return err
+		}
+		return nil
+	})
+
+	conn := NewFakeTLS(rand.Reader, client)
+	a.NoError(conn.Handshake([4]byte{}, 2, mtproxy.Secret{
+		Secret:    secret,
+		CloakHost: "example.com",
+		Type:      mtproxy.TLS,
+	}))
+	_, err := conn.Write([]byte("hello"))
+	a.NoError(err)
+
+	got := make([]byte, 3*maxTLSRecordPayload)
+	_, err = io.ReadFull(conn, got)
+	a.NoError(err)
+	a.Equal(bytes.Repeat([]byte{0x42}, 3*maxTLSRecordPayload), got)
+
+	a.NoError(grp.Wait())
+}
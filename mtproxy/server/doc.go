@@ -0,0 +1,8 @@
+// Package server contains MTProxy server implementation.
+//
+// Server supports simple, secured (dd) and FakeTLS (ee) secrets,
+// direct relaying to Telegram DCs and relaying through Telegram middle
+// proxies, which is required to show promoted channel (ad tag).
+//
+// See https://core.telegram.org/mtproto/mtproto-transports#transport-obfuscation.
+package server
@@ -0,0 +1,541 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"  // #nosec G501
+	"crypto/sha1" // #nosec G505
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/proto/codec"
+)
+
+// Middle proxy RPC constructors.
+//
+// See https://github.com/TelegramMessenger/MTProxy/blob/master/net/net-tcp-rpc-common.h.
+var (
+	rpcNonce      = [4]byte{0xaa, 0x87, 0xcb, 0x7a}
+	rpcHandshake  = [4]byte{0xf5, 0xee, 0x82, 0x76}
+	rpcProxyReq   = [4]byte{0xee, 0xf1, 0xce, 0x36}
+	rpcProxyAns   = [4]byte{0x0d, 0xda, 0x03, 0x44}
+	rpcCloseExt   = [4]byte{0xa2, 0x34, 0xb6, 0x5e}
+	rpcSimpleAck  = [4]byte{0x9b, 0x40, 0xac, 0x3b}
+	rpcUnknown    = [4]byte{0xdf, 0xa2, 0x30, 0x57}
+	rpcCryptoAES  = [4]byte{0x01, 0x00, 0x00, 0x00}
+	rpcProxyTag   = [4]byte{0xae, 0x26, 0x1e, 0xdb}
+	rpcProcessID  = []byte("IPIPPRPDTIME")
+	rpcPadding    = [4]byte{0x04, 0x00, 0x00, 0x00}
+	rpcNoEncrypt8 = [8]byte{}
+)
+
+// RPC_PROXY_REQ flags.
+const (
+	flagNotEncrypted = 0x2
+	flagHasAdTag     = 0x8
+	flagMagic        = 0x1000
+	flagExtMode2     = 0x20000
+	flagPad          = 0x8000000
+	flagIntermediate = 0x20000000
+	flagAbridged     = 0x40000000
+)
+
+const (
+	// startSeqNo is a sequence number of the first frame.
+	startSeqNo = -2
+	// cbcPadding is a frame alignment for AES-CBC.
+	cbcPadding = 16
+	// maxFrameLength is a maximum middle proxy frame length.
+	maxFrameLength = 1 << 24
+)
+
+// middleStream is a framed middle proxy RPC stream.
+type middleStream struct {
+	r        io.Reader
+	w        io.Writer
+	readSeq  int32
+	writeSeq int32
+	// padded is true if frames are aligned, i.e. encryption is enabled.
+	padded bool
+}
+
+func newMiddleStream(conn io.ReadWriter) *middleStream {
+	return &middleStream{
+		r:        conn,
+		w:        conn,
+		readSeq:  startSeqNo,
+		writeSeq: startSeqNo,
+	}
+}
+
+// encrypt switches stream to AES-CBC encryption.
+func (m *middleStream) encrypt(encKey, encIV, decKey, decIV []byte) error {
+	enc, err := aes.NewCipher(encKey)
+	if err != nil {
+		return errors.Wrap(err, "create encrypt cipher")
+	}
+	dec, err := aes.NewCipher(decKey)
+	if err != nil {
+		return errors.Wrap(err, "create decrypt cipher")
+	}
+
+	m.w = &cbcWriter{w: m.w, mode: cipher.NewCBCEncrypter(enc, encIV)}
+	m.r = &cbcReader{r: m.r, mode: cipher.NewCBCDecrypter(dec, decIV)}
+	m.padded = true
+	return nil
+}
+
+// writeFrame writes frame with given payload.
+//
+// Frame format is `length (4) + seq_no (4) + payload + crc32 (4)`,
+// encrypted frames are padded to 16 bytes.
+func (m *middleStream) writeFrame(payload []byte) error {
+	length := 4 + 4 + len(payload) + 4
+	b := make([]byte, 0, length+cbcPadding)
+	b = binary.LittleEndian.AppendUint32(b, uint32(length))
+	b = binary.LittleEndian.AppendUint32(b, uint32(m.writeSeq))
+	b = append(b, payload...)
+	b = binary.LittleEndian.AppendUint32(b, crc32.ChecksumIEEE(b))
+	for m.padded && len(b)%cbcPadding != 0 {
+		b = append(b, rpcPadding[:]...)
+	}
+	m.writeSeq++
+
+	if _, err := m.w.Write(b); err != nil {
+		return errors.Wrap(err, "write frame")
+	}
+	return nil
+}
+
+// readFrame reads frame and returns its payload.
+func (m *middleStream) readFrame() ([]byte, error) {
+	var header [8]byte
+	for {
+		if _, err := io.ReadFull(m.r, header[:4]); err != nil {
+			return nil, errors.Wrap(err, "read length")
+		}
+		// Skip padding.
+		if !bytes.Equal(header[:4], rpcPadding[:]) {
+			break
+		}
+	}
+	length := int(binary.LittleEndian.Uint32(header[:4]))
+	if length < 12 || length > maxFrameLength || length%4 != 0 {
+		return nil, errors.Errorf("invalid frame length %d", length)
+	}
+	if _, err := io.ReadFull(m.r, header[4:]); err != nil {
+		return nil, errors.Wrap(err, "read seq_no")
+	}
+	if seq := int32(binary.LittleEndian.Uint32(header[4:])); seq != m.readSeq {
+		return nil, errors.Errorf("unexpected seq_no %d, expected %d", seq, m.readSeq)
+	}
+	m.readSeq++
+
+	rest := make([]byte, length-8)
+	if _, err := io.ReadFull(m.r, rest); err != nil {
+		return nil, errors.Wrap(err, "read payload")
+	}
+	payload, checksum := rest[:len(rest)-4], binary.LittleEndian.Uint32(rest[len(rest)-4:])
+
+	crc := crc32.NewIEEE()
+	_, _ = crc.Write(header[:])
+	_, _ = crc.Write(payload)
+	if crc.Sum32() != checksum {
+		return nil, errors.New("frame checksum mismatch")
+	}
+	return payload, nil
+}
+
+type cbcWriter struct {
+	w    io.Writer
+	mode cipher.BlockMode
+}
+
+func (c *cbcWriter) Write(p []byte) (int, error) {
+	if len(p)%c.mode.BlockSize() != 0 {
+		return 0, errors.Errorf("data is not aligned to block size: %d", len(p))
+	}
+	encrypted := make([]byte, len(p))
+	c.mode.CryptBlocks(encrypted, p)
+	if _, err := c.w.Write(encrypted); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+type cbcReader struct {
+	r    io.Reader
+	mode cipher.BlockMode
+	buf  bytes.Buffer
+}
+
+func (c *cbcReader) Read(p []byte) (int, error) {
+	if c.buf.Len() == 0 {
+		block := make([]byte, c.mode.BlockSize()*64)
+		n, err := io.ReadAtLeast(c.r, block, c.mode.BlockSize())
+		if err != nil {
+			return 0, err
+		}
+		// Read the rest of the last block.
+		if rem := n % c.mode.BlockSize(); rem != 0 {
+			m, err := io.ReadFull(c.r, block[n:n+c.mode.BlockSize()-rem])
+			if err != nil {
+				return 0, err
+			}
+			n += m
+		}
+		block = block[:n]
+		c.mode.CryptBlocks(block, block)
+		c.buf.Write(block)
+	}
+	return c.buf.Read(p)
+}
+
+// middleKeyPurpose is a key derivation purpose.
+type middleKeyPurpose string
+
+const (
+	purposeClient middleKeyPurpose = "CLIENT"
+	purposeServer middleKeyPurpose = "SERVER"
+)
+
+// middleKeyParams are middle proxy key derivation parameters.
+type middleKeyParams struct {
+	serverNonce []byte
+	clientNonce []byte
+	clientTime  []byte
+	serverAddr  *net.TCPAddr
+	clientAddr  *net.TCPAddr
+	secret      []byte
+}
+
+// reversedIPv4 returns IPv4 address bytes in reversed order.
+func reversedIPv4(ip net.IP) []byte {
+	v4 := ip.To4()
+	if v4 == nil {
+		return nil
+	}
+	return []byte{v4[3], v4[2], v4[1], v4[0]}
+}
+
+func port(addr *net.TCPAddr) []byte {
+	return binary.LittleEndian.AppendUint16(nil, uint16(addr.Port))
+}
+
+// deriveKey derives AES-CBC key and IV for given purpose.
+func (p middleKeyParams) deriveKey(purpose middleKeyPurpose) (key, iv []byte) {
+	clientIP, serverIP := reversedIPv4(p.clientAddr.IP), reversedIPv4(p.serverAddr.IP)
+	if clientIP == nil || serverIP == nil {
+		clientIP, serverIP = make([]byte, 4), make([]byte, 4)
+	}
+
+	var s []byte
+	s = append(s, p.serverNonce...)
+	s = append(s, p.clientNonce...)
+	s = append(s, p.clientTime...)
+	s = append(s, serverIP...)
+	s = append(s, port(p.clientAddr)...)
+	s = append(s, purpose...)
+	s = append(s, clientIP...)
+	s = append(s, port(p.serverAddr)...)
+	s = append(s, p.secret...)
+	s = append(s, p.serverNonce...)
+	if p.clientAddr.IP.To4() == nil && p.serverAddr.IP.To4() == nil {
+		s = append(s, p.clientAddr.IP.To16()...)
+		s = append(s, p.serverAddr.IP.To16()...)
+	}
+	s = append(s, p.clientNonce...)
+
+	md5Sum := md5.Sum(s[1:]) // #nosec G401
+	sha1Sum := sha1.Sum(s)   // #nosec G401
+	ivSum := md5.Sum(s[2:])  // #nosec G401
+
+	key = append(key, md5Sum[:12]...)
+	key = append(key, sha1Sum[:]...)
+	return key, ivSum[:]
+}
+
+// middleConn is a connection to Telegram middle proxy.
+type middleConn struct {
+	conn   net.Conn
+	stream *middleStream
+
+	connID     [8]byte
+	clientAddr []byte
+	proxyAddr  []byte
+	adTag      []byte
+	protocol   [4]byte
+}
+
+// ipPort encodes address as 16-byte IPv6 (or IPv4-mapped) address and 4-byte port.
+func ipPort(addr *net.TCPAddr) []byte {
+	b := make([]byte, 0, 20)
+	ip := addr.IP.To16()
+	if ip == nil {
+		ip = make(net.IP, net.IPv6len)
+	}
+	b = append(b, ip...)
+	return binary.LittleEndian.AppendUint32(b, uint32(addr.Port))
+}
+
+func tcpAddr(addr net.Addr) *net.TCPAddr {
+	if a, ok := addr.(*net.TCPAddr); ok {
+		return a
+	}
+	host, portStr, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return &net.TCPAddr{IP: net.IPv4zero}
+	}
+	p, _ := strconv.Atoi(portStr)
+	ip := net.ParseIP(host)
+	if ip == nil {
+		ip = net.IPv4zero
+	}
+	return &net.TCPAddr{IP: ip, Port: p}
+}
+
+// dialMiddle connects to the middle proxy and performs RPC handshake.
+func (s *Server) dialMiddle(ctx context.Context, conn net.Conn, acc accepted) (_ *middleConn, rErr error) {
+	addrs := s.middle.Addrs[acc.dc]
+	if len(addrs) == 0 && acc.dc < 0 {
+		addrs = s.middle.Addrs[-acc.dc]
+	}
+	if len(addrs) == 0 {
+		return nil, errors.Errorf("no middle proxy for DC %d", acc.dc)
+	}
+
+	for _, addr := range addrs {
+		upstream, err := s.dial(ctx, "tcp", addr)
+		if err != nil {
+			rErr = multierr.Append(rErr, err)
+			continue
+		}
+
+		m, err := s.middleHandshake(upstream)
+		if err != nil {
+			rErr = multierr.Append(rErr, multierr.Append(err, upstream.Close()))
+			continue
+		}
+		m.clientAddr = ipPort(tcpAddr(conn.RemoteAddr()))
+		m.proxyAddr = ipPort(tcpAddr(conn.LocalAddr()))
+		m.adTag = acc.secret.adTag
+		m.protocol = acc.protocol
+		return m, nil
+	}
+
+	return nil, errors.Wrapf(rErr, "dial middle proxy for DC %d", acc.dc)
+}
+
+func (s *Server) middleHandshake(conn net.Conn) (*middleConn, error) {
+	stream := newMiddleStream(conn)
+
+	nonce := make([]byte, 16)
+	if _, err := io.ReadFull(s.rand, nonce); err != nil {
+		return nil, errors.Wrap(err, "generate nonce")
+	}
+	ts := binary.LittleEndian.AppendUint32(nil, uint32(s.clock.Now().Unix()))
+	keySelector := s.middle.Secret[:4]
+
+	var req []byte
+	req = append(req, rpcNonce[:]...)
+	req = append(req, keySelector...)
+	req = append(req, rpcCryptoAES[:]...)
+	req = append(req, ts...)
+	req = append(req, nonce...)
+	if err := stream.writeFrame(req); err != nil {
+		return nil, errors.Wrap(err, "send nonce")
+	}
+
+	ans, err := stream.readFrame()
+	if err != nil {
+		return nil, errors.Wrap(err, "read nonce")
+	}
+	if len(ans) != 32 ||
+		!bytes.Equal(ans[:4], rpcNonce[:]) ||
+		!bytes.Equal(ans[4:8], keySelector) ||
+		!bytes.Equal(ans[8:12], rpcCryptoAES[:]) {
+		return nil, errors.New("invalid nonce answer")
+	}
+
+	clientAddr := tcpAddr(conn.LocalAddr())
+	if ip := s.middle.ExternalIP.To4(); ip != nil && clientAddr.IP.To4() != nil {
+		clientAddr = &net.TCPAddr{IP: ip, Port: clientAddr.Port}
+	}
+	params := middleKeyParams{
+		serverNonce: ans[16:32],
+		clientNonce: nonce,
+		clientTime:  ts,
+		serverAddr:  tcpAddr(conn.RemoteAddr()),
+		clientAddr:  clientAddr,
+		secret:      s.middle.Secret,
+	}
+	encKey, encIV := params.deriveKey(purposeClient)
+	decKey, decIV := params.deriveKey(purposeServer)
+	if err := stream.encrypt(encKey, encIV, decKey, decIV); err != nil {
+		return nil, errors.Wrap(err, "setup encryption")
+	}
+
+	var handshake []byte
+	handshake = append(handshake, rpcHandshake[:]...)
+	handshake = append(handshake, 0, 0, 0, 0) // flags
+	handshake = append(handshake, rpcProcessID...)
+	handshake = append(handshake, rpcProcessID...)
+	if err := stream.writeFrame(handshake); err != nil {
+		return nil, errors.Wrap(err, "send handshake")
+	}
+
+	ans, err = stream.readFrame()
+	if err != nil {
+		return nil, errors.Wrap(err, "read handshake")
+	}
+	if len(ans) != 32 ||
+		!bytes.Equal(ans[:4], rpcHandshake[:]) ||
+		!bytes.Equal(ans[20:32], rpcProcessID) {
+		return nil, errors.New("invalid handshake answer")
+	}
+
+	m := &middleConn{
+		conn:   conn,
+		stream: stream,
+	}
+	if _, err := io.ReadFull(s.rand, m.connID[:]); err != nil {
+		return nil, errors.Wrap(err, "generate connection id")
+	}
+	return m, nil
+}
+
+// send wraps client message to RPC_PROXY_REQ.
+func (m *middleConn) send(msg []byte) error {
+	flags := uint32(flagHasAdTag | flagMagic | flagExtMode2)
+	switch m.protocol {
+	case codec.Abridged{}.ObfuscatedTag():
+		flags |= flagAbridged
+	case codec.IntermediateClientStart:
+		flags |= flagIntermediate
+	case codec.PaddedIntermediateClientStart:
+		flags |= flagIntermediate | flagPad
+	}
+	if bytes.HasPrefix(msg, rpcNoEncrypt8[:]) {
+		flags |= flagNotEncrypted
+	}
+
+	b := make([]byte, 0, 4+4+8+20+20+4+4+1+len(m.adTag)+3+len(msg))
+	b = append(b, rpcProxyReq[:]...)
+	b = binary.LittleEndian.AppendUint32(b, flags)
+	b = append(b, m.connID[:]...)
+	b = append(b, m.clientAddr...)
+	b = append(b, m.proxyAddr...)
+	// Extra: proxy tag TL string, aligned to 4 bytes.
+	extra := append([]byte(nil), rpcProxyTag[:]...)
+	extra = append(extra, byte(len(m.adTag)))
+	extra = append(extra, m.adTag...)
+	for len(extra)%4 != 0 {
+		extra = append(extra, 0)
+	}
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(extra)))
+	b = append(b, extra...)
+	b = append(b, msg...)
+
+	return m.stream.writeFrame(b)
+}
+
+// recv reads answer from middle proxy.
+//
+// Returns io.EOF if middle proxy closed client connection.
+func (m *middleConn) recv() ([]byte, error) {
+	for {
+		ans, err := m.stream.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if len(ans) < 4 {
+			return nil, errors.Errorf("invalid answer length %d", len(ans))
+		}
+
+		var typ [4]byte
+		copy(typ[:], ans)
+		switch typ {
+		case rpcProxyAns:
+			// type (4) + flags (4) + conn_id (8) + data
+			if len(ans) < 16 {
+				return nil, errors.Errorf("invalid RPC_PROXY_ANS length %d", len(ans))
+			}
+			return ans[16:], nil
+		case rpcCloseExt:
+			return nil, io.EOF
+		case rpcSimpleAck, rpcUnknown:
+			// Quick acks are not requested, skip.
+			continue
+		default:
+			return nil, errors.Errorf("unexpected answer %x", typ)
+		}
+	}
+}
+
+func clientCodec(protocol [4]byte) codec.Codec {
+	switch protocol {
+	case codec.Abridged{}.ObfuscatedTag():
+		return codec.Abridged{}
+	case codec.IntermediateClientStart:
+		return codec.Intermediate{}
+	default:
+		return codec.PaddedIntermediate{}
+	}
+}
+
+// relayMiddle relays client connection through Telegram middle proxy.
+func (s *Server) relayMiddle(ctx context.Context, conn net.Conn, acc accepted) error {
+	m, err := s.dialMiddle(ctx, conn, acc)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = m.conn.Close()
+	}()
+
+	var (
+		stats = acc.secret.stats
+		cdc   = clientCodec(acc.protocol)
+	)
+	return pipe(conn, m.conn,
+		func() error {
+			var b bin.Buffer
+			for {
+				if err := cdc.Read(acc.rw, &b); err != nil {
+					return errors.Wrap(err, "read client")
+				}
+				n := int64(b.Len())
+				stats.bytesIn.Add(n)
+				s.metrics.traffic.Add(ctx, n, stats.inAttrs)
+
+				if err := m.send(b.Buf); err != nil {
+					return errors.Wrap(err, "send to middle proxy")
+				}
+			}
+		},
+		func() error {
+			for {
+				data, err := m.recv()
+				if err != nil {
+					return errors.Wrap(err, "read middle proxy")
+				}
+				n := int64(len(data))
+				stats.bytesOut.Add(n)
+				s.metrics.traffic.Add(ctx, n, stats.outAttrs)
+
+				if err := cdc.Write(acc.rw, &bin.Buffer{Buf: data}); err != nil {
+					return errors.Wrap(err, "write client")
+				}
+			}
+		},
+	)
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	// ProxySecretURL is an URL of Telegram middle proxy secret.
+	ProxySecretURL = "https://core.telegram.org/getProxySecret"
+	// ProxyConfigURL is an URL of Telegram middle proxy list.
+	ProxyConfigURL = "https://core.telegram.org/getProxyConfig"
+	// ProxyConfigV6URL is an URL of Telegram IPv6 middle proxy list.
+	ProxyConfigV6URL = "https://core.telegram.org/getProxyConfigV6"
+)
+
+// ParseProxyConfig parses Telegram middle proxy list.
+//
+// Config consists of `proxy_for <dc> <ip:port>;` directives, other
+// directives are ignored.
+func ParseProxyConfig(r io.Reader) (map[int][]string, error) {
+	addrs := map[int][]string{}
+
+	s := bufio.NewScanner(r)
+	line := 0
+	for s.Scan() {
+		line++
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimSuffix(text, ";"))
+		if fields[0] != "proxy_for" {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, errors.Errorf("line %d: invalid proxy_for directive", line)
+		}
+
+		dc, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d: parse DC ID", line)
+		}
+		if _, _, err := net.SplitHostPort(fields[2]); err != nil {
+			return nil, errors.Wrapf(err, "line %d: parse address", line)
+		}
+		addrs[dc] = append(addrs[dc], fields[2])
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrap(err, "scan")
+	}
+
+	return addrs, nil
+}
+
+func fetch(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create request")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send request")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+	return data, nil
+}
+
+// FetchMiddleProxy fetches Telegram middle proxy secret and list.
+//
+// If client is nil, http.DefaultClient is used.
+func FetchMiddleProxy(ctx context.Context, client *http.Client) (MiddleProxy, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	secret, err := fetch(ctx, client, ProxySecretURL)
+	if err != nil {
+		return MiddleProxy{}, errors.Wrap(err, "fetch secret")
+	}
+
+	config, err := fetch(ctx, client, ProxyConfigURL)
+	if err != nil {
+		return MiddleProxy{}, errors.Wrap(err, "fetch config")
+	}
+	addrs, err := ParseProxyConfig(bytes.NewReader(config))
+	if err != nil {
+		return MiddleProxy{}, errors.Wrap(err, "parse config")
+	}
+
+	return MiddleProxy{
+		Secret: secret,
+		Addrs:  addrs,
+	}, nil
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"encoding/hex"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/telegram/dcs"
+)
+
+// Secret is a served MTProxy secret.
+type Secret struct {
+	// Name of secret, used in logs and metrics.
+	// Defaults to hex-encoded secret.
+	Name string
+	// Secret to accept.
+	Secret mtproxy.Secret
+	// AdTag is a promoted channel tag received from @MTProxybot.
+	//
+	// If set, connections are relayed through Telegram middle proxies,
+	// so MiddleProxy options must be set too.
+	AdTag []byte
+}
+
+// MiddleProxy is a Telegram middle proxies configuration.
+//
+// See https://core.telegram.org/getProxySecret and https://core.telegram.org/getProxyConfig.
+type MiddleProxy struct {
+	// Secret is a proxy secret.
+	Secret []byte
+	// Addrs is a map of DC ID to middle proxy addresses.
+	Addrs map[int][]string
+	// ExternalIP is a public IPv4 address of this proxy.
+	//
+	// Middle proxy uses address in key derivation, so it must be set if
+	// proxy is behind NAT.
+	ExternalIP net.IP
+}
+
+// Options of Server.
+type Options struct {
+	// Secrets to accept. At least one secret is required.
+	Secrets []Secret
+	// MiddleProxy is a Telegram middle proxies configuration.
+	// Required only for secrets with ad tag.
+	MiddleProxy MiddleProxy
+
+	// List is a DC list to relay connections to.
+	// Defaults to dcs.Prod().
+	List dcs.List
+	// PreferIPv6 gives IPv6 DCs higher precedence.
+	PreferIPv6 bool
+	// Dial specifies the dial function for creating connections to the DCs,
+	// middle proxies and mask host.
+	// If Dial is nil, then the server dials using package net.
+	Dial dcs.DialFunc
+
+	// MaskHost is an address of host to forward unrecognized clients.
+	// Defaults to the cloak host of the first FakeTLS secret, port 443.
+	// If there is no FakeTLS secret, unrecognized clients are disconnected.
+	MaskHost string
+	// MaxTimeSkew is a maximum allowed difference between FakeTLS client time
+	// and server time.
+	// Defaults to 10 minutes.
+	MaxTimeSkew time.Duration
+	// HandshakeTimeout is a timeout of client handshake.
+	// Defaults to 10 seconds.
+	HandshakeTimeout time.Duration
+	// ReplayCacheSize is a size of cache of seen handshakes to detect replay attacks.
+	// Defaults to 100000.
+	ReplayCacheSize int
+
+	// Random is random source. Defaults to crypto.
+	Random io.Reader
+	// Clock is current time source. Defaults to system time.
+	Clock clock.Clock
+	// Logger is instance of zerolog.Logger. No logs by default.
+	Logger *zerolog.Logger
+	// MeterProvider to export traffic metrics.
+	// No metrics are exported by default.
+	MeterProvider metric.MeterProvider
+}
+
+func (opt *Options) setDefaults() {
+	for i := range opt.Secrets {
+		if opt.Secrets[i].Name == "" {
+			opt.Secrets[i].Name = hex.EncodeToString(opt.Secrets[i].Secret.Secret)
+		}
+	}
+	if opt.List.Zero() {
+		opt.List = dcs.Prod()
+	}
+	if opt.Dial == nil {
+		var d net.Dialer
+		opt.Dial = d.DialContext
+	}
+	if opt.MaskHost == "" {
+		for _, s := range opt.Secrets {
+			if s.Secret.Type == mtproxy.TLS && s.Secret.CloakHost != "" {
+				opt.MaskHost = net.JoinHostPort(s.Secret.CloakHost, "443")
+				break
+			}
+		}
+	}
+	if opt.MaxTimeSkew == 0 {
+		opt.MaxTimeSkew = 10 * time.Minute
+	}
+	if opt.HandshakeTimeout == 0 {
+		opt.HandshakeTimeout = 10 * time.Second
+	}
+	if opt.ReplayCacheSize == 0 {
+		opt.ReplayCacheSize = 100000
+	}
+	if opt.Random == nil {
+		opt.Random = crypto.DefaultRand()
+	}
+	if opt.Clock == nil {
+		opt.Clock = clock.System
+	}
+	if opt.Logger == nil {
+		nop := zerolog.Nop()
+		opt.Logger = &nop
+	}
+	if opt.MeterProvider == nil {
+		opt.MeterProvider = noop.NewMeterProvider()
+	}
+}
+
+func (opt Options) validate() error {
+	if len(opt.Secrets) == 0 {
+		return errors.New("at least one secret is required")
+	}
+
+	names := map[string]struct{}{}
+	for _, s := range opt.Secrets {
+		if _, ok := names[s.Name]; ok {
+			return errors.Errorf("duplicate secret name %q", s.Name)
+		}
+		names[s.Name] = struct{}{}
+
+		if len(s.Secret.Secret) != 16 {
+			return errors.Errorf("secret %q: invalid length %d", s.Name, len(s.Secret.Secret))
+		}
+		if len(s.AdTag) == 0 {
+			continue
+		}
+		if len(s.AdTag) != 16 {
+			return errors.Errorf("secret %q: invalid ad tag length %d", s.Name, len(s.AdTag))
+		}
+		if len(opt.MiddleProxy.Secret) == 0 || len(opt.MiddleProxy.Addrs) == 0 {
+			return errors.Errorf("secret %q: ad tag requires middle proxy configuration", s.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/obfuscator"
+	"github.com/gotd/td/telegram/dcs"
+	"github.com/gotd/td/tg"
+)
+
+// findDC returns DC options to relay given DC ID.
+//
+// Negative DC ID means media DC.
+func (s *Server) findDC(dc int) []tg.DCOption {
+	id := dc
+	if id < 0 {
+		id = -id
+	}
+
+	var candidates []tg.DCOption
+	if dc < 0 {
+		for _, opt := range dcs.FindDCs(s.list.Options, id, s.preferIPv6) {
+			if opt.MediaOnly && !opt.CDN {
+				candidates = append(candidates, opt)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = dcs.FindPrimaryDCs(s.list.Options, id, s.preferIPv6)
+	}
+
+	// Filter (in place) from SliceTricks.
+	n := 0
+	for _, opt := range candidates {
+		// Such DCs require secret, which we do not know.
+		if !opt.TCPObfuscatedOnly {
+			candidates[n] = opt
+			n++
+		}
+	}
+	return candidates[:n]
+}
+
+// dialDC connects to given DC using obfuscated2 protocol.
+func (s *Server) dialDC(ctx context.Context, dc int, protocol [4]byte) (_ *obfuscator.Conn, rErr error) {
+	candidates := s.findDC(dc)
+	if len(candidates) == 0 {
+		return nil, errors.Errorf("no addresses for DC %d", dc)
+	}
+
+	for _, opt := range candidates {
+		addr := net.JoinHostPort(opt.IPAddress, strconv.Itoa(opt.Port))
+		conn, err := s.dial(ctx, "tcp", addr)
+		if err != nil {
+			rErr = multierr.Append(rErr, err)
+			continue
+		}
+
+		obfsConn := obfuscator.Obfuscated2(s.rand, conn)
+		if err := obfsConn.Handshake(protocol, dc, mtproxy.Secret{
+			Type: mtproxy.Simple,
+		}); err != nil {
+			rErr = multierr.Append(rErr, multierr.Append(err, conn.Close()))
+			continue
+		}
+		return obfsConn, nil
+	}
+
+	return nil, errors.Wrapf(rErr, "dial DC %d", dc)
+}
+
+// relayDirect relays client connection to the DC as is.
+func (s *Server) relayDirect(ctx context.Context, conn net.Conn, acc accepted) error {
+	upstream, err := s.dialDC(ctx, acc.dc, acc.protocol)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = upstream.Close()
+	}()
+
+	var (
+		stats = acc.secret.stats
+		// Use obfuscator directly: io.Copy would use promoted
+		// (*net.TCPConn).WriteTo and bypass deobfuscation otherwise.
+		rw = upstream.Obfuscator
+	)
+	return pipe(conn, upstream,
+		func() error {
+			_, err := io.Copy(countingWriter{
+				ctx:     ctx,
+				w:       rw,
+				counter: &stats.bytesIn,
+				traffic: s.metrics.traffic,
+				attrs:   stats.inAttrs,
+			}, acc.rw)
+			return err
+		},
+		func() error {
+			_, err := io.Copy(countingWriter{
+				ctx:     ctx,
+				w:       acc.rw,
+				counter: &stats.bytesOut,
+				traffic: s.metrics.traffic,
+				attrs:   stats.outAttrs,
+			}, rw)
+			return err
+		},
+	)
+}
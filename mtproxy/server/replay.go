@@ -0,0 +1,49 @@
+package server
+
+import "sync"
+
+// replayCache remembers seen handshakes to detect replay attacks.
+//
+// Active probing tools may replay captured handshake to detect proxy.
+type replayCache struct {
+	seen  map[[16]byte]struct{}
+	queue [][16]byte
+	next  int
+	mux   sync.Mutex
+}
+
+func newReplayCache(size int) *replayCache {
+	if size < 0 {
+		size = 0
+	}
+	return &replayCache{
+		seen:  make(map[[16]byte]struct{}, size),
+		queue: make([][16]byte, 0, size),
+	}
+}
+
+// Add adds key to cache and returns false if key was already seen.
+func (r *replayCache) Add(key [16]byte) bool {
+	if cap(r.queue) == 0 {
+		// Cache is disabled.
+		return true
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if _, ok := r.seen[key]; ok {
+		return false
+	}
+
+	if len(r.queue) < cap(r.queue) {
+		r.queue = append(r.queue, key)
+	} else {
+		// Evict the oldest key.
+		delete(r.seen, r.queue[r.next])
+		r.queue[r.next] = key
+		r.next = (r.next + 1) % len(r.queue)
+	}
+	r.seen[key] = struct{}{}
+	return true
+}
@@ -0,0 +1,378 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/faketls"
+	"github.com/gotd/td/mtproxy/obfuscated2"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram/dcs"
+)
+
+// Server is a MTProxy server.
+type Server struct {
+	secrets []*servedSecret // immutable
+	hasTLS  bool            // immutable
+	middle  MiddleProxy     // immutable
+
+	list       dcs.List     // immutable
+	preferIPv6 bool         // immutable
+	dial       dcs.DialFunc // immutable
+
+	maskHost         string        // immutable
+	maxTimeSkew      time.Duration // immutable
+	handshakeTimeout time.Duration // immutable
+	replay           *replayCache
+
+	rand    io.Reader
+	clock   clock.Clock
+	log     *zerolog.Logger
+	metrics metrics
+}
+
+type servedSecret struct {
+	name   string
+	secret mtproxy.Secret
+	adTag  []byte
+	stats  *secretStats
+}
+
+// New creates new Server.
+func New(opts Options) (*Server, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, errors.Wrap(err, "validate options")
+	}
+
+	m, err := newMetrics(opts.MeterProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, "create metrics")
+	}
+
+	s := &Server{
+		middle:           opts.MiddleProxy,
+		list:             opts.List,
+		preferIPv6:       opts.PreferIPv6,
+		dial:             opts.Dial,
+		maskHost:         opts.MaskHost,
+		maxTimeSkew:      opts.MaxTimeSkew,
+		handshakeTimeout: opts.HandshakeTimeout,
+		replay:           newReplayCache(opts.ReplayCacheSize),
+		rand:             opts.Random,
+		clock:            opts.Clock,
+		log:              opts.Logger,
+		metrics:          m,
+	}
+	for _, secret := range opts.Secrets {
+		if secret.Secret.Type == mtproxy.TLS {
+			s.hasTLS = true
+		}
+		s.secrets = append(s.secrets, &servedSecret{
+			name:   secret.Name,
+			secret: secret.Secret,
+			adTag:  secret.AdTag,
+			stats:  newSecretStats(secret.Name),
+		})
+	}
+	return s, nil
+}
+
+// Stats returns traffic statistics of every secret.
+func (s *Server) Stats() []Stats {
+	r := make([]Stats, 0, len(s.secrets))
+	for _, secret := range s.secrets {
+		r = append(r, secret.stats.snapshot())
+	}
+	return r
+}
+
+// Serve accepts connections on given listener until context is done.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	s.log.Info().Str("addr", l.Addr().String()).Msg("Serving")
+	defer func() {
+		s.log.Info().Msg("Stopping")
+	}()
+
+	grp := tdsync.NewCancellableGroup(ctx)
+	grp.Go(func(ctx context.Context) error {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					return nil
+				}
+				return errors.Wrap(err, "accept")
+			}
+
+			grp.Go(func(ctx context.Context) error {
+				if err := s.serveConn(ctx, conn); err != nil {
+					s.log.Debug().Err(err).
+						Str("remote", conn.RemoteAddr().String()).
+						Msg("Connection error")
+				}
+				return nil
+			})
+		}
+	})
+	grp.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return l.Close()
+	})
+	return grp.Wait()
+}
+
+// errRejected is returned when client handshake is not recognized.
+var errRejected = errors.New("handshake rejected")
+
+// accepted is an accepted client connection.
+type accepted struct {
+	secret   *servedSecret
+	rw       io.ReadWriter
+	protocol [4]byte
+	dc       int
+}
+
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Close connection on shutdown to interrupt all reads and writes.
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	if err := conn.SetDeadline(s.clock.Now().Add(s.handshakeTimeout)); err != nil {
+		return errors.Wrap(err, "set handshake deadline")
+	}
+
+	// Record all received bytes to forward them to mask host
+	// if handshake is rejected.
+	head := &bytes.Buffer{}
+	acc, err := s.handshake(io.TeeReader(conn, head), conn)
+	if err != nil {
+		s.metrics.rejected.Add(ctx, 1)
+		if !errors.Is(err, errRejected) {
+			return errors.Wrap(err, "handshake")
+		}
+
+		s.log.Debug().Err(err).
+			Str("remote", conn.RemoteAddr().String()).
+			Msg("Handshake rejected, masking")
+		if err := conn.SetDeadline(time.Time{}); err != nil {
+			return errors.Wrap(err, "reset deadline")
+		}
+		return s.mask(ctx, conn, head.Bytes())
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return errors.Wrap(err, "reset deadline")
+	}
+
+	stats := acc.secret.stats
+	stats.connections.Inc()
+	stats.active.Inc()
+	s.metrics.connections.Add(ctx, 1, stats.attrs)
+	s.metrics.active.Add(ctx, 1, stats.attrs)
+	defer func() {
+		stats.active.Dec()
+		s.metrics.active.Add(ctx, -1, stats.attrs)
+	}()
+
+	s.log.Debug().
+		Str("remote", conn.RemoteAddr().String()).
+		Str("secret", acc.secret.name).
+		Int("dc", acc.dc).
+		Msg("Client accepted")
+
+	if len(acc.secret.adTag) > 0 {
+		return s.relayMiddle(ctx, conn, acc)
+	}
+	return s.relayDirect(ctx, conn, acc)
+}
+
+func (s *Server) handshake(r io.Reader, conn net.Conn) (accepted, error) {
+	// Record type and TLS 1.0 version, like in ClientHello record.
+	var first [3]byte
+	if _, err := io.ReadFull(r, first[:]); err != nil {
+		return accepted{}, errors.Wrapf(errRejected, "read first bytes: %s", err)
+	}
+	r = io.MultiReader(bytes.NewReader(first[:]), r)
+
+	if s.hasTLS &&
+		faketls.RecordType(first[0]) == faketls.RecordTypeHandshake &&
+		[2]byte{first[1], first[2]} == faketls.Version10Bytes {
+		return s.handshakeTLS(r, conn)
+	}
+	return s.handshakeObfuscated2(r, conn)
+}
+
+func (s *Server) handshakeTLS(r io.Reader, conn net.Conn) (accepted, error) {
+	hello, err := faketls.ReadClientHello(r)
+	if err != nil {
+		return accepted{}, errors.Wrapf(errRejected, "read ClientHello: %s", err)
+	}
+
+	now := s.clock.Now()
+	for _, secret := range s.secrets {
+		if secret.secret.Type != mtproxy.TLS {
+			continue
+		}
+
+		ts, err := hello.Verify(secret.secret.Secret)
+		if err != nil {
+			continue
+		}
+		if host := secret.secret.CloakHost; host != "" && hello.Domain != host {
+			return accepted{}, errors.Wrapf(errRejected, "unexpected domain %q", hello.Domain)
+		}
+		if skew := now.Sub(ts); skew > s.maxTimeSkew || skew < -s.maxTimeSkew {
+			return accepted{}, errors.Wrapf(errRejected, "time skew %s", skew)
+		}
+		var key [16]byte
+		copy(key[:], hello.Random[:])
+		if !s.replay.Add(key) {
+			return accepted{}, errors.Wrap(errRejected, "replayed ClientHello")
+		}
+
+		if err := faketls.WriteServerHello(conn, s.rand, hello, secret.secret.Secret); err != nil {
+			return accepted{}, errors.Wrap(err, "send ServerHello")
+		}
+
+		rw, meta, err := obfuscated2.Accept(faketls.NewServerFakeTLS(s.rand, conn), secret.secret.Secret)
+		if err != nil {
+			return accepted{}, errors.Wrap(err, "obfuscated2 handshake")
+		}
+		if !validProtocol(meta.Protocol) {
+			return accepted{}, errors.Errorf("unknown protocol %x", meta.Protocol)
+		}
+
+		return accepted{
+			secret:   secret,
+			rw:       rw,
+			protocol: meta.Protocol,
+			dc:       int(int16(meta.DC)),
+		}, nil
+	}
+
+	return accepted{}, errors.Wrap(errRejected, "no matching TLS secret")
+}
+
+type readWriter struct {
+	io.Reader
+	io.Writer
+}
+
+func (s *Server) handshakeObfuscated2(r io.Reader, conn net.Conn) (accepted, error) {
+	header := make([]byte, 64)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return accepted{}, errors.Wrapf(errRejected, "read header: %s", err)
+	}
+
+	for _, secret := range s.secrets {
+		if secret.secret.Type == mtproxy.TLS {
+			continue
+		}
+
+		rw, meta, err := obfuscated2.Accept(readWriter{
+			Reader: io.MultiReader(bytes.NewReader(header), conn),
+			Writer: conn,
+		}, secret.secret.Secret)
+		if err != nil {
+			continue
+		}
+		if !validProtocol(meta.Protocol) {
+			continue
+		}
+		if secret.secret.Type == mtproxy.Secured && meta.Protocol != codec.PaddedIntermediateClientStart {
+			continue
+		}
+
+		var key [16]byte
+		copy(key[:], header[8:])
+		if !s.replay.Add(key) {
+			return accepted{}, errors.Wrap(errRejected, "replayed header")
+		}
+
+		return accepted{
+			secret:   secret,
+			rw:       rw,
+			protocol: meta.Protocol,
+			dc:       int(int16(meta.DC)),
+		}, nil
+	}
+
+	return accepted{}, errors.Wrap(errRejected, "no matching secret")
+}
+
+func validProtocol(p [4]byte) bool {
+	switch p {
+	case codec.Abridged{}.ObfuscatedTag(),
+		codec.IntermediateClientStart,
+		codec.PaddedIntermediateClientStart:
+		return true
+	default:
+		return false
+	}
+}
+
+// mask forwards unrecognized client to the mask host, so proxy looks like
+// a regular web server for active probing.
+func (s *Server) mask(ctx context.Context, conn net.Conn, head []byte) error {
+	if s.maskHost == "" {
+		return nil
+	}
+
+	upstream, err := s.dial(ctx, "tcp", s.maskHost)
+	if err != nil {
+		return errors.Wrapf(err, "dial mask host %q", s.maskHost)
+	}
+	defer func() {
+		_ = upstream.Close()
+	}()
+
+	if _, err := upstream.Write(head); err != nil {
+		return errors.Wrap(err, "write head")
+	}
+
+	return pipe(conn, upstream,
+		func() error {
+			_, err := io.Copy(upstream, conn)
+			return err
+		},
+		func() error {
+			_, err := io.Copy(conn, upstream)
+			return err
+		},
+	)
+}
+
+// pipe runs both relay directions and closes connections when
+// one of them is done.
+func pipe(client, upstream io.Closer, in, out func() error) error {
+	errs := make(chan error, 2)
+	for _, f := range []func() error{in, out} {
+		f := f
+		go func() {
+			err := f()
+			_ = client.Close()
+			_ = upstream.Close()
+			errs <- err
+		}()
+	}
+
+	err := <-errs
+	<-errs
+	if errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,354 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/obfuscated2"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/telegram/dcs"
+	"github.com/gotd/td/tg"
+)
+
+func listen(t *testing.T) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+	return l
+}
+
+func serveEach(l net.Listener, f func(conn net.Conn)) {
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() {
+					_ = conn.Close()
+				}()
+				f(conn)
+			}()
+		}
+	}()
+}
+
+func echoCodec(rw io.ReadWriter, cdc codec.Codec) {
+	var b bin.Buffer
+	for {
+		if err := cdc.Read(rw, &b); err != nil {
+			return
+		}
+		if err := cdc.Write(rw, &b); err != nil {
+			return
+		}
+	}
+}
+
+// fakeDC starts echo server which accepts obfuscated2 connections.
+func fakeDC(t *testing.T) dcs.List {
+	l := listen(t)
+	serveEach(l, func(conn net.Conn) {
+		rw, meta, err := obfuscated2.Accept(conn, nil)
+		if err != nil {
+			return
+		}
+		echoCodec(rw, clientCodec(meta.Protocol))
+	})
+
+	addr := l.Addr().(*net.TCPAddr)
+	return dcs.List{
+		Options: []tg.DCOption{
+			{ID: 2, IPAddress: addr.IP.String(), Port: addr.Port},
+		},
+	}
+}
+
+func startServer(t *testing.T, opts Options) (*Server, string) {
+	lg := zerolog.New(zerolog.NewTestWriter(t)).Level(zerolog.DebugLevel)
+	opts.Logger = &lg
+	s, err := New(opts)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l := listen(t)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = s.Serve(ctx, l)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return s, l.Addr().String()
+}
+
+func parseSecret(t *testing.T, secret []byte) mtproxy.Secret {
+	s, err := mtproxy.ParseSecret(secret)
+	require.NoError(t, err)
+	return s
+}
+
+func testEcho(t *testing.T, addr string, secret []byte) {
+	a := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := dcs.MTProxy(addr, secret, dcs.MTProxyOptions{})
+	a.NoError(err)
+
+	conn, err := r.Primary(ctx, 2, dcs.List{})
+	a.NoError(err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	for i := 0; i < 3; i++ {
+		payload := bytes.Repeat([]byte{byte(i + 1)}, 1024*(i+1))
+		a.NoError(conn.Send(ctx, &bin.Buffer{Buf: payload}))
+
+		var b bin.Buffer
+		a.NoError(conn.Recv(ctx, &b))
+		a.Equal(payload, b.Buf)
+	}
+}
+
+func TestServer(t *testing.T) {
+	key := bytes.Repeat([]byte{0x42}, 16)
+	secured := append([]byte{0xdd}, key...)
+	tls := append(append([]byte{0xee}, key...), "example.com"...)
+
+	for _, tt := range []struct {
+		name   string
+		secret []byte
+	}{
+		{"Simple", key},
+		{"Secured", secured},
+		{"TLS", tls},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s, addr := startServer(t, Options{
+				Secrets: []Secret{
+					{Name: "test", Secret: parseSecret(t, tt.secret)},
+				},
+				List:     fakeDC(t),
+				MaskHost: "127.0.0.1:1",
+			})
+			testEcho(t, addr, tt.secret)
+
+			stats := s.Stats()
+			require.Len(t, stats, 1)
+			require.Equal(t, "test", stats[0].Name)
+			require.Equal(t, int64(1), stats[0].Connections)
+			require.NotZero(t, stats[0].BytesIn)
+			require.NotZero(t, stats[0].BytesOut)
+		})
+	}
+}
+
+func TestServerMask(t *testing.T) {
+	a := require.New(t)
+
+	mask := listen(t)
+	serveEach(mask, func(conn net.Conn) {
+		_, _ = io.Copy(conn, conn)
+	})
+
+	key := bytes.Repeat([]byte{0x42}, 16)
+	_, addr := startServer(t, Options{
+		Secrets: []Secret{
+			{Secret: parseSecret(t, append(append([]byte{0xee}, key...), "example.com"...))},
+		},
+		List:     fakeDC(t),
+		MaskHost: mask.Addr().String(),
+	})
+
+	conn, err := net.Dial("tcp", addr)
+	a.NoError(err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// Prober should get response from the mask host.
+	req := []byte("GET / HTTP/1.1\r\nHost: example.com\r\n\r\n" + strings.Repeat("a", 64))
+	_, err = conn.Write(req)
+	a.NoError(err)
+
+	resp := make([]byte, len(req))
+	_, err = io.ReadFull(conn, resp)
+	a.NoError(err)
+	a.Equal(req, resp)
+}
+
+func TestReplayCache(t *testing.T) {
+	a := require.New(t)
+	c := newReplayCache(2)
+
+	a.True(c.Add([16]byte{1}))
+	a.False(c.Add([16]byte{1}))
+	a.True(c.Add([16]byte{2}))
+	a.True(c.Add([16]byte{3}))
+	// Oldest key is evicted.
+	a.True(c.Add([16]byte{1}))
+	a.False(c.Add([16]byte{3}))
+
+	disabled := newReplayCache(-1)
+	a.True(disabled.Add([16]byte{1}))
+	a.True(disabled.Add([16]byte{1}))
+}
+
+// fakeMiddleProxy starts Telegram middle proxy which echoes all messages.
+func fakeMiddleProxy(t *testing.T, secret []byte) string {
+	l := listen(t)
+	serveEach(l, func(conn net.Conn) {
+		_ = serveMiddle(conn, secret)
+	})
+	return l.Addr().String()
+}
+
+func serveMiddle(conn net.Conn, secret []byte) error {
+	stream := newMiddleStream(conn)
+
+	req, err := stream.readFrame()
+	if err != nil {
+		return err
+	}
+	if len(req) != 32 || !bytes.Equal(req[:4], rpcNonce[:]) {
+		return io.ErrUnexpectedEOF
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	ans := append(append([]byte(nil), req[:16]...), nonce...)
+	if err := stream.writeFrame(ans); err != nil {
+		return err
+	}
+
+	params := middleKeyParams{
+		serverNonce: nonce,
+		clientNonce: req[16:32],
+		clientTime:  req[12:16],
+		serverAddr:  tcpAddr(conn.LocalAddr()),
+		clientAddr:  tcpAddr(conn.RemoteAddr()),
+		secret:      secret,
+	}
+	encKey, encIV := params.deriveKey(purposeServer)
+	decKey, decIV := params.deriveKey(purposeClient)
+	if err := stream.encrypt(encKey, encIV, decKey, decIV); err != nil {
+		return err
+	}
+
+	handshake, err := stream.readFrame()
+	if err != nil {
+		return err
+	}
+	if err := stream.writeFrame(handshake); err != nil {
+		return err
+	}
+
+	for {
+		req, err := stream.readFrame()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(req[:4], rpcProxyReq[:]) {
+			return io.ErrUnexpectedEOF
+		}
+		// type (4) + flags (4) + conn_id (8) + client (20) + proxy (20).
+		connID := req[8:16]
+		extraSize := int(binary.LittleEndian.Uint32(req[56:60]))
+		extra := req[60 : 60+extraSize]
+		if !bytes.Equal(extra[:4], rpcProxyTag[:]) {
+			return io.ErrUnexpectedEOF
+		}
+		msg := req[60+extraSize:]
+
+		var resp []byte
+		resp = append(resp, rpcProxyAns[:]...)
+		resp = append(resp, 0, 0, 0, 0)
+		resp = append(resp, connID...)
+		resp = append(resp, msg...)
+		if err := stream.writeFrame(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func TestServerMiddleProxy(t *testing.T) {
+	middleSecret := make([]byte, 128)
+	_, err := rand.Read(middleSecret)
+	require.NoError(t, err)
+
+	key := bytes.Repeat([]byte{0x42}, 16)
+	secret := append([]byte{0xdd}, key...)
+	_, addr := startServer(t, Options{
+		Secrets: []Secret{
+			{
+				Secret: parseSecret(t, secret),
+				AdTag:  bytes.Repeat([]byte{0x01}, 16),
+			},
+		},
+		MiddleProxy: MiddleProxy{
+			Secret: middleSecret,
+			Addrs: map[int][]string{
+				2: {fakeMiddleProxy(t, middleSecret)},
+			},
+		},
+	})
+	testEcho(t, addr, secret)
+}
+
+func TestParseProxyConfig(t *testing.T) {
+	a := require.New(t)
+
+	addrs, err := ParseProxyConfig(strings.NewReader(`# force_probability 10 10
+default 2;
+proxy_for 1 149.154.175.50:8888;
+proxy_for -1 149.154.175.50:8888;
+proxy_for 2 149.154.161.144:8888;
+proxy_for 2 149.154.162.123:8888;
+`))
+	a.NoError(err)
+	a.Equal(map[int][]string{
+		1:  {"149.154.175.50:8888"},
+		-1: {"149.154.175.50:8888"},
+		2:  {"149.154.161.144:8888", "149.154.162.123:8888"},
+	}, addrs)
+
+	_, err = ParseProxyConfig(strings.NewReader("proxy_for 1;"))
+	a.Error(err)
+	_, err = ParseProxyConfig(strings.NewReader("proxy_for a 149.154.175.50:8888;"))
+	a.Error(err)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	key := bytes.Repeat([]byte{0x42}, 16)
+	for _, opts := range []Options{
+		{},
+		{Secrets: []Secret{{Secret: mtproxy.Secret{Secret: key[:8]}}}},
+		{Secrets: []Secret{{Name: "a", Secret: mtproxy.Secret{Secret: key}}, {Name: "a", Secret: mtproxy.Secret{Secret: key}}}},
+		{Secrets: []Secret{{Secret: mtproxy.Secret{Secret: key}, AdTag: key}}},
+	} {
+		_, err := New(opts)
+		require.Error(t, err)
+	}
+}
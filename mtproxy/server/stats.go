@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/atomic"
+)
+
+// Stats is a traffic statistics of a secret.
+type Stats struct {
+	// Name of secret.
+	Name string `json:"name"`
+	// Connections is a total count of accepted connections.
+	Connections int64 `json:"connections"`
+	// Active is a count of currently active connections.
+	Active int64 `json:"active"`
+	// BytesIn is a count of bytes received from clients.
+	BytesIn int64 `json:"bytes_in"`
+	// BytesOut is a count of bytes sent to clients.
+	BytesOut int64 `json:"bytes_out"`
+}
+
+type secretStats struct {
+	name        string
+	connections atomic.Int64
+	active      atomic.Int64
+	bytesIn     atomic.Int64
+	bytesOut    atomic.Int64
+
+	attrs    metric.MeasurementOption
+	inAttrs  metric.MeasurementOption
+	outAttrs metric.MeasurementOption
+}
+
+func newSecretStats(name string) *secretStats {
+	secret := attribute.String("secret", name)
+	return &secretStats{
+		name:     name,
+		attrs:    metric.WithAttributes(secret),
+		inAttrs:  metric.WithAttributes(secret, attribute.String("direction", "in")),
+		outAttrs: metric.WithAttributes(secret, attribute.String("direction", "out")),
+	}
+}
+
+func (s *secretStats) snapshot() Stats {
+	return Stats{
+		Name:        s.name,
+		Connections: s.connections.Load(),
+		Active:      s.active.Load(),
+		BytesIn:     s.bytesIn.Load(),
+		BytesOut:    s.bytesOut.Load(),
+	}
+}
+
+type metrics struct {
+	connections metric.Int64Counter
+	active      metric.Int64UpDownCounter
+	traffic     metric.Int64Counter
+	rejected    metric.Int64Counter
+}
+
+const meterName = "github.com/gotd/td/mtproxy/server"
+
+func newMetrics(p metric.MeterProvider) (m metrics, err error) {
+	meter := p.Meter(meterName)
+	if m.connections, err = meter.Int64Counter("mtproxy.connections",
+		metric.WithDescription("Total count of accepted connections"),
+	); err != nil {
+		return m, err
+	}
+	if m.active, err = meter.Int64UpDownCounter("mtproxy.connections.active",
+		metric.WithDescription("Count of active connections"),
+	); err != nil {
+		return m, err
+	}
+	if m.traffic, err = meter.Int64Counter("mtproxy.traffic",
+		metric.WithDescription("Relayed traffic"),
+		metric.WithUnit("By"),
+	); err != nil {
+		return m, err
+	}
+	if m.rejected, err = meter.Int64Counter("mtproxy.rejected",
+		metric.WithDescription("Count of rejected or masked connections"),
+	); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+// countingWriter counts written bytes of one direction.
+type countingWriter struct {
+	ctx     context.Context
+	w       io.Writer
+	counter *atomic.Int64
+	traffic metric.Int64Counter
+	attrs   metric.MeasurementOption
+}
+
+func (c countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	if n > 0 {
+		c.counter.Add(int64(n))
+		c.traffic.Add(c.ctx, int64(n), c.attrs)
+	}
+	return n, err
+}
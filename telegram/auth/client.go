@@ -28,3 +28,9 @@ func NewClient(
 		appHash: appHash,
 	}
 }
+
+var (
+	_ FlowClient                 = (*Client)(nil)
+	_ FlowEmailClient            = (*Client)(nil)
+	_ FlowPasswordRecoveryClient = (*Client)(nil)
+)
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// SetUpEmail sends verification code to email which will be used as login
+// email.
+//
+// Should be called if SendCode returned tg.AuthSentCodeTypeSetUpEmailRequired.
+// Use VerifyEmail to provide received code.
+//
+// See https://core.telegram.org/api/auth#email-verification.
+func (c *Client) SetUpEmail(ctx context.Context, phone, email, codeHash string) (*tg.AccountSentEmailCode, error) {
+	sent, err := c.api.AccountSendVerifyEmailCode(ctx, &tg.AccountSendVerifyEmailCodeRequest{
+		Purpose: &tg.EmailVerifyPurposeLoginSetup{
+			PhoneNumber:   phone,
+			PhoneCodeHash: codeHash,
+		},
+		Email: email,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "send verify email code")
+	}
+	return sent, nil
+}
+
+// VerifyEmail verifies login email set up by SetUpEmail.
+//
+// Returned sent code should be used to continue authentication flow as if
+// it was returned by SendCode.
+//
+// See https://core.telegram.org/api/auth#email-verification.
+func (c *Client) VerifyEmail(ctx context.Context, phone, code, codeHash string) (tg.AuthSentCodeClass, error) {
+	r, err := c.api.AccountVerifyEmail(ctx, &tg.AccountVerifyEmailRequest{
+		Purpose: &tg.EmailVerifyPurposeLoginSetup{
+			PhoneNumber:   phone,
+			PhoneCodeHash: codeHash,
+		},
+		Verification: &tg.EmailVerificationCode{Code: code},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "verify email")
+	}
+
+	login, ok := r.(*tg.AccountEmailVerifiedLogin)
+	if !ok {
+		return nil, errors.Errorf("got unexpected response %T", r)
+	}
+	return login.SentCode, nil
+}
+
+// SignInEmail performs sign in with provided user phone, code sent to email
+// and code hash.
+//
+// Should be used instead of SignIn if SendCode returned
+// tg.AuthSentCodeTypeEmailCode.
+//
+// If ErrPasswordAuthNeeded is returned, call Password to provide 2FA
+// password.
+func (c *Client) SignInEmail(ctx context.Context, phone, code, codeHash string) (*tg.AuthAuthorization, error) {
+	return c.signIn(ctx, &tg.AuthSignInRequest{
+		PhoneNumber:       phone,
+		PhoneCodeHash:     codeHash,
+		EmailVerification: &tg.EmailVerificationCode{Code: code},
+	})
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+type testEmailAuth struct {
+	UserAuthenticator
+	email string
+	codes []string
+}
+
+func (e *testEmailAuth) Email(ctx context.Context) (string, error) {
+	return e.email, nil
+}
+
+func (e *testEmailAuth) EmailCode(ctx context.Context, emailPattern string, length int) (string, error) {
+	code := e.codes[0]
+	e.codes = e.codes[1:]
+	return code, nil
+}
+
+func TestFlow_Email(t *testing.T) {
+	const (
+		phone    = "123123"
+		email    = "user@example.com"
+		codeHash = "hash"
+	)
+	ctx := context.Background()
+	purpose := &tg.EmailVerifyPurposeLoginSetup{
+		PhoneNumber:   phone,
+		PhoneCodeHash: codeHash,
+	}
+
+	mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		mock.ExpectCall(&tg.AuthSendCodeRequest{
+			PhoneNumber: phone,
+			APIID:       testAppID,
+			APIHash:     testAppHash,
+		}).ThenResult(&tg.AuthSentCode{
+			Type:          &tg.AuthSentCodeTypeSetUpEmailRequired{},
+			PhoneCodeHash: codeHash,
+		})
+		mock.ExpectCall(&tg.AccountSendVerifyEmailCodeRequest{
+			Purpose: purpose,
+			Email:   email,
+		}).ThenResult(&tg.AccountSentEmailCode{
+			EmailPattern: "u***@example.com",
+			Length:       6,
+		})
+		mock.ExpectCall(&tg.AccountVerifyEmailRequest{
+			Purpose:      purpose,
+			Verification: &tg.EmailVerificationCode{Code: "111111"},
+		}).ThenResult(&tg.AccountEmailVerifiedLogin{
+			Email: email,
+			SentCode: &tg.AuthSentCode{
+				Type: &tg.AuthSentCodeTypeEmailCode{
+					EmailPattern: "u***@example.com",
+					Length:       6,
+				},
+				PhoneCodeHash: codeHash,
+			},
+		})
+		mock.ExpectCall(&tg.AuthSignInRequest{
+			PhoneNumber:       phone,
+			PhoneCodeHash:     codeHash,
+			EmailVerification: &tg.EmailVerificationCode{Code: "222222"},
+		}).ThenResult(&tg.AuthAuthorization{
+			User: &tg.User{ID: 1},
+		})
+
+		a.NoError(NewFlow(&testEmailAuth{
+			UserAuthenticator: CodeOnly(phone, nil),
+			email:             email,
+			codes:             []string{"111111", "222222"},
+		}, SendCodeOptions{}).Run(ctx, client))
+	})(t)
+
+	mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		mock.ExpectCall(&tg.AuthSendCodeRequest{
+			PhoneNumber: phone,
+			APIID:       testAppID,
+			APIHash:     testAppHash,
+		}).ThenResult(&tg.AuthSentCode{
+			Type:          &tg.AuthSentCodeTypeSetUpEmailRequired{},
+			PhoneCodeHash: codeHash,
+		})
+
+		// Email setup is not supported by plain UserAuthenticator.
+		a.Error(NewFlow(CodeOnly(phone, nil), SendCodeOptions{}).Run(ctx, client))
+	})(t)
+}
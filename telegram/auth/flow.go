@@ -28,6 +28,21 @@ type Flow struct {
 	Options SendCodeOptions
 }
 
+func (f Flow) authorized(ctx context.Context, a *tg.AuthAuthorization) error {
+	saver, ok := f.Auth.(FutureAuthTokenSaver)
+	if !ok || a == nil {
+		return nil
+	}
+	token, ok := a.GetFutureAuthToken()
+	if !ok {
+		return nil
+	}
+	if err := saver.SaveFutureAuthToken(ctx, token); err != nil {
+		return errors.Wrap(err, "save future auth token")
+	}
+	return nil
+}
+
 func (f Flow) handleSignUp(ctx context.Context, client FlowClient, phone, hash string, s *SignUpRequired) error {
 	if err := f.Auth.AcceptTermsOfService(ctx, s.TermsOfService); err != nil {
 		return errors.Wrap(err, "confirm TOS")
@@ -36,66 +51,155 @@ func (f Flow) handleSignUp(ctx context.Context, client FlowClient, phone, hash s
 	if err != nil {
 		return errors.Wrap(err, "sign up info not provided")
 	}
-	if _, err := client.SignUp(ctx, SignUp{
+	a, err := client.SignUp(ctx, SignUp{
 		PhoneNumber:   phone,
 		PhoneCodeHash: hash,
 		FirstName:     info.FirstName,
 		LastName:      info.LastName,
-	}); err != nil {
+	})
+	if err != nil {
 		return errors.Wrap(err, "sign up")
 	}
-	return nil
+	return f.authorized(ctx, a)
 }
 
-// Run starts authentication flow on client.
-func (f Flow) Run(ctx context.Context, client FlowClient) error {
-	if f.Auth == nil {
-		return errors.New("no UserAuthenticator provided")
+func (f Flow) handlePassword(ctx context.Context, client FlowClient) error {
+	password, err := f.Auth.Password(ctx)
+	if errors.Is(err, ErrPasswordNotProvided) {
+		if r, ok := f.Auth.(PasswordRecoveryAuthenticator); ok {
+			return f.handlePasswordRecovery(ctx, client, r)
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, "get password")
 	}
+	a, err := client.Password(ctx, password)
+	if err != nil {
+		return errors.Wrap(err, "sign in with password")
+	}
+	return f.authorized(ctx, a)
+}
 
-	phone, err := f.Auth.Phone(ctx)
+func (f Flow) handlePasswordRecovery(ctx context.Context, client FlowClient, r PasswordRecoveryAuthenticator) error {
+	rc, ok := client.(FlowPasswordRecoveryClient)
+	if !ok {
+		return errors.Errorf("password recovery is not supported by %T", client)
+	}
+
+	pattern, err := rc.RequestPasswordRecovery(ctx)
 	if err != nil {
-		return errors.Wrap(err, "get phone")
+		return errors.Wrap(err, "request password recovery")
+	}
+	code, err := r.RecoveryCode(ctx, pattern)
+	if err != nil {
+		return errors.Wrap(err, "get recovery code")
+	}
+	newPassword, err := r.NewPassword(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get new password")
+	}
+	a, err := rc.RecoverPassword(ctx, code, RecoverPasswordOptions{
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return errors.Wrap(err, "recover password")
+	}
+	return f.authorized(ctx, a)
+}
+
+func (f Flow) emailClient(client FlowClient) (FlowEmailClient, error) {
+	ec, ok := client.(FlowEmailClient)
+	if !ok {
+		return nil, errors.Errorf("email login is not supported by %T", client)
 	}
+	return ec, nil
+}
 
-	sentCode, err := client.SendCode(ctx, phone, f.Options)
+func (f Flow) handleSetUpEmail(ctx context.Context, client FlowClient, phone string, s *tg.AuthSentCode) error {
+	ea, ok := f.Auth.(EmailAuthenticator)
+	if !ok {
+		return errors.New("email setup required, but EmailAuthenticator is not implemented")
+	}
+	ec, err := f.emailClient(client)
 	if err != nil {
-		return errors.Wrap(err, "send code")
+		return err
 	}
-	switch s := sentCode.(type) {
-	case *tg.AuthSentCode:
-		hash := s.PhoneCodeHash
-		code, err := f.Auth.Code(ctx, s)
+
+	email, err := ea.Email(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get email")
+	}
+	sent, err := ec.SetUpEmail(ctx, phone, email, s.PhoneCodeHash)
+	if err != nil {
+		return errors.Wrap(err, "set up email")
+	}
+	code, err := ea.EmailCode(ctx, sent.EmailPattern, sent.Length)
+	if err != nil {
+		return errors.Wrap(err, "get email code")
+	}
+	sentCode, err := ec.VerifyEmail(ctx, phone, code, s.PhoneCodeHash)
+	if err != nil {
+		return errors.Wrap(err, "verify email")
+	}
+	return f.handleSentCode(ctx, client, phone, sentCode)
+}
+
+func (f Flow) signIn(ctx context.Context, client FlowClient, phone string, s *tg.AuthSentCode) error {
+	var (
+		a         *tg.AuthAuthorization
+		signInErr error
+	)
+	switch t := s.Type.(type) {
+	case *tg.AuthSentCodeTypeSetUpEmailRequired:
+		return f.handleSetUpEmail(ctx, client, phone, s)
+	case *tg.AuthSentCodeTypeEmailCode:
+		ec, err := f.emailClient(client)
 		if err != nil {
-			return errors.Wrap(err, "get code")
+			return err
 		}
 
-		_, signInErr := client.SignIn(ctx, phone, code, hash)
-		if errors.Is(signInErr, ErrPasswordAuthNeeded) {
-			password, err := f.Auth.Password(ctx)
-			if err != nil {
-				return errors.Wrap(err, "get password")
-			}
-			if _, err := client.Password(ctx, password); err != nil {
-				return errors.Wrap(err, "sign in with password")
-			}
-			return nil
+		var code string
+		if ea, ok := f.Auth.(EmailAuthenticator); ok {
+			code, err = ea.EmailCode(ctx, t.EmailPattern, t.Length)
+		} else {
+			code, err = f.Auth.Code(ctx, s)
 		}
-		var signUpRequired *SignUpRequired
-		if errors.As(signInErr, &signUpRequired) {
-			return f.handleSignUp(ctx, client, phone, hash, signUpRequired)
+		if err != nil {
+			return errors.Wrap(err, "get email code")
 		}
-
-		if signInErr != nil {
-			return errors.Wrap(signInErr, "sign in")
+		a, signInErr = ec.SignInEmail(ctx, phone, code, s.PhoneCodeHash)
+	default:
+		code, err := f.Auth.Code(ctx, s)
+		if err != nil {
+			return errors.Wrap(err, "get code")
 		}
+		a, signInErr = client.SignIn(ctx, phone, code, s.PhoneCodeHash)
+	}
 
-		return nil
+	if errors.Is(signInErr, ErrPasswordAuthNeeded) {
+		return f.handlePassword(ctx, client)
+	}
+	var signUpRequired *SignUpRequired
+	if errors.As(signInErr, &signUpRequired) {
+		return f.handleSignUp(ctx, client, phone, s.PhoneCodeHash, signUpRequired)
+	}
+
+	if signInErr != nil {
+		return errors.Wrap(signInErr, "sign in")
+	}
+
+	return f.authorized(ctx, a)
+}
+
+func (f Flow) handleSentCode(ctx context.Context, client FlowClient, phone string, sentCode tg.AuthSentCodeClass) error {
+	switch s := sentCode.(type) {
+	case *tg.AuthSentCode:
+		return f.signIn(ctx, client, phone, s)
 	case *tg.AuthSentCodeSuccess:
 		switch a := s.Authorization.(type) {
 		case *tg.AuthAuthorization:
-			// Looks that we are already authorized.
-			return nil
+			// Looks that we are already authorized, e.g. by future auth token.
+			return f.authorized(ctx, a)
 		case *tg.AuthAuthorizationSignUpRequired:
 			if err := f.handleSignUp(ctx, client, phone, "", &SignUpRequired{
 				TermsOfService: a.TermsOfService,
@@ -112,6 +216,33 @@ func (f Flow) Run(ctx context.Context, client FlowClient) error {
 	}
 }
 
+// Run starts authentication flow on client.
+func (f Flow) Run(ctx context.Context, client FlowClient) error {
+	if f.Auth == nil {
+		return errors.New("no UserAuthenticator provided")
+	}
+
+	phone, err := f.Auth.Phone(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get phone")
+	}
+
+	opts := f.Options
+	if l, ok := f.Auth.(FutureAuthTokenLoader); ok {
+		tokens, err := l.FutureAuthTokens(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get future auth tokens")
+		}
+		opts.LogoutTokens = append(append([][]byte(nil), opts.LogoutTokens...), tokens...)
+	}
+
+	sentCode, err := client.SendCode(ctx, phone, opts)
+	if err != nil {
+		return errors.Wrap(err, "send code")
+	}
+	return f.handleSentCode(ctx, client, phone, sentCode)
+}
+
 // FlowClient abstracts telegram client for Flow.
 type FlowClient interface {
 	SignIn(ctx context.Context, phone, code, codeHash string) (*tg.AuthAuthorization, error)
@@ -120,6 +251,21 @@ type FlowClient interface {
 	SignUp(ctx context.Context, s SignUp) (*tg.AuthAuthorization, error)
 }
 
+// FlowEmailClient is an optional interface of FlowClient which enables
+// email login in Flow.
+type FlowEmailClient interface {
+	SetUpEmail(ctx context.Context, phone, email, codeHash string) (*tg.AccountSentEmailCode, error)
+	VerifyEmail(ctx context.Context, phone, code, codeHash string) (tg.AuthSentCodeClass, error)
+	SignInEmail(ctx context.Context, phone, code, codeHash string) (*tg.AuthAuthorization, error)
+}
+
+// FlowPasswordRecoveryClient is an optional interface of FlowClient which
+// enables 2FA password recovery in Flow.
+type FlowPasswordRecoveryClient interface {
+	RequestPasswordRecovery(ctx context.Context) (string, error)
+	RecoverPassword(ctx context.Context, code string, opts RecoverPasswordOptions) (*tg.AuthAuthorization, error)
+}
+
 // CodeAuthenticator asks user for received authentication code.
 type CodeAuthenticator interface {
 	Code(ctx context.Context, sentCode *tg.AuthSentCode) (string, error)
@@ -133,6 +279,58 @@ func (c CodeAuthenticatorFunc) Code(ctx context.Context, sentCode *tg.AuthSentCo
 	return c(ctx, sentCode)
 }
 
+// EmailAuthenticator is an optional interface of UserAuthenticator which
+// handles email login.
+//
+// If not implemented, code sent to email is requested via Code, and email
+// setup is not supported.
+//
+// See https://core.telegram.org/api/auth#email-verification.
+type EmailAuthenticator interface {
+	// Email returns email to set up as login email.
+	Email(ctx context.Context) (string, error)
+	// EmailCode asks user for code sent to email matching given pattern.
+	EmailCode(ctx context.Context, emailPattern string, length int) (string, error)
+}
+
+// PasswordRecoveryAuthenticator is an optional interface of
+// UserAuthenticator which handles 2FA password recovery.
+//
+// If implemented, password is recovered via recovery email when Password
+// returns ErrPasswordNotProvided.
+//
+// See https://core.telegram.org/api/srp#email-verification.
+type PasswordRecoveryAuthenticator interface {
+	// RecoveryCode asks user for code sent to recovery email matching
+	// given pattern.
+	RecoveryCode(ctx context.Context, emailPattern string) (string, error)
+	// NewPassword returns new 2FA password to set after recovery.
+	//
+	// Empty password disables 2FA.
+	NewPassword(ctx context.Context) (string, error)
+}
+
+// FutureAuthTokenSaver is an optional interface of UserAuthenticator which
+// receives future auth token returned by server after successful sign in.
+//
+// Saved token can be returned by FutureAuthTokenLoader to sign in again
+// without code.
+//
+// See https://core.telegram.org/api/auth#future-auth-tokens.
+type FutureAuthTokenSaver interface {
+	SaveFutureAuthToken(ctx context.Context, token []byte) error
+}
+
+// FutureAuthTokenLoader is an optional interface of UserAuthenticator which
+// supplies previously saved future auth tokens.
+//
+// Tokens are sent along with SendCodeOptions.LogoutTokens.
+//
+// See https://core.telegram.org/api/auth#future-auth-tokens.
+type FutureAuthTokenLoader interface {
+	FutureAuthTokens(ctx context.Context) ([][]byte, error)
+}
+
 // UserInfo represents user info required for sign up.
 type UserInfo struct {
 	FirstName string
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// ErrPasswordRecoveryUnavailable means that 2FA password can't be recovered,
+// because there is no recovery email.
+var ErrPasswordRecoveryUnavailable = errors.New("password recovery unavailable")
+
+// RequestPasswordRecovery requests 2FA password recovery code to be sent
+// to recovery email and returns pattern of this email.
+//
+// Use RecoverPassword to provide received code.
+//
+// See https://core.telegram.org/api/srp#email-verification.
+func (c *Client) RequestPasswordRecovery(ctx context.Context) (string, error) {
+	r, err := c.api.AuthRequestPasswordRecovery(ctx)
+	if tg.IsPasswordRecoveryNa(err) {
+		return "", ErrPasswordRecoveryUnavailable
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "request password recovery")
+	}
+	return r.EmailPattern, nil
+}
+
+// RecoverPasswordOptions is options structure for RecoverPassword.
+type RecoverPasswordOptions struct {
+	// NewPassword is new 2FA password.
+	//
+	// If empty, 2FA is disabled.
+	NewPassword string
+	// Hint is new password hint.
+	Hint string
+}
+
+// RecoverPassword resets 2FA password using code sent to recovery email and
+// logs in.
+//
+// See https://core.telegram.org/api/srp#email-verification.
+func (c *Client) RecoverPassword(
+	ctx context.Context,
+	code string,
+	opts RecoverPasswordOptions,
+) (*tg.AuthAuthorization, error) {
+	req := &tg.AuthRecoverPasswordRequest{
+		Code: code,
+	}
+	if opts.NewPassword != "" {
+		p, err := c.api.AccountGetPassword(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "get SRP parameters")
+		}
+
+		algo, ok := p.NewAlgo.(*tg.PasswordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow)
+		if !ok {
+			return nil, errors.Errorf("unsupported algo: %T", p.NewAlgo)
+		}
+
+		hash, err := NewPasswordHash([]byte(opts.NewPassword), algo)
+		if err != nil {
+			return nil, errors.Wrap(err, "compute new password hash")
+		}
+
+		req.SetNewSettings(tg.AccountPasswordInputSettings{
+			NewAlgo:         algo,
+			NewPasswordHash: hash,
+			Hint:            opts.Hint,
+		})
+	}
+
+	auth, err := c.api.AuthRecoverPassword(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "recover password")
+	}
+	result, err := checkResult(auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "check")
+	}
+	return result, nil
+}
@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgmock"
+)
+
+type testRecoveryAuth struct {
+	UserAuthenticator
+	code string
+}
+
+func (r testRecoveryAuth) RecoveryCode(ctx context.Context, emailPattern string) (string, error) {
+	return r.code, nil
+}
+
+func (r testRecoveryAuth) NewPassword(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func TestFlow_PasswordRecovery(t *testing.T) {
+	const (
+		phone    = "123123"
+		code     = "1010"
+		codeHash = "hash"
+	)
+	ctx := context.Background()
+	token := []byte{1, 2, 3}
+
+	mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		var settings tg.CodeSettings
+		settings.SetLogoutTokens([][]byte{token})
+		mock.ExpectCall(&tg.AuthSendCodeRequest{
+			PhoneNumber: phone,
+			APIID:       testAppID,
+			APIHash:     testAppHash,
+			Settings:    settings,
+		}).ThenResult(&tg.AuthSentCode{
+			Type:          &tg.AuthSentCodeTypeApp{Length: 4},
+			PhoneCodeHash: codeHash,
+		})
+		mock.ExpectCall(&tg.AuthSignInRequest{
+			PhoneNumber:   phone,
+			PhoneCodeHash: codeHash,
+			PhoneCode:     code,
+		}).ThenRPCErr(&tgerr.Error{
+			Code:    401,
+			Message: "SESSION_PASSWORD_NEEDED",
+			Type:    "SESSION_PASSWORD_NEEDED",
+		})
+		mock.ExpectCall(&tg.AuthRequestPasswordRecoveryRequest{}).ThenResult(&tg.AuthPasswordRecovery{
+			EmailPattern: "u***@example.com",
+		})
+		mock.ExpectCall(&tg.AuthRecoverPasswordRequest{
+			Code: "2020",
+		}).ThenResult(&tg.AuthAuthorization{
+			User: &tg.User{ID: 1},
+		})
+
+		a.NoError(NewFlow(testRecoveryAuth{
+			UserAuthenticator: CodeOnly(phone, CodeAuthenticatorFunc(
+				func(ctx context.Context, _ *tg.AuthSentCode) (string, error) {
+					return code, nil
+				},
+			)),
+			code: "2020",
+		}, SendCodeOptions{LogoutTokens: [][]byte{token}}).Run(ctx, client))
+	})(t)
+}
+
+func TestClient_RequestPasswordRecovery(t *testing.T) {
+	ctx := context.Background()
+	mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		mock.ExpectCall(&tg.AuthRequestPasswordRecoveryRequest{}).
+			ThenRPCErr(&tgerr.Error{Code: 400, Message: "PASSWORD_RECOVERY_NA", Type: "PASSWORD_RECOVERY_NA"})
+		_, err := client.RequestPasswordRecovery(ctx)
+		a.ErrorIs(err, ErrPasswordRecoveryUnavailable)
+	})(t)
+}
+
+func TestClient_LogOut(t *testing.T) {
+	ctx := context.Background()
+	mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		r := &tg.AuthLoggedOut{}
+		r.SetFutureAuthToken([]byte{1, 2, 3})
+		mock.ExpectCall(&tg.AuthLogOutRequest{}).ThenResult(r)
+		token, err := client.LogOut(ctx)
+		a.NoError(err)
+		a.Equal([]byte{1, 2, 3}, token)
+	})(t)
+}
+
+type testTokenAuth struct {
+	UserAuthenticator
+	tokens [][]byte
+	saved  [][]byte
+}
+
+func (r *testTokenAuth) FutureAuthTokens(ctx context.Context) ([][]byte, error) {
+	return r.tokens, nil
+}
+
+func (r *testTokenAuth) SaveFutureAuthToken(ctx context.Context, token []byte) error {
+	r.saved = append(r.saved, token)
+	return nil
+}
+
+func TestFlow_FutureAuthToken(t *testing.T) {
+	const (
+		phone    = "123123"
+		code     = "1010"
+		codeHash = "hash"
+	)
+	ctx := context.Background()
+	oldToken, newToken := []byte{1, 2, 3}, []byte{4, 5, 6}
+	authorization := func() *tg.AuthAuthorization {
+		r := &tg.AuthAuthorization{User: &tg.User{ID: 1}}
+		r.SetFutureAuthToken(newToken)
+		return r
+	}
+	sendCode := func(tokens ...[]byte) *tg.AuthSendCodeRequest {
+		var settings tg.CodeSettings
+		if len(tokens) > 0 {
+			settings.SetLogoutTokens(tokens)
+		}
+		return &tg.AuthSendCodeRequest{
+			PhoneNumber: phone,
+			APIID:       testAppID,
+			APIHash:     testAppHash,
+			Settings:    settings,
+		}
+	}
+
+	t.Run("SentCodeSuccess", mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		mock.ExpectCall(sendCode(oldToken)).ThenResult(&tg.AuthSentCodeSuccess{
+			Authorization: authorization(),
+		})
+
+		auth := &testTokenAuth{
+			UserAuthenticator: CodeOnly(phone, nil),
+			tokens:            [][]byte{oldToken},
+		}
+		a.NoError(NewFlow(auth, SendCodeOptions{}).Run(ctx, client))
+		a.Equal([][]byte{newToken}, auth.saved)
+	}))
+	t.Run("SignIn", mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		mock.ExpectCall(sendCode()).ThenResult(&tg.AuthSentCode{
+			Type:          &tg.AuthSentCodeTypeApp{Length: 4},
+			PhoneCodeHash: codeHash,
+		})
+		mock.ExpectCall(&tg.AuthSignInRequest{
+			PhoneNumber:   phone,
+			PhoneCodeHash: codeHash,
+			PhoneCode:     code,
+		}).ThenResult(authorization())
+
+		auth := &testTokenAuth{
+			UserAuthenticator: CodeOnly(phone, CodeAuthenticatorFunc(
+				func(ctx context.Context, _ *tg.AuthSentCode) (string, error) {
+					return code, nil
+				},
+			)),
+		}
+		a.NoError(NewFlow(auth, SendCodeOptions{}).Run(ctx, client))
+		a.Equal([][]byte{newToken}, auth.saved)
+	}))
+}
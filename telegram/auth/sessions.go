@@ -0,0 +1,317 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// ErrFreshReset means that session is too new to terminate other sessions.
+//
+// Telegram allows terminating other sessions only after 24 hours since login.
+var ErrFreshReset = errors.New("session is too new to terminate other sessions")
+
+// Authorization describes active session of current user.
+//
+// See https://core.telegram.org/api/auth#active-sessions.
+type Authorization struct {
+	// Hash is session identifier.
+	Hash int64
+	// Current whether this is the current session.
+	Current bool
+	// Official whether session is from official app.
+	Official bool
+	// PasswordPending whether session is still waiting for 2FA password.
+	PasswordPending bool
+	// Unconfirmed whether session is not confirmed yet.
+	//
+	// See https://core.telegram.org/api/auth#confirming-login.
+	Unconfirmed bool
+
+	// DeviceModel is model of device, like "iPhone 15".
+	DeviceModel string
+	// Platform is device platform, like "iOS".
+	Platform string
+	// SystemVersion is operating system version.
+	SystemVersion string
+
+	// AppID is API ID of application.
+	AppID int
+	// AppName is name of application.
+	AppName string
+	// AppVersion is version of application.
+	AppVersion string
+
+	// IP of last activity.
+	IP string
+	// Country of last activity.
+	Country string
+	// Region of last activity.
+	Region string
+
+	// Created is time of session creation.
+	Created time.Time
+	// LastActive is time of last activity.
+	LastActive time.Time
+
+	// Raw is raw session info.
+	Raw tg.Authorization
+}
+
+func newAuthorization(a tg.Authorization) Authorization {
+	return Authorization{
+		Hash:            a.Hash,
+		Current:         a.Current,
+		Official:        a.OfficialApp,
+		PasswordPending: a.PasswordPending,
+		Unconfirmed:     a.Unconfirmed,
+		DeviceModel:     a.DeviceModel,
+		Platform:        a.Platform,
+		SystemVersion:   a.SystemVersion,
+		AppID:           a.APIID,
+		AppName:         a.AppName,
+		AppVersion:      a.AppVersion,
+		IP:              a.IP,
+		Country:         a.Country,
+		Region:          a.Region,
+		Created:         time.Unix(int64(a.DateCreated), 0),
+		LastActive:      time.Unix(int64(a.DateActive), 0),
+		Raw:             a,
+	}
+}
+
+// Authorizations is a list of active sessions.
+type Authorizations struct {
+	// TTL is inactivity period after which sessions are terminated.
+	TTL time.Duration
+	// List of active sessions.
+	List []Authorization
+}
+
+// Current returns current session.
+func (a Authorizations) Current() (Authorization, bool) {
+	for _, s := range a.List {
+		if s.Current {
+			return s, true
+		}
+	}
+	return Authorization{}, false
+}
+
+// Others returns all sessions except current.
+func (a Authorizations) Others() []Authorization {
+	r := make([]Authorization, 0, len(a.List))
+	for _, s := range a.List {
+		if !s.Current {
+			r = append(r, s)
+		}
+	}
+	return r
+}
+
+// WebAuthorization describes website where user logged in via Telegram
+// Login Widget.
+//
+// See https://core.telegram.org/widgets/login.
+type WebAuthorization struct {
+	// Hash is authorization identifier.
+	Hash int64
+	// Bot is a bot linked to website.
+	Bot *tg.User
+	// Domain of website.
+	Domain string
+	// Browser used to log in.
+	Browser string
+	// Platform used to log in.
+	Platform string
+	// IP of last activity.
+	IP string
+	// Region of last activity.
+	Region string
+	// Created is time of authorization creation.
+	Created time.Time
+	// LastActive is time of last activity.
+	LastActive time.Time
+
+	// Raw is raw authorization info.
+	Raw tg.WebAuthorization
+}
+
+// Sessions manages active sessions of current user.
+//
+// See https://core.telegram.org/api/auth#active-sessions.
+type Sessions struct {
+	api *tg.Client
+}
+
+// Sessions returns active sessions manager.
+func (c *Client) Sessions() Sessions {
+	return Sessions{api: c.api}
+}
+
+// List returns active sessions.
+func (s Sessions) List(ctx context.Context) (Authorizations, error) {
+	r, err := s.api.AccountGetAuthorizations(ctx)
+	if err != nil {
+		return Authorizations{}, errors.Wrap(err, "get authorizations")
+	}
+
+	result := Authorizations{
+		TTL:  time.Duration(r.AuthorizationTTLDays) * 24 * time.Hour,
+		List: make([]Authorization, 0, len(r.Authorizations)),
+	}
+	for _, a := range r.Authorizations {
+		result.List = append(result.List, newAuthorization(a))
+	}
+	return result, nil
+}
+
+// Terminate terminates session with given hash.
+//
+// May return ErrFreshReset.
+func (s Sessions) Terminate(ctx context.Context, hash int64) error {
+	if _, err := s.api.AccountResetAuthorization(ctx, hash); err != nil {
+		if tg.IsFreshResetAuthorisationForbidden(err) {
+			return ErrFreshReset
+		}
+		return errors.Wrap(err, "reset authorization")
+	}
+	return nil
+}
+
+// TerminateOthers terminates all sessions except current.
+//
+// May return ErrFreshReset.
+func (s Sessions) TerminateOthers(ctx context.Context) error {
+	if _, err := s.api.AuthResetAuthorizations(ctx); err != nil {
+		if tg.IsFreshResetAuthorisationForbidden(err) {
+			return ErrFreshReset
+		}
+		return errors.Wrap(err, "reset authorizations")
+	}
+	return nil
+}
+
+// SetTTL sets inactivity period after which sessions are terminated.
+//
+// TTL is rounded up to days.
+func (s Sessions) SetTTL(ctx context.Context, ttl time.Duration) error {
+	const day = 24 * time.Hour
+	days := int((ttl + day - 1) / day)
+	if _, err := s.api.AccountSetAuthorizationTTL(ctx, days); err != nil {
+		return errors.Wrap(err, "set authorization TTL")
+	}
+	return nil
+}
+
+func (s Sessions) changeSettings(ctx context.Context, req *tg.AccountChangeAuthorizationSettingsRequest) error {
+	if _, err := s.api.AccountChangeAuthorizationSettings(ctx, req); err != nil {
+		return errors.Wrap(err, "change authorization settings")
+	}
+	return nil
+}
+
+// Confirm confirms unconfirmed session with given hash.
+//
+// See https://core.telegram.org/api/auth#confirming-login.
+func (s Sessions) Confirm(ctx context.Context, hash int64) error {
+	return s.changeSettings(ctx, &tg.AccountChangeAuthorizationSettingsRequest{
+		Confirmed: true,
+		Hash:      hash,
+	})
+}
+
+// SetCallRequests allows or disallows incoming calls in session with given hash.
+func (s Sessions) SetCallRequests(ctx context.Context, hash int64, enabled bool) error {
+	req := &tg.AccountChangeAuthorizationSettingsRequest{Hash: hash}
+	req.SetCallRequestsDisabled(!enabled)
+	return s.changeSettings(ctx, req)
+}
+
+// SetEncryptedRequests allows or disallows secret chats in session with given hash.
+func (s Sessions) SetEncryptedRequests(ctx context.Context, hash int64, enabled bool) error {
+	req := &tg.AccountChangeAuthorizationSettingsRequest{Hash: hash}
+	req.SetEncryptedRequestsDisabled(!enabled)
+	return s.changeSettings(ctx, req)
+}
+
+// Web returns websites where user logged in via Telegram Login Widget.
+func (s Sessions) Web(ctx context.Context) ([]WebAuthorization, error) {
+	r, err := s.api.AccountGetWebAuthorizations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get web authorizations")
+	}
+
+	bots := r.MapUsers().NotEmptyToMap()
+	result := make([]WebAuthorization, 0, len(r.Authorizations))
+	for _, a := range r.Authorizations {
+		result = append(result, WebAuthorization{
+			Hash:       a.Hash,
+			Bot:        bots[a.BotID],
+			Domain:     a.Domain,
+			Browser:    a.Browser,
+			Platform:   a.Platform,
+			IP:         a.IP,
+			Region:     a.Region,
+			Created:    time.Unix(int64(a.DateCreated), 0),
+			LastActive: time.Unix(int64(a.DateActive), 0),
+			Raw:        a,
+		})
+	}
+	return result, nil
+}
+
+// TerminateWeb terminates web authorization with given hash.
+func (s Sessions) TerminateWeb(ctx context.Context, hash int64) error {
+	if _, err := s.api.AccountResetWebAuthorization(ctx, hash); err != nil {
+		return errors.Wrap(err, "reset web authorization")
+	}
+	return nil
+}
+
+// TerminateAllWeb terminates all web authorizations.
+func (s Sessions) TerminateAllWeb(ctx context.Context) error {
+	if _, err := s.api.AccountResetWebAuthorizations(ctx); err != nil {
+		return errors.Wrap(err, "reset web authorizations")
+	}
+	return nil
+}
+
+// NewAuthorization describes new login to current account.
+type NewAuthorization struct {
+	// Hash is session identifier.
+	//
+	// Can be passed to Sessions.Terminate or Sessions.Confirm.
+	Hash int64
+	// Unconfirmed whether session is not confirmed yet.
+	Unconfirmed bool
+	// Date of login.
+	Date time.Time
+	// Device name.
+	Device string
+	// Location of login.
+	Location string
+}
+
+// OnNewAuthorization sets handler which is called on every new login to
+// current account.
+//
+// Handler can be used to detect account takeover and terminate suspicious
+// sessions.
+func OnNewAuthorization(d interface {
+	OnNewAuthorization(tg.NewAuthorizationHandler)
+}, cb func(ctx context.Context, a NewAuthorization) error,
+) {
+	d.OnNewAuthorization(func(ctx context.Context, e tg.Entities, u *tg.UpdateNewAuthorization) error {
+		return cb(ctx, NewAuthorization{
+			Hash:        u.Hash,
+			Unconfirmed: u.Unconfirmed,
+			Date:        time.Unix(int64(u.Date), 0),
+			Device:      u.Device,
+			Location:    u.Location,
+		})
+	})
+}
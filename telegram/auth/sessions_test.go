@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestSessions(t *testing.T) {
+	ctx := context.Background()
+
+	mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		s := client.Sessions()
+
+		mock.ExpectCall(&tg.AccountGetAuthorizationsRequest{}).ThenResult(&tg.AccountAuthorizations{
+			AuthorizationTTLDays: 180,
+			Authorizations: []tg.Authorization{
+				{Hash: 0, Current: true, DeviceModel: "PC", DateActive: 10},
+				{Hash: 1, Unconfirmed: true, DeviceModel: "Phone", APIID: 10, IP: "10.0.0.1"},
+			},
+		})
+		r, err := s.List(ctx)
+		a.NoError(err)
+		a.Equal(180*24*time.Hour, r.TTL)
+		a.Len(r.List, 2)
+		current, ok := r.Current()
+		a.True(ok)
+		a.Equal("PC", current.DeviceModel)
+		a.Equal(time.Unix(10, 0), current.LastActive)
+		others := r.Others()
+		a.Len(others, 1)
+		a.True(others[0].Unconfirmed)
+		a.Equal(10, others[0].AppID)
+		a.Equal("10.0.0.1", others[0].IP)
+
+		mock.ExpectCall(&tg.AccountResetAuthorizationRequest{Hash: 1}).ThenTrue()
+		a.NoError(s.Terminate(ctx, 1))
+
+		mock.ExpectCall(&tg.AuthResetAuthorizationsRequest{}).
+			ThenRPCErr(&tgerr.Error{
+				Code:    406,
+				Message: "FRESH_RESET_AUTHORISATION_FORBIDDEN",
+				Type:    "FRESH_RESET_AUTHORISATION_FORBIDDEN",
+			})
+		a.ErrorIs(s.TerminateOthers(ctx), ErrFreshReset)
+
+		mock.ExpectCall(&tg.AccountSetAuthorizationTTLRequest{AuthorizationTTLDays: 2}).ThenTrue()
+		a.NoError(s.SetTTL(ctx, 25*time.Hour))
+
+		mock.ExpectCall(&tg.AccountChangeAuthorizationSettingsRequest{
+			Confirmed: true,
+			Hash:      1,
+		}).ThenTrue()
+		a.NoError(s.Confirm(ctx, 1))
+
+		req := &tg.AccountChangeAuthorizationSettingsRequest{Hash: 1}
+		req.SetCallRequestsDisabled(true)
+		mock.ExpectCall(req).ThenTrue()
+		a.NoError(s.SetCallRequests(ctx, 1, false))
+	})(t)
+}
+
+func TestSessions_Web(t *testing.T) {
+	ctx := context.Background()
+
+	mockTest(func(a *require.Assertions, mock *tgmock.Mock, client *Client) {
+		s := client.Sessions()
+		bot := &tg.User{ID: 10, Bot: true}
+
+		mock.ExpectCall(&tg.AccountGetWebAuthorizationsRequest{}).ThenResult(&tg.AccountWebAuthorizations{
+			Authorizations: []tg.WebAuthorization{
+				{Hash: 1, BotID: 10, Domain: "example.com"},
+			},
+			Users: []tg.UserClass{bot},
+		})
+		r, err := s.Web(ctx)
+		a.NoError(err)
+		a.Len(r, 1)
+		a.Equal("example.com", r[0].Domain)
+		a.Equal(bot, r[0].Bot)
+
+		mock.ExpectCall(&tg.AccountResetWebAuthorizationRequest{Hash: 1}).ThenTrue()
+		a.NoError(s.TerminateWeb(ctx, 1))
+		mock.ExpectCall(&tg.AccountResetWebAuthorizationsRequest{}).ThenTrue()
+		a.NoError(s.TerminateAllWeb(ctx))
+	})(t)
+}
+
+func TestOnNewAuthorization(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	d := tg.NewUpdateDispatcher()
+	var got NewAuthorization
+	OnNewAuthorization(d, func(ctx context.Context, auth NewAuthorization) error {
+		got = auth
+		return nil
+	})
+
+	a.NoError(d.Handle(ctx, &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateNewAuthorization{
+				Unconfirmed: true,
+				Hash:        10,
+				Date:        100,
+				Device:      "Phone",
+				Location:    "Earth",
+			},
+		},
+	}))
+	a.Equal(NewAuthorization{
+		Hash:        10,
+		Unconfirmed: true,
+		Date:        time.Unix(100, 0),
+		Device:      "Phone",
+		Location:    "Earth",
+	}, got)
+}
@@ -58,6 +58,13 @@ type SendCodeOptions struct {
 	// If a token that will be included in eventually sent SMSs is required:
 	// required in newer versions of android, to use the android SMS receiver APIs.
 	AllowAppHash bool
+	// LogoutTokens are future auth tokens returned by LogOut.
+	//
+	// If one of tokens is valid for the phone, server may sign in without
+	// sending code, returning tg.AuthSentCodeSuccess.
+	//
+	// See https://core.telegram.org/api/auth#future-auth-tokens.
+	LogoutTokens [][]byte
 }
 
 // SendCode requests code for provided phone number, returning code hash
@@ -75,6 +82,9 @@ func (c *Client) SendCode(ctx context.Context, phone string, options SendCodeOpt
 	if options.CurrentNumber {
 		settings.SetCurrentNumber(true)
 	}
+	if len(options.LogoutTokens) > 0 {
+		settings.SetLogoutTokens(options.LogoutTokens)
+	}
 
 	sentCode, err := c.api.AuthSendCode(ctx, &tg.AuthSendCodeRequest{
 		PhoneNumber: phone,
@@ -100,11 +110,15 @@ var ErrPasswordAuthNeeded = errors.New("2FA required")
 //
 // To obtain codeHash, use SendCode.
 func (c *Client) SignIn(ctx context.Context, phone, code, codeHash string) (*tg.AuthAuthorization, error) {
-	auth, err := c.api.AuthSignIn(ctx, &tg.AuthSignInRequest{
+	return c.signIn(ctx, &tg.AuthSignInRequest{
 		PhoneNumber:   phone,
 		PhoneCodeHash: codeHash,
 		PhoneCode:     code,
 	})
+}
+
+func (c *Client) signIn(ctx context.Context, req *tg.AuthSignInRequest) (*tg.AuthAuthorization, error) {
+	auth, err := c.api.AuthSignIn(ctx, req)
 	if tgerr.Is(err, "SESSION_PASSWORD_NEEDED") {
 		return nil, ErrPasswordAuthNeeded
 	}
@@ -152,3 +166,18 @@ func (c *Client) SignUp(ctx context.Context, s SignUp) (*tg.AuthAuthorization, e
 	}
 	return result, nil
 }
+
+// LogOut logs out the user and returns future auth token, if any.
+//
+// Token can be passed to SendCodeOptions.LogoutTokens or returned by
+// FutureAuthTokenLoader to sign in again without code.
+//
+// See https://core.telegram.org/api/auth#future-auth-tokens.
+func (c *Client) LogOut(ctx context.Context) ([]byte, error) {
+	r, err := c.api.AuthLogOut(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "log out")
+	}
+	token, _ := r.GetFutureAuthToken()
+	return token, nil
+}
@@ -0,0 +1,180 @@
+package webauth
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/clock"
+)
+
+// InitData is a data passed to Mini App.
+//
+// See https://core.telegram.org/bots/webapps#webappinitdata.
+type InitData struct {
+	// QueryID is unique identifier of Mini App session, used to send message
+	// via answerWebAppQuery.
+	QueryID string
+	// User that opened Mini App.
+	User *User
+	// Receiver is a chat partner of User in private chat, if Mini App is
+	// opened from attachment menu.
+	Receiver *User
+	// Chat where Mini App is opened from attachment menu.
+	Chat *Chat
+	// ChatType is type of chat where Mini App is opened.
+	ChatType string
+	// ChatInstance is global identifier of chat where Mini App is opened.
+	ChatInstance string
+	// StartParam is value of startattach or startapp parameter.
+	StartParam string
+	// CanSendAfter is delay after which message can be sent via
+	// answerWebAppQuery.
+	CanSendAfter time.Duration
+	// AuthDate is time when Mini App was opened.
+	AuthDate time.Time
+	// Hash is HMAC of data.
+	Hash string
+	// Signature is Ed25519 signature of data for third-party validation.
+	Signature string
+	// Raw is raw parsed data.
+	Raw url.Values
+}
+
+func parseJSON(values url.Values, key string, to interface{}) (bool, error) {
+	v := values.Get(key)
+	if v == "" {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(v), to); err != nil {
+		return false, errors.Wrapf(err, "parse %s", key)
+	}
+	return true, nil
+}
+
+// ParseInitData parses Mini App init data without validation.
+func ParseInitData(raw string) (InitData, error) {
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return InitData{}, errors.Wrap(err, "parse query")
+	}
+
+	authDate, err := parseAuthDate(values)
+	if err != nil {
+		return InitData{}, err
+	}
+	d := InitData{
+		QueryID:      values.Get("query_id"),
+		ChatType:     values.Get("chat_type"),
+		ChatInstance: values.Get("chat_instance"),
+		StartParam:   values.Get("start_param"),
+		AuthDate:     authDate,
+		Hash:         values.Get("hash"),
+		Signature:    values.Get("signature"),
+		Raw:          values,
+	}
+	if v := values.Get("can_send_after"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return InitData{}, errors.Wrap(err, "parse can_send_after")
+		}
+		d.CanSendAfter = time.Duration(seconds) * time.Second
+	}
+
+	var (
+		user, receiver User
+		chat           Chat
+	)
+	if ok, err := parseJSON(values, "user", &user); err != nil {
+		return InitData{}, err
+	} else if ok {
+		d.User = &user
+	}
+	if ok, err := parseJSON(values, "receiver", &receiver); err != nil {
+		return InitData{}, err
+	} else if ok {
+		d.Receiver = &receiver
+	}
+	if ok, err := parseJSON(values, "chat", &chat); err != nil {
+		return InitData{}, err
+	} else if ok {
+		d.Chat = &chat
+	}
+
+	return d, nil
+}
+
+// InitData validates and parses Mini App init data.
+//
+// Raw is Telegram.WebApp.initData string passed by Mini App.
+//
+// See https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app.
+func (v Validator) InitData(raw string) (InitData, error) {
+	d, err := ParseInitData(raw)
+	if err != nil {
+		return InitData{}, err
+	}
+
+	secret := hmacSHA256([]byte("WebAppData"), v.token)
+	if err := checkHash(d.Raw, secret); err != nil {
+		return InitData{}, err
+	}
+	if err := checkAge(v.clock, v.maxAge, d.AuthDate); err != nil {
+		return InitData{}, err
+	}
+	return d, nil
+}
+
+// SignatureValidator validates Mini App init data using Ed25519 signature,
+// without knowing bot token.
+//
+// See https://core.telegram.org/bots/webapps#validating-data-for-third-party-use.
+type SignatureValidator struct {
+	botID  int64
+	key    ed25519.PublicKey
+	maxAge time.Duration
+	clock  clock.Clock
+}
+
+// NewSignatureValidator creates new SignatureValidator for bot with given ID.
+//
+// Key is Telegram public key, which differs for production and test
+// environments. See link above for actual keys.
+func NewSignatureValidator(botID int64, key ed25519.PublicKey, opts Options) SignatureValidator {
+	opts.setDefaults()
+	return SignatureValidator{
+		botID:  botID,
+		key:    key,
+		maxAge: opts.MaxAge,
+		clock:  opts.Clock,
+	}
+}
+
+// InitData validates and parses Mini App init data.
+func (v SignatureValidator) InitData(raw string) (InitData, error) {
+	d, err := ParseInitData(raw)
+	if err != nil {
+		return InitData{}, err
+	}
+
+	if len(v.key) != ed25519.PublicKeySize {
+		return InitData{}, errors.Errorf("invalid public key size %d", len(v.key))
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(d.Signature)
+	if err != nil {
+		return InitData{}, ErrSignatureInvalid
+	}
+	data := strconv.FormatInt(v.botID, 10) + ":WebAppData\n" + dataCheckString(d.Raw, "hash", "signature")
+	if !ed25519.Verify(v.key, []byte(data), sig) {
+		return InitData{}, ErrSignatureInvalid
+	}
+	if err := checkAge(v.clock, v.maxAge, d.AuthDate); err != nil {
+		return InitData{}, err
+	}
+	return d, nil
+}
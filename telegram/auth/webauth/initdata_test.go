@@ -0,0 +1,102 @@
+package webauth
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/neo"
+)
+
+func testInitData(authDate time.Time) url.Values {
+	return url.Values{
+		"query_id":       {"AAHdF6IQAAAAAN0XohDhrOrc"},
+		"user":           {`{"id":279058397,"first_name":"Vladislav","username":"vdkfrost","language_code":"ru","is_premium":true,"allows_write_to_pm":true}`},
+		"chat":           {`{"id":-100,"type":"supergroup","title":"Group"}`},
+		"chat_type":      {"supergroup"},
+		"chat_instance":  {"-1"},
+		"start_param":    {"ref"},
+		"can_send_after": {"10"},
+		"auth_date":      {strconv.FormatInt(authDate.Unix(), 10)},
+	}
+}
+
+func TestValidator_InitData(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	v := NewValidator(testToken, Options{Clock: neo.NewTime(now)})
+
+	values := testInitData(now.Add(-time.Minute))
+	secret := hmacSHA256([]byte("WebAppData"), testToken)
+	values.Set("hash", hex.EncodeToString(hmacSHA256(secret, dataCheckString(values, "hash"))))
+
+	t.Run("OK", func(t *testing.T) {
+		a := require.New(t)
+		d, err := v.InitData(values.Encode())
+		a.NoError(err)
+		a.Equal("AAHdF6IQAAAAAN0XohDhrOrc", d.QueryID)
+		a.Equal(&User{
+			ID:              279058397,
+			FirstName:       "Vladislav",
+			Username:        "vdkfrost",
+			LanguageCode:    "ru",
+			IsPremium:       true,
+			AllowsWriteToPM: true,
+		}, d.User)
+		a.Nil(d.Receiver)
+		a.Equal(&Chat{ID: -100, Type: "supergroup", Title: "Group"}, d.Chat)
+		a.Equal("ref", d.StartParam)
+		a.Equal(10*time.Second, d.CanSendAfter)
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		vals := url.Values{}
+		for k, v := range values {
+			vals[k] = v
+		}
+		vals.Set("start_param", "other")
+		_, err := v.InitData(vals.Encode())
+		require.ErrorIs(t, err, ErrHashInvalid)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		_, err := NewValidator(testToken, Options{
+			Clock: neo.NewTime(now.Add(48 * time.Hour)),
+		}).InitData(values.Encode())
+		var expired *ExpiredError
+		require.ErrorAs(t, err, &expired)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := v.InitData("auth_date=foo")
+		require.Error(t, err)
+		_, err = v.InitData("auth_date=1&user=foo")
+		require.Error(t, err)
+	})
+}
+
+func TestSignatureValidator_InitData(t *testing.T) {
+	const botID = 123456
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	priv := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	pub := priv.Public().(ed25519.PublicKey)
+
+	values := testInitData(now.Add(-time.Minute))
+	values.Set("hash", "deadbeef")
+	data := strconv.Itoa(botID) + ":WebAppData\n" + dataCheckString(values, "hash", "signature")
+	values.Set("signature", base64.RawURLEncoding.EncodeToString(ed25519.Sign(priv, []byte(data))))
+
+	a := require.New(t)
+	v := NewSignatureValidator(botID, pub, Options{Clock: neo.NewTime(now)})
+	d, err := v.InitData(values.Encode())
+	a.NoError(err)
+	a.Equal(int64(279058397), d.User.ID)
+
+	_, err = NewSignatureValidator(botID+1, pub, Options{Clock: neo.NewTime(now)}).InitData(values.Encode())
+	a.ErrorIs(err, ErrSignatureInvalid)
+
+	_, err = NewSignatureValidator(botID, nil, Options{}).InitData(values.Encode())
+	a.Error(err)
+}
@@ -0,0 +1,56 @@
+package webauth
+
+import (
+	"crypto/sha256"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// LoginData is a data received from Telegram Login Widget.
+//
+// See https://core.telegram.org/widgets/login#receiving-authorization-data.
+type LoginData struct {
+	User     User
+	AuthDate time.Time
+	Hash     string
+}
+
+// Login validates data received from Telegram Login Widget.
+//
+// Values are fields of redirect URL query or fields of object passed to
+// JavaScript callback.
+//
+// See https://core.telegram.org/widgets/login#checking-authorization.
+func (v Validator) Login(values url.Values) (LoginData, error) {
+	secret := sha256.Sum256([]byte(v.token))
+	if err := checkHash(values, secret[:]); err != nil {
+		return LoginData{}, err
+	}
+
+	authDate, err := parseAuthDate(values)
+	if err != nil {
+		return LoginData{}, err
+	}
+	if err := checkAge(v.clock, v.maxAge, authDate); err != nil {
+		return LoginData{}, err
+	}
+
+	id, err := strconv.ParseInt(values.Get("id"), 10, 64)
+	if err != nil {
+		return LoginData{}, errors.Wrap(err, "parse id")
+	}
+	return LoginData{
+		User: User{
+			ID:        id,
+			FirstName: values.Get("first_name"),
+			LastName:  values.Get("last_name"),
+			Username:  values.Get("username"),
+			PhotoURL:  values.Get("photo_url"),
+		},
+		AuthDate: authDate,
+		Hash:     values.Get("hash"),
+	}, nil
+}
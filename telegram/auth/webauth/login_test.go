@@ -0,0 +1,70 @@
+package webauth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/neo"
+)
+
+const testToken = "123456:ABC-DEF"
+
+func signLogin(values url.Values) url.Values {
+	secret := sha256.Sum256([]byte(testToken))
+	values.Set("hash", hex.EncodeToString(hmacSHA256(secret[:], dataCheckString(values, "hash"))))
+	return values
+}
+
+func TestValidator_Login(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	authDate := now.Add(-time.Hour)
+	v := NewValidator(testToken, Options{Clock: neo.NewTime(now)})
+
+	values := func() url.Values {
+		return signLogin(url.Values{
+			"id":         {"10"},
+			"first_name": {"John"},
+			"username":   {"john"},
+			"auth_date":  {strconv.FormatInt(authDate.Unix(), 10)},
+		})
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		a := require.New(t)
+		d, err := v.Login(values())
+		a.NoError(err)
+		a.Equal(User{ID: 10, FirstName: "John", Username: "john"}, d.User)
+		a.Equal(authDate.Unix(), d.AuthDate.Unix())
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		vals := values()
+		vals.Set("id", "11")
+		_, err := v.Login(vals)
+		require.ErrorIs(t, err, ErrHashInvalid)
+	})
+	t.Run("WrongToken", func(t *testing.T) {
+		_, err := NewValidator("other", Options{Clock: neo.NewTime(now)}).Login(values())
+		require.ErrorIs(t, err, ErrHashInvalid)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		_, err := NewValidator(testToken, Options{
+			Clock:  neo.NewTime(now),
+			MaxAge: time.Minute,
+		}).Login(values())
+		var expired *ExpiredError
+		require.ErrorAs(t, err, &expired)
+		require.Equal(t, time.Minute, expired.MaxAge)
+
+		_, err = NewValidator(testToken, Options{
+			Clock:  neo.NewTime(now),
+			MaxAge: -1,
+		}).Login(values())
+		require.NoError(t, err)
+	})
+}
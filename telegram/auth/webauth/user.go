@@ -0,0 +1,30 @@
+package webauth
+
+// User is a Telegram user from Login Widget or Mini App data.
+//
+// See https://core.telegram.org/bots/webapps#webappuser.
+type User struct {
+	ID           int64  `json:"id"`
+	IsBot        bool   `json:"is_bot,omitempty"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name,omitempty"`
+	Username     string `json:"username,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
+	IsPremium    bool   `json:"is_premium,omitempty"`
+	// AddedToAttachmentMenu whether user added bot to attachment menu.
+	AddedToAttachmentMenu bool `json:"added_to_attachment_menu,omitempty"`
+	// AllowsWriteToPM whether user allowed bot to message them.
+	AllowsWriteToPM bool   `json:"allows_write_to_pm,omitempty"`
+	PhotoURL        string `json:"photo_url,omitempty"`
+}
+
+// Chat is a chat from Mini App data.
+//
+// See https://core.telegram.org/bots/webapps#webappchat.
+type Chat struct {
+	ID       int64  `json:"id"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Username string `json:"username,omitempty"`
+	PhotoURL string `json:"photo_url,omitempty"`
+}
@@ -0,0 +1,142 @@
+// Package webauth validates user data received from Telegram Login Widget
+// and Mini Apps.
+//
+// See https://core.telegram.org/widgets/login#checking-authorization and
+// https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app.
+package webauth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/clock"
+)
+
+var (
+	// ErrHashInvalid means that data hash does not match.
+	ErrHashInvalid = errors.New("hash invalid")
+	// ErrSignatureInvalid means that data signature does not match.
+	ErrSignatureInvalid = errors.New("signature invalid")
+)
+
+// ExpiredError reports that data is older than allowed.
+type ExpiredError struct {
+	AuthDate time.Time
+	MaxAge   time.Duration
+}
+
+// Error implements error.
+func (e *ExpiredError) Error() string {
+	return fmt.Sprintf("auth date %s is older than %s", e.AuthDate.UTC().Format(time.RFC3339), e.MaxAge)
+}
+
+// Options of Validator.
+type Options struct {
+	// MaxAge is maximum age of auth_date.
+	//
+	// Defaults to 24 hours. If negative, age is not checked.
+	MaxAge time.Duration
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+}
+
+func (o *Options) setDefaults() {
+	if o.MaxAge == 0 {
+		o.MaxAge = 24 * time.Hour
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+}
+
+// Validator validates Login Widget and Mini App data signed with bot token.
+type Validator struct {
+	token  string
+	maxAge time.Duration
+	clock  clock.Clock
+}
+
+// NewValidator creates new Validator for bot with given token.
+func NewValidator(token string, opts Options) Validator {
+	opts.setDefaults()
+	return Validator{
+		token:  token,
+		maxAge: opts.MaxAge,
+		clock:  opts.Clock,
+	}
+}
+
+// dataCheckString returns data-check-string of given values.
+//
+// Values are sorted by key and joined by new line, skipping given keys.
+func dataCheckString(values url.Values, skip ...string) string {
+	keys := make([]string, 0, len(values))
+Keys:
+	for k := range values {
+		for _, s := range skip {
+			if k == s {
+				continue Keys
+			}
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(values.Get(k))
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func checkHash(values url.Values, secret []byte) error {
+	hash, err := hex.DecodeString(values.Get("hash"))
+	if err != nil {
+		return ErrHashInvalid
+	}
+	if !hmac.Equal(hash, hmacSHA256(secret, dataCheckString(values, "hash"))) {
+		return ErrHashInvalid
+	}
+	return nil
+}
+
+func parseAuthDate(values url.Values) (time.Time, error) {
+	v, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "parse auth_date")
+	}
+	return time.Unix(v, 0), nil
+}
+
+func checkAge(c clock.Clock, maxAge time.Duration, authDate time.Time) error {
+	if maxAge < 0 {
+		return nil
+	}
+	if c.Now().Sub(authDate) > maxAge {
+		return &ExpiredError{
+			AuthDate: authDate,
+			MaxAge:   maxAge,
+		}
+	}
+	return nil
+}
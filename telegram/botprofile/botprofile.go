@@ -0,0 +1,73 @@
+// Package botprofile contains declarative bot profile and synchronization
+// helpers.
+//
+// Profile describes commands, info, menu button and default admin rights of
+// a bot. Syncer compares it with the current state and applies only the
+// changes.
+//
+// See https://core.telegram.org/api/bots.
+package botprofile
+
+import (
+	"github.com/gotd/td/tg"
+)
+
+// Commands is a list of bot commands for given scope and language.
+//
+// See https://core.telegram.org/api/bots/commands.
+type Commands struct {
+	// Scope of commands. Defaults to tg.BotCommandScopeDefault.
+	Scope tg.BotCommandScopeClass
+	// LangCode is a two-letter ISO 639-1 language code.
+	//
+	// If empty, commands are applied to users with any language which
+	// have no dedicated commands.
+	LangCode string
+	// Commands list. If empty, commands for scope and language are reset.
+	Commands []tg.BotCommand
+}
+
+func (c Commands) scope() tg.BotCommandScopeClass {
+	if c.Scope == nil {
+		return &tg.BotCommandScopeDefault{}
+	}
+	return c.Scope
+}
+
+// Info is bot name, about text and description for given language.
+//
+// All fields are applied as is, so empty localized field means fallback
+// to the value of default language.
+type Info struct {
+	// LangCode is a two-letter ISO 639-1 language code.
+	//
+	// If empty, info of default language is set.
+	LangCode string
+	// Name of bot.
+	Name string
+	// About text, shown in bot profile.
+	About string
+	// Description, shown in empty chat with bot.
+	Description string
+}
+
+// Profile is a declarative bot profile.
+//
+// Only declared parts are synchronized: scopes and languages that are not
+// listed are left intact, as well as nil MenuButton and admin rights.
+type Profile struct {
+	// Commands per scope and language.
+	Commands []Commands
+	// Info per language.
+	Info []Info
+	// MenuButton is a default menu button.
+	//
+	// See https://core.telegram.org/api/bots/menu.
+	MenuButton tg.BotMenuButtonClass
+	// GroupAdminRights is default admin rights suggested when bot is added
+	// to groups as admin.
+	GroupAdminRights *tg.ChatAdminRights
+	// BroadcastAdminRights is default admin rights suggested when bot is
+	// added to channels as admin.
+	BroadcastAdminRights *tg.ChatAdminRights
+}
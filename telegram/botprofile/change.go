@@ -0,0 +1,160 @@
+package botprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// ChangeKind is a kind of profile change.
+type ChangeKind int
+
+const (
+	// ChangeCommands sets commands for scope and language.
+	ChangeCommands ChangeKind = iota
+	// ChangeResetCommands resets commands for scope and language.
+	ChangeResetCommands
+	// ChangeInfo sets name, about text or description for language.
+	ChangeInfo
+	// ChangeMenuButton sets default menu button.
+	ChangeMenuButton
+	// ChangeGroupAdminRights sets default admin rights in groups.
+	ChangeGroupAdminRights
+	// ChangeBroadcastAdminRights sets default admin rights in channels.
+	ChangeBroadcastAdminRights
+)
+
+// String implements fmt.Stringer.
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeCommands:
+		return "commands"
+	case ChangeResetCommands:
+		return "reset commands"
+	case ChangeInfo:
+		return "info"
+	case ChangeMenuButton:
+		return "menu button"
+	case ChangeGroupAdminRights:
+		return "group admin rights"
+	case ChangeBroadcastAdminRights:
+		return "broadcast admin rights"
+	default:
+		return fmt.Sprintf("ChangeKind(%d)", int(k))
+	}
+}
+
+// Change is a single change of bot profile.
+type Change struct {
+	// Kind of change.
+	Kind ChangeKind
+	// Scope of commands, set for ChangeCommands and ChangeResetCommands.
+	Scope tg.BotCommandScopeClass
+	// LangCode of commands or info.
+	LangCode string
+
+	apply func(ctx context.Context, api *tg.Client) error
+}
+
+// String implements fmt.Stringer.
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeCommands, ChangeResetCommands:
+		return fmt.Sprintf("%s (scope: %s, lang: %q)", c.Kind, c.Scope.TypeName(), c.LangCode)
+	case ChangeInfo:
+		return fmt.Sprintf("%s (lang: %q)", c.Kind, c.LangCode)
+	default:
+		return c.Kind.String()
+	}
+}
+
+func commandsChange(c Commands) Change {
+	scope := c.scope()
+	if len(c.Commands) == 0 {
+		return Change{
+			Kind:     ChangeResetCommands,
+			Scope:    scope,
+			LangCode: c.LangCode,
+			apply: func(ctx context.Context, api *tg.Client) error {
+				if _, err := api.BotsResetBotCommands(ctx, &tg.BotsResetBotCommandsRequest{
+					Scope:    scope,
+					LangCode: c.LangCode,
+				}); err != nil {
+					return errors.Wrap(err, "reset bot commands")
+				}
+				return nil
+			},
+		}
+	}
+
+	return Change{
+		Kind:     ChangeCommands,
+		Scope:    scope,
+		LangCode: c.LangCode,
+		apply: func(ctx context.Context, api *tg.Client) error {
+			if _, err := api.BotsSetBotCommands(ctx, &tg.BotsSetBotCommandsRequest{
+				Scope:    scope,
+				LangCode: c.LangCode,
+				Commands: c.Commands,
+			}); err != nil {
+				return errors.Wrap(err, "set bot commands")
+			}
+			return nil
+		},
+	}
+}
+
+func infoChange(req *tg.BotsSetBotInfoRequest) Change {
+	return Change{
+		Kind:     ChangeInfo,
+		LangCode: req.LangCode,
+		apply: func(ctx context.Context, api *tg.Client) error {
+			if _, err := api.BotsSetBotInfo(ctx, req); err != nil {
+				return errors.Wrap(err, "set bot info")
+			}
+			return nil
+		},
+	}
+}
+
+func menuButtonChange(button tg.BotMenuButtonClass) Change {
+	return Change{
+		Kind: ChangeMenuButton,
+		apply: func(ctx context.Context, api *tg.Client) error {
+			if _, err := api.BotsSetBotMenuButton(ctx, &tg.BotsSetBotMenuButtonRequest{
+				UserID: &tg.InputUserEmpty{},
+				Button: button,
+			}); err != nil {
+				return errors.Wrap(err, "set bot menu button")
+			}
+			return nil
+		},
+	}
+}
+
+func groupAdminRightsChange(rights tg.ChatAdminRights) Change {
+	return Change{
+		Kind: ChangeGroupAdminRights,
+		apply: func(ctx context.Context, api *tg.Client) error {
+			if _, err := api.BotsSetBotGroupDefaultAdminRights(ctx, rights); err != nil {
+				return errors.Wrap(err, "set group default admin rights")
+			}
+			return nil
+		},
+	}
+}
+
+func broadcastAdminRightsChange(rights tg.ChatAdminRights) Change {
+	return Change{
+		Kind: ChangeBroadcastAdminRights,
+		apply: func(ctx context.Context, api *tg.Client) error {
+			if _, err := api.BotsSetBotBroadcastDefaultAdminRights(ctx, rights); err != nil {
+				return errors.Wrap(err, "set broadcast default admin rights")
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,175 @@
+package botprofile
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+)
+
+// Options of Syncer.
+type Options struct {
+	// Logger to use. Defaults to zerolog.Nop.
+	Logger *zerolog.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.Logger == nil {
+		nop := zerolog.Nop()
+		o.Logger = &nop
+	}
+}
+
+// Syncer synchronizes bot profile of current bot.
+type Syncer struct {
+	api *tg.Client
+	log *zerolog.Logger
+}
+
+// NewSyncer creates new Syncer.
+func NewSyncer(api *tg.Client, opts Options) *Syncer {
+	opts.setDefaults()
+	return &Syncer{
+		api: api,
+		log: opts.Logger,
+	}
+}
+
+// Diff compares profile with current state and returns changes to apply.
+func (s *Syncer) Diff(ctx context.Context, p Profile) ([]Change, error) {
+	var changes []Change
+
+	for _, c := range p.Commands {
+		current, err := s.api.BotsGetBotCommands(ctx, &tg.BotsGetBotCommandsRequest{
+			Scope:    c.scope(),
+			LangCode: c.LangCode,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "get commands (scope: %s, lang: %q)", c.scope().TypeName(), c.LangCode)
+		}
+		if !commandsEqual(current, c.Commands) {
+			changes = append(changes, commandsChange(c))
+		}
+	}
+
+	for _, info := range p.Info {
+		current, err := s.api.BotsGetBotInfo(ctx, &tg.BotsGetBotInfoRequest{
+			LangCode: info.LangCode,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "get info (lang: %q)", info.LangCode)
+		}
+		if req, ok := infoRequest(current, info); ok {
+			changes = append(changes, infoChange(req))
+		}
+	}
+
+	if p.MenuButton != nil {
+		current, err := s.api.BotsGetBotMenuButton(ctx, &tg.InputUserEmpty{})
+		if err != nil {
+			return nil, errors.Wrap(err, "get menu button")
+		}
+		if !tlEqual(current, p.MenuButton) {
+			changes = append(changes, menuButtonChange(p.MenuButton))
+		}
+	}
+
+	if p.GroupAdminRights != nil || p.BroadcastAdminRights != nil {
+		full, err := s.api.UsersGetFullUser(ctx, &tg.InputUserSelf{})
+		if err != nil {
+			return nil, errors.Wrap(err, "get full user")
+		}
+
+		if p.GroupAdminRights != nil {
+			current, _ := full.FullUser.GetBotGroupAdminRights()
+			if !rightsEqual(current, *p.GroupAdminRights) {
+				changes = append(changes, groupAdminRightsChange(*p.GroupAdminRights))
+			}
+		}
+		if p.BroadcastAdminRights != nil {
+			current, _ := full.FullUser.GetBotBroadcastAdminRights()
+			if !rightsEqual(current, *p.BroadcastAdminRights) {
+				changes = append(changes, broadcastAdminRightsChange(*p.BroadcastAdminRights))
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// Apply applies given changes.
+func (s *Syncer) Apply(ctx context.Context, changes ...Change) error {
+	for _, c := range changes {
+		s.log.Debug().Stringer("change", c).Msg("Applying change")
+		if err := c.apply(ctx, s.api); err != nil {
+			return errors.Wrapf(err, "apply %s", c)
+		}
+	}
+	return nil
+}
+
+// Sync applies changes between profile and current state and returns
+// applied changes.
+func (s *Syncer) Sync(ctx context.Context, p Profile) ([]Change, error) {
+	changes, err := s.Diff(ctx, p)
+	if err != nil {
+		return nil, errors.Wrap(err, "diff")
+	}
+	if err := s.Apply(ctx, changes...); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func commandsEqual(a, b []tg.BotCommand) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// infoRequest returns request which sets changed fields of info.
+func infoRequest(current *tg.BotsBotInfo, info Info) (*tg.BotsSetBotInfoRequest, bool) {
+	req := &tg.BotsSetBotInfoRequest{LangCode: info.LangCode}
+	changed := false
+	if current.Name != info.Name {
+		req.SetName(info.Name)
+		changed = true
+	}
+	if current.About != info.About {
+		req.SetAbout(info.About)
+		changed = true
+	}
+	if current.Description != info.Description {
+		req.SetDescription(info.Description)
+		changed = true
+	}
+	return req, changed
+}
+
+// rightsEqual compares rights ignoring Flags field, which is not set for
+// rights created by user.
+func rightsEqual(a, b tg.ChatAdminRights) bool {
+	return tlEqual(&a, &b)
+}
+
+// tlEqual compares binary representations of given objects.
+func tlEqual(a, b bin.Encoder) bool {
+	var ab, bb bin.Buffer
+	if err := a.Encode(&ab); err != nil {
+		return false
+	}
+	if err := b.Encode(&bb); err != nil {
+		return false
+	}
+	return bytes.Equal(ab.Buf, bb.Buf)
+}
@@ -0,0 +1,140 @@
+package botprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestSyncer_Sync(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock := tgmock.New(t)
+	s := NewSyncer(tg.NewClient(mock), Options{})
+
+	start := tg.BotCommand{Command: "start", Description: "Start bot"}
+	help := tg.BotCommand{Command: "help", Description: "Show help"}
+	rights := tg.ChatAdminRights{PostMessages: true, EditMessages: true}
+	p := Profile{
+		Commands: []Commands{
+			{Commands: []tg.BotCommand{start, help}},
+			{LangCode: "ru", Commands: []tg.BotCommand{start}},
+			{Scope: &tg.BotCommandScopeChatAdmins{}},
+		},
+		Info: []Info{
+			{Name: "Bot", About: "About", Description: "Description"},
+			{LangCode: "ru", Name: "Бот"},
+		},
+		MenuButton:           &tg.BotMenuButtonCommands{},
+		BroadcastAdminRights: &rights,
+	}
+
+	// Default commands are up to date.
+	mock.ExpectCall(&tg.BotsGetBotCommandsRequest{
+		Scope: &tg.BotCommandScopeDefault{},
+	}).ThenResult(&tg.BotCommandVector{Elems: []tg.BotCommand{start, help}})
+	mock.ExpectCall(&tg.BotsGetBotCommandsRequest{
+		Scope:    &tg.BotCommandScopeDefault{},
+		LangCode: "ru",
+	}).ThenResult(&tg.BotCommandVector{})
+	mock.ExpectCall(&tg.BotsGetBotCommandsRequest{
+		Scope: &tg.BotCommandScopeChatAdmins{},
+	}).ThenResult(&tg.BotCommandVector{Elems: []tg.BotCommand{help}})
+
+	// Default info is up to date.
+	mock.ExpectCall(&tg.BotsGetBotInfoRequest{}).ThenResult(&tg.BotsBotInfo{
+		Name:        "Bot",
+		About:       "About",
+		Description: "Description",
+	})
+	mock.ExpectCall(&tg.BotsGetBotInfoRequest{
+		LangCode: "ru",
+	}).ThenResult(&tg.BotsBotInfo{
+		Name:  "Bot",
+		About: "О боте",
+	})
+
+	mock.ExpectCall(&tg.BotsGetBotMenuButtonRequest{
+		UserID: &tg.InputUserEmpty{},
+	}).ThenResult(&tg.BotMenuButtonDefault{})
+
+	current := tg.ChatAdminRights{PostMessages: true}
+	current.SetFlags()
+	full := tg.UserFull{ID: 1}
+	full.SetBotBroadcastAdminRights(current)
+	mock.ExpectCall(&tg.UsersGetFullUserRequest{
+		ID: &tg.InputUserSelf{},
+	}).ThenResult(&tg.UsersUserFull{FullUser: full})
+
+	// Changes.
+	mock.ExpectCall(&tg.BotsSetBotCommandsRequest{
+		Scope:    &tg.BotCommandScopeDefault{},
+		LangCode: "ru",
+		Commands: []tg.BotCommand{start},
+	}).ThenTrue()
+	mock.ExpectCall(&tg.BotsResetBotCommandsRequest{
+		Scope: &tg.BotCommandScopeChatAdmins{},
+	}).ThenTrue()
+	info := &tg.BotsSetBotInfoRequest{LangCode: "ru"}
+	info.SetName("Бот")
+	info.SetAbout("")
+	mock.ExpectCall(info).ThenTrue()
+	mock.ExpectCall(&tg.BotsSetBotMenuButtonRequest{
+		UserID: &tg.InputUserEmpty{},
+		Button: &tg.BotMenuButtonCommands{},
+	}).ThenTrue()
+	mock.ExpectCall(&tg.BotsSetBotBroadcastDefaultAdminRightsRequest{
+		AdminRights: rights,
+	}).ThenTrue()
+
+	changes, err := s.Sync(ctx, p)
+	a.NoError(err)
+
+	kinds := make([]ChangeKind, len(changes))
+	for i, c := range changes {
+		kinds[i] = c.Kind
+		a.NotEmpty(c.String())
+	}
+	a.Equal([]ChangeKind{
+		ChangeCommands,
+		ChangeResetCommands,
+		ChangeInfo,
+		ChangeMenuButton,
+		ChangeBroadcastAdminRights,
+	}, kinds)
+}
+
+func TestSyncer_DiffUpToDate(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock := tgmock.New(t)
+	s := NewSyncer(tg.NewClient(mock), Options{})
+
+	rights := tg.ChatAdminRights{ChangeInfo: true}
+	current := rights
+	current.SetFlags()
+	full := tg.UserFull{ID: 1}
+	full.SetBotGroupAdminRights(current)
+
+	mock.ExpectCall(&tg.BotsGetBotCommandsRequest{
+		Scope: &tg.BotCommandScopeUsers{},
+	}).ThenResult(&tg.BotCommandVector{})
+	mock.ExpectCall(&tg.BotsGetBotMenuButtonRequest{
+		UserID: &tg.InputUserEmpty{},
+	}).ThenResult(&tg.BotMenuButton{Text: "Open", URL: "https://example.com"})
+	mock.ExpectCall(&tg.UsersGetFullUserRequest{
+		ID: &tg.InputUserSelf{},
+	}).ThenResult(&tg.UsersUserFull{FullUser: full})
+
+	changes, err := s.Diff(ctx, Profile{
+		Commands:         []Commands{{Scope: &tg.BotCommandScopeUsers{}}},
+		MenuButton:       &tg.BotMenuButton{Text: "Open", URL: "https://example.com"},
+		GroupAdminRights: &rights,
+	})
+	a.NoError(err)
+	a.Empty(changes)
+}
@@ -0,0 +1,82 @@
+// Package botquery provides typed contexts for answering bot queries, like
+// callback, inline and payment queries.
+package botquery
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+// Options of Manager.
+type Options struct {
+	// Codec to encode and decode callback data and invoice payloads.
+	//
+	// Defaults to JSONCodec.
+	Codec Codec
+	// Random is random source used by inline result builder.
+	//
+	// Defaults to crypto.DefaultRand.
+	Random io.Reader
+}
+
+func (o *Options) setDefaults() {
+	if o.Codec == nil {
+		o.Codec = JSONCodec{}
+	}
+	if o.Random == nil {
+		o.Random = crypto.DefaultRand()
+	}
+}
+
+// Manager creates query contexts from bot updates.
+type Manager struct {
+	api    *tg.Client
+	peers  *peers.Manager
+	codec  Codec
+	random io.Reader
+}
+
+// New creates new Manager.
+func New(m *peers.Manager, opts Options) *Manager {
+	opts.setDefaults()
+	return &Manager{
+		api:    m.API(),
+		peers:  m,
+		codec:  opts.Codec,
+		random: opts.Random,
+	}
+}
+
+// Encode encodes given value to callback data or invoice payload using
+// Manager codec.
+func (m *Manager) Encode(v interface{}) ([]byte, error) {
+	data, err := m.codec.Encode(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode")
+	}
+	return data, nil
+}
+
+func (m *Manager) decode(data []byte, v interface{}) error {
+	if err := m.codec.Decode(data, v); err != nil {
+		return errors.Wrap(err, "decode")
+	}
+	return nil
+}
+
+func (m *Manager) user(ctx context.Context, e tg.Entities, id int64) (peers.User, error) {
+	if u, ok := e.Users[id]; ok {
+		return m.peers.User(u), nil
+	}
+	u, err := m.peers.ResolveUserID(ctx, id)
+	if err != nil {
+		return peers.User{}, errors.Wrapf(err, "resolve user %d", id)
+	}
+	return u, nil
+}
@@ -0,0 +1,40 @@
+package botquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/testutil"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func testManager(t *testing.T) (*tgmock.Mock, *Manager) {
+	mock := tgmock.New(t)
+	lg := zerolog.New(zerolog.NewTestWriter(t))
+	p := peers.Options{
+		Logger: &lg,
+		Cache:  &peers.InmemoryCache{},
+	}.Build(tg.NewClient(mock))
+	return mock, New(p, Options{Random: testutil.ZeroRand{}})
+}
+
+func getTestUser() *tg.User {
+	return &tg.User{
+		ID:         10,
+		AccessHash: 10,
+		FirstName:  "Sender",
+	}
+}
+
+func testEntities(t *testing.T, m *Manager) tg.Entities {
+	u := getTestUser()
+	require.NoError(t, m.peers.Apply(context.Background(), []tg.UserClass{u}, nil))
+	return tg.Entities{
+		Users: map[int64]*tg.User{u.ID: u},
+	}
+}
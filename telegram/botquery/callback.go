@@ -0,0 +1,227 @@
+package botquery
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+// CallbackQuery is a callback query context.
+//
+// See https://core.telegram.org/api/bots/buttons#callback-queries.
+type CallbackQuery struct {
+	// QueryID is query identifier.
+	QueryID int64
+	// Sender of query.
+	Sender peers.User
+	// Peer where message with button was sent.
+	//
+	// Nil if query is from inline message.
+	Peer peers.Peer
+	// MsgID is ID of message with button.
+	//
+	// Zero if query is from inline message.
+	MsgID int
+	// InlineMsgID is ID of inline message with button.
+	//
+	// Nil if query is not from inline message.
+	InlineMsgID tg.InputBotInlineMessageIDClass
+	// ChatInstance is global identifier of chat where message was sent.
+	ChatInstance int64
+	// Data is callback data.
+	Data []byte
+	// GameShortName is short name of game, if button is a game button.
+	GameShortName string
+
+	m *Manager
+}
+
+// CallbackQuery creates context from UpdateBotCallbackQuery.
+func (m *Manager) CallbackQuery(ctx context.Context, e tg.Entities, u *tg.UpdateBotCallbackQuery) (*CallbackQuery, error) {
+	sender, err := m.user(ctx, e, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := m.peers.ResolvePeer(ctx, u.Peer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resolve peer %+v", u.Peer)
+	}
+	return &CallbackQuery{
+		QueryID:       u.QueryID,
+		Sender:        sender,
+		Peer:          p,
+		MsgID:         u.MsgID,
+		ChatInstance:  u.ChatInstance,
+		Data:          u.Data,
+		GameShortName: u.GameShortName,
+		m:             m,
+	}, nil
+}
+
+// InlineCallbackQuery creates context from UpdateInlineBotCallbackQuery.
+func (m *Manager) InlineCallbackQuery(
+	ctx context.Context, e tg.Entities, u *tg.UpdateInlineBotCallbackQuery,
+) (*CallbackQuery, error) {
+	sender, err := m.user(ctx, e, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &CallbackQuery{
+		QueryID:       u.QueryID,
+		Sender:        sender,
+		InlineMsgID:   u.MsgID,
+		ChatInstance:  u.ChatInstance,
+		Data:          u.Data,
+		GameShortName: u.GameShortName,
+		m:             m,
+	}, nil
+}
+
+// OnCallbackQuery sets handler of callback queries, both from regular and
+// inline messages.
+func (m *Manager) OnCallbackQuery(d interface {
+	OnBotCallbackQuery(tg.BotCallbackQueryHandler)
+	OnInlineBotCallbackQuery(tg.InlineBotCallbackQueryHandler)
+}, h func(ctx context.Context, q *CallbackQuery) error,
+) {
+	d.OnBotCallbackQuery(func(ctx context.Context, e tg.Entities, u *tg.UpdateBotCallbackQuery) error {
+		q, err := m.CallbackQuery(ctx, e, u)
+		if err != nil {
+			return errors.Wrap(err, "callback query")
+		}
+		return h(ctx, q)
+	})
+	d.OnInlineBotCallbackQuery(func(ctx context.Context, e tg.Entities, u *tg.UpdateInlineBotCallbackQuery) error {
+		q, err := m.InlineCallbackQuery(ctx, e, u)
+		if err != nil {
+			return errors.Wrap(err, "inline callback query")
+		}
+		return h(ctx, q)
+	})
+}
+
+// Inline whether query is from inline message.
+func (q *CallbackQuery) Inline() bool {
+	return q.InlineMsgID != nil
+}
+
+// Decode decodes callback data using Manager codec.
+func (q *CallbackQuery) Decode(v interface{}) error {
+	return q.m.decode(q.Data, v)
+}
+
+// CallbackAnswer is an answer to callback query.
+type CallbackAnswer struct {
+	// Text of notification.
+	Text string
+	// Alert whether to show alert instead of notification.
+	Alert bool
+	// URL to open.
+	URL string
+	// CacheTime is time for which result may be cached on client side.
+	CacheTime time.Duration
+}
+
+// AnswerWith answers query with given answer.
+//
+// Query must be answered, otherwise client shows progress bar until timeout.
+func (q *CallbackQuery) AnswerWith(ctx context.Context, a CallbackAnswer) error {
+	if _, err := q.m.api.MessagesSetBotCallbackAnswer(ctx, &tg.MessagesSetBotCallbackAnswerRequest{
+		Alert:     a.Alert,
+		QueryID:   q.QueryID,
+		Message:   a.Text,
+		URL:       a.URL,
+		CacheTime: int(a.CacheTime.Seconds()),
+	}); err != nil {
+		return errors.Wrap(err, "set bot callback answer")
+	}
+	return nil
+}
+
+// Answer answers query with notification.
+//
+// If text is empty, nothing is shown.
+func (q *CallbackQuery) Answer(ctx context.Context, text string) error {
+	return q.AnswerWith(ctx, CallbackAnswer{Text: text})
+}
+
+// Alert answers query with alert.
+func (q *CallbackQuery) Alert(ctx context.Context, text string) error {
+	return q.AnswerWith(ctx, CallbackAnswer{Text: text, Alert: true})
+}
+
+// OpenURL answers query with URL to open.
+//
+// Only game URLs and t.me links with start parameter are allowed.
+func (q *CallbackQuery) OpenURL(ctx context.Context, url string) error {
+	return q.AnswerWith(ctx, CallbackAnswer{URL: url})
+}
+
+func (q *CallbackQuery) edit(
+	ctx context.Context,
+	text string,
+	entities []tg.MessageEntityClass,
+	markup tg.ReplyMarkupClass,
+) error {
+	if q.Inline() {
+		if _, err := q.m.api.MessagesEditInlineBotMessage(ctx, &tg.MessagesEditInlineBotMessageRequest{
+			ID:          q.InlineMsgID,
+			Message:     text,
+			Entities:    entities,
+			ReplyMarkup: markup,
+		}); err != nil {
+			return errors.Wrap(err, "edit inline bot message")
+		}
+		return nil
+	}
+
+	if _, err := q.m.api.MessagesEditMessage(ctx, &tg.MessagesEditMessageRequest{
+		Peer:        q.Peer.InputPeer(),
+		ID:          q.MsgID,
+		Message:     text,
+		Entities:    entities,
+		ReplyMarkup: markup,
+	}); err != nil {
+		return errors.Wrap(err, "edit message")
+	}
+	return nil
+}
+
+// Edit edits text and reply markup of message with button.
+//
+// If markup is nil, reply markup is removed.
+func (q *CallbackQuery) Edit(ctx context.Context, text string, markup tg.ReplyMarkupClass) error {
+	if text == "" {
+		return errors.New("empty text")
+	}
+	return q.edit(ctx, text, nil, markup)
+}
+
+// EditStyled edits text and reply markup of message with button using
+// styled text.
+func (q *CallbackQuery) EditStyled(
+	ctx context.Context,
+	markup tg.ReplyMarkupClass,
+	texts ...styling.StyledTextOption,
+) error {
+	tb := entity.Builder{}
+	if err := styling.Perform(&tb, texts...); err != nil {
+		return errors.Wrap(err, "perform styling")
+	}
+	text, entities := tb.Complete()
+	if text == "" {
+		return errors.New("empty text")
+	}
+	return q.edit(ctx, text, entities, markup)
+}
+
+// EditMarkup edits only reply markup of message with button.
+func (q *CallbackQuery) EditMarkup(ctx context.Context, markup tg.ReplyMarkupClass) error {
+	return q.edit(ctx, "", nil, markup)
+}
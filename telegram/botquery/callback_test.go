@@ -0,0 +1,107 @@
+package botquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/gotd/td/tg"
+)
+
+type testPayload struct {
+	Action string `json:"a"`
+	ID     int    `json:"id"`
+}
+
+func TestCallbackQuery(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+	// Seed peer cache to resolve message peer.
+	testEntities(t, m)
+
+	data, err := m.Encode(testPayload{Action: "del", ID: 5})
+	a.NoError(err)
+
+	d := tg.NewUpdateDispatcher()
+	var q *CallbackQuery
+	m.OnCallbackQuery(d, func(ctx context.Context, query *CallbackQuery) error {
+		q = query
+		return nil
+	})
+	a.NoError(d.Handle(ctx, &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateBotCallbackQuery{
+				QueryID: 1,
+				UserID:  10,
+				Peer:    &tg.PeerUser{UserID: 10},
+				MsgID:   2,
+				Data:    data,
+			},
+		},
+		Users: []tg.UserClass{getTestUser()},
+	}))
+	a.NotNil(q)
+	a.False(q.Inline())
+	a.Equal(int64(10), q.Sender.ID())
+	a.Equal(int64(10), q.Peer.ID())
+
+	var payload testPayload
+	a.NoError(q.Decode(&payload))
+	a.Equal(testPayload{Action: "del", ID: 5}, payload)
+
+	mock.ExpectCall(&tg.MessagesSetBotCallbackAnswerRequest{
+		QueryID: 1,
+		Message: "Done",
+	}).ThenTrue()
+	a.NoError(q.Answer(ctx, "Done"))
+
+	mock.ExpectCall(&tg.MessagesSetBotCallbackAnswerRequest{
+		Alert:   true,
+		QueryID: 1,
+		Message: "Error",
+	}).ThenTrue()
+	a.NoError(q.Alert(ctx, "Error"))
+
+	mock.ExpectCall(&tg.MessagesEditMessageRequest{
+		Peer:    &tg.InputPeerUser{UserID: 10, AccessHash: 10},
+		ID:      2,
+		Message: "Deleted",
+	}).ThenResult(&tg.Updates{})
+	a.NoError(q.Edit(ctx, "Deleted", nil))
+
+	mock.ExpectCall(&tg.MessagesEditMessageRequest{
+		Peer:     &tg.InputPeerUser{UserID: 10, AccessHash: 10},
+		ID:       2,
+		Message:  "Bold",
+		Entities: []tg.MessageEntityClass{&tg.MessageEntityBold{Length: 4}},
+	}).ThenResult(&tg.Updates{})
+	a.NoError(q.EditStyled(ctx, nil, styling.Bold("Bold")))
+
+	a.Error(q.Edit(ctx, "", nil))
+}
+
+func TestInlineCallbackQuery(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+	e := testEntities(t, m)
+
+	msgID := &tg.InputBotInlineMessageID{DCID: 2, ID: 1, AccessHash: 1}
+	q, err := m.InlineCallbackQuery(ctx, e, &tg.UpdateInlineBotCallbackQuery{
+		QueryID: 1,
+		UserID:  10,
+		MsgID:   msgID,
+	})
+	a.NoError(err)
+	a.True(q.Inline())
+
+	markup := &tg.ReplyInlineMarkup{}
+	mock.ExpectCall(&tg.MessagesEditInlineBotMessageRequest{
+		ID:          msgID,
+		ReplyMarkup: markup,
+	}).ThenTrue()
+	a.NoError(q.EditMarkup(ctx, markup))
+}
@@ -0,0 +1,57 @@
+package botquery
+
+import (
+	"encoding/json"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+)
+
+// Codec encodes and decodes callback data and invoice payloads.
+//
+// Note that callback data is limited to 64 bytes.
+type Codec interface {
+	Encode(v interface{}) ([]byte, error)
+	Decode(data []byte, v interface{}) error
+}
+
+// JSONCodec is a Codec which uses JSON encoding.
+type JSONCodec struct{}
+
+// Encode implements Codec.
+func (JSONCodec) Encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Decode implements Codec.
+func (JSONCodec) Decode(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// BinCodec is a Codec which uses TL binary encoding.
+//
+// Values must implement bin.Encoder and bin.Decoder.
+type BinCodec struct{}
+
+// Encode implements Codec.
+func (BinCodec) Encode(v interface{}) ([]byte, error) {
+	e, ok := v.(bin.Encoder)
+	if !ok {
+		return nil, errors.Errorf("%T does not implement bin.Encoder", v)
+	}
+	var b bin.Buffer
+	if err := e.Encode(&b); err != nil {
+		return nil, err
+	}
+	return b.Buf, nil
+}
+
+// Decode implements Codec.
+func (BinCodec) Decode(data []byte, v interface{}) error {
+	d, ok := v.(bin.Decoder)
+	if !ok {
+		return errors.Errorf("%T does not implement bin.Decoder", v)
+	}
+	return d.Decode(&bin.Buffer{Buf: data})
+}
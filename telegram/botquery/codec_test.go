@@ -0,0 +1,24 @@
+package botquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestBinCodec(t *testing.T) {
+	a := require.New(t)
+	c := BinCodec{}
+
+	data, err := c.Encode(&tg.InputPeerUser{UserID: 1, AccessHash: 2})
+	a.NoError(err)
+	var p tg.InputPeerUser
+	a.NoError(c.Decode(data, &p))
+	a.Equal(tg.InputPeerUser{UserID: 1, AccessHash: 2}, p)
+
+	_, err = c.Encode(1)
+	a.Error(err)
+	a.Error(c.Decode(data, new(int)))
+}
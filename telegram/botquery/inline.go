@@ -0,0 +1,96 @@
+package botquery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/message/inline"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+// InlineQuery is an inline query context.
+//
+// See https://core.telegram.org/api/bots/inline.
+type InlineQuery struct {
+	// QueryID is query identifier.
+	QueryID int64
+	// Sender of query.
+	Sender peers.User
+	// Query text.
+	Query string
+	// Offset is offset of results to be returned, as passed to
+	// ResultBuilder.NextOffset in previous answer.
+	Offset string
+	// Geo is location of sender, if requested by bot.
+	Geo tg.GeoPointClass
+	// PeerType is type of chat where query was sent.
+	PeerType tg.InlineQueryPeerTypeClass
+
+	m *Manager
+}
+
+// InlineQuery creates context from UpdateBotInlineQuery.
+func (m *Manager) InlineQuery(ctx context.Context, e tg.Entities, u *tg.UpdateBotInlineQuery) (*InlineQuery, error) {
+	sender, err := m.user(ctx, e, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &InlineQuery{
+		QueryID:  u.QueryID,
+		Sender:   sender,
+		Query:    u.Query,
+		Offset:   u.Offset,
+		Geo:      u.Geo,
+		PeerType: u.PeerType,
+		m:        m,
+	}, nil
+}
+
+// OnInlineQuery sets handler of inline queries.
+func (m *Manager) OnInlineQuery(d interface {
+	OnBotInlineQuery(tg.BotInlineQueryHandler)
+}, h func(ctx context.Context, q *InlineQuery) error,
+) {
+	d.OnBotInlineQuery(func(ctx context.Context, e tg.Entities, u *tg.UpdateBotInlineQuery) error {
+		q, err := m.InlineQuery(ctx, e, u)
+		if err != nil {
+			return errors.Wrap(err, "inline query")
+		}
+		return h(ctx, q)
+	})
+}
+
+// Results returns result builder to answer query.
+func (q *InlineQuery) Results() *inline.ResultBuilder {
+	return inline.New(q.m.api, q.m.random, q.QueryID)
+}
+
+// Page computes bounds of results page for offset-based pagination.
+//
+// Offset is interpreted as index of first result. Results in [from, to)
+// should be returned, with next passed to ResultBuilder.NextOffset.
+// Next is empty if there are no more results.
+func (q *InlineQuery) Page(pageSize, total int) (from, to int, next string) {
+	from, err := strconv.Atoi(q.Offset)
+	if err != nil || from < 0 || from > total {
+		from = 0
+	}
+	to = from + pageSize
+	if to >= total {
+		return from, total, ""
+	}
+	return from, to, strconv.Itoa(to)
+}
+
+// Answer answers query with given page of results.
+//
+// Use Results for more options, like caching and private results.
+func (q *InlineQuery) Answer(ctx context.Context, next string, results ...inline.ResultOption) error {
+	if _, err := q.Results().NextOffset(next).Set(ctx, results...); err != nil {
+		return errors.Wrap(err, "set inline results")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package botquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestInlineQuery_Page(t *testing.T) {
+	for _, tt := range []struct {
+		offset   string
+		from, to int
+		next     string
+	}{
+		{"", 0, 10, "10"},
+		{"10", 10, 20, "20"},
+		{"20", 20, 25, ""},
+		{"foo", 0, 10, "10"},
+		{"100", 0, 10, "10"},
+	} {
+		q := &InlineQuery{Offset: tt.offset}
+		from, to, next := q.Page(10, 25)
+		require.Equal(t, tt.from, from, tt.offset)
+		require.Equal(t, tt.to, to, tt.offset)
+		require.Equal(t, tt.next, next, tt.offset)
+	}
+}
+
+func TestInlineQuery(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	d := tg.NewUpdateDispatcher()
+	var q *InlineQuery
+	m.OnInlineQuery(d, func(ctx context.Context, query *InlineQuery) error {
+		q = query
+		return nil
+	})
+	a.NoError(d.Handle(ctx, &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateBotInlineQuery{
+				QueryID: 1,
+				UserID:  10,
+				Query:   "cats",
+				Offset:  "10",
+			},
+		},
+		Users: []tg.UserClass{getTestUser()},
+	}))
+	a.NotNil(q)
+	a.Equal("cats", q.Query)
+	a.Equal(int64(10), q.Sender.ID())
+
+	mock.ExpectCall(&tg.MessagesSetInlineBotResultsRequest{
+		QueryID:    1,
+		NextOffset: "20",
+	}).ThenTrue()
+	a.NoError(q.Answer(ctx, "20"))
+}
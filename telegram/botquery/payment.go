@@ -0,0 +1,167 @@
+package botquery
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+// PreCheckoutQuery is a pre-checkout query context.
+//
+// Bot must answer query in 10 seconds, otherwise payment is canceled.
+//
+// See https://core.telegram.org/api/payments#7-pre-checkout-query.
+type PreCheckoutQuery struct {
+	// QueryID is query identifier.
+	QueryID int64
+	// Sender of query.
+	Sender peers.User
+	// Payload is invoice payload.
+	Payload []byte
+	// Info is order info provided by user.
+	Info tg.PaymentRequestedInfo
+	// ShippingOptionID is identifier of chosen shipping option.
+	ShippingOptionID string
+	// Currency is three-letter ISO 4217 currency code.
+	Currency string
+	// TotalAmount is total amount in the smallest units of currency.
+	TotalAmount int64
+
+	m *Manager
+}
+
+// PreCheckoutQuery creates context from UpdateBotPrecheckoutQuery.
+func (m *Manager) PreCheckoutQuery(
+	ctx context.Context, e tg.Entities, u *tg.UpdateBotPrecheckoutQuery,
+) (*PreCheckoutQuery, error) {
+	sender, err := m.user(ctx, e, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &PreCheckoutQuery{
+		QueryID:          u.QueryID,
+		Sender:           sender,
+		Payload:          u.Payload,
+		Info:             u.Info,
+		ShippingOptionID: u.ShippingOptionID,
+		Currency:         u.Currency,
+		TotalAmount:      u.TotalAmount,
+		m:                m,
+	}, nil
+}
+
+// OnPreCheckoutQuery sets handler of pre-checkout queries.
+func (m *Manager) OnPreCheckoutQuery(d interface {
+	OnBotPrecheckoutQuery(tg.BotPrecheckoutQueryHandler)
+}, h func(ctx context.Context, q *PreCheckoutQuery) error,
+) {
+	d.OnBotPrecheckoutQuery(func(ctx context.Context, e tg.Entities, u *tg.UpdateBotPrecheckoutQuery) error {
+		q, err := m.PreCheckoutQuery(ctx, e, u)
+		if err != nil {
+			return errors.Wrap(err, "pre-checkout query")
+		}
+		return h(ctx, q)
+	})
+}
+
+// Decode decodes invoice payload using Manager codec.
+func (q *PreCheckoutQuery) Decode(v interface{}) error {
+	return q.m.decode(q.Payload, v)
+}
+
+// Approve approves payment.
+func (q *PreCheckoutQuery) Approve(ctx context.Context) error {
+	if _, err := q.m.api.MessagesSetBotPrecheckoutResults(ctx, &tg.MessagesSetBotPrecheckoutResultsRequest{
+		Success: true,
+		QueryID: q.QueryID,
+	}); err != nil {
+		return errors.Wrap(err, "set bot precheckout results")
+	}
+	return nil
+}
+
+// Reject rejects payment with given human-readable reason.
+func (q *PreCheckoutQuery) Reject(ctx context.Context, reason string) error {
+	if _, err := q.m.api.MessagesSetBotPrecheckoutResults(ctx, &tg.MessagesSetBotPrecheckoutResultsRequest{
+		QueryID: q.QueryID,
+		Error:   reason,
+	}); err != nil {
+		return errors.Wrap(err, "set bot precheckout results")
+	}
+	return nil
+}
+
+// ShippingQuery is a shipping query context.
+//
+// See https://core.telegram.org/api/payments#6-shipping-query.
+type ShippingQuery struct {
+	// QueryID is query identifier.
+	QueryID int64
+	// Sender of query.
+	Sender peers.User
+	// Payload is invoice payload.
+	Payload []byte
+	// Address is shipping address provided by user.
+	Address tg.PostAddress
+
+	m *Manager
+}
+
+// ShippingQuery creates context from UpdateBotShippingQuery.
+func (m *Manager) ShippingQuery(ctx context.Context, e tg.Entities, u *tg.UpdateBotShippingQuery) (*ShippingQuery, error) {
+	sender, err := m.user(ctx, e, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &ShippingQuery{
+		QueryID: u.QueryID,
+		Sender:  sender,
+		Payload: u.Payload,
+		Address: u.ShippingAddress,
+		m:       m,
+	}, nil
+}
+
+// OnShippingQuery sets handler of shipping queries.
+func (m *Manager) OnShippingQuery(d interface {
+	OnBotShippingQuery(tg.BotShippingQueryHandler)
+}, h func(ctx context.Context, q *ShippingQuery) error,
+) {
+	d.OnBotShippingQuery(func(ctx context.Context, e tg.Entities, u *tg.UpdateBotShippingQuery) error {
+		q, err := m.ShippingQuery(ctx, e, u)
+		if err != nil {
+			return errors.Wrap(err, "shipping query")
+		}
+		return h(ctx, q)
+	})
+}
+
+// Decode decodes invoice payload using Manager codec.
+func (q *ShippingQuery) Decode(v interface{}) error {
+	return q.m.decode(q.Payload, v)
+}
+
+// Answer answers query with available shipping options.
+func (q *ShippingQuery) Answer(ctx context.Context, options ...tg.ShippingOption) error {
+	if _, err := q.m.api.MessagesSetBotShippingResults(ctx, &tg.MessagesSetBotShippingResultsRequest{
+		QueryID:         q.QueryID,
+		ShippingOptions: options,
+	}); err != nil {
+		return errors.Wrap(err, "set bot shipping results")
+	}
+	return nil
+}
+
+// Reject reports that delivery to given address is not possible.
+func (q *ShippingQuery) Reject(ctx context.Context, reason string) error {
+	if _, err := q.m.api.MessagesSetBotShippingResults(ctx, &tg.MessagesSetBotShippingResultsRequest{
+		QueryID: q.QueryID,
+		Error:   reason,
+	}); err != nil {
+		return errors.Wrap(err, "set bot shipping results")
+	}
+	return nil
+}
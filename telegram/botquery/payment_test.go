@@ -0,0 +1,102 @@
+package botquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestPreCheckoutQuery(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+	e := testEntities(t, m)
+
+	payload, err := m.Encode(testPayload{Action: "buy", ID: 1})
+	a.NoError(err)
+	q, err := m.PreCheckoutQuery(ctx, e, &tg.UpdateBotPrecheckoutQuery{
+		QueryID:     1,
+		UserID:      10,
+		Payload:     payload,
+		Currency:    "XTR",
+		TotalAmount: 100,
+	})
+	a.NoError(err)
+	var p testPayload
+	a.NoError(q.Decode(&p))
+	a.Equal("buy", p.Action)
+
+	mock.ExpectCall(&tg.MessagesSetBotPrecheckoutResultsRequest{
+		Success: true,
+		QueryID: 1,
+	}).ThenTrue()
+	a.NoError(q.Approve(ctx))
+
+	mock.ExpectCall(&tg.MessagesSetBotPrecheckoutResultsRequest{
+		QueryID: 1,
+		Error:   "Out of stock",
+	}).ThenTrue()
+	a.NoError(q.Reject(ctx, "Out of stock"))
+}
+
+func TestShippingQuery(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+	e := testEntities(t, m)
+
+	q, err := m.ShippingQuery(ctx, e, &tg.UpdateBotShippingQuery{
+		QueryID: 1,
+		UserID:  10,
+	})
+	a.NoError(err)
+
+	option := tg.ShippingOption{ID: "post", Title: "Post"}
+	mock.ExpectCall(&tg.MessagesSetBotShippingResultsRequest{
+		QueryID:         1,
+		ShippingOptions: []tg.ShippingOption{option},
+	}).ThenTrue()
+	a.NoError(q.Answer(ctx, option))
+
+	mock.ExpectCall(&tg.MessagesSetBotShippingResultsRequest{
+		QueryID: 1,
+		Error:   "Unavailable",
+	}).ThenTrue()
+	a.NoError(q.Reject(ctx, "Unavailable"))
+}
+
+func TestWebhookQuery(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	d := tg.NewUpdateDispatcher()
+	var queries []*WebhookQuery
+	m.OnWebhookQuery(d, func(ctx context.Context, q *WebhookQuery) error {
+		queries = append(queries, q)
+		return nil
+	})
+	a.NoError(d.Handle(ctx, &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateBotWebhookJSON{Data: tg.DataJSON{Data: `{"update_id":1}`}},
+			&tg.UpdateBotWebhookJSONQuery{QueryID: 2, Data: tg.DataJSON{Data: `{"update_id":2}`}, Timeout: 5},
+		},
+	}))
+	a.Len(queries, 2)
+
+	var upd struct {
+		UpdateID int `json:"update_id"`
+	}
+	a.NoError(queries[1].Decode(&upd))
+	a.Equal(2, upd.UpdateID)
+
+	a.Error(queries[0].Answer(ctx, struct{}{}))
+	mock.ExpectCall(&tg.BotsAnswerWebhookJSONQueryRequest{
+		QueryID: 2,
+		Data:    tg.DataJSON{Data: `{"ok":true}`},
+	}).ThenTrue()
+	a.NoError(queries[1].Answer(ctx, map[string]bool{"ok": true}))
+}
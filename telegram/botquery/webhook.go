@@ -0,0 +1,77 @@
+package botquery
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// WebhookQuery is a context of Bot API webhook query, which is received by
+// bots which use both MTProto and Bot API webhooks.
+//
+// See https://core.telegram.org/api/bots/webhooks.
+type WebhookQuery struct {
+	// QueryID is query identifier.
+	//
+	// Zero if query does not expect answer.
+	QueryID int64
+	// Data is JSON-serialized Bot API update.
+	Data []byte
+	// Timeout is time in which query should be answered.
+	Timeout time.Duration
+
+	m *Manager
+}
+
+// OnWebhookQuery sets handler of webhook updates, both which expect and
+// do not expect answer.
+func (m *Manager) OnWebhookQuery(d interface {
+	OnBotWebhookJSON(tg.BotWebhookJSONHandler)
+	OnBotWebhookJSONQuery(tg.BotWebhookJSONQueryHandler)
+}, h func(ctx context.Context, q *WebhookQuery) error,
+) {
+	d.OnBotWebhookJSON(func(ctx context.Context, e tg.Entities, u *tg.UpdateBotWebhookJSON) error {
+		return h(ctx, &WebhookQuery{
+			Data: []byte(u.Data.Data),
+			m:    m,
+		})
+	})
+	d.OnBotWebhookJSONQuery(func(ctx context.Context, e tg.Entities, u *tg.UpdateBotWebhookJSONQuery) error {
+		return h(ctx, &WebhookQuery{
+			QueryID: u.QueryID,
+			Data:    []byte(u.Data.Data),
+			Timeout: time.Duration(u.Timeout) * time.Second,
+			m:       m,
+		})
+	})
+}
+
+// Decode decodes JSON data of query.
+func (q *WebhookQuery) Decode(v interface{}) error {
+	if err := json.Unmarshal(q.Data, v); err != nil {
+		return errors.Wrap(err, "decode")
+	}
+	return nil
+}
+
+// Answer answers query with JSON-serialized v.
+func (q *WebhookQuery) Answer(ctx context.Context, v interface{}) error {
+	if q.QueryID == 0 {
+		return errors.New("query does not expect answer")
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "encode")
+	}
+	if _, err := q.m.api.BotsAnswerWebhookJSONQuery(ctx, &tg.BotsAnswerWebhookJSONQueryRequest{
+		QueryID: q.QueryID,
+		Data:    tg.DataJSON{Data: string(data)},
+	}); err != nil {
+		return errors.Wrap(err, "answer webhook JSON query")
+	}
+	return nil
+}
@@ -42,9 +42,17 @@ var user = &tg.User{
 	Username:   "username",
 }
 
+type listenMode int
+
+const (
+	listenTCP listenMode = iota
+	listenWebsocket
+	listenHTTP
+)
+
 func testCluster(
 	p dcs.Protocol,
-	ws bool,
+	mode listenMode,
 	setup func(q clusterSetup),
 	run func(ctx context.Context, c clientSetup) error,
 ) func(t *testing.T) {
@@ -58,7 +66,8 @@ func testCluster(
 		g := tdsync.NewCancellableGroup(ctx)
 		clg := log.With().Str("logger", "cluster").Logger()
 		c := cluster.NewCluster(cluster.Options{
-			Web:      ws,
+			Web:      mode == listenWebsocket,
+			HTTP:     mode == listenHTTP,
 			Logger:   &clg,
 			Protocol: p,
 		})
@@ -115,7 +124,7 @@ func testAllTransports(t *testing.T, test func(p dcs.Protocol) func(t *testing.T
 func testTransport(p dcs.Protocol) func(t *testing.T) {
 	testMessage := "ну че там с деньгами?"
 
-	return testCluster(p, false, func(s clusterSetup) {
+	return testCluster(p, listenTCP, func(s clusterSetup) {
 		tlg := s.Logger.With().Str("logger", "handler").Logger()
 		h := tgtest.TestTransport(s.TB, &tlg, testMessage)
 		d := s.Cluster.Dispatch(2, "server")
@@ -168,9 +177,9 @@ func TestClientE2E(t *testing.T) {
 }
 
 func testMigrate(p dcs.Protocol) func(t *testing.T) {
-	test := func(ws bool) func(t *testing.T) {
+	test := func(mode listenMode) func(t *testing.T) {
 		wait := make(chan struct{}, 1)
-		return testCluster(p, ws, func(s clusterSetup) {
+		return testCluster(p, mode, func(s clusterSetup) {
 			c := s.Cluster
 			c.Common().Vector(tg.UsersGetUsersRequestTypeID, user)
 			c.Dispatch(1, "server").HandleFunc(tg.MessagesSendMessageRequestTypeID,
@@ -222,8 +231,9 @@ func testMigrate(p dcs.Protocol) func(t *testing.T) {
 	}
 
 	return func(t *testing.T) {
-		t.Run("TCP", test(false))
-		t.Run("Websocket", test(true))
+		t.Run("TCP", test(listenTCP))
+		t.Run("Websocket", test(listenWebsocket))
+		t.Run("HTTP", test(listenHTTP))
 	}
 }
 
@@ -232,8 +242,8 @@ func TestMigrate(t *testing.T) {
 }
 
 func testFiles(p dcs.Protocol) func(t *testing.T) {
-	test := func(ws bool) func(t *testing.T) {
-		return testCluster(p, ws, func(s clusterSetup) {
+	test := func(mode listenMode) func(t *testing.T) {
+		return testCluster(p, mode, func(s clusterSetup) {
 			c := s.Cluster
 			c.Common().Vector(tg.UsersGetUsersRequestTypeID, user)
 			f := file.NewService(file.Config{
@@ -286,8 +296,9 @@ func testFiles(p dcs.Protocol) func(t *testing.T) {
 	}
 
 	return func(t *testing.T) {
-		t.Run("TCP", test(false))
-		t.Run("Websocket", test(true))
+		t.Run("TCP", test(listenTCP))
+		t.Run("Websocket", test(listenWebsocket))
+		t.Run("HTTP", test(listenHTTP))
 	}
 }
 
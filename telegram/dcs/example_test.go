@@ -2,6 +2,7 @@ package dcs_test
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -50,3 +51,66 @@ func ExampleDialFunc_dialer() {
 		return nil
 	})
 }
+
+func ExampleParseProxyLink() {
+	// Connect using proxy from link.
+
+	link, err := dcs.ParseProxyLink("tg://socks?server=127.0.0.1&port=1080&user=user&pass=pass")
+	if err != nil {
+		panic(err)
+	}
+	resolver, err := link.Resolver(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	client := telegram.NewClient(1, "appHash", telegram.Options{
+		Resolver: resolver,
+	})
+	_ = client
+}
+
+func ExampleProxyChain() {
+	// Dial through HTTP proxy, then through SOCKS5 proxy.
+
+	dial, err := dcs.ProxyChain(nil,
+		dcs.Proxy{Type: dcs.ProxyHTTP, Addr: "127.0.0.1:3128"},
+		dcs.Proxy{Type: dcs.ProxySOCKS5, Addr: "127.0.0.1:1080", Username: "user", Password: "pass"},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	client := telegram.NewClient(1, "appHash", telegram.Options{
+		Resolver: dcs.Plain(dcs.PlainOptions{Dial: dial}),
+	})
+	_ = client
+}
+
+func ExampleRace() {
+	// Use plain TCP with happy eyeballs, falling back to websocket and
+	// MTProxy if TCP is filtered or slow.
+
+	secret, err := hex.DecodeString("dd00000000000000000000000000000000")
+	if err != nil {
+		panic(err)
+	}
+	mtproxy, err := dcs.MTProxy("127.0.0.1:3128", secret, dcs.MTProxyOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	resolver := dcs.Race([]dcs.Resolver{
+		dcs.Plain(dcs.PlainOptions{
+			PreferIPv6:   true,
+			AttemptDelay: 250 * time.Millisecond,
+		}),
+		dcs.Websocket(dcs.WebsocketOptions{}),
+		mtproxy,
+	}, dcs.RaceOptions{})
+
+	client := telegram.NewClient(1, "appHash", telegram.Options{
+		Resolver: resolver,
+	})
+	_ = client
+}
@@ -0,0 +1,118 @@
+package dcs
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/transport"
+)
+
+var _ Resolver = httpResolver{}
+
+type httpResolver struct {
+	client     *http.Client
+	endpoint   func(dc tg.DCOption) string
+	preferIPv6 bool
+}
+
+func (h httpResolver) connect(ctx context.Context, dc int, candidates []tg.DCOption) (transport.Conn, error) {
+	if len(candidates) == 0 {
+		return nil, errors.Errorf("no addresses for DC %d", dc)
+	}
+
+	// HTTP is connectionless, so just try to create connection
+	// to the first valid endpoint.
+	var rErr error
+	for _, candidate := range candidates {
+		if candidate.TCPObfuscatedOnly {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		conn, err := transport.HTTP(h.client, h.endpoint(candidate))
+		if err != nil {
+			rErr = multierr.Append(rErr, err)
+			continue
+		}
+		return conn, nil
+	}
+	if rErr == nil {
+		rErr = errors.Errorf("no HTTP addresses for DC %d", dc)
+	}
+	return nil, rErr
+}
+
+func (h httpResolver) Primary(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return h.connect(ctx, dc, FindPrimaryDCs(list.Options, dc, h.preferIPv6))
+}
+
+func (h httpResolver) MediaOnly(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	candidates := FindDCs(list.Options, dc, h.preferIPv6)
+	// Filter (in place) from SliceTricks.
+	n := 0
+	for _, x := range candidates {
+		if x.MediaOnly {
+			candidates[n] = x
+			n++
+		}
+	}
+	return h.connect(ctx, dc, candidates[:n])
+}
+
+func (h httpResolver) CDN(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return nil, errors.Errorf("can't resolve %d: CDN is unsupported", dc)
+}
+
+// HTTPOptions is HTTP resolver creation options.
+type HTTPOptions struct {
+	// Client is HTTP client to use.
+	// If Client is nil, then the resolver uses http.DefaultClient,
+	// which respects HTTP_PROXY and HTTPS_PROXY environment variables.
+	Client *http.Client
+	// Endpoint returns URL of HTTP endpoint for given DC option.
+	// Defaults to http://<ip>:80/api.
+	Endpoint func(dc tg.DCOption) string
+	// PreferIPv6 gives IPv6 DCs higher precedence.
+	// Default is to prefer IPv4 DCs over IPv6.
+	PreferIPv6 bool
+}
+
+// HTTPEndpoint returns default HTTP transport endpoint for given DC option.
+func HTTPEndpoint(dc tg.DCOption) string {
+	u := url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(dc.IPAddress, strconv.Itoa(80)),
+		Path:   "/api",
+	}
+	return u.String()
+}
+
+func (m *HTTPOptions) setDefaults() {
+	if m.Client == nil {
+		m.Client = http.DefaultClient
+	}
+	if m.Endpoint == nil {
+		m.Endpoint = HTTPEndpoint
+	}
+}
+
+// HTTP creates MTProto over HTTP DC resolver.
+//
+// See https://core.telegram.org/mtproto/transports#http.
+func HTTP(opts HTTPOptions) Resolver {
+	opts.setDefaults()
+	return httpResolver{
+		client:     opts.Client,
+		endpoint:   opts.Endpoint,
+		preferIPv6: opts.PreferIPv6,
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"io"
 	"net"
 	"strconv"
+	"time"
 
 	"github.com/go-faster/errors"
 	"go.uber.org/multierr"
 
+	"github.com/gotd/td/clock"
 	"github.com/gotd/td/crypto"
 	"github.com/gotd/td/mtproxy"
 	"github.com/gotd/td/mtproxy/obfuscator"
@@ -26,6 +28,7 @@ type plain struct {
 	network      string
 	noObfuscated bool
 	preferIPv6   bool
+	attemptDelay time.Duration
 }
 
 func (p plain) Primary(ctx context.Context, dc int, list List) (transport.Conn, error) {
@@ -129,53 +132,41 @@ func (p plain) connect(ctx context.Context, dc int, test bool, dcOptions []tg.DC
 		return p.dialTransport(ctx, test, dcOptions[0])
 	}
 
-	type dialResult struct {
-		conn transport.Conn
-		err  error
+	if p.attemptDelay > 0 {
+		dcOptions = interleaveFamilies(dcOptions)
 	}
+	conn, _, err := raceDial(ctx, len(dcOptions), p.attemptDelay, clock.System,
+		func(ctx context.Context, i int) (transport.Conn, error) {
+			return p.dialTransport(ctx, test, dcOptions[i])
+		},
+	)
+	return conn, err
+}
 
-	// We use unbuffered channel to ensure that only one connection will be returned
-	// and all other will be closed.
-	results := make(chan dialResult)
-	tryDial := func(ctx context.Context, option tg.DCOption) {
-		conn, err := p.dialTransport(ctx, test, option)
-		select {
-		case results <- dialResult{
-			conn: conn,
-			err:  err,
-		}:
-		case <-ctx.Done():
-			if conn != nil {
-				_ = conn.Close()
-			}
+// interleaveFamilies reorders DC options to alternate address families,
+// keeping the first option first.
+//
+// See RFC 8305, section 4.
+func interleaveFamilies(opts []tg.DCOption) []tg.DCOption {
+	var first, second []tg.DCOption
+	for _, opt := range opts {
+		if opt.Ipv6 == opts[0].Ipv6 {
+			first = append(first, opt)
+		} else {
+			second = append(second, opt)
 		}
 	}
 
-	dialCtx, dialCancel := context.WithCancel(ctx)
-	defer dialCancel()
-
-	for _, dcOption := range dcOptions {
-		go tryDial(dialCtx, dcOption)
-	}
-
-	remain := len(dcOptions)
-	var rErr error
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case result := <-results:
-			remain--
-			if result.err != nil {
-				rErr = multierr.Append(rErr, result.err)
-				if remain == 0 {
-					return nil, rErr
-				}
-				continue
-			}
-			return result.conn, nil
+	r := make([]tg.DCOption, 0, len(opts))
+	for i := 0; i < len(first) || i < len(second); i++ {
+		if i < len(first) {
+			r = append(r, first[i])
+		}
+		if i < len(second) {
+			r = append(r, second[i])
 		}
 	}
+	return r
 }
 
 // PlainOptions is plain resolver creation options.
@@ -194,6 +185,11 @@ type PlainOptions struct {
 	// PreferIPv6 gives IPv6 DCs higher precedence.
 	// Default is to prefer IPv4 DCs over IPv6.
 	PreferIPv6 bool
+	// AttemptDelay is a delay between connection attempts to DC addresses,
+	// like in Happy Eyeballs (RFC 8305): addresses of both families are
+	// tried alternately, starting from the preferred one.
+	// Default is to connect to all addresses at once.
+	AttemptDelay time.Duration
 }
 
 func (m *PlainOptions) setDefaults() {
@@ -222,5 +218,6 @@ func Plain(opts PlainOptions) Resolver {
 		network:      opts.Network,
 		noObfuscated: opts.NoObfuscated,
 		preferIPv6:   opts.PreferIPv6,
+		attemptDelay: opts.AttemptDelay,
 	}
 }
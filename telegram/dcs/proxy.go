@@ -0,0 +1,210 @@
+package dcs
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/net/proxy"
+)
+
+// ProxyType is a type of proxy server.
+type ProxyType string
+
+const (
+	// ProxySOCKS5 is a SOCKS5 proxy.
+	//
+	// Hostnames are resolved by proxy server (like socks5h), so
+	// there are no DNS leaks.
+	//
+	// See RFC 1928 and RFC 1929.
+	ProxySOCKS5 ProxyType = "socks5"
+	// ProxyHTTP is an HTTP proxy, which supports CONNECT method.
+	//
+	// See RFC 9110, section 9.3.6.
+	ProxyHTTP ProxyType = "http"
+)
+
+// Proxy describes proxy server to dial through.
+type Proxy struct {
+	// Type of proxy.
+	Type ProxyType
+	// Addr is a proxy address in host:port format.
+	Addr string
+	// Username for authentication. Authentication is disabled if empty.
+	Username string
+	// Password for authentication.
+	Password string
+}
+
+// String implements fmt.Stringer.
+//
+// Credentials are omitted.
+func (p Proxy) String() string {
+	return string(p.Type) + "://" + p.Addr
+}
+
+// Dialer creates DialFunc which connects to the address through proxy.
+//
+// Connection to the proxy itself is created using forward.
+// If forward is nil, then package net is used.
+func (p Proxy) Dialer(forward DialFunc) (DialFunc, error) {
+	if forward == nil {
+		var d net.Dialer
+		forward = d.DialContext
+	}
+	if _, _, err := net.SplitHostPort(p.Addr); err != nil {
+		return nil, errors.Wrapf(err, "invalid proxy address %q", p.Addr)
+	}
+
+	switch p.Type {
+	case ProxySOCKS5:
+		var auth *proxy.Auth
+		if p.Username != "" {
+			auth = &proxy.Auth{
+				User:     p.Username,
+				Password: p.Password,
+			}
+		}
+		d, err := proxy.SOCKS5("tcp", p.Addr, auth, forwardDialer(forward))
+		if err != nil {
+			return nil, errors.Wrap(err, "create SOCKS5 dialer")
+		}
+		cd, ok := d.(proxy.ContextDialer)
+		if !ok {
+			return nil, errors.Errorf("unexpected SOCKS5 dialer %T", d)
+		}
+		return cd.DialContext, nil
+	case ProxyHTTP:
+		return httpConnect{
+			addr:     p.Addr,
+			username: p.Username,
+			password: p.Password,
+			forward:  forward,
+		}.DialContext, nil
+	default:
+		return nil, errors.Errorf("unknown proxy type %q", p.Type)
+	}
+}
+
+// ProxyChain creates DialFunc which connects to the address through
+// given proxies: first proxy is dialed directly using forward, each next
+// proxy is dialed through the previous one.
+//
+// If forward is nil, then package net is used.
+func ProxyChain(forward DialFunc, proxies ...Proxy) (DialFunc, error) {
+	if forward == nil {
+		var d net.Dialer
+		forward = d.DialContext
+	}
+
+	dial := forward
+	for i, p := range proxies {
+		next, err := p.Dialer(dial)
+		if err != nil {
+			return nil, errors.Wrapf(err, "proxy %d", i)
+		}
+		dial = next
+	}
+	return dial, nil
+}
+
+// forwardDialer adapts DialFunc to proxy.ContextDialer.
+type forwardDialer DialFunc
+
+func (d forwardDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	return d(ctx, network, addr)
+}
+
+func (d forwardDialer) Dial(network, addr string) (net.Conn, error) {
+	return d(context.Background(), network, addr)
+}
+
+// httpConnect is an HTTP CONNECT proxy dialer.
+type httpConnect struct {
+	addr     string
+	username string
+	password string
+	forward  DialFunc
+}
+
+// bufferedConn is a net.Conn with buffered reader.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+func (h httpConnect) DialContext(ctx context.Context, network, addr string) (_ net.Conn, rErr error) {
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+	default:
+		return nil, errors.Errorf("network %q is not supported by HTTP proxy", network)
+	}
+
+	conn, err := h.forward(ctx, "tcp", h.addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial HTTP proxy %q", h.addr)
+	}
+	defer func() {
+		if rErr != nil {
+			multierr.AppendInto(&rErr, conn.Close())
+		}
+	}()
+
+	// Interrupt handshake if context is done.
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, errors.Wrap(err, "set deadline")
+		}
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if h.username != "" {
+		token := base64.StdEncoding.EncodeToString([]byte(h.username + ":" + h.password))
+		req.Header.Set("Proxy-Authorization", "Basic "+token)
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, errors.Wrap(err, "write CONNECT request")
+	}
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "read CONNECT response")
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("HTTP proxy %q: unexpected status %q", h.addr, resp.Status)
+	}
+
+	if !stop() {
+		return nil, ctx.Err()
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return nil, errors.Wrap(err, "reset deadline")
+	}
+
+	if r.Buffered() > 0 {
+		return bufferedConn{Conn: conn, r: r}, nil
+	}
+	return conn, nil
+}
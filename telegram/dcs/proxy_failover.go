@@ -0,0 +1,157 @@
+package dcs
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/clock"
+)
+
+// FailoverOptions is failover dialer options.
+type FailoverOptions struct {
+	// DialTimeout is a timeout of single dial attempt.
+	// Defaults to 10 seconds.
+	DialTimeout time.Duration
+	// Cooldown is a duration to deprioritize dialer after failure.
+	// Cooldown is doubled after each consecutive failure.
+	// Defaults to 5 seconds.
+	Cooldown time.Duration
+	// MaxCooldown is a maximum cooldown.
+	// Defaults to 5 minutes.
+	MaxCooldown time.Duration
+	// Clock to use. Defaults to system time.
+	Clock clock.Clock
+}
+
+func (f *FailoverOptions) setDefaults() {
+	if f.DialTimeout == 0 {
+		f.DialTimeout = 10 * time.Second
+	}
+	if f.Cooldown == 0 {
+		f.Cooldown = 5 * time.Second
+	}
+	if f.MaxCooldown == 0 {
+		f.MaxCooldown = 5 * time.Minute
+	}
+	if f.Clock == nil {
+		f.Clock = clock.System
+	}
+}
+
+// dialerHealth is a health state of dialer.
+type dialerHealth struct {
+	failures int
+	retryAt  time.Time
+}
+
+type failover struct {
+	dialers []DialFunc
+	health  []dialerHealth
+	mux     sync.Mutex
+	opts    FailoverOptions
+}
+
+// order returns dialer indexes, healthy dialers first.
+func (f *failover) order() []int {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	now := f.opts.Clock.Now()
+	idx := make([]int, len(f.dialers))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		a, b := f.health[idx[i]], f.health[idx[j]]
+		aReady, bReady := !now.Before(a.retryAt), !now.Before(b.retryAt)
+		if aReady != bReady {
+			return aReady
+		}
+		if !aReady {
+			return a.retryAt.Before(b.retryAt)
+		}
+		return a.failures < b.failures
+	})
+	return idx
+}
+
+func (f *failover) report(i int, err error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	h := &f.health[i]
+	if err == nil {
+		*h = dialerHealth{}
+		return
+	}
+
+	cooldown := f.opts.Cooldown
+	for n := 0; n < h.failures && cooldown < f.opts.MaxCooldown; n++ {
+		cooldown *= 2
+	}
+	if cooldown > f.opts.MaxCooldown {
+		cooldown = f.opts.MaxCooldown
+	}
+	h.failures++
+	h.retryAt = f.opts.Clock.Now().Add(cooldown)
+}
+
+func (f *failover) DialContext(ctx context.Context, network, addr string) (_ net.Conn, rErr error) {
+	for _, i := range f.order() {
+		if err := ctx.Err(); err != nil {
+			return nil, multierr.Append(rErr, err)
+		}
+
+		dialCtx, cancel := context.WithTimeout(ctx, f.opts.DialTimeout)
+		conn, err := f.dialers[i](dialCtx, network, addr)
+		cancel()
+		if err != nil && ctx.Err() != nil {
+			// Parent context is done, do not blame the dialer.
+			return nil, multierr.Append(rErr, err)
+		}
+		f.report(i, err)
+		if err != nil {
+			rErr = multierr.Append(rErr, errors.Wrapf(err, "dialer %d", i))
+			continue
+		}
+		return conn, nil
+	}
+	return nil, errors.Wrap(rErr, "all dialers failed")
+}
+
+// Failover creates DialFunc which dials using given dialers, falling over
+// to the next one on failure.
+//
+// Failed dialers are deprioritized for a cooldown, so healthy dialers
+// are tried first.
+func Failover(dialers []DialFunc, opts FailoverOptions) DialFunc {
+	opts.setDefaults()
+	f := &failover{
+		dialers: dialers,
+		health:  make([]dialerHealth, len(dialers)),
+		opts:    opts,
+	}
+	return f.DialContext
+}
+
+// ProxyFailover creates failover DialFunc for given proxies.
+//
+// Connection to proxies is created using forward.
+// If forward is nil, then package net is used.
+func ProxyFailover(forward DialFunc, proxies []Proxy, opts FailoverOptions) (DialFunc, error) {
+	dialers := make([]DialFunc, 0, len(proxies))
+	for _, p := range proxies {
+		d, err := p.Dialer(forward)
+		if err != nil {
+			return nil, errors.Wrapf(err, "proxy %s", p)
+		}
+		dialers = append(dialers, d)
+	}
+	return Failover(dialers, opts), nil
+}
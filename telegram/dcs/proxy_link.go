@@ -0,0 +1,126 @@
+package dcs
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// ProxyLink is a parsed proxy link.
+//
+// See https://core.telegram.org/api/links#socks5-proxy-links and
+// https://core.telegram.org/api/links#mtproxy-links.
+type ProxyLink struct {
+	// Proxy is a SOCKS5 proxy. Set only for socks links.
+	Proxy *Proxy
+	// MTProxy is a MTProxy address. Set only for proxy links.
+	MTProxy string
+	// Secret is a MTProxy secret. Set only for proxy links.
+	Secret []byte
+}
+
+// Resolver creates DC resolver which connects using proxy from link.
+//
+// Connection to the proxy is created using forward.
+// If forward is nil, then package net is used.
+func (l ProxyLink) Resolver(forward DialFunc) (Resolver, error) {
+	if l.Proxy != nil {
+		dial, err := l.Proxy.Dialer(forward)
+		if err != nil {
+			return nil, err
+		}
+		return Plain(PlainOptions{Dial: dial}), nil
+	}
+	return MTProxy(l.MTProxy, l.Secret, MTProxyOptions{Dial: forward})
+}
+
+// ParseProxyLink parses tg://socks, tg://proxy and equivalent
+// https://t.me/socks, https://t.me/proxy links.
+//
+// Scheme of t.me links may be omitted, like t.me/socks?server=...
+func ParseProxyLink(link string) (ProxyLink, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ProxyLink{}, errors.Wrap(err, "parse url")
+	}
+	if u.Scheme == "" && u.Host == "" {
+		// Host is parsed as a part of path if scheme is omitted.
+		if u, err = url.Parse("https://" + link); err != nil {
+			return ProxyLink{}, errors.Wrap(err, "parse url")
+		}
+	}
+
+	var kind string
+	switch u.Scheme {
+	case "tg":
+		// tg://socks?server=...
+		kind = u.Host
+		if kind == "" {
+			kind = u.Opaque
+		}
+	case "https", "http":
+		switch strings.TrimPrefix(u.Host, "www.") {
+		case "t.me", "telegram.me", "telegram.dog":
+		default:
+			return ProxyLink{}, errors.Errorf("unexpected host %q", u.Host)
+		}
+		kind = strings.Trim(u.Path, "/")
+	default:
+		return ProxyLink{}, errors.Errorf("unexpected scheme %q", u.Scheme)
+	}
+
+	q := u.Query()
+	server, port := q.Get("server"), q.Get("port")
+	if server == "" || port == "" {
+		return ProxyLink{}, errors.New("server and port are required")
+	}
+	addr := net.JoinHostPort(server, port)
+
+	switch kind {
+	case "socks":
+		return ProxyLink{
+			Proxy: &Proxy{
+				Type:     ProxySOCKS5,
+				Addr:     addr,
+				Username: q.Get("user"),
+				Password: q.Get("pass"),
+			},
+		}, nil
+	case "proxy":
+		secret, err := parseProxySecret(q.Get("secret"))
+		if err != nil {
+			return ProxyLink{}, errors.Wrap(err, "parse secret")
+		}
+		return ProxyLink{
+			MTProxy: addr,
+			Secret:  secret,
+		}, nil
+	default:
+		return ProxyLink{}, errors.Errorf("unexpected link type %q", kind)
+	}
+}
+
+// parseProxySecret decodes hex or base64 encoded MTProxy secret.
+func parseProxySecret(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("secret is empty")
+	}
+	if secret, err := hex.DecodeString(s); err == nil {
+		return secret, nil
+	}
+	for _, enc := range []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.URLEncoding,
+		base64.RawStdEncoding,
+		base64.StdEncoding,
+	} {
+		if secret, err := enc.DecodeString(s); err == nil {
+			return secret, nil
+		}
+	}
+	return nil, errors.Errorf("invalid secret %q", s)
+}
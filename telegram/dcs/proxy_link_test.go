@@ -0,0 +1,66 @@
+package dcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProxyLink(t *testing.T) {
+	secret := []byte{
+		0xee, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+		'g', 'o', 'o', 'g', 'l', 'e', '.', 'c', 'o', 'm',
+	}
+	for _, tt := range []struct {
+		link   string
+		result ProxyLink
+	}{
+		{
+			"tg://socks?server=1.2.3.4&port=1080&user=u&pass=p",
+			ProxyLink{Proxy: &Proxy{Type: ProxySOCKS5, Addr: "1.2.3.4:1080", Username: "u", Password: "p"}},
+		},
+		{
+			"https://t.me/socks?server=example.com&port=1080",
+			ProxyLink{Proxy: &Proxy{Type: ProxySOCKS5, Addr: "example.com:1080"}},
+		},
+		{
+			"tg://proxy?server=1.2.3.4&port=443&secret=ee0102030405060708090a0b0c0d0e0f10676f6f676c652e636f6d",
+			ProxyLink{MTProxy: "1.2.3.4:443", Secret: secret},
+		},
+		{
+			"https://t.me/proxy?server=::1&port=443&secret=7gECAwQFBgcICQoLDA0ODxBnb29nbGUuY29t",
+			ProxyLink{MTProxy: "[::1]:443", Secret: secret},
+		},
+		{
+			"t.me/socks?server=example.com&port=1080",
+			ProxyLink{Proxy: &Proxy{Type: ProxySOCKS5, Addr: "example.com:1080"}},
+		},
+		{
+			"www.t.me/proxy?server=1.2.3.4&port=443&secret=7gECAwQFBgcICQoLDA0ODxBnb29nbGUuY29t",
+			ProxyLink{MTProxy: "1.2.3.4:443", Secret: secret},
+		},
+	} {
+		t.Run(tt.link, func(t *testing.T) {
+			a := require.New(t)
+			link, err := ParseProxyLink(tt.link)
+			a.NoError(err)
+			a.Equal(tt.result, link)
+
+			_, err = link.Resolver(nil)
+			a.NoError(err)
+		})
+	}
+
+	for _, link := range []string{
+		"tg://socks?server=1.2.3.4",
+		"tg://proxy?server=1.2.3.4&port=443",
+		"tg://proxy?server=1.2.3.4&port=443&secret=!!!",
+		"tg://resolve?domain=gotd",
+		"https://example.com/socks?server=1.2.3.4&port=1080",
+		"ftp://t.me/socks?server=1.2.3.4&port=1080",
+		"example.com/socks?server=1.2.3.4&port=1080",
+	} {
+		_, err := ParseProxyLink(link)
+		require.Error(t, err, link)
+	}
+}
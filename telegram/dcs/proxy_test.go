@@ -0,0 +1,303 @@
+package dcs
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+)
+
+func testListen(t *testing.T) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+	return l
+}
+
+func testServe(l net.Listener, handler func(conn net.Conn) error) {
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() {
+					_ = conn.Close()
+				}()
+				_ = handler(conn)
+			}()
+		}
+	}()
+}
+
+// testEchoServer starts TCP echo server.
+func testEchoServer(t *testing.T) string {
+	l := testListen(t)
+	testServe(l, func(conn net.Conn) error {
+		_, err := io.Copy(conn, conn)
+		return err
+	})
+	return l.Addr().String()
+}
+
+func testPipe(conn net.Conn, target string) error {
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = upstream.Close()
+	}()
+
+	go func() {
+		_, _ = io.Copy(upstream, conn)
+		_ = upstream.Close()
+	}()
+	_, err = io.Copy(conn, upstream)
+	return err
+}
+
+// testSOCKS5Server starts minimal SOCKS5 server with username/password
+// authentication which supports only CONNECT command.
+//
+// Requested hostnames are saved to hosts channel.
+func testSOCKS5Server(t *testing.T, user, pass string, hosts chan<- string) string {
+	l := testListen(t)
+	testServe(l, func(conn net.Conn) error {
+		r := bufio.NewReader(conn)
+		var header [2]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return err
+		}
+		methods := make([]byte, header[1])
+		if _, err := io.ReadFull(r, methods); err != nil {
+			return err
+		}
+		if _, err := conn.Write([]byte{0x05, 0x02}); err != nil {
+			return err
+		}
+
+		// Username/password authentication, RFC 1929.
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return err
+		}
+		u := make([]byte, header[1])
+		if _, err := io.ReadFull(r, u); err != nil {
+			return err
+		}
+		n, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+		p := make([]byte, n)
+		if _, err := io.ReadFull(r, p); err != nil {
+			return err
+		}
+		if string(u) != user || string(p) != pass {
+			_, _ = conn.Write([]byte{0x01, 0x01})
+			return errors.New("auth failed")
+		}
+		if _, err := conn.Write([]byte{0x01, 0x00}); err != nil {
+			return err
+		}
+
+		// CONNECT request.
+		var req [4]byte
+		if _, err := io.ReadFull(r, req[:]); err != nil {
+			return err
+		}
+		var host string
+		switch req[3] {
+		case 0x01:
+			ip := make(net.IP, net.IPv4len)
+			if _, err := io.ReadFull(r, ip); err != nil {
+				return err
+			}
+			host = ip.String()
+		case 0x03:
+			n, err := r.ReadByte()
+			if err != nil {
+				return err
+			}
+			name := make([]byte, n)
+			if _, err := io.ReadFull(r, name); err != nil {
+				return err
+			}
+			host = string(name)
+		default:
+			return errors.Errorf("unsupported address type %d", req[3])
+		}
+		var port [2]byte
+		if _, err := io.ReadFull(r, port[:]); err != nil {
+			return err
+		}
+		if hosts != nil {
+			hosts <- host
+		}
+		if host == "localhost" {
+			host = "127.0.0.1"
+		}
+
+		reply := []byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}
+		if _, err := conn.Write(reply); err != nil {
+			return err
+		}
+		target := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port[:]))))
+		return testPipe(bufferedConn{Conn: conn, r: r}, target)
+	})
+	return l.Addr().String()
+}
+
+// testHTTPProxy starts minimal HTTP CONNECT proxy with basic authentication.
+func testHTTPProxy(t *testing.T, user, pass string) string {
+	l := testListen(t)
+	testServe(l, func(conn net.Conn) error {
+		r := bufio.NewReader(conn)
+		req, err := http.ReadRequest(r)
+		if err != nil {
+			return err
+		}
+		token := base64.StdEncoding.EncodeToString([]byte(user + ":" + pass))
+		if req.Method != http.MethodConnect || req.Header.Get("Proxy-Authorization") != "Basic "+token {
+			_, _ = io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+			return errors.New("auth failed")
+		}
+		if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+			return err
+		}
+		return testPipe(bufferedConn{Conn: conn, r: r}, req.Host)
+	})
+	return l.Addr().String()
+}
+
+func testDialEcho(t *testing.T, dial DialFunc, addr string) {
+	a := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := dial(ctx, "tcp", addr)
+	a.NoError(err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_, err = conn.Write([]byte("hello"))
+	a.NoError(err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	a.NoError(err)
+	a.Equal("hello", string(buf))
+}
+
+func TestProxy(t *testing.T) {
+	echo := testEchoServer(t)
+	_, echoPort, err := net.SplitHostPort(echo)
+	require.NoError(t, err)
+
+	t.Run("SOCKS5", func(t *testing.T) {
+		hosts := make(chan string, 1)
+		p := Proxy{
+			Type:     ProxySOCKS5,
+			Addr:     testSOCKS5Server(t, "user", "pass", hosts),
+			Username: "user",
+			Password: "pass",
+		}
+		dial, err := p.Dialer(nil)
+		require.NoError(t, err)
+
+		// Hostname should be resolved by proxy.
+		testDialEcho(t, dial, net.JoinHostPort("localhost", echoPort))
+		require.Equal(t, "localhost", <-hosts)
+	})
+	t.Run("HTTP", func(t *testing.T) {
+		p := Proxy{
+			Type:     ProxyHTTP,
+			Addr:     testHTTPProxy(t, "user", "pass"),
+			Username: "user",
+			Password: "pass",
+		}
+		dial, err := p.Dialer(nil)
+		require.NoError(t, err)
+		testDialEcho(t, dial, echo)
+	})
+	t.Run("BadAuth", func(t *testing.T) {
+		for _, p := range []Proxy{
+			{Type: ProxySOCKS5, Addr: testSOCKS5Server(t, "user", "pass", nil), Username: "user", Password: "bad"},
+			{Type: ProxyHTTP, Addr: testHTTPProxy(t, "user", "pass"), Username: "user", Password: "bad"},
+		} {
+			dial, err := p.Dialer(nil)
+			require.NoError(t, err)
+			_, err = dial(context.Background(), "tcp", echo)
+			require.Error(t, err, p.String())
+		}
+	})
+	t.Run("Chain", func(t *testing.T) {
+		dial, err := ProxyChain(nil,
+			Proxy{Type: ProxyHTTP, Addr: testHTTPProxy(t, "a", "b"), Username: "a", Password: "b"},
+			Proxy{Type: ProxySOCKS5, Addr: testSOCKS5Server(t, "c", "d", nil), Username: "c", Password: "d"},
+			Proxy{Type: ProxyHTTP, Addr: testHTTPProxy(t, "e", "f"), Username: "e", Password: "f"},
+		)
+		require.NoError(t, err)
+		testDialEcho(t, dial, echo)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := Proxy{Type: "foo", Addr: "127.0.0.1:1"}.Dialer(nil)
+		require.Error(t, err)
+		_, err = Proxy{Type: ProxySOCKS5, Addr: "127.0.0.1"}.Dialer(nil)
+		require.Error(t, err)
+	})
+}
+
+func TestFailover(t *testing.T) {
+	a := require.New(t)
+	echo := testEchoServer(t)
+
+	var calls []int
+	failing := func(id int) DialFunc {
+		return func(ctx context.Context, network, addr string) (net.Conn, error) {
+			calls = append(calls, id)
+			return nil, errors.New("failed")
+		}
+	}
+	var d net.Dialer
+	working := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		calls = append(calls, 2)
+		return d.DialContext(ctx, network, addr)
+	}
+
+	clock := neo.NewTime(time.Now())
+	dial := Failover([]DialFunc{failing(0), failing(1), working}, FailoverOptions{
+		Cooldown: time.Second,
+		Clock:    clock,
+	})
+
+	testDialEcho(t, dial, echo)
+	a.Equal([]int{0, 1, 2}, calls)
+
+	// Failed dialers are deprioritized.
+	calls = nil
+	testDialEcho(t, dial, echo)
+	a.Equal([]int{2}, calls)
+
+	// Cooldown is over, but dialer without failures is still preferred.
+	calls = nil
+	clock.Travel(time.Second)
+	testDialEcho(t, dial, echo)
+	a.Equal([]int{2}, calls)
+
+	_, err := Failover([]DialFunc{failing(0)}, FailoverOptions{})(context.Background(), "tcp", echo)
+	a.Error(err)
+}
@@ -0,0 +1,315 @@
+package dcs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/transport"
+)
+
+// raceDial runs n connection attempts and returns first successful
+// connection and index of its attempt. All other connections are closed.
+//
+// Attempts are started in order: next attempt is started after delay or
+// immediately if all started attempts have failed, like in Happy Eyeballs
+// (RFC 8305). If delay is zero, all attempts are started at once.
+func raceDial(
+	ctx context.Context,
+	n int,
+	delay time.Duration,
+	clk clock.Clock,
+	dial func(ctx context.Context, i int) (transport.Conn, error),
+) (transport.Conn, int, error) {
+	if n == 0 {
+		return nil, -1, errors.New("no attempts")
+	}
+
+	type dialResult struct {
+		conn transport.Conn
+		idx  int
+		err  error
+	}
+
+	// We use unbuffered channel to ensure that only one connection will be returned
+	// and all other will be closed.
+	results := make(chan dialResult)
+	tryDial := func(ctx context.Context, i int) {
+		conn, err := dial(ctx, i)
+		select {
+		case results <- dialResult{
+			conn: conn,
+			idx:  i,
+			err:  err,
+		}:
+		case <-ctx.Done():
+			if conn != nil {
+				_ = conn.Close()
+			}
+		}
+	}
+
+	dialCtx, dialCancel := context.WithCancel(ctx)
+	defer dialCancel()
+
+	var (
+		started = 0
+		pending = 0
+		rErr    error
+		timer   clock.Timer
+	)
+	defer func() {
+		if timer != nil {
+			clock.StopTimer(timer)
+		}
+	}()
+	start := func() {
+		go tryDial(dialCtx, started)
+		started++
+		pending++
+
+		if timer != nil {
+			clock.StopTimer(timer)
+			timer = nil
+		}
+		if started < n {
+			timer = clk.Timer(delay)
+		}
+	}
+	if delay <= 0 {
+		for started < n {
+			start()
+		}
+	} else {
+		start()
+	}
+
+	for {
+		var next <-chan time.Time
+		if timer != nil {
+			next = timer.C()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, -1, ctx.Err()
+		case <-next:
+			start()
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				return result.conn, result.idx, nil
+			}
+
+			rErr = multierr.Append(rErr, result.err)
+			if pending == 0 {
+				if started == n {
+					return nil, -1, rErr
+				}
+				// All started attempts failed, do not wait for delay.
+				start()
+			}
+		}
+	}
+}
+
+// RaceOptions is Race resolver options.
+type RaceOptions struct {
+	// Delay is a delay before starting next resolver if previous has not
+	// connected yet. Negative value means starting all resolvers at once.
+	// Defaults to 300ms.
+	Delay time.Duration
+	// TTL is a duration to remember the fastest resolver for DC.
+	// After TTL expiration, resolvers are raced again.
+	// Defaults to 10 minutes.
+	TTL time.Duration
+	// Clock to use. Defaults to system time.
+	Clock clock.Clock
+	// Logger is instance of zerolog.Logger. No logs by default.
+	Logger *zerolog.Logger
+}
+
+func (r *RaceOptions) setDefaults() {
+	if r.Delay == 0 {
+		r.Delay = 300 * time.Millisecond
+	}
+	if r.TTL == 0 {
+		r.TTL = 10 * time.Minute
+	}
+	if r.Clock == nil {
+		r.Clock = clock.System
+	}
+	if r.Logger == nil {
+		nop := zerolog.Nop()
+		r.Logger = &nop
+	}
+}
+
+type raceKind int
+
+const (
+	racePrimary raceKind = iota
+	raceMediaOnly
+	raceCDN
+)
+
+func (k raceKind) String() string {
+	switch k {
+	case racePrimary:
+		return "primary"
+	case raceMediaOnly:
+		return "media"
+	default:
+		return "cdn"
+	}
+}
+
+type raceKey struct {
+	kind raceKind
+	dc   int
+	test bool
+}
+
+type raceWinner struct {
+	idx     int
+	expires time.Time
+}
+
+var _ Resolver = (*race)(nil)
+
+type race struct {
+	resolvers []Resolver
+	delay     time.Duration
+	ttl       time.Duration
+	clock     clock.Clock
+	log       *zerolog.Logger
+
+	winners    map[raceKey]raceWinner
+	winnersMux sync.Mutex
+}
+
+// Race creates resolver which races given resolvers and remembers the
+// fastest one for each DC.
+//
+// Resolvers are started in order of preference, like in Happy Eyeballs:
+// next resolver is started if previous one has not connected during
+// delay or failed. So resolvers which are slower or blocked (e.g. plain
+// TCP is filtered), are automatically replaced with next ones, like
+// Websocket or MTProxy.
+//
+// Remembered resolver is used directly until TTL expires or it fails.
+func Race(resolvers []Resolver, opts RaceOptions) Resolver {
+	opts.setDefaults()
+	return &race{
+		resolvers: resolvers,
+		delay:     opts.Delay,
+		ttl:       opts.TTL,
+		clock:     opts.Clock,
+		log:       opts.Logger,
+		winners:   map[raceKey]raceWinner{},
+	}
+}
+
+func (r *race) winner(key raceKey) (int, bool) {
+	r.winnersMux.Lock()
+	defer r.winnersMux.Unlock()
+
+	w, ok := r.winners[key]
+	if !ok {
+		return -1, false
+	}
+	if !r.clock.Now().Before(w.expires) {
+		// Re-probe.
+		delete(r.winners, key)
+		return w.idx, false
+	}
+	return w.idx, true
+}
+
+func (r *race) setWinner(key raceKey, idx int) {
+	r.winnersMux.Lock()
+	defer r.winnersMux.Unlock()
+
+	if idx < 0 {
+		delete(r.winners, key)
+		return
+	}
+	r.winners[key] = raceWinner{
+		idx:     idx,
+		expires: r.clock.Now().Add(r.ttl),
+	}
+}
+
+func (r *race) resolve(ctx context.Context, key raceKey, list List) (transport.Conn, error) {
+	call := func(ctx context.Context, res Resolver) (transport.Conn, error) {
+		switch key.kind {
+		case racePrimary:
+			return res.Primary(ctx, key.dc, list)
+		case raceMediaOnly:
+			return res.MediaOnly(ctx, key.dc, list)
+		default:
+			return res.CDN(ctx, key.dc, list)
+		}
+	}
+
+	idx, ok := r.winner(key)
+	if ok {
+		conn, err := call(ctx, r.resolvers[idx])
+		if err == nil {
+			return conn, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		r.log.Debug().Err(err).
+			Int("dc_id", key.dc).
+			Stringer("kind", key.kind).
+			Int("resolver", idx).
+			Msg("Remembered resolver failed, racing")
+		r.setWinner(key, -1)
+		idx = -1
+	}
+
+	// Try previous winner first, if it is re-probed after TTL.
+	order := make([]int, 0, len(r.resolvers))
+	if idx >= 0 {
+		order = append(order, idx)
+	}
+	for i := range r.resolvers {
+		if i != idx {
+			order = append(order, i)
+		}
+	}
+
+	conn, won, err := raceDial(ctx, len(order), r.delay, r.clock, func(ctx context.Context, i int) (transport.Conn, error) {
+		return call(ctx, r.resolvers[order[i]])
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "race %s DC %d", key.kind, key.dc)
+	}
+	r.log.Debug().
+		Int("dc_id", key.dc).
+		Stringer("kind", key.kind).
+		Int("resolver", order[won]).
+		Msg("Resolver won the race")
+	r.setWinner(key, order[won])
+
+	return conn, nil
+}
+
+func (r *race) Primary(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return r.resolve(ctx, raceKey{kind: racePrimary, dc: dc, test: list.Test}, list)
+}
+
+func (r *race) MediaOnly(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return r.resolve(ctx, raceKey{kind: raceMediaOnly, dc: dc, test: list.Test}, list)
+}
+
+func (r *race) CDN(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return r.resolve(ctx, raceKey{kind: raceCDN, dc: dc, test: list.Test}, list)
+}
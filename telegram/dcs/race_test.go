@@ -0,0 +1,226 @@
+package dcs
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/transport"
+)
+
+type raceConn struct {
+	id     int
+	closed chan struct{}
+	once   sync.Once
+}
+
+func (c *raceConn) Send(ctx context.Context, b *bin.Buffer) error { return nil }
+
+func (c *raceConn) Recv(ctx context.Context, b *bin.Buffer) error { return nil }
+
+func (c *raceConn) Close() error {
+	c.once.Do(func() { close(c.closed) })
+	return nil
+}
+
+// raceResolver is a Resolver which calls dial for every method.
+type raceResolver struct {
+	id   int
+	dial func(ctx context.Context) error
+
+	mux   sync.Mutex
+	calls int
+	conns []*raceConn
+}
+
+func (r *raceResolver) connect(ctx context.Context) (transport.Conn, error) {
+	r.mux.Lock()
+	r.calls++
+	r.mux.Unlock()
+
+	if r.dial != nil {
+		if err := r.dial(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	conn := &raceConn{id: r.id, closed: make(chan struct{})}
+	r.mux.Lock()
+	r.conns = append(r.conns, conn)
+	r.mux.Unlock()
+	return conn, nil
+}
+
+func (r *raceResolver) Calls() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.calls
+}
+
+func (r *raceResolver) Primary(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return r.connect(ctx)
+}
+
+func (r *raceResolver) MediaOnly(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return r.connect(ctx)
+}
+
+func (r *raceResolver) CDN(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return r.connect(ctx)
+}
+
+func failDial(ctx context.Context) error {
+	return errors.New("filtered")
+}
+
+func blockDial(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func raceConnID(t *testing.T, r Resolver, dc int) int {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := r.Primary(ctx, dc, List{})
+	require.NoError(t, err)
+	return conn.(*raceConn).id
+}
+
+func TestRace(t *testing.T) {
+	t.Run("Fallback", func(t *testing.T) {
+		a := require.New(t)
+		plain := &raceResolver{id: 0, dial: failDial}
+		ws := &raceResolver{id: 1}
+		r := Race([]Resolver{plain, ws}, RaceOptions{})
+
+		a.Equal(1, raceConnID(t, r, 2))
+		// Winner is remembered, failed resolver is not called.
+		a.Equal(1, raceConnID(t, r, 2))
+		a.Equal(1, plain.Calls())
+		a.Equal(2, ws.Calls())
+
+		// Other DCs are raced separately.
+		a.Equal(1, raceConnID(t, r, 4))
+		a.Equal(2, plain.Calls())
+	})
+	t.Run("Slow", func(t *testing.T) {
+		a := require.New(t)
+		plain := &raceResolver{id: 0, dial: blockDial}
+		ws := &raceResolver{id: 1}
+		r := Race([]Resolver{plain, ws}, RaceOptions{
+			Delay: 10 * time.Millisecond,
+		})
+
+		a.Equal(1, raceConnID(t, r, 2))
+		a.Equal(1, plain.Calls())
+	})
+	t.Run("LosersClosed", func(t *testing.T) {
+		a := require.New(t)
+		release := make(chan struct{})
+		slow := &raceResolver{id: 1, dial: func(ctx context.Context) error {
+			<-release
+			return nil
+		}}
+		fast := &raceResolver{id: 0}
+		r := Race([]Resolver{slow, fast}, RaceOptions{
+			Delay: -1,
+		})
+
+		a.Equal(0, raceConnID(t, r, 2))
+		close(release)
+
+		require.Eventually(t, func() bool {
+			slow.mux.Lock()
+			defer slow.mux.Unlock()
+			if len(slow.conns) != 1 {
+				return false
+			}
+			select {
+			case <-slow.conns[0].closed:
+				return true
+			default:
+				return false
+			}
+		}, 5*time.Second, time.Millisecond)
+	})
+	t.Run("TTL", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Now())
+
+		var filtered bool
+		plain := &raceResolver{id: 0, dial: func(ctx context.Context) error {
+			if filtered {
+				return errors.New("filtered")
+			}
+			return nil
+		}}
+		ws := &raceResolver{id: 1}
+		filtered = true
+		r := Race([]Resolver{plain, ws}, RaceOptions{
+			TTL:   time.Minute,
+			Clock: clock,
+		})
+		a.Equal(1, raceConnID(t, r, 2))
+
+		// Plain TCP is unblocked, but remembered resolver is used until TTL.
+		filtered = false
+		a.Equal(1, raceConnID(t, r, 2))
+		a.Equal(1, plain.Calls())
+
+		// Re-probe: previous winner is tried first and still wins.
+		clock.Travel(time.Minute)
+		a.Equal(1, raceConnID(t, r, 2))
+		a.Equal(1, plain.Calls())
+	})
+	t.Run("WinnerFailed", func(t *testing.T) {
+		a := require.New(t)
+		plain := &raceResolver{id: 0}
+		var blocked bool
+		ws := &raceResolver{id: 1}
+		plain.dial = func(ctx context.Context) error {
+			if blocked {
+				return errors.New("filtered")
+			}
+			return nil
+		}
+		r := Race([]Resolver{plain, ws}, RaceOptions{})
+
+		a.Equal(0, raceConnID(t, r, 2))
+		blocked = true
+		a.Equal(1, raceConnID(t, r, 2))
+		a.Equal(1, raceConnID(t, r, 2))
+		a.Equal(3, plain.Calls())
+	})
+	t.Run("AllFailed", func(t *testing.T) {
+		r := Race([]Resolver{
+			&raceResolver{dial: failDial},
+			&raceResolver{dial: failDial},
+		}, RaceOptions{})
+		_, err := r.Primary(context.Background(), 2, List{})
+		require.Error(t, err)
+	})
+}
+
+func TestInterleaveFamilies(t *testing.T) {
+	v4 := func(id int) tg.DCOption { return tg.DCOption{ID: id} }
+	v6 := func(id int) tg.DCOption { return tg.DCOption{ID: id, Ipv6: true} }
+
+	require.Equal(t,
+		[]tg.DCOption{v6(1), v4(4), v6(2), v4(5), v6(3)},
+		interleaveFamilies([]tg.DCOption{v6(1), v6(2), v6(3), v4(4), v4(5)}),
+	)
+	require.Equal(t,
+		[]tg.DCOption{v4(1), v4(2)},
+		interleaveFamilies([]tg.DCOption{v4(1), v4(2)}),
+	)
+}
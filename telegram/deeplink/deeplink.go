@@ -40,6 +40,16 @@ const (
 	//
 	// See https://core.telegram.org/api/links#business-chat-links
 	BusinessChat Type = "message"
+
+	// AddList is deeplink like
+	//
+	// tg:addlist?slug={slug}
+	// tg://addlist?slug={slug}
+	// https://t.me/addlist/{slug}
+	// https://telegram.me/addlist/{slug}
+	//
+	// See https://core.telegram.org/api/links#chat-folder-links
+	AddList Type = "addlist"
 )
 
 // DeepLink represents Telegram deeplink.
@@ -61,7 +71,7 @@ func (d DeepLink) validate() error {
 		return ensureParam(d.Args, "domain")
 	case Join:
 		return ensureParam(d.Args, "invite")
-	case BusinessChat:
+	case BusinessChat, AddList:
 		return ensureParam(d.Args, "slug")
 	default:
 		return errors.Errorf("unsupported deeplink %q", d.Type)
@@ -86,6 +96,11 @@ func parseTg(u *url.URL) (DeepLink, error) {
 			Type: BusinessChat,
 			Args: query,
 		}, nil
+	case AddList:
+		return DeepLink{
+			Type: AddList,
+			Args: query,
+		}, nil
 	}
 
 	return DeepLink{}, errors.Errorf("unsupported deeplink %q", u.String())
@@ -125,6 +140,12 @@ func parseHTTPS(u *url.URL) (DeepLink, error) {
 			Type: BusinessChat,
 			Args: query,
 		}, nil
+	case "addlist":
+		query.Set("slug", base)
+		return DeepLink{
+			Type: AddList,
+			Args: query,
+		}, nil
 	case "":
 		return DeepLink{}, errors.Errorf("unsupported deeplink %q", u.String())
 	}
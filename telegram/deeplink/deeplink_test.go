@@ -40,6 +40,15 @@ func businessChat(arg string) DeepLink {
 	}
 }
 
+func addList(arg string) DeepLink {
+	return DeepLink{
+		Type: AddList,
+		Args: map[string][]string{
+			"slug": {arg},
+		},
+	}
+}
+
 func joinSuite() map[string][]testCase {
 	expect := join("AAAAAAAAAAAAAAAAAA")
 	return map[string][]testCase{
@@ -173,10 +182,30 @@ func businessChatSuite() map[string][]testCase {
 	}
 }
 
+func addListSuite() map[string][]testCase {
+	expect := addList("AAAAAAAAAAAAAAAA")
+	return map[string][]testCase{
+		"Test": {
+			{expect, `t.me/addlist/AAAAAAAAAAAAAAAA`, false},
+			{expect, `t.me/addlist/AAAAAAAAAAAAAAAA/`, false},
+			{expect, `https://t.me/addlist/AAAAAAAAAAAAAAAA`, false},
+			{expect, `https://telegram.me/addlist/AAAAAAAAAAAAAAAA`, false},
+			{expect, `tg:addlist?slug=AAAAAAAAAAAAAAAA`, false},
+			{expect, `tg://addlist?slug=AAAAAAAAAAAAAAAA`, false},
+
+			{DeepLink{}, `https://t.co/addlist/AAAAAAAAAAAAAAAA`, true},
+			{DeepLink{}, `t.me/addlist`, true},
+			{DeepLink{}, `t.me/addlist/`, true},
+			{DeepLink{}, `tg://addlist?slug=`, true},
+		},
+	}
+}
+
 var typeSuites = map[string]map[string][]testCase{
 	"Join":         joinSuite(),
 	"Resolve":      resolveSuite(),
 	"BusinessChat": businessChatSuite(),
+	"AddList":      addListSuite(),
 }
 
 func TestParseDeeplink(t *testing.T) {
@@ -35,6 +35,10 @@ type Builder struct {
 
 	// The reply target.
 	replyTo tg.InputReplyToClass
+	// Quote of replied message.
+	quote *Quote
+	// Forum topic ID (ID of topic service message).
+	topicID int
 	// Reply markup for sending bot buttons.
 	replyMarkup tg.ReplyMarkupClass
 	// Scheduled message date for scheduled messages.
@@ -42,6 +46,11 @@ type Builder struct {
 
 	// sendAs sets peer to send message as it.
 	sendAs tg.InputPeerClass
+
+	// Maximum length of text and caption in UTF-16 code units.
+	// Zero means that splitting is disabled.
+	textLimit    int
+	captionLimit int
 }
 
 func (b *Builder) copy() *Builder {
@@ -84,6 +93,43 @@ func (b *Builder) ReplyMsg(msg tg.MessageClass) *Builder {
 	return b.Reply(msg.GetID())
 }
 
+// Topic sets forum topic to send message to.
+//
+// Topic ID is an ID of topic service message. Reply, if set, should
+// target message of the same topic.
+//
+// See https://core.telegram.org/api/forum#forum-topics.
+func (b *Builder) Topic(id int) *Builder {
+	b.topicID = id
+	return b
+}
+
+// replyTarget returns reply target of message, including forum topic.
+func (b *Builder) replyTarget() tg.InputReplyToClass {
+	switch r := b.replyTo.(type) {
+	case nil:
+		return b.topicTarget()
+	case *tg.InputReplyToMessage:
+		reply := b.applyQuote(*r)
+		if b.topicID != 0 && reply.TopMsgID == 0 && reply.ReplyToMsgID != b.topicID {
+			reply.TopMsgID = b.topicID
+		}
+		return &reply
+	default:
+		return r
+	}
+}
+
+// topicTarget returns reply target of forum topic, if any.
+func (b *Builder) topicTarget() tg.InputReplyToClass {
+	if b.topicID == 0 {
+		return nil
+	}
+	return &tg.InputReplyToMessage{
+		ReplyToMsgID: b.topicID,
+	}
+}
+
 // ScheduleTS sets scheduled message timestamp for scheduled messages.
 func (b *Builder) ScheduleTS(date int) *Builder {
 	b.scheduleDate = date
@@ -124,6 +170,32 @@ func (b *Builder) Row(buttons ...tg.KeyboardButtonClass) *Builder {
 	return b.Markup(markup.InlineRow(buttons...))
 }
 
+// Split enables splitting of long messages using default limits.
+//
+// See SplitLimits.
+func (b *Builder) Split() *Builder {
+	return b.SplitLimits(MaxMessageLength, MaxCaptionLength)
+}
+
+// SplitLimits enables splitting of long messages using given limits
+// in UTF-16 code units. Actual limits are sent by server in config as
+// message_length_max and caption_length_max.
+//
+// Text longer than text limit is sent as multiple messages: text is cut at
+// paragraph, line or word boundary and formatting is preserved. Reply is
+// set only for the first message and reply markup only for the last one.
+//
+// Media caption longer than caption limit is sent as follow-up text
+// message(s). If text limit is not positive, MaxMessageLength is used to
+// split follow-up messages.
+//
+// NB: splitting is not applied to albums.
+func (b *Builder) SplitLimits(text, caption int) *Builder {
+	b.textLimit = text
+	b.captionLimit = caption
+	return b
+}
+
 // SendAs sets peer to send as.
 //
 // See https://telegram.org/blog/protected-content-delete-by-date-and-more#anonymous-posting-in-public-groups.
@@ -18,7 +18,7 @@ func (b *Builder) saveDraftRequest(
 	return &tg.MessagesSaveDraftRequest{
 		NoWebpage: b.noWebpage,
 		Peer:      peer,
-		ReplyTo:   b.replyTo,
+		ReplyTo:   b.replyTarget(),
 		Message:   msg,
 		Entities:  entities,
 	}
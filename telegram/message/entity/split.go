@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/gotd/td/tg"
+)
+
+// Chunk is a part of split message.
+type Chunk struct {
+	// Text of part.
+	Text string
+	// Entities of part, offsets are relative to Text.
+	Entities []tg.MessageEntityClass
+}
+
+type splitRune struct {
+	// offset in bytes.
+	offset int
+	// utf16offset is offset in UTF-16 code units.
+	utf16offset int
+	r           rune
+}
+
+// Split splits message text into parts of at most limit UTF-16 code units.
+//
+// Text is cut at paragraph, line or word boundary if possible. Whitespace
+// at the end of each part is trimmed. Entities are re-sliced for every
+// part: entities crossing the boundary are clipped, so they are
+// continued in the next part.
+//
+// If limit is not positive, text is returned as single part.
+func Split(text string, entities []tg.MessageEntityClass, limit int) []Chunk {
+	if limit <= 0 || ComputeLength(text) <= limit {
+		return []Chunk{{
+			Text:     text,
+			Entities: clipEntities(entities, 0, ComputeLength(text)),
+		}}
+	}
+
+	runes := make([]splitRune, 0, len(text)+1)
+	utf16offset := 0
+	for offset, r := range text {
+		runes = append(runes, splitRune{offset: offset, utf16offset: utf16offset, r: r})
+		utf16offset += utf16RuneLen(r)
+	}
+	// Sentinel.
+	runes = append(runes, splitRune{offset: len(text), utf16offset: utf16offset})
+	n := len(runes) - 1
+
+	var chunks []Chunk
+	for start := 0; start < n; {
+		// Find maximum end which fits limit.
+		end := start
+		for end < n && runes[end+1].utf16offset-runes[start].utf16offset <= limit {
+			end++
+		}
+		if end == start {
+			// Rune is wider than limit, take it anyway.
+			end++
+		}
+
+		next := end
+		if end < n {
+			next = findCut(runes, start, end)
+			end = next
+		}
+		// Trim whitespace at the end.
+		for end > start && unicode.IsSpace(runes[end-1].r) {
+			end--
+		}
+
+		if end > start {
+			from, to := runes[start], runes[end]
+			chunks = append(chunks, Chunk{
+				Text:     text[from.offset:to.offset],
+				Entities: clipEntities(entities, from.utf16offset, to.utf16offset),
+			})
+		}
+		start = next
+	}
+	return chunks
+}
+
+// findCut finds best position to cut runes[start:end], preferring
+// paragraph, then line, then word boundary.
+func findCut(runes []splitRune, start, end int) int {
+	line, word := -1, -1
+	// Cut position i means that runes[i-1] is the last rune of part.
+	for i := end; i > start+1; i-- {
+		switch r := runes[i-1].r; {
+		case r == '\n':
+			if runes[i-2].r == '\n' {
+				return i
+			}
+			if line < 0 {
+				line = i
+			}
+		case unicode.IsSpace(r):
+			if word < 0 {
+				word = i
+			}
+		}
+	}
+	switch {
+	case line > 0:
+		return line
+	case word > 0:
+		return word
+	default:
+		return end
+	}
+}
+
+// clipEntities returns entities which intersect with [from, to) UTF-16 range,
+// clipped to this range and shifted to start of range.
+func clipEntities(entities []tg.MessageEntityClass, from, to int) []tg.MessageEntityClass {
+	var r []tg.MessageEntityClass
+	for _, e := range entities {
+		start, end := e.GetOffset(), e.GetOffset()+e.GetLength()
+		if start < from {
+			start = from
+		}
+		if end > to {
+			end = to
+		}
+		if end <= start {
+			continue
+		}
+		r = append(r, withRange(e, start-from, end-start))
+	}
+	return r
+}
+
+// withRange returns copy of entity with given Offset and Length.
+func withRange(e tg.MessageEntityClass, offset, length int) tg.MessageEntityClass {
+	src := reflect.ValueOf(e).Elem()
+	v := reflect.New(src.Type())
+	v.Elem().Set(src)
+	v.Elem().FieldByName("Offset").SetInt(int64(offset))
+	v.Elem().FieldByName("Length").SetInt(int64(length))
+	return v.Interface().(tg.MessageEntityClass)
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestSplit(t *testing.T) {
+	texts := func(chunks []Chunk) (r []string) {
+		for _, c := range chunks {
+			r = append(r, c.Text)
+		}
+		return r
+	}
+
+	t.Run("Short", func(t *testing.T) {
+		a := require.New(t)
+		entities := []tg.MessageEntityClass{&tg.MessageEntityBold{Offset: 0, Length: 5}}
+		chunks := Split("hello", entities, 10)
+		a.Equal([]Chunk{{Text: "hello", Entities: entities}}, chunks)
+		a.Len(Split(strings.Repeat("a", 100), nil, 0), 1)
+	})
+	t.Run("Paragraph", func(t *testing.T) {
+		chunks := Split("first line\nsecond\n\nthird paragraph", nil, 25)
+		require.Equal(t, []string{"first line\nsecond", "third paragraph"}, texts(chunks))
+	})
+	t.Run("Line", func(t *testing.T) {
+		chunks := Split("first line\nsecond line", nil, 15)
+		require.Equal(t, []string{"first line", "second line"}, texts(chunks))
+	})
+	t.Run("Word", func(t *testing.T) {
+		chunks := Split("one two three four", nil, 9)
+		require.Equal(t, []string{"one two", "three", "four"}, texts(chunks))
+	})
+	t.Run("Hard", func(t *testing.T) {
+		chunks := Split("abcdefgh", nil, 3)
+		require.Equal(t, []string{"abc", "def", "gh"}, texts(chunks))
+	})
+	t.Run("UTF16", func(t *testing.T) {
+		// Each emoji is a surrogate pair.
+		chunks := Split("😀😀😀", nil, 3)
+		require.Equal(t, []string{"😀", "😀", "😀"}, texts(chunks))
+		for _, c := range chunks {
+			require.LessOrEqual(t, ComputeLength(c.Text), 3)
+		}
+	})
+	t.Run("Entities", func(t *testing.T) {
+		a := require.New(t)
+		// "😀 bold text plain"
+		text := "😀 bold text plain"
+		bold := &tg.MessageEntityBold{Offset: 3, Length: 9}
+		url := &tg.MessageEntityTextURL{Offset: 8, Length: 10, URL: "https://example.com"}
+		chunks := Split(text, []tg.MessageEntityClass{bold, url}, 8)
+		a.Equal([]Chunk{
+			{
+				Text: "😀 bold",
+				Entities: []tg.MessageEntityClass{
+					&tg.MessageEntityBold{Offset: 3, Length: 4},
+				},
+			},
+			{
+				Text: "text",
+				Entities: []tg.MessageEntityClass{
+					&tg.MessageEntityBold{Offset: 0, Length: 4},
+					&tg.MessageEntityTextURL{Offset: 0, Length: 4, URL: "https://example.com"},
+				},
+			},
+			{
+				Text: "plain",
+				Entities: []tg.MessageEntityClass{
+					&tg.MessageEntityTextURL{Offset: 0, Length: 5, URL: "https://example.com"},
+				},
+			},
+		}, chunks)
+		// Original entities are not modified.
+		a.Equal(3, bold.Offset)
+		a.Equal(10, url.Length)
+	})
+}
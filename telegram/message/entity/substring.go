@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// Substring returns part of text in given range of UTF-16 code units.
+//
+// Entities are clipped to the range and their offsets are made relative
+// to returned Text. Returns error if range is out of text bounds or
+// splits a surrogate pair.
+func Substring(text string, entities []tg.MessageEntityClass, offset, length int) (Chunk, error) {
+	if offset < 0 || length <= 0 {
+		return Chunk{}, errors.Errorf("invalid range [%d, %d)", offset, offset+length)
+	}
+	end := offset + length
+
+	from, to := -1, -1
+	utf16offset := 0
+	for i, r := range text {
+		if utf16offset == offset {
+			from = i
+		}
+		if utf16offset == end {
+			to = i
+			break
+		}
+		utf16offset += utf16RuneLen(r)
+	}
+	if to < 0 && utf16offset == end {
+		to = len(text)
+	}
+	if from < 0 || to < 0 {
+		return Chunk{}, errors.Errorf("range [%d, %d) does not match text of length %d",
+			offset, end, ComputeLength(text))
+	}
+
+	return Chunk{
+		Text:     text[from:to],
+		Entities: clipEntities(entities, offset, end),
+	}, nil
+}
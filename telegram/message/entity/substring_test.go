@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestSubstring(t *testing.T) {
+	// "👋" is two UTF-16 code units.
+	text := "hi 👋 bold text"
+	entities := []tg.MessageEntityClass{
+		&tg.MessageEntityBold{Offset: 6, Length: 4},
+		&tg.MessageEntityItalic{Offset: 0, Length: 2},
+	}
+
+	for _, tt := range []struct {
+		name           string
+		offset, length int
+		want           Chunk
+		wantErr        bool
+	}{
+		{"Full", 0, 15, Chunk{Text: text, Entities: entities}, false},
+		{"Emoji", 3, 2, Chunk{Text: "👋"}, false},
+		{"Clipped", 8, 7, Chunk{
+			Text:     "ld text",
+			Entities: []tg.MessageEntityClass{&tg.MessageEntityBold{Offset: 0, Length: 2}},
+		}, false},
+		{"Tail", 11, 4, Chunk{Text: "text"}, false},
+		{"SurrogatePair", 4, 2, Chunk{}, true},
+		{"OutOfBounds", 11, 5, Chunk{}, true},
+		{"Empty", 0, 0, Chunk{}, true},
+		{"Negative", -1, 2, Chunk{}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+			got, err := Substring(text, entities, tt.offset, tt.length)
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package message
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/fileid"
+	"github.com/gotd/td/tg"
+)
+
+// FileIDBuilder is a media option which sends file by Bot API file ID.
+type FileIDBuilder struct {
+	id      string
+	caption []StyledTextOption
+}
+
+// apply implements MediaOption.
+func (u *FileIDBuilder) apply(ctx context.Context, b *multiMediaBuilder) error {
+	id, err := fileid.DecodeFileID(u.id)
+	if err != nil {
+		return errors.Wrap(err, "decode file ID")
+	}
+
+	media, ok := id.AsInputMedia()
+	if !ok {
+		return errors.Errorf("file ID of type %s can't be sent", id.Type)
+	}
+
+	return Media(media, u.caption...).apply(ctx, b)
+}
+
+// applyMulti implements MultiMediaOption.
+func (u *FileIDBuilder) applyMulti(ctx context.Context, b *multiMediaBuilder) error {
+	return u.apply(ctx, b)
+}
+
+// FileID adds attachment using Bot API file ID.
+//
+// File is sent without downloading it.
+func FileID(id string, caption ...StyledTextOption) *FileIDBuilder {
+	return &FileIDBuilder{
+		id:      id,
+		caption: caption,
+	}
+}
+
+// FileID sends file by Bot API file ID.
+func (b *Builder) FileID(
+	ctx context.Context, id string, caption ...StyledTextOption,
+) (tg.UpdatesClass, error) {
+	return b.Media(ctx, FileID(id, caption...))
+}
@@ -0,0 +1,70 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/fileid"
+	"github.com/gotd/td/tg"
+)
+
+func TestFileID(t *testing.T) {
+	ctx := context.Background()
+	sender, mock := testSender(t)
+
+	encode := func(id fileid.FileID) string {
+		s, err := fileid.EncodeFileID(id)
+		require.NoError(t, err)
+		return s
+	}
+	doc := encode(fileid.FileID{
+		Type:          fileid.Sticker,
+		DC:            2,
+		ID:            10,
+		AccessHash:    11,
+		FileReference: []byte{1, 2, 3},
+	})
+	photo := encode(fileid.FileID{
+		Type:       fileid.Photo,
+		DC:         2,
+		ID:         20,
+		AccessHash: 21,
+		PhotoSizeSource: fileid.PhotoSizeSource{
+			Type:          fileid.PhotoSizeSourceThumbnail,
+			FileType:      fileid.Photo,
+			ThumbnailType: 'y',
+		},
+	})
+	thumb := encode(fileid.FileID{
+		Type: fileid.Thumbnail,
+		DC:   2,
+		ID:   30,
+		PhotoSizeSource: fileid.PhotoSizeSource{
+			Type:          fileid.PhotoSizeSourceThumbnail,
+			FileType:      fileid.Thumbnail,
+			ThumbnailType: 'm',
+		},
+	})
+
+	expectSendMedia(t, &tg.InputMediaDocument{ID: &tg.InputDocument{
+		ID:            10,
+		AccessHash:    11,
+		FileReference: []byte{1, 2, 3},
+	}}, mock)
+	expectSendMedia(t, &tg.InputMediaPhoto{ID: &tg.InputPhoto{
+		ID:         20,
+		AccessHash: 21,
+	}}, mock)
+
+	_, err := sender.Self().FileID(ctx, doc)
+	require.NoError(t, err)
+	_, err = sender.Self().Media(ctx, FileID(photo))
+	require.NoError(t, err)
+
+	_, err = sender.Self().FileID(ctx, thumb)
+	require.Error(t, err)
+	_, err = sender.Self().FileID(ctx, "invalid")
+	require.Error(t, err)
+}
@@ -1,9 +1,11 @@
 package message
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"io/fs"
+	"os"
 
 	"go.uber.org/atomic"
 
@@ -70,26 +72,98 @@ func Upload(promise FilePromise) UploadOption {
 	})
 }
 
+// probeFile is a file content to probe.
+type probeFile struct {
+	io.ReaderAt
+	size  int64
+	close func() error
+}
+
+// probeSource is an UploadOption which content can be probed
+// before sending.
+type probeSource interface {
+	openProbe() (probeFile, bool, error)
+}
+
+// probedUpload is an UploadOption with probeable content.
+type probedUpload struct {
+	UploadOption
+	open func() (probeFile, bool, error)
+}
+
+func (p probedUpload) openProbe() (probeFile, bool, error) {
+	return p.open()
+}
+
+// openStat returns probeFile for given file, if it supports random access.
+func openStat(f interface{ Stat() (fs.FileInfo, error) }, closeFn func() error) (probeFile, bool, error) {
+	r, ok := f.(io.ReaderAt)
+	if !ok {
+		return probeFile{}, false, closeFn()
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = closeFn()
+		return probeFile{}, false, err
+	}
+	return probeFile{
+		ReaderAt: r,
+		size:     info.Size(),
+		close:    closeFn,
+	}, true, nil
+}
+
+func nopClose() error { return nil }
+
 // FromFile uploads given File.
 // NB: FromFile does not close given file.
 func FromFile(f uploader.File) UploadOption {
-	return Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
-		return b.FromFile(ctx, f)
-	})
+	return probedUpload{
+		UploadOption: Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
+			return b.FromFile(ctx, f)
+		}),
+		open: func() (probeFile, bool, error) {
+			if f == nil {
+				return probeFile{}, false, nil
+			}
+			return openStat(f, nopClose)
+		},
+	}
 }
 
 // FromPath uploads file from given path.
 func FromPath(path string) UploadOption {
-	return Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
-		return b.FromPath(ctx, path)
-	})
+	return probedUpload{
+		UploadOption: Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
+			return b.FromPath(ctx, path)
+		}),
+		open: func() (probeFile, bool, error) {
+			f, err := os.Open(path)
+			if err != nil {
+				return probeFile{}, false, err
+			}
+			return openStat(f, f.Close)
+		},
+	}
 }
 
 // FromFS uploads file from given path using given fs.FS.
 func FromFS(filesystem fs.FS, path string) UploadOption {
-	return Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
-		return b.FromFS(ctx, filesystem, path)
-	})
+	return probedUpload{
+		UploadOption: Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
+			return b.FromFS(ctx, filesystem, path)
+		}),
+		open: func() (probeFile, bool, error) {
+			if filesystem == nil {
+				return probeFile{}, false, nil
+			}
+			f, err := filesystem.Open(path)
+			if err != nil {
+				return probeFile{}, false, err
+			}
+			return openStat(f, f.Close)
+		},
+	}
 }
 
 // FromReader uploads file from given io.Reader.
@@ -103,9 +177,18 @@ func FromReader(name string, r io.Reader) UploadOption {
 
 // FromBytes uploads file from given byte slice.
 func FromBytes(name string, data []byte) UploadOption {
-	return Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
-		return b.FromBytes(ctx, name, data)
-	})
+	return probedUpload{
+		UploadOption: Upload(func(ctx context.Context, b Uploader) (tg.InputFileClass, error) {
+			return b.FromBytes(ctx, name, data)
+		}),
+		open: func() (probeFile, bool, error) {
+			return probeFile{
+				ReaderAt: bytes.NewReader(data),
+				size:     int64(len(data)),
+				close:    nopClose,
+			}, true, nil
+		},
+	}
 }
 
 // FromURL uploads file from given URL.
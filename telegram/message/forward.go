@@ -36,6 +36,7 @@ func (b *ForwardBuilder) Send(ctx context.Context) (tg.UpdatesClass, error) {
 		FromPeer:     b.from,
 		ID:           b.ids,
 		ToPeer:       p,
+		TopMsgID:     b.builder.topicID,
 		ScheduleDate: b.builder.scheduleDate,
 	})
 	if err != nil {
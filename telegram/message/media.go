@@ -58,7 +58,7 @@ func (b *Builder) Album(ctx context.Context, media MultiMediaOption, album ...Mu
 		Background:   b.background,
 		ClearDraft:   b.clearDraft,
 		Peer:         p,
-		ReplyTo:      b.replyTo,
+		ReplyTo:      b.replyTarget(),
 		MultiMedia:   mb,
 		ScheduleDate: b.scheduleDate,
 	})
@@ -107,23 +107,45 @@ func (b *Builder) Media(ctx context.Context, media MediaOption) (tg.UpdatesClass
 		return nil, err
 	}
 
+	// Move too long caption to follow-up message.
+	var caption []entity.Chunk
+	replyMarkup := b.replyMarkup
+	if b.captionLimit > 0 && entity.ComputeLength(attachment.Message) > b.captionLimit {
+		textLimit := b.textLimit
+		if textLimit <= 0 {
+			// Text splitting is disabled, but follow-up messages still
+			// must fit into message limit.
+			textLimit = MaxMessageLength
+		}
+		caption = entity.Split(attachment.Message, attachment.Entities, textLimit)
+		attachment.Message, attachment.Entities = "", nil
+		replyMarkup = nil
+	}
+
 	upd, err := b.sender.sendMedia(ctx, &tg.MessagesSendMediaRequest{
 		Silent:       b.silent,
 		Background:   b.background,
 		ClearDraft:   b.clearDraft,
 		Peer:         p,
-		ReplyTo:      b.replyTo,
+		ReplyTo:      b.replyTarget(),
 		Media:        attachment.Media,
 		Message:      attachment.Message,
-		ReplyMarkup:  b.replyMarkup,
+		ReplyMarkup:  replyMarkup,
 		Entities:     attachment.Entities,
 		ScheduleDate: b.scheduleDate,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "send media")
 	}
+	if len(caption) == 0 {
+		return upd, nil
+	}
 
-	return upd, nil
+	rest, err := b.sendParts(ctx, p, caption, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "send caption")
+	}
+	return mergeUpdates([]tg.UpdatesClass{upd, rest}), nil
 }
 
 func (b *Builder) applySingleMedia(
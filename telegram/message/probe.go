@@ -0,0 +1,120 @@
+package message
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/message/probe"
+	"github.com/gotd/td/tg"
+)
+
+// Probed sets MIME type, if it is not set yet, and image size
+// attribute using given probing result.
+func (u *UploadedDocumentBuilder) Probed(info probe.Info) *UploadedDocumentBuilder {
+	if u.doc.MimeType == "" && info.MIME != "" {
+		u.MIME(info.MIME)
+	}
+	if info.Kind == probe.Image && info.Width > 0 && info.Height > 0 {
+		u.Attributes(&tg.DocumentAttributeImageSize{
+			W: info.Width,
+			H: info.Height,
+		})
+	}
+	return u
+}
+
+// Probed sets resolution, duration and streaming flag using given
+// probing result.
+func (u *VideoDocumentBuilder) Probed(info probe.Info) *VideoDocumentBuilder {
+	if info.Kind != probe.Video {
+		return u
+	}
+	u.Resolution(info.Width, info.Height).Duration(info.Duration)
+	if info.Streaming {
+		u.SupportsStreaming()
+	}
+	return u
+}
+
+// Probed sets duration, title and performer using given probing result.
+// Title and performer are not overwritten if already set.
+func (u *AudioDocumentBuilder) Probed(info probe.Info) *AudioDocumentBuilder {
+	if info.Kind != probe.Audio {
+		return u
+	}
+	u.Duration(info.Duration)
+	if u.attr.Title == "" {
+		u.Title(info.Title)
+	}
+	if u.attr.Performer == "" {
+		u.Performer(info.Performer)
+	}
+	return u
+}
+
+// probeResult is a result of upload probing.
+type probeResult struct {
+	info  probe.Info
+	thumb tg.InputFileClass
+}
+
+// inspect probes content of upload, if it is possible.
+//
+// Probing errors are ignored: file is sent as is.
+func (u *UploadBuilder) inspect(ctx context.Context) (probeResult, error) {
+	src, ok := u.option.(probeSource)
+	if !ok {
+		return probeResult{}, nil
+	}
+	f, ok, err := src.openProbe()
+	if err != nil || !ok {
+		return probeResult{}, nil
+	}
+	defer func() {
+		_ = f.close()
+	}()
+
+	info, err := probe.Probe(f, f.size)
+	if err != nil {
+		return probeResult{}, nil
+	}
+	r := probeResult{info: info}
+	if !u.thumb || info.Kind != probe.Image {
+		return r, nil
+	}
+
+	data, err := probe.Thumbnail(io.NewSectionReader(f, 0, f.size), probe.MaxThumbSize)
+	if err != nil {
+		// Format is not supported by decoder, e.g. WebP.
+		return r, nil
+	}
+	r.thumb, err = u.builder.sender.uploader.FromBytes(ctx, "thumb.jpg", data)
+	if err != nil {
+		return probeResult{}, errors.Wrap(err, "upload thumbnail")
+	}
+	return r, nil
+}
+
+// sendProbed uploads, probes and sends file using media created by given function.
+func (u *UploadBuilder) sendProbed(
+	ctx context.Context,
+	caption []StyledTextOption,
+	media func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption,
+) (tg.UpdatesClass, error) {
+	f, err := u.file(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "upload")
+	}
+	r, err := u.inspect(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "probe")
+	}
+
+	doc := UploadedDocument(f, caption...).Probed(r.info)
+	if r.thumb != nil {
+		doc.Thumb(r.thumb)
+	}
+	return u.builder.Media(ctx, media(doc, r.info))
+}
@@ -0,0 +1,5 @@
+// Package probe implements pure Go media metadata probing.
+//
+// Supported formats are MP4/MOV, WebM/Matroska, MP3, OGG (Opus and
+// Vorbis), PNG, JPEG, GIF and WebP.
+package probe
@@ -0,0 +1,176 @@
+package probe
+
+import (
+	"bufio"
+	"encoding/binary"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+func probeConfig(
+	r io.ReaderAt, size int64,
+	decode func(r io.Reader) (image.Config, error),
+	format, mime string,
+) (Info, error) {
+	cfg, err := decode(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return Info{}, err
+	}
+	return Info{
+		Kind:   Image,
+		Format: format,
+		MIME:   mime,
+		Width:  cfg.Width,
+		Height: cfg.Height,
+	}, nil
+}
+
+func probePNG(r io.ReaderAt, size int64) (Info, error) {
+	return probeConfig(r, size, png.DecodeConfig, "png", "image/png")
+}
+
+func probeJPEG(r io.ReaderAt, size int64) (Info, error) {
+	return probeConfig(r, size, jpeg.DecodeConfig, "jpeg", "image/jpeg")
+}
+
+func probeGIF(r io.ReaderAt, size int64) (Info, error) {
+	info, err := probeConfig(r, size, gif.DecodeConfig, "gif", "image/gif")
+	if err != nil {
+		return Info{}, err
+	}
+
+	frames, delay, err := gifFrames(bufio.NewReader(io.NewSectionReader(r, 0, size)))
+	if err != nil {
+		return Info{}, errors.Wrap(err, "read frames")
+	}
+	if frames > 1 {
+		info.Animated = true
+		info.Duration = time.Duration(delay) * 10 * time.Millisecond
+	}
+	return info, nil
+}
+
+// gifFrames counts GIF frames and sums their delays, in 1/100 seconds.
+func gifFrames(r *bufio.Reader) (frames, delay int, _ error) {
+	var header [13]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, 0, err
+	}
+	if flags := header[10]; flags&0x80 != 0 {
+		// Global color table.
+		if _, err := r.Discard(3 << (flags&0x07 + 1)); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	skipBlocks := func() error {
+		for {
+			n, err := r.ReadByte()
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return nil
+			}
+			if _, err := r.Discard(int(n)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		kind, err := r.ReadByte()
+		if err != nil {
+			return 0, 0, err
+		}
+		switch kind {
+		case 0x21: // Extension.
+			label, err := r.ReadByte()
+			if err != nil {
+				return 0, 0, err
+			}
+			if label == 0xf9 {
+				// Graphic Control Extension.
+				var gce [6]byte
+				if _, err := io.ReadFull(r, gce[:]); err != nil {
+					return 0, 0, err
+				}
+				delay += int(binary.LittleEndian.Uint16(gce[2:4]))
+				if gce[5] != 0 {
+					return 0, 0, errors.New("invalid graphic control extension")
+				}
+				continue
+			}
+			if err := skipBlocks(); err != nil {
+				return 0, 0, err
+			}
+		case 0x2c: // Image descriptor.
+			var desc [9]byte
+			if _, err := io.ReadFull(r, desc[:]); err != nil {
+				return 0, 0, err
+			}
+			if flags := desc[8]; flags&0x80 != 0 {
+				// Local color table.
+				if _, err := r.Discard(3 << (flags&0x07 + 1)); err != nil {
+					return 0, 0, err
+				}
+			}
+			// LZW minimum code size.
+			if _, err := r.ReadByte(); err != nil {
+				return 0, 0, err
+			}
+			if err := skipBlocks(); err != nil {
+				return 0, 0, err
+			}
+			frames++
+		case 0x3b: // Trailer.
+			return frames, delay, nil
+		default:
+			return 0, 0, errors.Errorf("unexpected block %#x", kind)
+		}
+	}
+}
+
+func probeWebP(r io.ReaderAt, _ int64) (Info, error) {
+	var b [30]byte
+	if err := readAt(r, b[:], 0); err != nil {
+		return Info{}, err
+	}
+
+	info := Info{
+		Kind:   Image,
+		Format: "webp",
+		MIME:   "image/webp",
+	}
+	switch chunk := string(b[12:16]); chunk {
+	case "VP8 ":
+		// Lossy, see RFC 6386, section 9.1.
+		if b[23] != 0x9d || b[24] != 0x01 || b[25] != 0x2a {
+			return Info{}, errors.New("invalid VP8 start code")
+		}
+		info.Width = int(binary.LittleEndian.Uint16(b[26:28]) & 0x3fff)
+		info.Height = int(binary.LittleEndian.Uint16(b[28:30]) & 0x3fff)
+	case "VP8L":
+		// Lossless.
+		if b[20] != 0x2f {
+			return Info{}, errors.New("invalid VP8L signature")
+		}
+		v := binary.LittleEndian.Uint32(b[21:25])
+		info.Width = int(v&0x3fff) + 1
+		info.Height = int(v>>14&0x3fff) + 1
+	case "VP8X":
+		// Extended.
+		info.Animated = b[20]&0x02 != 0
+		info.Width = int(uint32(b[24])|uint32(b[25])<<8|uint32(b[26])<<16) + 1
+		info.Height = int(uint32(b[27])|uint32(b[28])<<8|uint32(b[29])<<16) + 1
+	default:
+		return Info{}, errors.Errorf("unexpected chunk %q", chunk)
+	}
+	return info, nil
+}
@@ -0,0 +1,258 @@
+package probe
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Matroska element IDs.
+//
+// See RFC 9559.
+const (
+	ebmlHeader        = 0x1a45dfa3
+	ebmlDocType       = 0x4282
+	mkvSegment        = 0x18538067
+	mkvInfo           = 0x1549a966
+	mkvTimestampScale = 0x2ad7b1
+	mkvDuration       = 0x4489
+	mkvTracks         = 0x1654ae6b
+	mkvTrackEntry     = 0xae
+	mkvTrackType      = 0x83
+	mkvVideo          = 0xe0
+	mkvPixelWidth     = 0xb0
+	mkvPixelHeight    = 0xba
+	mkvCluster        = 0x1f43b675
+)
+
+// errStop stops element iteration.
+var errStop = errors.New("stop")
+
+// readVint reads EBML variable size integer. If id is true, length
+// marker is kept.
+func readVint(r io.ReaderAt, offset int64, id bool) (v uint64, n int, unknown bool, _ error) {
+	var b [8]byte
+	if err := readAt(r, b[:1], offset); err != nil {
+		return 0, 0, false, err
+	}
+	n = bits.LeadingZeros8(b[0]) + 1
+	if n > 8 || (id && n > 4) {
+		return 0, 0, false, errors.Errorf("invalid vint %#x", b[0])
+	}
+	if err := readAt(r, b[1:n], offset+1); err != nil {
+		return 0, 0, false, err
+	}
+
+	v = uint64(b[0])
+	if !id {
+		v &= 0xff >> n
+	}
+	allOnes := v == 0xff>>n
+	for _, c := range b[1:n] {
+		v = v<<8 | uint64(c)
+		allOnes = allOnes && c == 0xff
+	}
+	return v, n, !id && allOnes, nil
+}
+
+// forEachElement calls fn for every EBML element in [start, end) range.
+func forEachElement(r io.ReaderAt, start, end int64, fn func(id uint64, offset, size int64) error) error {
+	for offset := start; offset < end; {
+		id, idLen, _, err := readVint(r, offset, true)
+		if err != nil {
+			return errors.Wrap(err, "read element id")
+		}
+		size, sizeLen, unknown, err := readVint(r, offset+int64(idLen), false)
+		if err != nil {
+			return errors.Wrap(err, "read element size")
+		}
+		offset += int64(idLen + sizeLen)
+		if offset > end {
+			return errors.New("element header exceeds parent")
+		}
+		if unknown || size > uint64(end-offset) {
+			// Element extends to the end of parent.
+			size = uint64(end - offset)
+		}
+
+		if err := fn(id, offset, int64(size)); err != nil {
+			return err
+		}
+		offset += int64(size)
+	}
+	return nil
+}
+
+func readElement(r io.ReaderAt, offset, size int64) ([]byte, error) {
+	if size < 0 || size > 1024 {
+		return nil, errors.Errorf("invalid element size %d", size)
+	}
+	b := make([]byte, size)
+	if err := readAt(r, b, offset); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func readUint(r io.ReaderAt, offset, size int64) (uint64, error) {
+	if size > 8 {
+		return 0, errors.Errorf("invalid uint size %d", size)
+	}
+	b, err := readElement(r, offset, size)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v, nil
+}
+
+func readFloat(r io.ReaderAt, offset, size int64) (float64, error) {
+	b, err := readElement(r, offset, size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 0:
+		return 0, nil
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	default:
+		return 0, errors.Errorf("invalid float size %d", size)
+	}
+}
+
+func probeMatroska(r io.ReaderAt, size int64) (Info, error) {
+	var (
+		docType   string
+		scale     = uint64(time.Millisecond)
+		duration  float64
+		hasVideo  bool
+		hasAudio  bool
+		w, h      uint64
+		gotInfo   bool
+		gotTracks bool
+	)
+
+	parseTrack := func(offset, size int64) error {
+		var (
+			typ    uint64
+			tw, th uint64
+		)
+		if err := forEachElement(r, offset, offset+size, func(id uint64, offset, size int64) (err error) {
+			switch id {
+			case mkvTrackType:
+				typ, err = readUint(r, offset, size)
+			case mkvVideo:
+				return forEachElement(r, offset, offset+size, func(id uint64, offset, size int64) (err error) {
+					switch id {
+					case mkvPixelWidth:
+						tw, err = readUint(r, offset, size)
+					case mkvPixelHeight:
+						th, err = readUint(r, offset, size)
+					}
+					return err
+				})
+			}
+			return err
+		}); err != nil {
+			return err
+		}
+
+		switch typ {
+		case 1:
+			if !hasVideo {
+				w, h = tw, th
+			}
+			hasVideo = true
+		case 2:
+			hasAudio = true
+		}
+		return nil
+	}
+
+	err := forEachElement(r, 0, size, func(id uint64, offset, size int64) error {
+		switch id {
+		case ebmlHeader:
+			return forEachElement(r, offset, offset+size, func(id uint64, offset, size int64) error {
+				if id == ebmlDocType {
+					b, err := readElement(r, offset, size)
+					if err != nil {
+						return err
+					}
+					docType = string(b)
+				}
+				return nil
+			})
+		case mkvSegment:
+			return forEachElement(r, offset, offset+size, func(id uint64, offset, size int64) (err error) {
+				switch id {
+				case mkvInfo:
+					gotInfo = true
+					err = forEachElement(r, offset, offset+size, func(id uint64, offset, size int64) (err error) {
+						switch id {
+						case mkvTimestampScale:
+							scale, err = readUint(r, offset, size)
+						case mkvDuration:
+							duration, err = readFloat(r, offset, size)
+						}
+						return err
+					})
+				case mkvTracks:
+					gotTracks = true
+					err = forEachElement(r, offset, offset+size, func(id uint64, offset, size int64) error {
+						if id != mkvTrackEntry {
+							return nil
+						}
+						return parseTrack(offset, size)
+					})
+				case mkvCluster:
+					// Media data, metadata is usually placed before it.
+					return errStop
+				}
+				if err == nil && gotInfo && gotTracks {
+					return errStop
+				}
+				return err
+			})
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStop) {
+		return Info{}, err
+	}
+
+	info := Info{
+		Format:   "matroska",
+		Width:    int(w),
+		Height:   int(h),
+		Duration: time.Duration(duration * float64(scale)),
+		NoSound:  !hasAudio,
+	}
+	prefix := "video"
+	switch {
+	case hasVideo:
+		info.Kind = Video
+	case hasAudio:
+		info.Kind = Audio
+		prefix = "audio"
+	}
+	switch docType {
+	case "webm":
+		info.Format = "webm"
+		info.MIME = prefix + "/webm"
+	case "matroska":
+		info.MIME = prefix + "/x-matroska"
+	default:
+		return Info{}, errors.Errorf("unknown doc type %q", docType)
+	}
+	return info, nil
+}
@@ -0,0 +1,244 @@
+package probe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/go-faster/errors"
+)
+
+// mp3Header is a parsed MPEG audio frame header.
+type mp3Header struct {
+	mpeg1   bool
+	layer   int
+	mono    bool
+	bitrate int // in bits per second
+	rate    int // sample rate
+	samples int // samples per frame
+}
+
+var (
+	mp3Bitrates = [2][3][15]int{
+		// MPEG-1: Layer I, II, III.
+		{
+			{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+			{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+			{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		},
+		// MPEG-2 and MPEG-2.5: Layer I, II, III.
+		{
+			{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		},
+	}
+	mp3Rates = [3]int{44100, 48000, 32000}
+)
+
+func parseMP3Header(b []byte) (h mp3Header, ok bool) {
+	if len(b) < 4 || b[0] != 0xff || b[1]&0xe0 != 0xe0 {
+		return h, false
+	}
+	version := b[1] >> 3 & 0x03
+	layer := b[1] >> 1 & 0x03
+	bitrate := b[2] >> 4
+	rate := b[2] >> 2 & 0x03
+	if version == 1 || layer == 0 || bitrate == 0 || bitrate == 15 || rate == 3 {
+		// Reserved or free format.
+		return h, false
+	}
+
+	h.mpeg1 = version == 3
+	h.layer = 4 - int(layer)
+	h.mono = b[3]>>6 == 3
+	h.rate = mp3Rates[rate]
+	table := 1
+	switch version {
+	case 3:
+		table = 0
+	case 2:
+		h.rate /= 2
+	case 0:
+		h.rate /= 4
+	}
+	h.bitrate = mp3Bitrates[table][h.layer-1][bitrate] * 1000
+
+	switch {
+	case h.layer == 1:
+		h.samples = 384
+	case h.layer == 3 && !h.mpeg1:
+		h.samples = 576
+	default:
+		h.samples = 1152
+	}
+	return h, true
+}
+
+// xingOffset returns offset of Xing/Info header in Layer III frame.
+func (h mp3Header) xingOffset() int {
+	switch {
+	case h.mpeg1 && h.mono:
+		return 4 + 17
+	case h.mpeg1:
+		return 4 + 32
+	case h.mono:
+		return 4 + 9
+	default:
+		return 4 + 17
+	}
+}
+
+// decodeID3Text decodes ID3v2 text frame.
+func decodeID3Text(b []byte) string {
+	if len(b) < 1 {
+		return ""
+	}
+	enc, b := b[0], b[1:]
+	switch enc {
+	case 1, 2: // UTF-16 with BOM, UTF-16BE.
+		order := binary.ByteOrder(binary.BigEndian)
+		if enc == 1 && len(b) >= 2 {
+			if b[0] == 0xff && b[1] == 0xfe {
+				order = binary.LittleEndian
+			}
+			b = b[2:]
+		}
+		u := make([]uint16, 0, len(b)/2)
+		for i := 0; i+1 < len(b); i += 2 {
+			u = append(u, order.Uint16(b[i:]))
+		}
+		return strings.TrimRight(string(utf16.Decode(u)), "\x00")
+	case 3: // UTF-8.
+		return strings.TrimRight(string(b), "\x00")
+	default: // ISO-8859-1.
+		r := make([]rune, 0, len(b))
+		for _, c := range b {
+			r = append(r, rune(c))
+		}
+		return strings.TrimRight(string(r), "\x00")
+	}
+}
+
+func syncsafe(b []byte) int {
+	return int(b[0]&0x7f)<<21 | int(b[1]&0x7f)<<14 | int(b[2]&0x7f)<<7 | int(b[3]&0x7f)
+}
+
+// parseID3v2 parses ID3v2 tag frames.
+//
+// See https://id3.org/id3v2.4.0-structure.
+func parseID3v2(major byte, tag []byte, info *Info) {
+	idSize, headerSize := 4, 10
+	if major == 2 {
+		idSize, headerSize = 3, 6
+	}
+	for len(tag) >= headerSize && tag[0] != 0 {
+		id := string(tag[:idSize])
+		var size int
+		switch major {
+		case 2:
+			size = int(tag[3])<<16 | int(tag[4])<<8 | int(tag[5])
+		case 3:
+			size = int(binary.BigEndian.Uint32(tag[4:8]))
+		default:
+			size = syncsafe(tag[4:8])
+		}
+		tag = tag[headerSize:]
+		if size > len(tag) {
+			return
+		}
+		switch id {
+		case "TIT2", "TT2":
+			info.Title = decodeID3Text(tag[:size])
+		case "TPE1", "TP1":
+			info.Performer = decodeID3Text(tag[:size])
+		}
+		tag = tag[size:]
+	}
+}
+
+func probeMP3(r io.ReaderAt, size int64) (Info, error) {
+	info := Info{
+		Kind:   Audio,
+		Format: "mp3",
+		MIME:   "audio/mpeg",
+	}
+
+	var start int64
+	var id3 [10]byte
+	if err := readAt(r, id3[:], 0); err == nil && string(id3[:3]) == "ID3" {
+		tagSize := int64(syncsafe(id3[6:10]))
+		start = 10 + tagSize
+		if id3[5]&0x10 != 0 {
+			// Footer.
+			start += 10
+		}
+		if tagSize <= 1<<20 {
+			tag := make([]byte, tagSize)
+			if err := readAt(r, tag, 10); err == nil {
+				parseID3v2(id3[3], tag, &info)
+			}
+		}
+	}
+
+	end := size
+	var id3v1 [128]byte
+	if size-start >= 128 {
+		if err := readAt(r, id3v1[:], size-128); err == nil && string(id3v1[:3]) == "TAG" {
+			end -= 128
+			if info.Title == "" && info.Performer == "" {
+				info.Title = strings.TrimRight(string(id3v1[3:33]), "\x00 ")
+				info.Performer = strings.TrimRight(string(id3v1[33:63]), "\x00 ")
+			}
+		}
+	}
+
+	// Find first frame.
+	buf := make([]byte, 64*1024)
+	n, err := r.ReadAt(buf, start)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return Info{}, err
+	}
+	buf = buf[:n]
+
+	var h mp3Header
+	offset := -1
+	for i := 0; i+4 <= len(buf); i++ {
+		if v, ok := parseMP3Header(buf[i:]); ok {
+			h, offset = v, i
+			break
+		}
+	}
+	if offset < 0 {
+		return Info{}, errors.New("no MPEG audio frame found")
+	}
+	frame := buf[offset:]
+
+	var frames int
+	if h.layer == 3 {
+		if x := h.xingOffset(); len(frame) >= x+12 {
+			switch string(frame[x : x+4]) {
+			case "Xing", "Info":
+				if flags := binary.BigEndian.Uint32(frame[x+4:]); flags&0x01 != 0 {
+					frames = int(binary.BigEndian.Uint32(frame[x+8:]))
+				}
+			}
+		}
+		// VBRI header is placed right after side information of MPEG-1 stereo.
+		if x := 4 + 32; frames == 0 && len(frame) >= x+18 && bytes.Equal(frame[x:x+4], []byte("VBRI")) {
+			frames = int(binary.BigEndian.Uint32(frame[x+14:]))
+		}
+	}
+
+	switch {
+	case frames > 0:
+		info.Duration = scaleDuration(uint32(h.rate), uint64(frames)*uint64(h.samples))
+	case h.bitrate > 0:
+		// Assume constant bitrate.
+		audio := end - start - int64(offset)
+		info.Duration = scaleDuration(uint32(h.bitrate), uint64(audio)*8)
+	}
+	return info, nil
+}
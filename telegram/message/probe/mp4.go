@@ -0,0 +1,251 @@
+package probe
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// forEachBox calls fn for every ISO BMFF box in [start, end) range.
+//
+// See ISO/IEC 14496-12, section 4.2.
+func forEachBox(r io.ReaderAt, start, end int64, fn func(typ string, offset, size int64) error) error {
+	var header [16]byte
+	for offset := start; offset+8 <= end; {
+		if err := readAt(r, header[:8], offset); err != nil {
+			return errors.Wrap(err, "read box header")
+		}
+		var (
+			size       = int64(binary.BigEndian.Uint32(header[0:4]))
+			typ        = string(header[4:8])
+			headerSize = int64(8)
+		)
+		switch size {
+		case 0:
+			// Box extends to the end.
+			size = end - offset
+		case 1:
+			if err := readAt(r, header[8:16], offset+8); err != nil {
+				return errors.Wrap(err, "read box size")
+			}
+			size = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = 16
+		}
+		if size < headerSize || offset+size > end {
+			return errors.Errorf("invalid %q box size %d", typ, size)
+		}
+
+		if err := fn(typ, offset+headerSize, size-headerSize); err != nil {
+			return errors.Wrap(err, typ)
+		}
+		offset += size
+	}
+	return nil
+}
+
+// readFullBox reads version and payload of full box.
+func readFullBox(r io.ReaderAt, offset, size int64, max int) (version byte, _ []byte, _ error) {
+	if size < 4 {
+		return 0, nil, errors.New("box is too small")
+	}
+	if size > int64(max) {
+		size = int64(max)
+	}
+	b := make([]byte, size)
+	if err := readAt(r, b, offset); err != nil {
+		return 0, nil, err
+	}
+	return b[0], b[4:], nil
+}
+
+// readTimes reads timescale and duration of mvhd and mdhd boxes.
+func readTimes(version byte, b []byte) (timescale uint32, duration uint64, _ error) {
+	switch version {
+	case 0:
+		// creation_time(4) modification_time(4) timescale(4) duration(4).
+		if len(b) < 16 {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		return binary.BigEndian.Uint32(b[8:12]), uint64(binary.BigEndian.Uint32(b[12:16])), nil
+	case 1:
+		// creation_time(8) modification_time(8) timescale(4) duration(8).
+		if len(b) < 28 {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		return binary.BigEndian.Uint32(b[16:20]), binary.BigEndian.Uint64(b[20:28]), nil
+	default:
+		return 0, 0, errors.Errorf("unknown version %d", version)
+	}
+}
+
+func scaleDuration(timescale uint32, duration uint64) time.Duration {
+	if timescale == 0 {
+		return 0
+	}
+	sec := duration / uint64(timescale)
+	rem := duration % uint64(timescale)
+	return time.Duration(sec)*time.Second +
+		time.Duration(rem)*time.Second/time.Duration(timescale)
+}
+
+type mp4Track struct {
+	handler       string
+	width, height int
+	duration      time.Duration
+}
+
+// rotated90 reports whether tkhd transformation matrix encodes ±90°
+// rotation, i.e. video should be displayed with swapped dimensions.
+func rotated90(matrix []byte) bool {
+	const one = 1 << 16 // 16.16 fixed-point 1.0
+	var (
+		a = int32(binary.BigEndian.Uint32(matrix[0:4]))
+		b = int32(binary.BigEndian.Uint32(matrix[4:8]))
+		c = int32(binary.BigEndian.Uint32(matrix[12:16]))
+		d = int32(binary.BigEndian.Uint32(matrix[16:20]))
+	)
+	return a == 0 && d == 0 &&
+		((b == one && c == -one) || (b == -one && c == one))
+}
+
+func probeMP4Track(r io.ReaderAt, offset, size int64) (t mp4Track, _ error) {
+	var parse func(typ string, offset, size int64) error
+	parse = func(typ string, offset, size int64) error {
+		switch typ {
+		case "mdia", "minf":
+			return forEachBox(r, offset, offset+size, parse)
+		case "tkhd":
+			version, b, err := readFullBox(r, offset, size, 96)
+			if err != nil {
+				return err
+			}
+			// Width and height are last two 16.16 fixed-point fields.
+			pos := 72
+			if version == 1 {
+				pos = 84
+			}
+			if len(b) < pos+8 {
+				return io.ErrUnexpectedEOF
+			}
+			t.width = int(binary.BigEndian.Uint32(b[pos:]) >> 16)
+			t.height = int(binary.BigEndian.Uint32(b[pos+4:]) >> 16)
+			// Transformation matrix {a, b, u, c, d, v, x, y, w} precedes
+			// width and height.
+			if rotated90(b[pos-36 : pos]) {
+				t.width, t.height = t.height, t.width
+			}
+		case "mdhd":
+			version, b, err := readFullBox(r, offset, size, 32)
+			if err != nil {
+				return err
+			}
+			timescale, duration, err := readTimes(version, b)
+			if err != nil {
+				return err
+			}
+			t.duration = scaleDuration(timescale, duration)
+		case "hdlr":
+			_, b, err := readFullBox(r, offset, size, 12)
+			if err != nil {
+				return err
+			}
+			// pre_defined(4) handler_type(4).
+			if len(b) < 8 {
+				return io.ErrUnexpectedEOF
+			}
+			t.handler = string(b[4:8])
+		}
+		return nil
+	}
+	err := forEachBox(r, offset, offset+size, parse)
+	return t, err
+}
+
+func probeMP4(r io.ReaderAt, size int64) (Info, error) {
+	info := Info{
+		Format: "mp4",
+		MIME:   "video/mp4",
+	}
+	var (
+		moov, mdat = int64(-1), int64(-1)
+		tracks     []mp4Track
+	)
+	if err := forEachBox(r, 0, size, func(typ string, offset, size int64) error {
+		switch typ {
+		case "ftyp":
+			var brand [4]byte
+			if err := readAt(r, brand[:], offset); err != nil {
+				return err
+			}
+			if string(brand[:]) == "qt  " {
+				info.Format = "mov"
+				info.MIME = "video/quicktime"
+			}
+		case "mdat":
+			if mdat < 0 {
+				mdat = offset
+			}
+		case "moov":
+			if moov >= 0 {
+				return nil
+			}
+			moov = offset
+			return forEachBox(r, offset, offset+size, func(typ string, offset, size int64) error {
+				switch typ {
+				case "mvhd":
+					version, b, err := readFullBox(r, offset, size, 32)
+					if err != nil {
+						return err
+					}
+					timescale, duration, err := readTimes(version, b)
+					if err != nil {
+						return err
+					}
+					info.Duration = scaleDuration(timescale, duration)
+				case "trak":
+					t, err := probeMP4Track(r, offset, size)
+					if err != nil {
+						return err
+					}
+					tracks = append(tracks, t)
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return Info{}, err
+	}
+	if moov < 0 {
+		return Info{}, errors.New("no moov box")
+	}
+
+	info.NoSound = true
+	for _, t := range tracks {
+		switch t.handler {
+		case "vide":
+			info.Kind = Video
+			if info.Width == 0 {
+				info.Width, info.Height = t.width, t.height
+			}
+		case "soun":
+			info.NoSound = false
+			if info.Kind == Unknown {
+				info.Kind = Audio
+			}
+		default:
+			continue
+		}
+		if info.Duration == 0 {
+			info.Duration = t.duration
+		}
+	}
+	if info.Kind == Audio {
+		info.NoSound = false
+		info.MIME = "audio/mp4"
+	}
+	info.Streaming = info.Kind == Video && mdat >= 0 && moov < mdat
+	return info, nil
+}
@@ -0,0 +1,173 @@
+package probe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+const oggHeaderSize = 27
+
+type oggPage struct {
+	granule uint64
+	serial  uint32
+	// size is a full size of page, including header.
+	size    int64
+	payload []byte
+}
+
+// readOGGPage reads OGG page at offset.
+//
+// See RFC 3533, section 6.
+func readOGGPage(r io.ReaderAt, offset int64) (oggPage, error) {
+	var header [oggHeaderSize + 255]byte
+	if err := readAt(r, header[:oggHeaderSize], offset); err != nil {
+		return oggPage{}, err
+	}
+	if string(header[0:4]) != "OggS" {
+		return oggPage{}, errors.New("invalid page signature")
+	}
+	segments := int(header[26])
+	table := header[oggHeaderSize : oggHeaderSize+segments]
+	if err := readAt(r, table, offset+oggHeaderSize); err != nil {
+		return oggPage{}, err
+	}
+	length := 0
+	for _, s := range table {
+		length += int(s)
+	}
+
+	payload := make([]byte, length)
+	if err := readAt(r, payload, offset+int64(oggHeaderSize+segments)); err != nil {
+		return oggPage{}, err
+	}
+	return oggPage{
+		granule: binary.LittleEndian.Uint64(header[6:14]),
+		serial:  binary.LittleEndian.Uint32(header[14:18]),
+		size:    int64(oggHeaderSize + segments + length),
+		payload: payload,
+	}, nil
+}
+
+// lastOGGGranule finds granule position of last page of given stream.
+func lastOGGGranule(r io.ReaderAt, size int64, serial uint32) (uint64, bool) {
+	const tail = 64 * 1024
+	start := size - tail
+	if start < 0 {
+		start = 0
+	}
+	b := make([]byte, size-start)
+	if err := readAt(r, b, start); err != nil {
+		return 0, false
+	}
+	for end := len(b); end > 0; {
+		i := bytes.LastIndex(b[:end], []byte("OggS"))
+		if i < 0 {
+			break
+		}
+		end = i
+		if len(b)-i < oggHeaderSize {
+			continue
+		}
+		h := b[i:]
+		granule := binary.LittleEndian.Uint64(h[6:14])
+		if binary.LittleEndian.Uint32(h[14:18]) == serial && granule != ^uint64(0) {
+			return granule, true
+		}
+	}
+	return 0, false
+}
+
+// parseComments parses Vorbis comments, which are also used by Opus.
+//
+// See https://www.xiph.org/vorbis/doc/v-comment.html.
+func parseComments(b []byte, info *Info) {
+	next := func() (string, bool) {
+		if len(b) < 4 {
+			return "", false
+		}
+		n := binary.LittleEndian.Uint32(b)
+		b = b[4:]
+		if uint32(len(b)) < n {
+			return "", false
+		}
+		s := string(b[:n])
+		b = b[n:]
+		return s, true
+	}
+
+	// Vendor string.
+	if _, ok := next(); !ok || len(b) < 4 {
+		return
+	}
+	count := binary.LittleEndian.Uint32(b)
+	b = b[4:]
+	for i := uint32(0); i < count; i++ {
+		c, ok := next()
+		if !ok {
+			return
+		}
+		key, value, ok := strings.Cut(c, "=")
+		if !ok {
+			continue
+		}
+		switch strings.ToUpper(key) {
+		case "TITLE":
+			info.Title = value
+		case "ARTIST":
+			info.Performer = value
+		}
+	}
+}
+
+func probeOGG(r io.ReaderAt, size int64) (Info, error) {
+	first, err := readOGGPage(r, 0)
+	if err != nil {
+		return Info{}, errors.Wrap(err, "read first page")
+	}
+
+	info := Info{
+		Kind:   Audio,
+		Format: "ogg",
+		MIME:   "audio/ogg",
+	}
+	var (
+		rate     uint64
+		preSkip  uint64
+		comments []byte
+	)
+	switch p := first.payload; {
+	case bytes.HasPrefix(p, []byte("OpusHead")):
+		// See RFC 7845, section 5.1.
+		if len(p) < 19 {
+			return Info{}, errors.New("invalid Opus header")
+		}
+		info.Format = "opus"
+		// Opus granule position is always in 48 kHz.
+		rate = 48000
+		preSkip = uint64(binary.LittleEndian.Uint16(p[10:12]))
+		comments = []byte("OpusTags")
+	case bytes.HasPrefix(p, []byte("\x01vorbis")):
+		if len(p) < 16 {
+			return Info{}, errors.New("invalid Vorbis header")
+		}
+		info.Format = "vorbis"
+		rate = uint64(binary.LittleEndian.Uint32(p[12:16]))
+		comments = []byte("\x03vorbis")
+	default:
+		return Info{}, errors.New("unknown codec")
+	}
+
+	// Comments are placed in second page.
+	if second, err := readOGGPage(r, first.size); err == nil && bytes.HasPrefix(second.payload, comments) {
+		parseComments(second.payload[len(comments):], &info)
+	}
+
+	if granule, ok := lastOGGGranule(r, size, first.serial); ok && rate > 0 && granule > preSkip {
+		info.Duration = scaleDuration(uint32(rate), granule-preSkip)
+	}
+	return info, nil
+}
@@ -0,0 +1,148 @@
+package probe
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Kind is a kind of media.
+type Kind int
+
+const (
+	// Unknown is unknown media kind.
+	Unknown Kind = iota
+	// Image is a still image.
+	Image
+	// Video is a video, possibly with audio.
+	Video
+	// Audio is an audio without video.
+	Audio
+)
+
+// String implements fmt.Stringer.
+func (k Kind) String() string {
+	switch k {
+	case Image:
+		return "image"
+	case Video:
+		return "video"
+	case Audio:
+		return "audio"
+	default:
+		return "unknown"
+	}
+}
+
+// Info is a probed media metadata.
+type Info struct {
+	// Kind of media.
+	Kind Kind
+	// Format name, like "mp4", "webm" or "png".
+	Format string
+	// MIME type of media.
+	MIME string
+
+	// Width and Height of image or video, in pixels.
+	Width  int
+	Height int
+	// Duration of video or audio.
+	Duration time.Duration
+	// Streaming denotes that video metadata is placed before media data,
+	// so video can be played while downloading.
+	Streaming bool
+	// NoSound denotes that video has no audio tracks.
+	NoSound bool
+	// Animated denotes that image has multiple frames, like animated GIF.
+	Animated bool
+
+	// Title and Performer of audio, from ID3 tags or Vorbis comments.
+	Title     string
+	Performer string
+}
+
+// ErrUnknownFormat is returned by Probe if media format is not supported.
+var ErrUnknownFormat = errors.New("unknown format")
+
+type format struct {
+	name  string
+	match func(header []byte) bool
+	probe func(r io.ReaderAt, size int64) (Info, error)
+}
+
+var formats = []format{
+	{"png", hasPrefix("\x89PNG\r\n\x1a\n"), probePNG},
+	{"jpeg", hasPrefix("\xff\xd8\xff"), probeJPEG},
+	{"gif", func(h []byte) bool {
+		return bytes.HasPrefix(h, []byte("GIF87a")) || bytes.HasPrefix(h, []byte("GIF89a"))
+	}, probeGIF},
+	{"webp", func(h []byte) bool {
+		return len(h) >= 12 && string(h[0:4]) == "RIFF" && string(h[8:12]) == "WEBP"
+	}, probeWebP},
+	{"mp4", func(h []byte) bool {
+		if len(h) < 8 {
+			return false
+		}
+		switch string(h[4:8]) {
+		case "ftyp", "moov", "mdat", "free", "wide", "skip":
+			return true
+		default:
+			return false
+		}
+	}, probeMP4},
+	{"matroska", hasPrefix("\x1a\x45\xdf\xa3"), probeMatroska},
+	{"ogg", hasPrefix("OggS"), probeOGG},
+	{"mp3", func(h []byte) bool {
+		return bytes.HasPrefix(h, []byte("ID3")) ||
+			(len(h) >= 2 && h[0] == 0xff && h[1]&0xe0 == 0xe0)
+	}, probeMP3},
+}
+
+func hasPrefix(prefix string) func(header []byte) bool {
+	return func(header []byte) bool {
+		return bytes.HasPrefix(header, []byte(prefix))
+	}
+}
+
+// Probe detects media format and probes its metadata.
+//
+// Only headers and metadata are read, not the whole media.
+func Probe(r io.ReaderAt, size int64) (Info, error) {
+	header := make([]byte, 16)
+	n, err := r.ReadAt(header, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return Info{}, errors.Wrap(err, "read header")
+	}
+	header = header[:n]
+
+	for _, f := range formats {
+		if !f.match(header) {
+			continue
+		}
+		info, err := f.probe(r, size)
+		if err != nil {
+			return Info{}, errors.Wrapf(err, "probe %s", f.name)
+		}
+		return info, nil
+	}
+	return Info{}, ErrUnknownFormat
+}
+
+// ProbeBytes probes metadata of media in given buffer.
+func ProbeBytes(data []byte) (Info, error) {
+	return Probe(bytes.NewReader(data), int64(len(data)))
+}
+
+// readAt reads exactly len(b) bytes at offset.
+func readAt(r io.ReaderAt, b []byte, offset int64) error {
+	n, err := r.ReadAt(b, offset)
+	if n == len(b) {
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
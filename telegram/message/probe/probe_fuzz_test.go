@@ -0,0 +1,28 @@
+//go:build go1.18
+
+package probe
+
+import (
+	"testing"
+)
+
+func FuzzProbe(f *testing.F) {
+	for _, input := range [][]byte{
+		testMP4(true, true, true),
+		testMP4(true, false, false),
+		testMatroska("webm"),
+		testMatroska("matroska"),
+		testMP3(true),
+		testOpus(),
+		[]byte("\x1aEߣ\x01\x00\x00\x00\x00\x00\x00\x04B\x8200000000"),
+	} {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, err := ProbeBytes(data)
+		if err != nil {
+			t.Skip(err)
+		}
+	})
+}
@@ -0,0 +1,331 @@
+package probe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 0x80, A: 0xff})
+		}
+	}
+	return img
+}
+
+func box(typ string, payload ...[]byte) []byte {
+	data := bytes.Join(payload, nil)
+	b := binary.BigEndian.AppendUint32(nil, uint32(8+len(data)))
+	b = append(b, typ...)
+	return append(b, data...)
+}
+
+func u32(v uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, v)
+}
+
+func testMP4(video, audio, fastStart bool) []byte {
+	return testRotatedMP4(video, audio, fastStart, 0)
+}
+
+// testRotatedMP4 creates MP4 with video track rotated by given degrees
+// (0, 90 or 270).
+func testRotatedMP4(video, audio, fastStart bool, degrees int) []byte {
+	const one = 1 << 16
+	tkhd := func(w, h uint32) []byte {
+		// version/flags(4) + 36 bytes before matrix.
+		b := make([]byte, 4+36)
+		// Matrix {a, b, u, c, d, v, x, y, w}.
+		matrix := make([]byte, 36)
+		var ma, mb, mc, md int32 = one, 0, 0, one
+		switch degrees {
+		case 90:
+			ma, mb, mc, md = 0, one, -one, 0
+		case 270:
+			ma, mb, mc, md = 0, -one, one, 0
+		}
+		binary.BigEndian.PutUint32(matrix[0:], uint32(ma))
+		binary.BigEndian.PutUint32(matrix[4:], uint32(mb))
+		binary.BigEndian.PutUint32(matrix[12:], uint32(mc))
+		binary.BigEndian.PutUint32(matrix[16:], uint32(md))
+		binary.BigEndian.PutUint32(matrix[32:], 1<<30)
+		return box("tkhd", b, matrix, u32(w<<16), u32(h<<16))
+	}
+	hdlr := func(typ string) []byte {
+		return box("hdlr", make([]byte, 8), []byte(typ), make([]byte, 12))
+	}
+	mvhd := box("mvhd", make([]byte, 4+8), u32(1000), u32(5500), make([]byte, 80))
+
+	moov := [][]byte{mvhd}
+	if video {
+		moov = append(moov, box("trak", tkhd(640, 360), box("mdia", hdlr("vide"))))
+	}
+	if audio {
+		moov = append(moov, box("trak", tkhd(0, 0), box("mdia", hdlr("soun"))))
+	}
+
+	ftyp := box("ftyp", []byte("isom"), u32(0x200))
+	mdat := box("mdat", make([]byte, 100))
+	if fastStart {
+		return bytes.Join([][]byte{ftyp, box("moov", moov...), mdat}, nil)
+	}
+	return bytes.Join([][]byte{ftyp, mdat, box("moov", moov...)}, nil)
+}
+
+func ebml(id uint32, payload ...[]byte) []byte {
+	var b []byte
+	switch {
+	case id > 0xffffff:
+		b = binary.BigEndian.AppendUint32(b, id)
+	case id > 0xffff:
+		b = append(b, byte(id>>16), byte(id>>8), byte(id))
+	case id > 0xff:
+		b = binary.BigEndian.AppendUint16(b, uint16(id))
+	default:
+		b = append(b, byte(id))
+	}
+	data := bytes.Join(payload, nil)
+	// 8-byte size.
+	b = binary.BigEndian.AppendUint64(b, 1<<56|uint64(len(data)))
+	return append(b, data...)
+}
+
+func testMatroska(docType string) []byte {
+	// Segment of unknown size.
+	segment := []byte{0x18, 0x53, 0x80, 0x67, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	return bytes.Join([][]byte{
+		ebml(ebmlHeader, ebml(ebmlDocType, []byte(docType))),
+		segment,
+		ebml(mkvInfo,
+			ebml(mkvTimestampScale, []byte{0x0f, 0x42, 0x40}),
+			ebml(mkvDuration, binary.BigEndian.AppendUint64(nil, math.Float64bits(12345))),
+		),
+		ebml(mkvTracks,
+			ebml(mkvTrackEntry,
+				ebml(mkvTrackType, []byte{1}),
+				ebml(mkvVideo,
+					ebml(mkvPixelWidth, []byte{0x05, 0x00}),
+					ebml(mkvPixelHeight, []byte{0x02, 0xd0}),
+				),
+			),
+			ebml(mkvTrackEntry, ebml(mkvTrackType, []byte{2})),
+		),
+		ebml(mkvCluster, make([]byte, 100)),
+	}, nil)
+}
+
+func id3Frame(id string, data []byte) []byte {
+	b := append([]byte(id), u32(uint32(len(data)))...)
+	b = append(b, 0, 0)
+	return append(b, data...)
+}
+
+func testMP3(xing bool) []byte {
+	performer := utf16.Encode([]rune("Исполнитель"))
+	performerData := []byte{1, 0xff, 0xfe}
+	for _, c := range performer {
+		performerData = binary.LittleEndian.AppendUint16(performerData, c)
+	}
+	frames := append(
+		id3Frame("TIT2", append([]byte{3}, "Title"...)),
+		id3Frame("TPE1", performerData)...,
+	)
+	size := len(frames)
+	id3 := append([]byte("ID3\x03\x00\x00"),
+		byte(size>>21&0x7f), byte(size>>14&0x7f), byte(size>>7&0x7f), byte(size&0x7f),
+	)
+	b := append(id3, frames...)
+
+	// MPEG-1 Layer III, 128 kbps, 44100 Hz, stereo.
+	const frameSize = 417
+	for i := 0; i < 10; i++ {
+		frame := make([]byte, frameSize)
+		copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+		if i == 0 && xing {
+			copy(frame[4+32:], "Xing")
+			copy(frame[4+32+4:], u32(1))
+			copy(frame[4+32+8:], u32(100))
+		}
+		b = append(b, frame...)
+	}
+	return b
+}
+
+func oggPageData(granule uint64, payload []byte) []byte {
+	b := []byte("OggS\x00\x00")
+	b = binary.LittleEndian.AppendUint64(b, granule)
+	b = binary.LittleEndian.AppendUint32(b, 42) // serial
+	b = binary.LittleEndian.AppendUint32(b, 0)  // sequence
+	b = binary.LittleEndian.AppendUint32(b, 0)  // crc
+
+	var table []byte
+	n := len(payload)
+	for ; n >= 255; n -= 255 {
+		table = append(table, 255)
+	}
+	table = append(table, byte(n))
+	b = append(b, byte(len(table)))
+	b = append(b, table...)
+	return append(b, payload...)
+}
+
+func testOpus() []byte {
+	head := []byte("OpusHead\x01\x01")
+	head = binary.LittleEndian.AppendUint16(head, 312)
+	head = binary.LittleEndian.AppendUint32(head, 48000)
+	head = append(head, 0, 0, 0)
+
+	comment := func(s string) []byte {
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(s))), s...)
+	}
+	tags := []byte("OpusTags")
+	tags = append(tags, comment("gotd")...)
+	tags = binary.LittleEndian.AppendUint32(tags, 2)
+	tags = append(tags, comment("title=Song")...)
+	tags = append(tags, comment("ARTIST=Artist")...)
+
+	return bytes.Join([][]byte{
+		oggPageData(0, head),
+		oggPageData(0, tags),
+		oggPageData(48000, make([]byte, 300)),
+		oggPageData(312+3*48000, make([]byte, 300)),
+	}, nil)
+}
+
+func TestProbe(t *testing.T) {
+	encode := func(f func(b *bytes.Buffer) error) []byte {
+		var b bytes.Buffer
+		require.NoError(t, f(&b))
+		return b.Bytes()
+	}
+	animated := &gif.GIF{}
+	for i := 0; i < 3; i++ {
+		frame := image.NewPaletted(image.Rect(0, 0, 20, 10), color.Palette{color.Black, color.White})
+		animated.Image = append(animated.Image, frame)
+		animated.Delay = append(animated.Delay, 10)
+	}
+
+	vp8x := []byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x02\x00\x00\x00")
+	vp8x = append(vp8x, 99, 0, 0, 49, 0, 0)
+	vp8l := []byte("RIFF\x00\x00\x00\x00WEBPVP8L\x00\x00\x00\x00\x2f")
+	vp8l = binary.LittleEndian.AppendUint32(vp8l, 99|49<<14)
+	vp8l = append(vp8l, 0, 0, 0, 0, 0)
+	vp8 := []byte("RIFF\x00\x00\x00\x00WEBPVP8 \x00\x00\x00\x00\x00\x00\x00\x9d\x01\x2a")
+	vp8 = binary.LittleEndian.AppendUint16(vp8, 100)
+	vp8 = binary.LittleEndian.AppendUint16(vp8, 50)
+
+	for _, tt := range []struct {
+		name string
+		data []byte
+		info Info
+	}{
+		{"PNG", encode(func(b *bytes.Buffer) error {
+			return png.Encode(b, testImage(40, 30))
+		}), Info{Kind: Image, Format: "png", MIME: "image/png", Width: 40, Height: 30}},
+		{"JPEG", encode(func(b *bytes.Buffer) error {
+			return jpeg.Encode(b, testImage(30, 40), nil)
+		}), Info{Kind: Image, Format: "jpeg", MIME: "image/jpeg", Width: 30, Height: 40}},
+		{"GIF", encode(func(b *bytes.Buffer) error {
+			return gif.EncodeAll(b, animated)
+		}), Info{
+			Kind: Image, Format: "gif", MIME: "image/gif", Width: 20, Height: 10,
+			Animated: true, Duration: 300 * time.Millisecond,
+		}},
+		{"WebPExtended", vp8x, Info{
+			Kind: Image, Format: "webp", MIME: "image/webp", Width: 100, Height: 50, Animated: true,
+		}},
+		{"WebPLossless", vp8l, Info{Kind: Image, Format: "webp", MIME: "image/webp", Width: 100, Height: 50}},
+		{"WebPLossy", vp8, Info{Kind: Image, Format: "webp", MIME: "image/webp", Width: 100, Height: 50}},
+		{"MP4", testMP4(true, true, true), Info{
+			Kind: Video, Format: "mp4", MIME: "video/mp4", Width: 640, Height: 360,
+			Duration: 5500 * time.Millisecond, Streaming: true,
+		}},
+		{"MP4Rotated90", testRotatedMP4(true, true, true, 90), Info{
+			Kind: Video, Format: "mp4", MIME: "video/mp4", Width: 360, Height: 640,
+			Duration: 5500 * time.Millisecond, Streaming: true,
+		}},
+		{"MP4Rotated270", testRotatedMP4(true, true, true, 270), Info{
+			Kind: Video, Format: "mp4", MIME: "video/mp4", Width: 360, Height: 640,
+			Duration: 5500 * time.Millisecond, Streaming: true,
+		}},
+		{"MP4NoFastStart", testMP4(true, false, false), Info{
+			Kind: Video, Format: "mp4", MIME: "video/mp4", Width: 640, Height: 360,
+			Duration: 5500 * time.Millisecond, NoSound: true,
+		}},
+		{"M4A", testMP4(false, true, true), Info{
+			Kind: Audio, Format: "mp4", MIME: "audio/mp4", Duration: 5500 * time.Millisecond,
+		}},
+		{"WebM", testMatroska("webm"), Info{
+			Kind: Video, Format: "webm", MIME: "video/webm", Width: 1280, Height: 720,
+			Duration: 12345 * time.Millisecond,
+		}},
+		{"Matroska", testMatroska("matroska"), Info{
+			Kind: Video, Format: "matroska", MIME: "video/x-matroska", Width: 1280, Height: 720,
+			Duration: 12345 * time.Millisecond,
+		}},
+		{"MP3", testMP3(true), Info{
+			Kind: Audio, Format: "mp3", MIME: "audio/mpeg",
+			Duration: 2612244897, Title: "Title", Performer: "Исполнитель",
+		}},
+		{"MP3CBR", testMP3(false), Info{
+			Kind: Audio, Format: "mp3", MIME: "audio/mpeg",
+			Duration: 260625 * time.Microsecond, Title: "Title", Performer: "Исполнитель",
+		}},
+		{"Opus", testOpus(), Info{
+			Kind: Audio, Format: "opus", MIME: "audio/ogg",
+			Duration: 3 * time.Second, Title: "Song", Performer: "Artist",
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := ProbeBytes(tt.data)
+			require.NoError(t, err)
+			require.Equal(t, tt.info, info)
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := ProbeBytes([]byte("hello, world"))
+		require.ErrorIs(t, err, ErrUnknownFormat)
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		data := testMP4(true, true, true)
+		_, err := ProbeBytes(data[:40])
+		require.Error(t, err)
+	})
+	t.Run("MatroskaOverflow", func(t *testing.T) {
+		// Element size vint does not fit into int64.
+		_, err := ProbeBytes([]byte("\x1aE\xdf\xa3\x01\x00\x00\x00\x00\x00\x00\x04B\x8200000000"))
+		require.Error(t, err)
+	})
+}
+
+func TestThumbnail(t *testing.T) {
+	a := require.New(t)
+
+	var b bytes.Buffer
+	a.NoError(png.Encode(&b, testImage(640, 480)))
+
+	thumb, err := Thumbnail(&b, 0)
+	a.NoError(err)
+
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(thumb))
+	a.NoError(err)
+	a.Equal(320, cfg.Width)
+	a.Equal(240, cfg.Height)
+
+	_, err = Thumbnail(bytes.NewReader([]byte("not an image")), 0)
+	a.Error(err)
+}
@@ -0,0 +1,32 @@
+package probe
+
+import (
+	"image"
+	"io"
+
+	// Register image decoders.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/thumbnail"
+)
+
+// MaxThumbSize is a maximum size of document thumbnail side.
+//
+// See https://core.telegram.org/api/files#uploading-files.
+const MaxThumbSize = thumbnail.MaxSize
+
+// Thumbnail decodes PNG, JPEG or GIF image and creates JPEG thumbnail,
+// which fits size x size square.
+//
+// If size is not positive, MaxThumbSize is used.
+func Thumbnail(r io.Reader, size int) ([]byte, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode")
+	}
+	return thumbnail.JPEG(img, size)
+}
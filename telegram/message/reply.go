@@ -0,0 +1,95 @@
+package message
+
+import (
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/tg"
+)
+
+// Quote is a quoted fragment of replied message.
+//
+// See https://core.telegram.org/api/reply#quotes.
+type Quote struct {
+	// Text of quote.
+	Text string
+	// Entities of quote, offsets are relative to Text.
+	Entities []tg.MessageEntityClass
+	// Offset of quote in original message text, in UTF-16 code units.
+	Offset int
+}
+
+// quoteEntity reports whether entity is allowed in quotes.
+func quoteEntity(e tg.MessageEntityClass) bool {
+	switch e.(type) {
+	case *tg.MessageEntityBold,
+		*tg.MessageEntityItalic,
+		*tg.MessageEntityUnderline,
+		*tg.MessageEntityStrike,
+		*tg.MessageEntitySpoiler,
+		*tg.MessageEntityCustomEmoji:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewQuote creates Quote of msg text in given range of UTF-16 code units.
+//
+// Entities that are not allowed in quotes are dropped.
+func NewQuote(msg *tg.Message, offset, length int) (Quote, error) {
+	chunk, err := entity.Substring(msg.Message, msg.Entities, offset, length)
+	if err != nil {
+		return Quote{}, errors.Wrap(err, "quote")
+	}
+
+	var entities []tg.MessageEntityClass
+	for _, e := range chunk.Entities {
+		if quoteEntity(e) {
+			entities = append(entities, e)
+		}
+	}
+	return Quote{
+		Text:     chunk.Text,
+		Entities: entities,
+		Offset:   offset,
+	}, nil
+}
+
+// Quote sets quote of replied message.
+//
+// Quote is used only with Reply, ReplyMsg and ReplyIn.
+func (b *Builder) Quote(q Quote) *Builder {
+	b.quote = &q
+	return b
+}
+
+// ReplyIn sets message to reply from another chat.
+func (b *Builder) ReplyIn(peer tg.InputPeerClass, id int) *Builder {
+	b.replyTo = &tg.InputReplyToMessage{
+		ReplyToMsgID:  id,
+		ReplyToPeerID: peer,
+	}
+	return b
+}
+
+// ReplyStory sets story to reply.
+func (b *Builder) ReplyStory(peer tg.InputPeerClass, storyID int) *Builder {
+	b.replyTo = &tg.InputReplyToStory{
+		Peer:    peer,
+		StoryID: storyID,
+	}
+	return b
+}
+
+// applyQuote returns copy of reply with quote applied.
+func (b *Builder) applyQuote(reply tg.InputReplyToMessage) tg.InputReplyToMessage {
+	if q := b.quote; q != nil {
+		reply.SetQuoteText(q.Text)
+		if len(q.Entities) > 0 {
+			reply.SetQuoteEntities(q.Entities)
+		}
+		reply.SetQuoteOffset(q.Offset)
+	}
+	return reply
+}
@@ -0,0 +1,77 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+)
+
+func TestNewQuote(t *testing.T) {
+	a := require.New(t)
+	msg := &tg.Message{
+		ID:      10,
+		Message: "hello 👋 world",
+		Entities: []tg.MessageEntityClass{
+			&tg.MessageEntityBold{Offset: 9, Length: 5},
+			&tg.MessageEntityURL{Offset: 0, Length: 14},
+		},
+	}
+
+	q, err := NewQuote(msg, 6, 8)
+	a.NoError(err)
+	a.Equal(Quote{
+		Text: "👋 world",
+		Entities: []tg.MessageEntityClass{
+			&tg.MessageEntityBold{Offset: 3, Length: 5},
+		},
+		Offset: 6,
+	}, q)
+
+	_, err = NewQuote(msg, 7, 2)
+	a.Error(err)
+}
+
+func TestBuilder_Quote(t *testing.T) {
+	ctx := context.Background()
+	sender, mock := testSender(t)
+
+	expectReply := func(reply tg.InputReplyToClass) {
+		mock.ExpectFunc(func(b bin.Encoder) {
+			req, ok := b.(*tg.MessagesSendMessageRequest)
+			require.True(t, ok)
+			require.Equal(t, reply, req.ReplyTo)
+		}).ThenResult(&tg.Updates{})
+	}
+	quote := Quote{
+		Text:     "world",
+		Entities: []tg.MessageEntityClass{&tg.MessageEntityItalic{Offset: 0, Length: 5}},
+		Offset:   6,
+	}
+	withQuote := func(reply tg.InputReplyToMessage) *tg.InputReplyToMessage {
+		reply.SetQuoteText(quote.Text)
+		reply.SetQuoteEntities(quote.Entities)
+		reply.SetQuoteOffset(quote.Offset)
+		return &reply
+	}
+
+	expectReply(withQuote(tg.InputReplyToMessage{ReplyToMsgID: 10}))
+	_, err := sender.Self().Quote(quote).Reply(10).Text(ctx, "quote")
+	require.NoError(t, err)
+
+	other := &tg.InputPeerUser{UserID: 1, AccessHash: 1}
+	expectReply(&tg.InputReplyToMessage{ReplyToMsgID: 10, ReplyToPeerID: other})
+	_, err = sender.Self().ReplyIn(other, 10).Text(ctx, "cross-chat")
+	require.NoError(t, err)
+
+	expectReply(withQuote(tg.InputReplyToMessage{ReplyToMsgID: 10, ReplyToPeerID: other, TopMsgID: 5}))
+	_, err = sender.Self().ReplyIn(other, 10).Quote(quote).Topic(5).Text(ctx, "cross-chat quote")
+	require.NoError(t, err)
+
+	expectReply(&tg.InputReplyToStory{Peer: other, StoryID: 3})
+	_, err = sender.Self().ReplyStory(other, 3).Quote(quote).Text(ctx, "story")
+	require.NoError(t, err)
+}
@@ -0,0 +1,101 @@
+package message
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/internal/upconv"
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/tg"
+)
+
+const (
+	// MaxMessageLength is a default maximum length of message text
+	// in UTF-16 code units.
+	MaxMessageLength = 4096
+	// MaxCaptionLength is a default maximum length of media caption
+	// in UTF-16 code units.
+	MaxCaptionLength = 1024
+)
+
+// sendText sends text message, splitting it if needed.
+func (b *Builder) sendText(
+	ctx context.Context,
+	p tg.InputPeerClass,
+	msg string,
+	entities []tg.MessageEntityClass,
+) (tg.UpdatesClass, error) {
+	if b.textLimit <= 0 || entity.ComputeLength(msg) <= b.textLimit {
+		return b.sender.sendMessage(ctx, b.sendRequest(p, msg, entities))
+	}
+	return b.sendParts(ctx, p, entity.Split(msg, entities, b.textLimit), true)
+}
+
+// sendParts sends every chunk as separate message.
+//
+// Reply is set only to the first message, if first is true, other messages
+// are sent to the same forum topic.
+// Reply markup is set only to the last message.
+func (b *Builder) sendParts(
+	ctx context.Context,
+	p tg.InputPeerClass,
+	chunks []entity.Chunk,
+	first bool,
+) (tg.UpdatesClass, error) {
+	result := make([]tg.UpdatesClass, 0, len(chunks))
+	for i, chunk := range chunks {
+		req := b.sendRequest(p, chunk.Text, chunk.Entities)
+		if i > 0 || !first {
+			req.ReplyTo = b.topicTarget()
+			req.ClearDraft = false
+		}
+		if i < len(chunks)-1 {
+			req.ReplyMarkup = nil
+		}
+
+		upd, err := b.sender.sendMessage(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "send part %d", i+1)
+		}
+		result = append(result, upd)
+	}
+	return mergeUpdates(result), nil
+}
+
+// mergeUpdates merges results of multiple requests into single tg.Updates.
+func mergeUpdates(list []tg.UpdatesClass) tg.UpdatesClass {
+	if len(list) == 1 {
+		return list[0]
+	}
+
+	r := &tg.Updates{}
+	for _, u := range list {
+		var short *tg.UpdateShort
+		switch v := u.(type) {
+		case *tg.UpdateShortMessage:
+			short = upconv.ShortMessage(v)
+		case *tg.UpdateShortChatMessage:
+			short = upconv.ShortChatMessage(v)
+		case *tg.UpdateShortSentMessage:
+			short = upconv.ShortSentMessage(v)
+		case *tg.UpdateShort:
+			short = v
+		case *tg.UpdatesCombined:
+			r.Updates = append(r.Updates, v.Updates...)
+			r.Users = append(r.Users, v.Users...)
+			r.Chats = append(r.Chats, v.Chats...)
+			r.Date, r.Seq = v.Date, v.Seq
+		case *tg.Updates:
+			r.Updates = append(r.Updates, v.Updates...)
+			r.Users = append(r.Users, v.Users...)
+			r.Chats = append(r.Chats, v.Chats...)
+			r.Date, r.Seq = v.Date, v.Seq
+		}
+		if short != nil {
+			r.Updates = append(r.Updates, short.Update)
+			r.Date = short.Date
+		}
+	}
+	return r
+}
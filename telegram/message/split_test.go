@@ -0,0 +1,111 @@
+package message
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram/message/markup"
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/gotd/td/telegram/message/unpack"
+	"github.com/gotd/td/tg"
+)
+
+func TestBuilder_Split(t *testing.T) {
+	ctx := context.Background()
+	sender, mock := testSender(t)
+	kb := markup.InlineRow(markup.Callback("ok", []byte("ok")))
+
+	expect := func(text string, entities []tg.MessageEntityClass, reply, markup bool, id int) {
+		mock.ExpectFunc(func(b bin.Encoder) {
+			req, ok := b.(*tg.MessagesSendMessageRequest)
+			require.True(t, ok)
+			require.Equal(t, text, req.Message)
+			require.Equal(t, entities, req.Entities)
+			require.Equal(t, reply, req.ReplyTo != nil)
+			require.Equal(t, markup, req.ReplyMarkup != nil)
+		}).ThenResult(&tg.UpdateShortSentMessage{ID: id})
+	}
+
+	first, second := strings.Repeat("a", 8), strings.Repeat("b", 8)
+	expect(first, []tg.MessageEntityClass{&tg.MessageEntityBold{Offset: 4, Length: 4}}, true, false, 1)
+	expect(second, []tg.MessageEntityClass{&tg.MessageEntityBold{Offset: 0, Length: 4}}, false, true, 2)
+	id, err := unpack.MessageID(sender.Self().
+		SplitLimits(10, 5).
+		Reply(10).
+		Markup(kb).
+		StyledText(ctx,
+			styling.Plain(first[:4]),
+			styling.Bold(first[4:]+"\n\n"+second[:4]),
+			styling.Plain(second[4:]),
+		))
+	require.NoError(t, err)
+	require.Equal(t, 1, id)
+
+	// Text fits limit.
+	expect(first, nil, true, true, 3)
+	_, err = sender.Self().SplitLimits(10, 5).Reply(10).Markup(kb).Text(ctx, first)
+	require.NoError(t, err)
+
+	// Splitting is disabled by default.
+	expect(first+" "+second, nil, false, false, 4)
+	_, err = sender.Self().Text(ctx, first+" "+second)
+	require.NoError(t, err)
+}
+
+func TestBuilder_SplitCaption(t *testing.T) {
+	ctx := context.Background()
+	sender, mock := testSender(t)
+	doc := &tg.InputDocument{ID: 10}
+	kb := markup.InlineRow(markup.Callback("ok", []byte("ok")))
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesSendMediaRequest)
+		require.True(t, ok)
+		require.Equal(t, &tg.InputMediaDocument{ID: doc}, req.Media)
+		require.Empty(t, req.Message)
+		require.NotNil(t, req.ReplyTo)
+		require.Nil(t, req.ReplyMarkup)
+	}).ThenResult(&tg.Updates{})
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesSendMessageRequest)
+		require.True(t, ok)
+		require.Equal(t, "long caption", req.Message)
+		require.Nil(t, req.ReplyTo)
+		require.NotNil(t, req.ReplyMarkup)
+	}).ThenResult(&tg.Updates{})
+
+	_, err := sender.Self().Split().SplitLimits(100, 5).Reply(1).Markup(kb).
+		Media(ctx, Document(doc, styling.Plain("long caption")))
+	require.NoError(t, err)
+}
+
+func TestBuilder_SplitCaptionNoTextLimit(t *testing.T) {
+	ctx := context.Background()
+	sender, mock := testSender(t)
+	doc := &tg.InputDocument{ID: 10}
+	caption := strings.Repeat("a", MaxMessageLength) + " " + strings.Repeat("b", 10)
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesSendMediaRequest)
+		require.True(t, ok)
+		require.Empty(t, req.Message)
+	}).ThenResult(&tg.Updates{})
+	var parts []string
+	for i := 0; i < 2; i++ {
+		mock.ExpectFunc(func(b bin.Encoder) {
+			req, ok := b.(*tg.MessagesSendMessageRequest)
+			require.True(t, ok)
+			require.LessOrEqual(t, len(req.Message), MaxMessageLength)
+			parts = append(parts, req.Message)
+		}).ThenResult(&tg.Updates{})
+	}
+
+	_, err := sender.Self().SplitLimits(0, 1024).
+		Media(ctx, Document(doc, styling.Plain(caption)))
+	require.NoError(t, err)
+	require.Equal(t, caption, strings.Join(parts, ""))
+}
@@ -22,7 +22,7 @@ func (b *Builder) sendRequest(
 		ClearDraft:   b.clearDraft,
 		Noforwards:   b.noForwards,
 		Peer:         p,
-		ReplyTo:      b.replyTo,
+		ReplyTo:      b.replyTarget(),
 		Message:      msg,
 		RandomID:     0,
 		ReplyMarkup:  b.replyMarkup,
@@ -33,13 +33,15 @@ func (b *Builder) sendRequest(
 }
 
 // Text sends text message.
+//
+// See Split to send long text as multiple messages.
 func (b *Builder) Text(ctx context.Context, msg string) (tg.UpdatesClass, error) {
 	p, err := b.peer(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "peer")
 	}
 
-	upd, err := b.sender.sendMessage(ctx, b.sendRequest(p, msg, nil))
+	upd, err := b.sendText(ctx, p, msg, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "send text")
 	}
@@ -65,7 +67,7 @@ func (b *Builder) StyledText(ctx context.Context, texts ...StyledTextOption) (tg
 	}
 	msg, entities := tb.Complete()
 
-	upd, err := b.sender.sendMessage(ctx, b.sendRequest(p, msg, entities))
+	upd, err := b.sendText(ctx, p, msg, entities)
 	if err != nil {
 		return nil, errors.Wrap(err, "send styled text")
 	}
@@ -0,0 +1,52 @@
+package message
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+)
+
+func TestBuilder_Topic(t *testing.T) {
+	ctx := context.Background()
+	sender, mock := testSender(t)
+
+	expectReply := func(reply tg.InputReplyToClass) {
+		mock.ExpectFunc(func(b bin.Encoder) {
+			req, ok := b.(*tg.MessagesSendMessageRequest)
+			require.True(t, ok)
+			require.Equal(t, reply, req.ReplyTo)
+		}).ThenResult(&tg.Updates{})
+	}
+
+	expectReply(&tg.InputReplyToMessage{ReplyToMsgID: 10})
+	_, err := sender.Self().Topic(10).Text(ctx, "topic")
+	require.NoError(t, err)
+
+	expectReply(&tg.InputReplyToMessage{ReplyToMsgID: 15, TopMsgID: 10})
+	_, err = sender.Self().Reply(15).Topic(10).Text(ctx, "reply in topic")
+	require.NoError(t, err)
+
+	expectReply(&tg.InputReplyToMessage{ReplyToMsgID: 10})
+	_, err = sender.Self().Topic(10).Reply(10).Text(ctx, "reply to topic")
+	require.NoError(t, err)
+
+	// Every part of split message is sent to the topic.
+	expectReply(&tg.InputReplyToMessage{ReplyToMsgID: 15, TopMsgID: 10})
+	expectReply(&tg.InputReplyToMessage{ReplyToMsgID: 10})
+	_, err = sender.Self().Topic(10).Reply(15).SplitLimits(10, 10).
+		Text(ctx, strings.Repeat("a", 15))
+	require.NoError(t, err)
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesForwardMessagesRequest)
+		require.True(t, ok)
+		require.Equal(t, 10, req.TopMsgID)
+	}).ThenResult(&tg.Updates{})
+	_, err = sender.Self().Topic(10).ForwardIDs(&tg.InputPeerSelf{}, 1).Send(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,55 @@
+package unpack
+
+import "github.com/gotd/td/tg"
+
+// TopicID returns forum topic ID of given message.
+//
+// Topic ID is an ID of topic service message, so for this message its own ID
+// is returned. Messages of the "General" topic have no reply header, so
+// TopicID returns false for them.
+//
+// See https://core.telegram.org/api/forum#forum-topics.
+func TopicID(msg tg.MessageClass) (int, bool) {
+	var reply tg.MessageReplyHeaderClass
+	switch msg := msg.(type) {
+	case *tg.Message:
+		reply = msg.ReplyTo
+	case *tg.MessageService:
+		if _, ok := msg.Action.(*tg.MessageActionTopicCreate); ok {
+			return msg.ID, true
+		}
+		reply = msg.ReplyTo
+	default:
+		return 0, false
+	}
+	return ReplyTopicID(reply)
+}
+
+// ReplyTopicID returns forum topic ID from given reply header.
+func ReplyTopicID(reply tg.MessageReplyHeaderClass) (int, bool) {
+	h, ok := reply.(*tg.MessageReplyHeader)
+	if !ok || !h.ForumTopic {
+		return 0, false
+	}
+	if h.ReplyToTopID != 0 {
+		return h.ReplyToTopID, true
+	}
+	// Message is a direct reply to the topic service message.
+	return h.ReplyToMsgID, h.ReplyToMsgID != 0
+}
+
+// UpdateTopicID returns forum topic ID of message from given update.
+func UpdateTopicID(u tg.UpdateClass) (int, bool) {
+	switch u := u.(type) {
+	case *tg.UpdateNewMessage:
+		return TopicID(u.Message)
+	case *tg.UpdateNewChannelMessage:
+		return TopicID(u.Message)
+	case *tg.UpdateEditMessage:
+		return TopicID(u.Message)
+	case *tg.UpdateEditChannelMessage:
+		return TopicID(u.Message)
+	default:
+		return 0, false
+	}
+}
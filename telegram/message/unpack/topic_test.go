@@ -0,0 +1,59 @@
+package unpack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestTopicID(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  tg.MessageClass
+		id   int
+		ok   bool
+	}{
+		{"Empty", &tg.MessageEmpty{ID: 1}, 0, false},
+		{"NoReply", &tg.Message{ID: 10}, 0, false},
+		{"NotForum", &tg.Message{ID: 10, ReplyTo: &tg.MessageReplyHeader{
+			ReplyToMsgID: 5,
+		}}, 0, false},
+		{"TopicRoot", &tg.Message{ID: 10, ReplyTo: &tg.MessageReplyHeader{
+			ForumTopic:   true,
+			ReplyToMsgID: 5,
+		}}, 5, true},
+		{"TopicReply", &tg.Message{ID: 10, ReplyTo: &tg.MessageReplyHeader{
+			ForumTopic:   true,
+			ReplyToMsgID: 7,
+			ReplyToTopID: 5,
+		}}, 5, true},
+		{"TopicCreate", &tg.MessageService{ID: 5, Action: &tg.MessageActionTopicCreate{
+			Title: "Topic",
+		}}, 5, true},
+		{"TopicEdit", &tg.MessageService{
+			ID:     11,
+			Action: &tg.MessageActionTopicEdit{Title: "Renamed"},
+			ReplyTo: &tg.MessageReplyHeader{
+				ForumTopic:   true,
+				ReplyToMsgID: 5,
+			},
+		}, 5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+			id, ok := TopicID(tt.msg)
+			a.Equal(tt.ok, ok)
+			a.Equal(tt.id, id)
+
+			id, ok = UpdateTopicID(&tg.UpdateNewChannelMessage{Message: tt.msg})
+			a.Equal(tt.ok, ok)
+			a.Equal(tt.id, id)
+		})
+	}
+
+	_, ok := UpdateTopicID(&tg.UpdateUserName{})
+	require.False(t, ok)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-faster/errors"
 
+	"github.com/gotd/td/telegram/message/probe"
 	"github.com/gotd/td/tg"
 )
 
@@ -21,6 +22,28 @@ func (b *Builder) Upload(upd UploadOption) *UploadBuilder {
 type UploadBuilder struct {
 	builder *Builder
 	option  UploadOption
+	probe   bool
+	thumb   bool
+}
+
+// Probe enables media metadata probing.
+//
+// Content of file is inspected before sending to fill MIME type, resolution,
+// duration, streaming flag and other attributes of document.
+// Probing is supported only for FromPath, FromFS, FromBytes and FromFile
+// (if file implements io.ReaderAt), other files and files of unknown format
+// are sent as is.
+func (u *UploadBuilder) Probe() *UploadBuilder {
+	u.probe = true
+	return u
+}
+
+// Thumbnail enables probing and generation of JPEG thumbnail
+// for PNG, JPEG and GIF images.
+func (u *UploadBuilder) Thumbnail() *UploadBuilder {
+	u.probe = true
+	u.thumb = true
+	return u
 }
 
 func (u *UploadBuilder) file(ctx context.Context) (tg.InputFileClass, error) {
@@ -52,11 +75,22 @@ func (u *UploadBuilder) Photo(ctx context.Context, caption ...StyledTextOption)
 
 // Audio uploads and sends audio file.
 func (u *UploadBuilder) Audio(ctx context.Context, caption ...StyledTextOption) (tg.UpdatesClass, error) {
+	if u.probe {
+		return u.sendProbed(ctx, caption, func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption {
+			return doc.Audio().Probed(info)
+		})
+	}
 	return u.send(ctx, u.builder.Audio, caption)
 }
 
 // Voice uploads and sends voice message.
 func (u *UploadBuilder) Voice(ctx context.Context) (tg.UpdatesClass, error) {
+	if u.probe {
+		return u.sendProbed(ctx, nil, func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption {
+			return doc.Voice().Probed(info)
+		})
+	}
+
 	f, err := u.file(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "upload")
@@ -67,25 +101,56 @@ func (u *UploadBuilder) Voice(ctx context.Context) (tg.UpdatesClass, error) {
 
 // Video uploads and sends video.
 func (u *UploadBuilder) Video(ctx context.Context, caption ...StyledTextOption) (tg.UpdatesClass, error) {
+	if u.probe {
+		return u.sendProbed(ctx, caption, func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption {
+			return doc.Video().Probed(info)
+		})
+	}
 	return u.send(ctx, u.builder.Video, caption)
 }
 
 // RoundVideo uploads and sends round video.
 func (u *UploadBuilder) RoundVideo(ctx context.Context, caption ...StyledTextOption) (tg.UpdatesClass, error) {
+	if u.probe {
+		return u.sendProbed(ctx, caption, func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption {
+			return doc.RoundVideo().Probed(info)
+		})
+	}
 	return u.send(ctx, u.builder.RoundVideo, caption)
 }
 
 // GIF uploads and sends gif file.
 func (u *UploadBuilder) GIF(ctx context.Context, caption ...StyledTextOption) (tg.UpdatesClass, error) {
+	if u.probe {
+		return u.sendProbed(ctx, caption, func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption {
+			if info.Kind == probe.Video {
+				// Animation encoded as MPEG4 video.
+				return doc.NosoundVideo(true).
+					Attributes(&tg.DocumentAttributeAnimated{}).
+					Video().Probed(info)
+			}
+			return doc.GIF()
+		})
+	}
 	return u.send(ctx, u.builder.GIF, caption)
 }
 
 // Sticker uploads and sends sticker.
 func (u *UploadBuilder) Sticker(ctx context.Context, caption ...StyledTextOption) (tg.UpdatesClass, error) {
+	if u.probe {
+		return u.sendProbed(ctx, caption, func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption {
+			return doc.UploadedSticker()
+		})
+	}
 	return u.send(ctx, u.builder.UploadedSticker, caption)
 }
 
 // File uploads and sends plain file.
 func (u *UploadBuilder) File(ctx context.Context, caption ...StyledTextOption) (tg.UpdatesClass, error) {
+	if u.probe {
+		return u.sendProbed(ctx, caption, func(doc *UploadedDocumentBuilder, info probe.Info) MediaOption {
+			return doc.ForceFile(true)
+		})
+	}
 	return u.send(ctx, u.builder.File, caption)
 }
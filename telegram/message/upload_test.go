@@ -1,7 +1,10 @@
 package message
 
 import (
+	"bytes"
 	"context"
+	"image"
+	"image/png"
 	"io"
 	"io/fs"
 	"testing"
@@ -106,3 +109,57 @@ func TestUpload(t *testing.T) {
 	_, err = dialog.Upload(FromSource(source.NewHTTPSource(), "http://example.com")).File(ctx)
 	require.NoError(t, err)
 }
+
+func TestUploadProbe(t *testing.T) {
+	ctx := context.Background()
+	sender, mock := testSender(t)
+
+	f := &tg.InputFile{
+		ID:    1,
+		Parts: 1,
+		Name:  "image.png",
+	}
+	dialog := sender.WithUploader(mockUploader{file: f}).Self()
+
+	var img bytes.Buffer
+	require.NoError(t, png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 640, 480))))
+
+	expectSendMedia(t, &tg.InputMediaUploadedDocument{
+		File:      f,
+		ForceFile: true,
+		MimeType:  "image/png",
+		Attributes: []tg.DocumentAttributeClass{
+			&tg.DocumentAttributeImageSize{W: 640, H: 480},
+		},
+	}, mock)
+	_, err := dialog.Upload(FromBytes("image.png", img.Bytes())).Probe().File(ctx)
+	require.NoError(t, err)
+
+	expectSendMedia(t, &tg.InputMediaUploadedDocument{
+		File:      f,
+		ForceFile: true,
+		Thumb:     f,
+		MimeType:  "image/png",
+		Attributes: []tg.DocumentAttributeClass{
+			&tg.DocumentAttributeImageSize{W: 640, H: 480},
+		},
+	}, mock)
+	_, err = dialog.Upload(FromBytes("image.png", img.Bytes())).Thumbnail().File(ctx)
+	require.NoError(t, err)
+
+	// Unknown format and unprobeable sources are sent as is.
+	for _, option := range []UploadOption{
+		FromBytes("video.mp4", []byte("not a video")),
+		FromReader("video.mp4", nil),
+	} {
+		expectSendMedia(t, &tg.InputMediaUploadedDocument{
+			File:     f,
+			MimeType: DefaultVideoMIME,
+			Attributes: []tg.DocumentAttributeClass{
+				&tg.DocumentAttributeVideo{},
+			},
+		}, mock)
+		_, err = dialog.Upload(option).Probe().Video(ctx)
+		require.NoError(t, err)
+	}
+}
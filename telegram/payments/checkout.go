@@ -0,0 +1,281 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/telegram/botquery"
+	"github.com/gotd/td/tg"
+)
+
+// State is a checkout state.
+type State uint8
+
+const (
+	// StateNone means that checkout is unknown.
+	StateNone State = iota
+	// StateApproved means that pre-checkout query was approved and payment
+	// is awaited.
+	StateApproved
+	// StateRejected means that pre-checkout query was rejected.
+	StateRejected
+	// StatePaid means that payment was received.
+	StatePaid
+)
+
+// String implements fmt.Stringer.
+func (s State) String() string {
+	switch s {
+	case StateNone:
+		return "none"
+	case StateApproved:
+		return "approved"
+	case StateRejected:
+		return "rejected"
+	case StatePaid:
+		return "paid"
+	default:
+		return fmt.Sprintf("State(%d)", s)
+	}
+}
+
+// Payment is a successful payment.
+type Payment struct {
+	// UserID is ID of user who paid.
+	UserID int64
+	// Payload is invoice payload.
+	Payload []byte
+	// Currency is three-letter ISO 4217 currency code.
+	Currency string
+	// TotalAmount is total amount in the smallest units of currency.
+	TotalAmount int64
+	// Info is order info provided by user.
+	Info tg.PaymentRequestedInfo
+	// ShippingOptionID is identifier of chosen shipping option.
+	ShippingOptionID string
+	// Charge identifiers, used for refunds.
+	Charge tg.PaymentCharge
+	// SubscriptionUntil is expiration date of subscription, if payment is
+	// a subscription payment.
+	SubscriptionUntil time.Time
+	// Approved whether pre-checkout query of this payment was approved by
+	// this Checkout.
+	//
+	// May be false if, for example, bot was restarted between pre-checkout
+	// query and payment.
+	Approved bool
+	// Message is payment service message.
+	Message *tg.MessageService
+}
+
+// RejectError is returned by CheckoutOptions.Approve to reject
+// pre-checkout query with given human-readable reason.
+type RejectError struct {
+	Reason string
+}
+
+// Error implements error.
+func (r *RejectError) Error() string {
+	return fmt.Sprintf("checkout rejected: %s", r.Reason)
+}
+
+// Reject returns new RejectError.
+func Reject(reason string) error {
+	return &RejectError{Reason: reason}
+}
+
+// CheckoutOptions is options of Checkout.
+type CheckoutOptions struct {
+	// Approve is called on pre-checkout query.
+	//
+	// If it returns RejectError, query is rejected with given reason.
+	// Any other error rejects query with InternalErrorReason and is
+	// returned from handler.
+	//
+	// If nil, all queries are approved.
+	Approve func(ctx context.Context, q *botquery.PreCheckoutQuery) error
+	// OnPaid is called on successful payment.
+	OnPaid func(ctx context.Context, p Payment) error
+	// InternalErrorReason is rejection reason used if Approve returns
+	// error which is not RejectError.
+	//
+	// Defaults to "Internal error".
+	InternalErrorReason string
+	// TTL is a time for which approved checkout is waiting for payment.
+	//
+	// Defaults to 1 hour.
+	TTL time.Duration
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger to use. Defaults to zerolog.Nop.
+	Logger *zerolog.Logger
+}
+
+func (o *CheckoutOptions) setDefaults() {
+	if o.InternalErrorReason == "" {
+		o.InternalErrorReason = "Internal error"
+	}
+	if o.TTL == 0 {
+		o.TTL = time.Hour
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		nop := zerolog.Nop()
+		o.Logger = &nop
+	}
+}
+
+type checkoutKey struct {
+	userID  int64
+	payload string
+}
+
+type checkoutState struct {
+	state State
+	until time.Time
+}
+
+// Checkout tracks state of checkouts, answering pre-checkout queries and
+// handling payment service messages.
+//
+// Pre-checkout queries are received from botquery.Manager:
+//
+//	bq.OnPreCheckoutQuery(dispatcher, checkout.HandlePreCheckout)
+//
+// See https://core.telegram.org/api/payments#7-pre-checkout-query.
+type Checkout struct {
+	opts CheckoutOptions
+
+	states    map[checkoutKey]checkoutState
+	statesMux sync.Mutex
+}
+
+// NewCheckout creates new Checkout.
+func NewCheckout(opts CheckoutOptions) *Checkout {
+	opts.setDefaults()
+	return &Checkout{
+		opts:   opts,
+		states: map[checkoutKey]checkoutState{},
+	}
+}
+
+func (c *Checkout) set(key checkoutKey, s State) {
+	c.statesMux.Lock()
+	defer c.statesMux.Unlock()
+
+	now := c.opts.Clock.Now()
+	// Cleanup expired states.
+	for k, v := range c.states {
+		if now.After(v.until) {
+			delete(c.states, k)
+		}
+	}
+	c.states[key] = checkoutState{
+		state: s,
+		until: now.Add(c.opts.TTL),
+	}
+}
+
+func (c *Checkout) get(key checkoutKey) State {
+	c.statesMux.Lock()
+	defer c.statesMux.Unlock()
+
+	v, ok := c.states[key]
+	if !ok || c.opts.Clock.Now().After(v.until) {
+		return StateNone
+	}
+	return v.state
+}
+
+// State returns state of checkout of given user and invoice payload.
+func (c *Checkout) State(userID int64, payload []byte) State {
+	return c.get(checkoutKey{userID: userID, payload: string(payload)})
+}
+
+// HandlePreCheckout approves or rejects pre-checkout query.
+func (c *Checkout) HandlePreCheckout(ctx context.Context, q *botquery.PreCheckoutQuery) error {
+	userID := q.Sender.ID()
+	key := checkoutKey{userID: userID, payload: string(q.Payload)}
+
+	var approveErr error
+	if c.opts.Approve != nil {
+		approveErr = c.opts.Approve(ctx, q)
+	}
+	if approveErr == nil {
+		if err := q.Approve(ctx); err != nil {
+			return err
+		}
+		c.set(key, StateApproved)
+		return nil
+	}
+
+	reason := c.opts.InternalErrorReason
+	var rejectErr *RejectError
+	if errors.As(approveErr, &rejectErr) {
+		reason = rejectErr.Reason
+		approveErr = nil
+	}
+	c.opts.Logger.Debug().
+		Int64("user_id", userID).
+		Str("reason", reason).
+		Msg("Rejecting checkout")
+
+	if err := q.Reject(ctx, reason); err != nil {
+		return err
+	}
+	c.set(key, StateRejected)
+	if approveErr != nil {
+		return errors.Wrap(approveErr, "approve")
+	}
+	return nil
+}
+
+// HandleMessage handles payment service message.
+//
+// Returns false if message is not a payment message.
+func (c *Checkout) HandleMessage(ctx context.Context, msg tg.MessageClass) (bool, error) {
+	m, ok := msg.(*tg.MessageService)
+	if !ok {
+		return false, nil
+	}
+	action, ok := m.Action.(*tg.MessageActionPaymentSentMe)
+	if !ok {
+		return false, nil
+	}
+	user, ok := m.PeerID.(*tg.PeerUser)
+	if !ok {
+		return false, errors.Errorf("unexpected payment peer %T", m.PeerID)
+	}
+
+	key := checkoutKey{userID: user.UserID, payload: string(action.Payload)}
+	p := Payment{
+		UserID:           user.UserID,
+		Payload:          action.Payload,
+		Currency:         action.Currency,
+		TotalAmount:      action.TotalAmount,
+		Info:             action.Info,
+		ShippingOptionID: action.ShippingOptionID,
+		Charge:           action.Charge,
+		Approved:         c.get(key) == StateApproved,
+		Message:          m,
+	}
+	if action.SubscriptionUntilDate != 0 {
+		p.SubscriptionUntil = time.Unix(int64(action.SubscriptionUntilDate), 0)
+	}
+	c.set(key, StatePaid)
+
+	if c.opts.OnPaid != nil {
+		if err := c.opts.OnPaid(ctx, p); err != nil {
+			return true, errors.Wrap(err, "on paid")
+		}
+	}
+	return true, nil
+}
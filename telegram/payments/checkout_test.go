@@ -0,0 +1,131 @@
+package payments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/neo"
+	"github.com/gotd/td/telegram/botquery"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func paymentMessage(userID int64, payload []byte) *tg.MessageService {
+	return &tg.MessageService{
+		ID:     1,
+		PeerID: &tg.PeerUser{UserID: userID},
+		Action: &tg.MessageActionPaymentSentMe{
+			Currency:    StarsCurrency,
+			TotalAmount: 10,
+			Payload:     payload,
+			Charge:      tg.PaymentCharge{ID: "charge"},
+		},
+	}
+}
+
+func TestCheckout(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock := tgmock.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := neo.NewTime(now)
+
+	bq := botquery.New(peers.Options{
+		Cache: &peers.InmemoryCache{},
+	}.Build(tg.NewClient(mock)), botquery.Options{})
+	user := &tg.User{ID: 10, AccessHash: 10}
+	e := tg.Entities{Users: map[int64]*tg.User{user.ID: user}}
+	preCheckout := func(u *tg.UpdateBotPrecheckoutQuery) *botquery.PreCheckoutQuery {
+		q, err := bq.PreCheckoutQuery(ctx, e, u)
+		a.NoError(err)
+		return q
+	}
+
+	var paid []Payment
+	c := NewCheckout(CheckoutOptions{
+		Approve: func(ctx context.Context, q *botquery.PreCheckoutQuery) error {
+			switch string(q.Payload) {
+			case "ok":
+				return nil
+			case "sold":
+				return Reject("Sold out")
+			default:
+				return errors.New("database is down")
+			}
+		},
+		OnPaid: func(ctx context.Context, p Payment) error {
+			paid = append(paid, p)
+			return nil
+		},
+		Clock: clock,
+	})
+	d := tg.NewUpdateDispatcher()
+	bq.OnPreCheckoutQuery(d, c.HandlePreCheckout)
+
+	mock.ExpectCall(&tg.MessagesSetBotPrecheckoutResultsRequest{
+		Success: true,
+		QueryID: 1,
+	}).ThenTrue()
+	a.NoError(d.Handle(ctx, &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateBotPrecheckoutQuery{QueryID: 1, UserID: 10, Payload: []byte("ok")},
+		},
+		Users: []tg.UserClass{user},
+	}))
+	a.Equal(StateApproved, c.State(10, []byte("ok")))
+
+	mock.ExpectCall(&tg.MessagesSetBotPrecheckoutResultsRequest{
+		QueryID: 2,
+		Error:   "Sold out",
+	}).ThenTrue()
+	a.NoError(c.HandlePreCheckout(ctx, preCheckout(&tg.UpdateBotPrecheckoutQuery{
+		QueryID: 2,
+		UserID:  10,
+		Payload: []byte("sold"),
+	})))
+	a.Equal(StateRejected, c.State(10, []byte("sold")))
+
+	mock.ExpectCall(&tg.MessagesSetBotPrecheckoutResultsRequest{
+		QueryID: 3,
+		Error:   "Internal error",
+	}).ThenTrue()
+	a.Error(c.HandlePreCheckout(ctx, preCheckout(&tg.UpdateBotPrecheckoutQuery{
+		QueryID: 3,
+		UserID:  10,
+		Payload: []byte("other"),
+	})))
+
+	ok, err := c.HandleMessage(ctx, &tg.Message{ID: 1})
+	a.NoError(err)
+	a.False(ok)
+
+	ok, err = c.HandleMessage(ctx, paymentMessage(10, []byte("ok")))
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(StatePaid, c.State(10, []byte("ok")))
+
+	// Payment without approval, e.g. after restart.
+	ok, err = c.HandleMessage(ctx, paymentMessage(11, []byte("ok")))
+	a.NoError(err)
+	a.True(ok)
+
+	a.Len(paid, 2)
+	a.True(paid[0].Approved)
+	a.Equal("charge", paid[0].Charge.ID)
+	a.False(paid[1].Approved)
+
+	// States expire.
+	clock.Travel(2 * time.Hour)
+	a.Equal(StateNone, c.State(10, []byte("ok")))
+}
+
+func TestState_String(t *testing.T) {
+	for s := StateNone; s <= StatePaid+1; s++ {
+		require.NotEmpty(t, s.String())
+	}
+}
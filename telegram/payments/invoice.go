@@ -0,0 +1,159 @@
+package payments
+
+import (
+	"time"
+
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/tg"
+)
+
+// StarsCurrency is a currency code of Telegram Stars.
+const StarsCurrency = "XTR"
+
+// InvoiceBuilder is an invoice media builder.
+//
+// See https://core.telegram.org/api/payments#sending-invoices.
+type InvoiceBuilder struct {
+	media tg.InputMediaInvoice
+}
+
+// Invoice creates new invoice builder.
+//
+// Payload is an internal bot data, which is not displayed to user and
+// passed back in pre-checkout query and payment service message.
+func Invoice(
+	title, description string,
+	payload []byte,
+	currency string,
+	prices ...tg.LabeledPrice,
+) *InvoiceBuilder {
+	return &InvoiceBuilder{
+		media: tg.InputMediaInvoice{
+			Title:       title,
+			Description: description,
+			Payload:     payload,
+			Invoice: tg.Invoice{
+				Currency: currency,
+				Prices:   prices,
+			},
+		},
+	}
+}
+
+// Stars creates new invoice builder for payment in Telegram Stars.
+func Stars(title, description string, payload []byte, amount int64) *InvoiceBuilder {
+	return Invoice(title, description, payload, StarsCurrency, tg.LabeledPrice{
+		Label:  title,
+		Amount: amount,
+	})
+}
+
+// Provider sets payment provider token.
+//
+// Must be empty for payments in Telegram Stars.
+func (b *InvoiceBuilder) Provider(token string) *InvoiceBuilder {
+	b.media.Provider = token
+	return b
+}
+
+// ProviderData sets JSON-encoded data about the invoice, which will be
+// shared with the payment provider.
+func (b *InvoiceBuilder) ProviderData(data string) *InvoiceBuilder {
+	b.media.ProviderData = tg.DataJSON{Data: data}
+	return b
+}
+
+// Photo sets URL of product photo.
+func (b *InvoiceBuilder) Photo(url string, size int, mimeType string) *InvoiceBuilder {
+	b.media.Photo = tg.InputWebDocument{
+		URL:      url,
+		Size:     size,
+		MimeType: mimeType,
+	}
+	return b
+}
+
+// StartParam sets start parameter of bot deep link, which is used if
+// invoice is forwarded.
+func (b *InvoiceBuilder) StartParam(param string) *InvoiceBuilder {
+	b.media.StartParam = param
+	return b
+}
+
+// ExtendedMedia sets paid media, which will be unlocked after payment.
+func (b *InvoiceBuilder) ExtendedMedia(media tg.InputMediaClass) *InvoiceBuilder {
+	b.media.ExtendedMedia = media
+	return b
+}
+
+// Test marks invoice as test.
+func (b *InvoiceBuilder) Test() *InvoiceBuilder {
+	b.media.Invoice.Test = true
+	return b
+}
+
+// RequestName requests user full name.
+func (b *InvoiceBuilder) RequestName() *InvoiceBuilder {
+	b.media.Invoice.NameRequested = true
+	return b
+}
+
+// RequestPhone requests user phone number.
+func (b *InvoiceBuilder) RequestPhone() *InvoiceBuilder {
+	b.media.Invoice.PhoneRequested = true
+	return b
+}
+
+// RequestEmail requests user email.
+func (b *InvoiceBuilder) RequestEmail() *InvoiceBuilder {
+	b.media.Invoice.EmailRequested = true
+	return b
+}
+
+// RequestShipping requests user shipping address.
+func (b *InvoiceBuilder) RequestShipping() *InvoiceBuilder {
+	b.media.Invoice.ShippingAddressRequested = true
+	return b
+}
+
+// Flexible marks that final price depends on shipping method.
+//
+// Bot receives shipping query in that case.
+func (b *InvoiceBuilder) Flexible() *InvoiceBuilder {
+	b.media.Invoice.Flexible = true
+	return b
+}
+
+// Tips allows tips up to given amount with suggested tip amounts.
+func (b *InvoiceBuilder) Tips(max int64, suggested ...int64) *InvoiceBuilder {
+	b.media.Invoice.MaxTipAmount = max
+	b.media.Invoice.SuggestedTipAmounts = suggested
+	return b
+}
+
+// TermsURL sets URL of terms of service.
+func (b *InvoiceBuilder) TermsURL(url string) *InvoiceBuilder {
+	b.media.Invoice.TermsURL = url
+	return b
+}
+
+// Subscription makes invoice a Telegram Stars subscription with given
+// period.
+//
+// Currently, only 30 days period is supported by server. Subscription
+// invoices should be sent as links, see Manager.InvoiceLink.
+func (b *InvoiceBuilder) Subscription(period time.Duration) *InvoiceBuilder {
+	b.media.Invoice.SubscriptionPeriod = int(period.Seconds())
+	return b
+}
+
+// Build returns invoice media.
+func (b *InvoiceBuilder) Build() *tg.InputMediaInvoice {
+	r := b.media
+	return &r
+}
+
+// Media returns media option to send invoice using message.Builder.
+func (b *InvoiceBuilder) Media(caption ...message.StyledTextOption) message.MediaOption {
+	return message.Media(b.Build(), caption...)
+}
@@ -0,0 +1,69 @@
+package payments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestInvoice(t *testing.T) {
+	a := require.New(t)
+
+	prices := []tg.LabeledPrice{{Label: "Item", Amount: 1000}, {Label: "Tax", Amount: 100}}
+	inv := Invoice("Title", "Description", []byte("payload"), "USD", prices...).
+		Provider("token").
+		ProviderData(`{"a":1}`).
+		Photo("https://example.com/a.jpg", 10, "image/jpeg").
+		StartParam("start").
+		Test().
+		RequestName().
+		RequestPhone().
+		RequestEmail().
+		RequestShipping().
+		Flexible().
+		Tips(500, 100, 200).
+		TermsURL("https://example.com/tos")
+
+	a.Equal(&tg.InputMediaInvoice{
+		Title:       "Title",
+		Description: "Description",
+		Photo: tg.InputWebDocument{
+			URL:      "https://example.com/a.jpg",
+			Size:     10,
+			MimeType: "image/jpeg",
+		},
+		Invoice: tg.Invoice{
+			Test:                     true,
+			NameRequested:            true,
+			PhoneRequested:           true,
+			EmailRequested:           true,
+			ShippingAddressRequested: true,
+			Flexible:                 true,
+			Currency:                 "USD",
+			Prices:                   prices,
+			MaxTipAmount:             500,
+			SuggestedTipAmounts:      []int64{100, 200},
+			TermsURL:                 "https://example.com/tos",
+		},
+		Payload:      []byte("payload"),
+		Provider:     "token",
+		ProviderData: tg.DataJSON{Data: `{"a":1}`},
+		StartParam:   "start",
+	}, inv.Build())
+	a.NotNil(inv.Media())
+}
+
+func TestStars(t *testing.T) {
+	a := require.New(t)
+
+	inv := Stars("Title", "Description", []byte("payload"), 10).
+		Subscription(30 * 24 * time.Hour).
+		Build()
+	a.Equal(StarsCurrency, inv.Invoice.Currency)
+	a.Equal([]tg.LabeledPrice{{Label: "Title", Amount: 10}}, inv.Invoice.Prices)
+	a.Equal(2592000, inv.Invoice.SubscriptionPeriod)
+	a.Empty(inv.Provider)
+}
@@ -0,0 +1,66 @@
+// Package payments contains helpers for bot payments and Telegram Stars.
+//
+// See https://core.telegram.org/api/payments and
+// https://core.telegram.org/api/stars.
+package payments
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// Manager is a payments helper.
+type Manager struct {
+	raw *tg.Client
+}
+
+// NewManager creates new Manager.
+func NewManager(raw *tg.Client) *Manager {
+	return &Manager{raw: raw}
+}
+
+// Balance returns Telegram Stars balance of given peer.
+//
+// Use tg.InputPeerSelf for current user or bot.
+func (m *Manager) Balance(ctx context.Context, peer tg.InputPeerClass) (tg.StarsAmount, error) {
+	r, err := m.raw.PaymentsGetStarsStatus(ctx, &tg.PaymentsGetStarsStatusRequest{
+		Peer: peer,
+	})
+	if err != nil {
+		return tg.StarsAmount{}, errors.Wrap(err, "get stars status")
+	}
+
+	balance, ok := r.Balance.(*tg.StarsAmount)
+	if !ok {
+		return tg.StarsAmount{}, errors.Errorf("unexpected balance type %T", r.Balance)
+	}
+	return *balance, nil
+}
+
+// Refund refunds Telegram Stars payment with given charge ID.
+//
+// Only bots can refund payments.
+func (m *Manager) Refund(ctx context.Context, user tg.InputUserClass, chargeID string) error {
+	if _, err := m.raw.PaymentsRefundStarsCharge(ctx, &tg.PaymentsRefundStarsChargeRequest{
+		UserID:   user,
+		ChargeID: chargeID,
+	}); err != nil {
+		return errors.Wrap(err, "refund stars charge")
+	}
+	return nil
+}
+
+// InvoiceLink exports invoice as a link, which can be shared or opened
+// by users.
+//
+// Subscription invoices can be sent only as links.
+func (m *Manager) InvoiceLink(ctx context.Context, inv *InvoiceBuilder) (string, error) {
+	r, err := m.raw.PaymentsExportInvoice(ctx, inv.Build())
+	if err != nil {
+		return "", errors.Wrap(err, "export invoice")
+	}
+	return r.URL, nil
+}
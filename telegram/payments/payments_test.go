@@ -0,0 +1,60 @@
+package payments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func testManager(t *testing.T) (*tgmock.Mock, *Manager) {
+	mock := tgmock.New(t)
+	return mock, NewManager(tg.NewClient(mock))
+}
+
+func TestManager_Balance(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	mock.ExpectCall(&tg.PaymentsGetStarsStatusRequest{
+		Peer: &tg.InputPeerSelf{},
+	}).ThenResult(&tg.PaymentsStarsStatus{
+		Balance: &tg.StarsAmount{Amount: 100},
+	})
+	balance, err := m.Balance(ctx, &tg.InputPeerSelf{})
+	a.NoError(err)
+	a.Equal(int64(100), balance.Amount)
+}
+
+func TestManager_Refund(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	user := &tg.InputUser{UserID: 10, AccessHash: 10}
+	mock.ExpectCall(&tg.PaymentsRefundStarsChargeRequest{
+		UserID:   user,
+		ChargeID: "charge",
+	}).ThenResult(&tg.Updates{})
+	a.NoError(m.Refund(ctx, user, "charge"))
+}
+
+func TestManager_InvoiceLink(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	inv := Stars("Premium", "Monthly access", []byte("sub"), 100).
+		Subscription(30 * 24 * time.Hour)
+	mock.ExpectCall(&tg.PaymentsExportInvoiceRequest{
+		InvoiceMedia: inv.Build(),
+	}).ThenResult(&tg.PaymentsExportedInvoice{URL: "https://t.me/$abc"})
+	link, err := m.InvoiceLink(ctx, inv)
+	a.NoError(err)
+	a.Equal("https://t.me/$abc", link)
+}
@@ -0,0 +1,102 @@
+package payments
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// Subscriptions returns Telegram Stars subscriptions of given peer.
+//
+// If missingBalance is true, only subscriptions which can't be renewed
+// because of insufficient balance are returned.
+func (m *Manager) Subscriptions(
+	ctx context.Context,
+	peer tg.InputPeerClass,
+	missingBalance bool,
+) ([]tg.StarsSubscription, error) {
+	var (
+		result []tg.StarsSubscription
+		offset string
+	)
+	for {
+		r, err := m.raw.PaymentsGetStarsSubscriptions(ctx, &tg.PaymentsGetStarsSubscriptionsRequest{
+			MissingBalance: missingBalance,
+			Peer:           peer,
+			Offset:         offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "get stars subscriptions")
+		}
+		result = append(result, r.Subscriptions...)
+
+		if r.SubscriptionsNextOffset == "" || r.SubscriptionsNextOffset == offset {
+			return result, nil
+		}
+		offset = r.SubscriptionsNextOffset
+	}
+}
+
+func (m *Manager) changeSubscription(ctx context.Context, peer tg.InputPeerClass, id string, canceled bool) error {
+	req := &tg.PaymentsChangeStarsSubscriptionRequest{
+		Peer:           peer,
+		SubscriptionID: id,
+	}
+	req.SetCanceled(canceled)
+	if _, err := m.raw.PaymentsChangeStarsSubscription(ctx, req); err != nil {
+		return errors.Wrap(err, "change stars subscription")
+	}
+	return nil
+}
+
+// CancelSubscription cancels renewal of subscription with given ID.
+//
+// Subscription stays active until the end of paid period.
+func (m *Manager) CancelSubscription(ctx context.Context, peer tg.InputPeerClass, id string) error {
+	return m.changeSubscription(ctx, peer, id, true)
+}
+
+// RestoreSubscription restores renewal of canceled subscription with given ID.
+func (m *Manager) RestoreSubscription(ctx context.Context, peer tg.InputPeerClass, id string) error {
+	return m.changeSubscription(ctx, peer, id, false)
+}
+
+// FulfillSubscription renews subscription, which was not renewed because of
+// insufficient balance.
+func (m *Manager) FulfillSubscription(ctx context.Context, peer tg.InputPeerClass, id string) error {
+	if _, err := m.raw.PaymentsFulfillStarsSubscription(ctx, &tg.PaymentsFulfillStarsSubscriptionRequest{
+		Peer:           peer,
+		SubscriptionID: id,
+	}); err != nil {
+		return errors.Wrap(err, "fulfill stars subscription")
+	}
+	return nil
+}
+
+// BotCancelSubscription cancels renewal of user subscription to bot.
+//
+// ChargeID is a charge ID of the last subscription payment.
+func (m *Manager) BotCancelSubscription(ctx context.Context, user tg.InputUserClass, chargeID string) error {
+	if _, err := m.raw.PaymentsBotCancelStarsSubscription(ctx, &tg.PaymentsBotCancelStarsSubscriptionRequest{
+		UserID:   user,
+		ChargeID: chargeID,
+	}); err != nil {
+		return errors.Wrap(err, "bot cancel stars subscription")
+	}
+	return nil
+}
+
+// BotRestoreSubscription restores renewal of user subscription to bot,
+// canceled by BotCancelSubscription.
+func (m *Manager) BotRestoreSubscription(ctx context.Context, user tg.InputUserClass, chargeID string) error {
+	if _, err := m.raw.PaymentsBotCancelStarsSubscription(ctx, &tg.PaymentsBotCancelStarsSubscriptionRequest{
+		Restore:  true,
+		UserID:   user,
+		ChargeID: chargeID,
+	}); err != nil {
+		return errors.Wrap(err, "bot restore stars subscription")
+	}
+	return nil
+}
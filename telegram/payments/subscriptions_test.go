@@ -0,0 +1,61 @@
+package payments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestManager_Subscriptions(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+	self := &tg.InputPeerSelf{}
+
+	sub := func(id string) tg.StarsSubscription {
+		return tg.StarsSubscription{
+			ID:      id,
+			Peer:    &tg.PeerUser{UserID: 10},
+			Pricing: tg.StarsSubscriptionPricing{Period: 2592000, Amount: 10},
+		}
+	}
+	mock.ExpectCall(&tg.PaymentsGetStarsSubscriptionsRequest{
+		Peer: self,
+	}).ThenResult(&tg.PaymentsStarsStatus{
+		Balance:                 &tg.StarsAmount{},
+		Subscriptions:           []tg.StarsSubscription{sub("1")},
+		SubscriptionsNextOffset: "next",
+	})
+	mock.ExpectCall(&tg.PaymentsGetStarsSubscriptionsRequest{
+		Peer:   self,
+		Offset: "next",
+	}).ThenResult(&tg.PaymentsStarsStatus{
+		Balance:       &tg.StarsAmount{},
+		Subscriptions: []tg.StarsSubscription{sub("2")},
+	})
+	subs, err := m.Subscriptions(ctx, self, false)
+	a.NoError(err)
+	a.Len(subs, 2)
+
+	cancel := &tg.PaymentsChangeStarsSubscriptionRequest{Peer: self, SubscriptionID: "1"}
+	cancel.SetCanceled(true)
+	mock.ExpectCall(cancel).ThenTrue()
+	a.NoError(m.CancelSubscription(ctx, self, "1"))
+
+	restore := &tg.PaymentsChangeStarsSubscriptionRequest{Peer: self, SubscriptionID: "1"}
+	restore.SetCanceled(false)
+	mock.ExpectCall(restore).ThenTrue()
+	a.NoError(m.RestoreSubscription(ctx, self, "1"))
+
+	mock.ExpectCall(&tg.PaymentsFulfillStarsSubscriptionRequest{Peer: self, SubscriptionID: "1"}).ThenTrue()
+	a.NoError(m.FulfillSubscription(ctx, self, "1"))
+
+	user := &tg.InputUser{UserID: 10, AccessHash: 10}
+	mock.ExpectCall(&tg.PaymentsBotCancelStarsSubscriptionRequest{UserID: user, ChargeID: "c"}).ThenTrue()
+	a.NoError(m.BotCancelSubscription(ctx, user, "c"))
+	mock.ExpectCall(&tg.PaymentsBotCancelStarsSubscriptionRequest{Restore: true, UserID: user, ChargeID: "c"}).ThenTrue()
+	a.NoError(m.BotRestoreSubscription(ctx, user, "c"))
+}
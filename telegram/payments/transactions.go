@@ -0,0 +1,165 @@
+package payments
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// TransactionsQuery is a query builder of Telegram Stars transactions.
+type TransactionsQuery struct {
+	raw       *tg.Client
+	req       tg.PaymentsGetStarsTransactionsRequest
+	batchSize int
+}
+
+// Transactions returns query builder of Telegram Stars transactions of
+// given peer.
+//
+// Use tg.InputPeerSelf for current user or bot.
+func (m *Manager) Transactions(peer tg.InputPeerClass) *TransactionsQuery {
+	return &TransactionsQuery{
+		raw: m.raw,
+		req: tg.PaymentsGetStarsTransactionsRequest{
+			Peer: peer,
+		},
+		batchSize: 100,
+	}
+}
+
+// Inbound requests only incoming transactions.
+func (q *TransactionsQuery) Inbound() *TransactionsQuery {
+	q.req.Inbound = true
+	q.req.Outbound = false
+	return q
+}
+
+// Outbound requests only outgoing transactions.
+func (q *TransactionsQuery) Outbound() *TransactionsQuery {
+	q.req.Outbound = true
+	q.req.Inbound = false
+	return q
+}
+
+// Ascending requests transactions from oldest to newest.
+func (q *TransactionsQuery) Ascending() *TransactionsQuery {
+	q.req.Ascending = true
+	return q
+}
+
+// Subscription requests only transactions of subscription with given ID.
+func (q *TransactionsQuery) Subscription(id string) *TransactionsQuery {
+	q.req.SubscriptionID = id
+	return q
+}
+
+// BatchSize sets count of transactions requested by one request.
+func (q *TransactionsQuery) BatchSize(size int) *TransactionsQuery {
+	q.batchSize = size
+	return q
+}
+
+// Iter returns iterator over transactions.
+func (q *TransactionsQuery) Iter() *TransactionsIterator {
+	return &TransactionsIterator{
+		bufCur: -1,
+		query:  q,
+	}
+}
+
+// ForEach calls cb for every transaction.
+func (q *TransactionsQuery) ForEach(ctx context.Context, cb func(context.Context, tg.StarsTransaction) error) error {
+	iter := q.Iter()
+	for iter.Next(ctx) {
+		if err := cb(ctx, iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// Collect returns all transactions.
+func (q *TransactionsQuery) Collect(ctx context.Context) ([]tg.StarsTransaction, error) {
+	var r []tg.StarsTransaction
+	return r, q.ForEach(ctx, func(ctx context.Context, t tg.StarsTransaction) error {
+		r = append(r, t)
+		return nil
+	})
+}
+
+// TransactionsIterator is an iterator over Telegram Stars transactions.
+type TransactionsIterator struct {
+	// Current state.
+	lastErr error
+	// Buffer state.
+	buf    []tg.StarsTransaction
+	bufCur int
+	// Request state.
+	offset    string
+	lastBatch bool
+
+	query *TransactionsQuery
+}
+
+func (i *TransactionsIterator) requestNext(ctx context.Context) error {
+	if i.lastBatch {
+		i.buf = i.buf[:0]
+		return nil
+	}
+
+	req := i.query.req
+	req.Offset = i.offset
+	req.Limit = i.query.batchSize
+	r, err := i.query.raw.PaymentsGetStarsTransactions(ctx, &req)
+	if err != nil {
+		return errors.Wrap(err, "get stars transactions")
+	}
+
+	i.lastBatch = r.NextOffset == "" || r.NextOffset == i.offset || len(r.History) == 0
+	i.offset = r.NextOffset
+	i.bufCur = -1
+	i.buf = append(i.buf[:0], r.History...)
+	return nil
+}
+
+func (i *TransactionsIterator) bufNext() bool {
+	if len(i.buf)-1 <= i.bufCur {
+		return false
+	}
+
+	i.bufCur++
+	return true
+}
+
+// Next prepares the next transaction for reading with the Value method.
+// It returns true on success, or false if there is no next transaction or an error happened while preparing it.
+// Err should be consulted to distinguish between the two cases.
+func (i *TransactionsIterator) Next(ctx context.Context) bool {
+	if i.lastErr != nil {
+		return false
+	}
+
+	if !i.bufNext() {
+		// If buffer is empty, we should fetch next batch.
+		if err := i.requestNext(ctx); err != nil {
+			i.lastErr = err
+			return false
+		}
+		// Try again with new buffer.
+		return i.bufNext()
+	}
+
+	return true
+}
+
+// Value returns current transaction.
+func (i *TransactionsIterator) Value() tg.StarsTransaction {
+	return i.buf[i.bufCur]
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (i *TransactionsIterator) Err() error {
+	return i.lastErr
+}
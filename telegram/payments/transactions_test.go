@@ -0,0 +1,91 @@
+package payments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestManager_Transactions(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	tx := func(id string) tg.StarsTransaction {
+		return tg.StarsTransaction{
+			ID:     id,
+			Amount: &tg.StarsAmount{Amount: 1},
+			Peer:   &tg.StarsTransactionPeerAppStore{},
+		}
+	}
+
+	mock.ExpectCall(&tg.PaymentsGetStarsTransactionsRequest{
+		Inbound: true,
+		Peer:    &tg.InputPeerSelf{},
+		Limit:   2,
+	}).ThenResult(&tg.PaymentsStarsStatus{
+		Balance:    &tg.StarsAmount{Amount: 3},
+		History:    []tg.StarsTransaction{tx("1"), tx("2")},
+		NextOffset: "next",
+	})
+	mock.ExpectCall(&tg.PaymentsGetStarsTransactionsRequest{
+		Inbound: true,
+		Peer:    &tg.InputPeerSelf{},
+		Offset:  "next",
+		Limit:   2,
+	}).ThenResult(&tg.PaymentsStarsStatus{
+		Balance: &tg.StarsAmount{Amount: 3},
+		History: []tg.StarsTransaction{tx("3")},
+	})
+
+	r, err := m.Transactions(&tg.InputPeerSelf{}).Inbound().BatchSize(2).Collect(ctx)
+	a.NoError(err)
+	a.Len(r, 3)
+	a.Equal("3", r[2].ID)
+}
+
+func TestManager_TransactionsRepeatedOffset(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	req := func(offset string) *tg.PaymentsGetStarsTransactionsRequest {
+		return &tg.PaymentsGetStarsTransactionsRequest{
+			Peer:   &tg.InputPeerSelf{},
+			Offset: offset,
+			Limit:  100,
+		}
+	}
+	tx := tg.StarsTransaction{
+		ID:     "1",
+		Amount: &tg.StarsAmount{Amount: 1},
+		Peer:   &tg.StarsTransactionPeerAppStore{},
+	}
+
+	mock.ExpectCall(req("")).ThenResult(&tg.PaymentsStarsStatus{
+		Balance:    &tg.StarsAmount{Amount: 1},
+		History:    []tg.StarsTransaction{tx},
+		NextOffset: "next",
+	})
+	// Same offset is returned again.
+	mock.ExpectCall(req("next")).ThenResult(&tg.PaymentsStarsStatus{
+		Balance:    &tg.StarsAmount{Amount: 1},
+		History:    []tg.StarsTransaction{tx},
+		NextOffset: "next",
+	})
+	r, err := m.Transactions(&tg.InputPeerSelf{}).Collect(ctx)
+	a.NoError(err)
+	a.Len(r, 2)
+
+	// Empty page with non-empty offset.
+	mock.ExpectCall(req("")).ThenResult(&tg.PaymentsStarsStatus{
+		Balance:    &tg.StarsAmount{Amount: 1},
+		NextOffset: "next",
+	})
+	r, err = m.Transactions(&tg.InputPeerSelf{}).Collect(ctx)
+	a.NoError(err)
+	a.Empty(r)
+}
@@ -0,0 +1,241 @@
+package peers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// AdminLog returns admin log of this channel.
+//
+// See https://core.telegram.org/api/recent-actions.
+func (c Channel) AdminLog() AdminLog {
+	return AdminLog{
+		channel: c,
+		m:       c.m,
+	}
+}
+
+// AdminLog represents admin log (recent actions) of Channel.
+type AdminLog struct {
+	channel Channel
+	m       *Manager
+}
+
+// AdminLogOptions is options for AdminLog queries.
+type AdminLogOptions struct {
+	// Query to search in event descriptions.
+	//
+	// If zero, will not be used.
+	Query string
+	// Filter of event types.
+	//
+	// If zero, all events are returned.
+	Filter tg.ChannelAdminLogEventsFilter
+	// Admins to return events of.
+	//
+	// If empty, events of all admins are returned.
+	Admins []tg.InputUserClass
+	// MinID is an exclusive lower bound of event ID.
+	MinID int64
+	// MaxID is an exclusive upper bound of event ID.
+	//
+	// If zero, events are returned starting from the newest one.
+	MaxID int64
+}
+
+func (o AdminLogOptions) request(channel tg.InputChannelClass, limit int) *tg.ChannelsGetAdminLogRequest {
+	req := &tg.ChannelsGetAdminLogRequest{
+		Channel: channel,
+		Q:       o.Query,
+		MaxID:   o.MaxID,
+		MinID:   o.MinID,
+		Limit:   limit,
+	}
+	if !o.Filter.Zero() {
+		req.SetEventsFilter(o.Filter)
+	}
+	if len(o.Admins) > 0 {
+		req.SetAdmins(o.Admins)
+	}
+	return req
+}
+
+// AdminLogEvent represents admin log event with resolved users.
+type AdminLogEvent struct {
+	raw       tg.ChannelAdminLogEvent
+	user      User
+	target    User
+	hasTarget bool
+}
+
+// Raw returns raw tg.ChannelAdminLogEvent.
+func (e AdminLogEvent) Raw() *tg.ChannelAdminLogEvent {
+	return &e.raw
+}
+
+// ID returns event ID.
+func (e AdminLogEvent) ID() int64 {
+	return e.raw.ID
+}
+
+// Date returns event date.
+func (e AdminLogEvent) Date() time.Time {
+	return time.Unix(int64(e.raw.Date), 0)
+}
+
+// User returns user that triggered the event.
+func (e AdminLogEvent) User() User {
+	return e.user
+}
+
+// Action returns event action.
+func (e AdminLogEvent) Action() tg.ChannelAdminLogEventActionClass {
+	return e.raw.Action
+}
+
+// Target returns user affected by event, if any.
+//
+// Target is available for participant-related actions like bans, admin
+// rights changes and invites.
+func (e AdminLogEvent) Target() (User, bool) {
+	return e.target, e.hasTarget
+}
+
+// Message returns message affected by event, if any.
+//
+// For edits, new version of message is returned.
+func (e AdminLogEvent) Message() (tg.MessageClass, bool) {
+	switch a := e.raw.Action.(type) {
+	case *tg.ChannelAdminLogEventActionUpdatePinned:
+		return a.Message, true
+	case *tg.ChannelAdminLogEventActionEditMessage:
+		return a.NewMessage, true
+	case *tg.ChannelAdminLogEventActionDeleteMessage:
+		return a.Message, true
+	case *tg.ChannelAdminLogEventActionStopPoll:
+		return a.Message, true
+	case *tg.ChannelAdminLogEventActionSendMessage:
+		return a.Message, true
+	default:
+		return nil, false
+	}
+}
+
+func participantUserID(p tg.ChannelParticipantClass) (int64, bool) {
+	switch p := p.(type) {
+	case *tg.ChannelParticipant:
+		return p.UserID, true
+	case *tg.ChannelParticipantSelf:
+		return p.UserID, true
+	case *tg.ChannelParticipantCreator:
+		return p.UserID, true
+	case *tg.ChannelParticipantAdmin:
+		return p.UserID, true
+	case *tg.ChannelParticipantBanned:
+		u, ok := p.Peer.(*tg.PeerUser)
+		if !ok {
+			return 0, false
+		}
+		return u.UserID, true
+	case *tg.ChannelParticipantLeft:
+		u, ok := p.Peer.(*tg.PeerUser)
+		if !ok {
+			return 0, false
+		}
+		return u.UserID, true
+	default:
+		return 0, false
+	}
+}
+
+func adminLogTargetID(action tg.ChannelAdminLogEventActionClass) (int64, bool) {
+	switch a := action.(type) {
+	case *tg.ChannelAdminLogEventActionParticipantInvite:
+		return participantUserID(a.Participant)
+	case *tg.ChannelAdminLogEventActionParticipantToggleBan:
+		return participantUserID(a.NewParticipant)
+	case *tg.ChannelAdminLogEventActionParticipantToggleAdmin:
+		return participantUserID(a.NewParticipant)
+	case *tg.ChannelAdminLogEventActionParticipantSubExtend:
+		return participantUserID(a.NewParticipant)
+	default:
+		return 0, false
+	}
+}
+
+func (l AdminLog) resolveUser(ctx context.Context, users map[int64]*tg.User, id int64) (User, error) {
+	if u, ok := users[id]; ok {
+		return l.m.User(u), nil
+	}
+	return l.m.ResolveUserID(ctx, id)
+}
+
+func (l AdminLog) apply(ctx context.Context, r *tg.ChannelsAdminLogResults) ([]AdminLogEvent, error) {
+	if err := l.m.applyEntities(ctx, r.Users, r.Chats); err != nil {
+		return nil, errors.Wrap(err, "apply entities")
+	}
+	users := make(map[int64]*tg.User, len(r.Users))
+	for _, u := range r.Users {
+		if u, ok := u.AsNotEmpty(); ok {
+			users[u.ID] = u
+		}
+	}
+
+	events := make([]AdminLogEvent, 0, len(r.Events))
+	for _, raw := range r.Events {
+		user, err := l.resolveUser(ctx, users, raw.UserID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolve user %d", raw.UserID)
+		}
+		e := AdminLogEvent{
+			raw:  raw,
+			user: user,
+		}
+		if id, ok := adminLogTargetID(raw.Action); ok {
+			target, err := l.resolveUser(ctx, users, id)
+			if err != nil {
+				return nil, errors.Wrapf(err, "resolve target %d", id)
+			}
+			e.target = target
+			e.hasTarget = true
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// Get returns one page of admin log events, from newest to oldest.
+func (l AdminLog) Get(ctx context.Context, opts AdminLogOptions, limit int) ([]AdminLogEvent, error) {
+	r, err := l.m.api.ChannelsGetAdminLog(ctx, opts.request(l.channel.InputChannel(), limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "get admin log")
+	}
+	return l.apply(ctx, r)
+}
+
+// ForEach calls cb for every admin log event, from newest to oldest.
+func (l AdminLog) ForEach(ctx context.Context, opts AdminLogOptions, cb func(AdminLogEvent) error) error {
+	const limit = 100
+
+	seen := 0
+	for {
+		events, err := l.Get(ctx, opts, limit)
+		if err != nil {
+			return err
+		}
+		for i, e := range events {
+			if err := cb(e); err != nil {
+				return errors.Wrapf(err, "callback (index: %d)", seen+i)
+			}
+		}
+		seen += len(events)
+		if len(events) < limit {
+			return nil
+		}
+		opts.MaxID = events[len(events)-1].ID()
+	}
+}
@@ -0,0 +1,143 @@
+package peers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/gotd/td/clock"
+)
+
+// AdminLogPollerOptions is options of AdminLogPoller.
+type AdminLogPollerOptions struct {
+	// Query, Filter and Admins to use. MinID and MaxID are ignored.
+	Filter AdminLogOptions
+	// LastID is ID of last processed event.
+	//
+	// Only events newer than LastID are delivered. If zero, events
+	// that are already in the log are skipped.
+	LastID int64
+	// Interval between polls. Defaults to 10 seconds.
+	Interval time.Duration
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger to use. Defaults to zerolog.Nop.
+	Logger *zerolog.Logger
+}
+
+func (o *AdminLogPollerOptions) setDefaults() {
+	if o.Interval == 0 {
+		o.Interval = 10 * time.Second
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		nop := zerolog.Nop()
+		o.Logger = &nop
+	}
+}
+
+// AdminLogPoller turns admin log into incremental stream of events.
+//
+// Telegram does not send updates for admin log, so poller periodically
+// requests events newer than last seen one.
+type AdminLogPoller struct {
+	log      AdminLog
+	filter   AdminLogOptions
+	lastID   int64
+	interval time.Duration
+	clock    clock.Clock
+	logger   *zerolog.Logger
+}
+
+// Poller creates new AdminLogPoller.
+func (l AdminLog) Poller(opts AdminLogPollerOptions) *AdminLogPoller {
+	opts.setDefaults()
+	filter := opts.Filter
+	filter.MinID = 0
+	filter.MaxID = 0
+	return &AdminLogPoller{
+		log:      l,
+		filter:   filter,
+		lastID:   opts.LastID,
+		interval: opts.Interval,
+		clock:    opts.Clock,
+		logger:   opts.Logger,
+	}
+}
+
+// LastID returns ID of last delivered event.
+//
+// It can be persisted and passed as AdminLogPollerOptions.LastID to resume
+// polling later.
+func (p *AdminLogPoller) LastID() int64 {
+	return p.lastID
+}
+
+func (p *AdminLogPoller) init(ctx context.Context) error {
+	if p.lastID != 0 {
+		return nil
+	}
+	events, err := p.log.Get(ctx, p.filter, 1)
+	if err != nil {
+		return errors.Wrap(err, "get last event")
+	}
+	if len(events) > 0 {
+		p.lastID = events[0].ID()
+	}
+	return nil
+}
+
+// Poll requests new events once and calls cb for each of them, from oldest
+// to newest.
+func (p *AdminLogPoller) Poll(ctx context.Context, cb func(AdminLogEvent) error) error {
+	opts := p.filter
+	opts.MinID = p.lastID
+
+	var events []AdminLogEvent
+	if err := p.log.ForEach(ctx, opts, func(e AdminLogEvent) error {
+		events = append(events, e)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for i := len(events) - 1; i >= 0; i-- {
+		e := events[i]
+		if e.ID() <= p.lastID {
+			continue
+		}
+		if err := cb(e); err != nil {
+			return errors.Wrapf(err, "callback (id: %d)", e.ID())
+		}
+		p.lastID = e.ID()
+	}
+	return nil
+}
+
+// Run polls admin log until context is done, calling cb for every new event
+// from oldest to newest.
+func (p *AdminLogPoller) Run(ctx context.Context, cb func(AdminLogEvent) error) error {
+	if err := p.init(ctx); err != nil {
+		return errors.Wrap(err, "init")
+	}
+	p.logger.Debug().Int64("last_id", p.lastID).Msg("Starting admin log polling")
+
+	ticker := p.clock.Ticker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Poll(ctx, cb); err != nil {
+			return errors.Wrap(err, "poll")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package peers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func getTestAdminLogEvent(id int64, action tg.ChannelAdminLogEventActionClass) tg.ChannelAdminLogEvent {
+	return tg.ChannelAdminLogEvent{
+		ID:     id,
+		Date:   int(id),
+		UserID: getTestSelf().ID,
+		Action: action,
+	}
+}
+
+func TestAdminLog_ForEach(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	ch := m.Channel(getTestChannel())
+	target := getTestUser()
+
+	filter := tg.ChannelAdminLogEventsFilter{Ban: true}
+	req := &tg.ChannelsGetAdminLogRequest{
+		Channel: ch.InputChannel(),
+		Q:       "spam",
+		Limit:   100,
+	}
+	req.SetEventsFilter(filter)
+	mock.ExpectCall(req).ThenResult(&tg.ChannelsAdminLogResults{
+		Events: []tg.ChannelAdminLogEvent{
+			getTestAdminLogEvent(2, &tg.ChannelAdminLogEventActionParticipantToggleBan{
+				PrevParticipant: &tg.ChannelParticipant{UserID: target.ID},
+				NewParticipant: &tg.ChannelParticipantBanned{
+					Peer:         &tg.PeerUser{UserID: target.ID},
+					BannedRights: tg.ChatBannedRights{ViewMessages: true},
+				},
+			}),
+			getTestAdminLogEvent(1, &tg.ChannelAdminLogEventActionChangeTitle{
+				PrevValue: "a",
+				NewValue:  "b",
+			}),
+		},
+		Users: []tg.UserClass{getTestSelf(), target},
+	})
+
+	var events []AdminLogEvent
+	a.NoError(ch.AdminLog().ForEach(ctx, AdminLogOptions{
+		Query:  "spam",
+		Filter: filter,
+	}, func(e AdminLogEvent) error {
+		events = append(events, e)
+		return nil
+	}))
+	a.Len(events, 2)
+
+	ban := events[0]
+	a.Equal(int64(2), ban.ID())
+	a.Equal(getTestSelf().ID, ban.User().ID())
+	u, ok := ban.Target()
+	a.True(ok)
+	a.Equal(target.ID, u.ID())
+	a.IsType(&tg.ChannelAdminLogEventActionParticipantToggleBan{}, ban.Action())
+
+	title := events[1]
+	_, ok = title.Target()
+	a.False(ok)
+	_, ok = title.Message()
+	a.False(ok)
+}
+
+func TestAdminLogPoller(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	ch := m.Channel(getTestChannel())
+	msg := &tg.Message{
+		ID:      10,
+		PeerID:  &tg.PeerChannel{ChannelID: ch.ID()},
+		Message: "text",
+	}
+	result := func(events ...tg.ChannelAdminLogEvent) *tg.ChannelsAdminLogResults {
+		return &tg.ChannelsAdminLogResults{
+			Events: events,
+			Users:  []tg.UserClass{getTestSelf()},
+		}
+	}
+
+	// Initial request skips existing events.
+	mock.ExpectCall(&tg.ChannelsGetAdminLogRequest{
+		Channel: ch.InputChannel(),
+		Limit:   1,
+	}).ThenResult(result(getTestAdminLogEvent(5, &tg.ChannelAdminLogEventActionToggleInvites{})))
+
+	p := ch.AdminLog().Poller(AdminLogPollerOptions{})
+	a.NoError(p.init(ctx))
+	a.Equal(int64(5), p.LastID())
+
+	// New events are delivered from oldest to newest.
+	mock.ExpectCall(&tg.ChannelsGetAdminLogRequest{
+		Channel: ch.InputChannel(),
+		MinID:   5,
+		Limit:   100,
+	}).ThenResult(result(
+		getTestAdminLogEvent(7, &tg.ChannelAdminLogEventActionDeleteMessage{Message: msg}),
+		getTestAdminLogEvent(6, &tg.ChannelAdminLogEventActionToggleInvites{}),
+	))
+
+	var ids []int64
+	a.NoError(p.Poll(ctx, func(e AdminLogEvent) error {
+		ids = append(ids, e.ID())
+		if e.ID() == 7 {
+			m, ok := e.Message()
+			a.True(ok)
+			a.Equal(10, m.GetID())
+		}
+		return nil
+	}))
+	a.Equal([]int64{6, 7}, ids)
+	a.Equal(int64(7), p.LastID())
+
+	// Callback error stops Run and keeps LastID at last delivered event.
+	mock.ExpectCall(&tg.ChannelsGetAdminLogRequest{
+		Channel: ch.InputChannel(),
+		MinID:   7,
+		Limit:   100,
+	}).ThenResult(result(
+		getTestAdminLogEvent(9, &tg.ChannelAdminLogEventActionToggleInvites{}),
+		getTestAdminLogEvent(8, &tg.ChannelAdminLogEventActionToggleInvites{}),
+	))
+	testErr := errors.New("test")
+	err := p.Run(ctx, func(e AdminLogEvent) error {
+		if e.ID() == 9 {
+			return testErr
+		}
+		return nil
+	})
+	a.ErrorIs(err, testErr)
+	a.Equal(int64(8), p.LastID())
+}
@@ -2,6 +2,7 @@ package peers
 
 import (
 	"context"
+	"sort"
 
 	"github.com/go-faster/errors"
 	"go.uber.org/multierr"
@@ -219,20 +220,31 @@ func (h *vectorHash) apply(n uint64) {
 
 // See https://github.com/tdlib/td/blob/aa8a4979df8fc56032f134471a2cb939a7b0839f/td/telegram/ContactsManager.cpp#L5125.
 func contactsHash(myID int64, contacts *tg.ContactsContacts) int64 {
-	contacts.MapUsers().SortStableByID()
+	ids := make([]int64, 0, len(contacts.Users))
+	for _, user := range contacts.Users {
+		ids = append(ids, user.GetID())
+	}
+	return contactsHashIDs(myID, ids)
+}
+
+// contactsHashIDs computes contacts hash from given contact user IDs.
+//
+// NB: sorts ids.
+func contactsHashIDs(myID int64, ids []int64) int64 {
+	sort.SliceStable(ids, func(i, j int) bool { return ids[i] < ids[j] })
 
-	var lesserIDx = len(contacts.Users) - 1
-	for i, user := range contacts.Users {
-		if user.GetID() < myID {
+	var lesserIDx = len(ids) - 1
+	for i, id := range ids {
+		if id < myID {
 			lesserIDx = i
 			break
 		}
 	}
 
 	var h vectorHash
-	h.apply(uint64(len(contacts.Users)))
-	for i, contact := range contacts.Users {
-		h.apply(uint64(contact.GetID()))
+	h.apply(uint64(len(ids)))
+	for i, id := range ids {
+		h.apply(uint64(id))
 		if i == lesserIDx {
 			h.apply(uint64(myID))
 		}
@@ -0,0 +1,253 @@
+package peers
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/deeplink"
+	"github.com/gotd/td/tg"
+)
+
+// ChatlistInvite represents invite link to shared chat folder.
+//
+// See https://core.telegram.org/api/folders#shared-folders.
+type ChatlistInvite struct {
+	raw   tg.ExportedChatlistInvite
+	peers []Peer
+}
+
+// Raw returns raw tg.ExportedChatlistInvite.
+func (i ChatlistInvite) Raw() tg.ExportedChatlistInvite {
+	return i.raw
+}
+
+// Title returns invite title.
+func (i ChatlistInvite) Title() string {
+	return i.raw.Title
+}
+
+// URL returns invite link.
+func (i ChatlistInvite) URL() string {
+	return i.raw.URL
+}
+
+// Slug returns invite slug, which is used to edit or delete invite.
+func (i ChatlistInvite) Slug() (string, bool) {
+	l, err := deeplink.Expect(i.raw.URL, deeplink.AddList)
+	if err != nil {
+		return "", false
+	}
+	return l.Args.Get("slug"), true
+}
+
+// Peers returns peers shared by invite.
+func (i ChatlistInvite) Peers() []Peer {
+	return i.peers
+}
+
+func (m *Manager) resolvePeers(ctx context.Context, peers []tg.PeerClass) ([]Peer, error) {
+	r := make([]Peer, 0, len(peers))
+	for i, p := range peers {
+		resolved, err := m.ResolvePeer(ctx, p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolve %d (%+v)", i, p)
+		}
+		r = append(r, resolved)
+	}
+	return r, nil
+}
+
+func (m *Manager) chatlistInvite(ctx context.Context, raw tg.ExportedChatlistInvite) (ChatlistInvite, error) {
+	peers, err := m.resolvePeers(ctx, raw.Peers)
+	if err != nil {
+		return ChatlistInvite{}, errors.Wrap(err, "peers")
+	}
+	return ChatlistInvite{
+		raw:   raw,
+		peers: peers,
+	}, nil
+}
+
+func (f DialogFilter) inputChatlist() tg.InputChatlistDialogFilter {
+	return tg.InputChatlistDialogFilter{FilterID: f.ID()}
+}
+
+// ExportInvite creates invite link to filter with given peers.
+//
+// Regular filter becomes shared chat folder after exporting first invite.
+// Peers must be chats and channels, where current user can invite users.
+func (f DialogFilter) ExportInvite(ctx context.Context, title string, peers ...Peer) (ChatlistInvite, error) {
+	r, err := f.m.api.ChatlistsExportChatlistInvite(ctx, &tg.ChatlistsExportChatlistInviteRequest{
+		Chatlist: f.inputChatlist(),
+		Title:    title,
+		Peers:    inputPeers(peers),
+	})
+	if err != nil {
+		return ChatlistInvite{}, errors.Wrap(err, "export chatlist invite")
+	}
+	return ChatlistInvite{
+		raw:   r.Invite,
+		peers: peers,
+	}, nil
+}
+
+// Invites returns invite links to shared chat folder.
+func (f DialogFilter) Invites(ctx context.Context) ([]ChatlistInvite, error) {
+	r, err := f.m.api.ChatlistsGetExportedInvites(ctx, f.inputChatlist())
+	if err != nil {
+		return nil, errors.Wrap(err, "get exported invites")
+	}
+	if err := f.m.applyEntities(ctx, r.Users, r.Chats); err != nil {
+		return nil, errors.Wrap(err, "apply entities")
+	}
+
+	result := make([]ChatlistInvite, 0, len(r.Invites))
+	for i, raw := range r.Invites {
+		invite, err := f.m.chatlistInvite(ctx, raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invite %d", i)
+		}
+		result = append(result, invite)
+	}
+	return result, nil
+}
+
+// EditInvite sets title and peers of invite link with given slug.
+func (f DialogFilter) EditInvite(ctx context.Context, slug, title string, peers ...Peer) (ChatlistInvite, error) {
+	req := &tg.ChatlistsEditExportedInviteRequest{
+		Chatlist: f.inputChatlist(),
+		Slug:     slug,
+	}
+	req.SetTitle(title)
+	req.SetPeers(inputPeers(peers))
+
+	r, err := f.m.api.ChatlistsEditExportedInvite(ctx, req)
+	if err != nil {
+		return ChatlistInvite{}, errors.Wrap(err, "edit exported invite")
+	}
+	return ChatlistInvite{
+		raw:   *r,
+		peers: peers,
+	}, nil
+}
+
+// DeleteInvite deletes invite link with given slug.
+func (f DialogFilter) DeleteInvite(ctx context.Context, slug string) error {
+	if _, err := f.m.api.ChatlistsDeleteExportedInvite(ctx, &tg.ChatlistsDeleteExportedInviteRequest{
+		Chatlist: f.inputChatlist(),
+		Slug:     slug,
+	}); err != nil {
+		return errors.Wrap(err, "delete exported invite")
+	}
+	return nil
+}
+
+// ChatlistPreview is a result of chat folder invite check.
+type ChatlistPreview struct {
+	// Slug of invite.
+	Slug string
+	// Title of shared chat folder.
+	//
+	// Empty if folder is already added.
+	Title string
+	// Emoticon of shared chat folder, if any.
+	Emoticon string
+	// FilterID is ID of already added folder, zero otherwise.
+	FilterID int
+	// Peers which are not joined yet.
+	Peers []Peer
+	// Joined peers.
+	Joined []Peer
+}
+
+// Already whether shared chat folder is already added.
+func (p ChatlistPreview) Already() bool {
+	return p.FilterID != 0
+}
+
+// chatlistSlug extracts slug from chat folder link or returns it as is.
+func chatlistSlug(link string) (string, error) {
+	if !deeplink.IsDeeplinkLike(link) {
+		return link, nil
+	}
+	l, err := deeplink.Expect(link, deeplink.AddList)
+	if err != nil {
+		return "", err
+	}
+	return l.Args.Get("slug"), nil
+}
+
+// CheckChatlist returns info about shared chat folder by given link or slug.
+// Input examples:
+//
+//	t.me/addlist/AAAAAAAAAAAAAAAA
+//	https://t.me/addlist/AAAAAAAAAAAAAAAA
+//	tg:addlist?slug=AAAAAAAAAAAAAAAA
+//	tg://addlist?slug=AAAAAAAAAAAAAAAA
+//	AAAAAAAAAAAAAAAA
+func (m *Manager) CheckChatlist(ctx context.Context, link string) (ChatlistPreview, error) {
+	slug, err := chatlistSlug(link)
+	if err != nil {
+		return ChatlistPreview{}, err
+	}
+
+	r, err := m.api.ChatlistsCheckChatlistInvite(ctx, slug)
+	if err != nil {
+		return ChatlistPreview{}, errors.Wrap(err, "check chatlist invite")
+	}
+
+	preview := ChatlistPreview{Slug: slug}
+	switch r := r.(type) {
+	case *tg.ChatlistsChatlistInviteAlready:
+		if err := m.applyEntities(ctx, r.Users, r.Chats); err != nil {
+			return ChatlistPreview{}, errors.Wrap(err, "apply entities")
+		}
+		preview.FilterID = r.FilterID
+		if preview.Peers, err = m.resolvePeers(ctx, r.MissingPeers); err != nil {
+			return ChatlistPreview{}, errors.Wrap(err, "missing peers")
+		}
+		if preview.Joined, err = m.resolvePeers(ctx, r.AlreadyPeers); err != nil {
+			return ChatlistPreview{}, errors.Wrap(err, "already peers")
+		}
+	case *tg.ChatlistsChatlistInvite:
+		if err := m.applyEntities(ctx, r.Users, r.Chats); err != nil {
+			return ChatlistPreview{}, errors.Wrap(err, "apply entities")
+		}
+		preview.Title = r.Title.Text
+		preview.Emoticon = r.Emoticon
+		if preview.Peers, err = m.resolvePeers(ctx, r.Peers); err != nil {
+			return ChatlistPreview{}, errors.Wrap(err, "peers")
+		}
+	default:
+		return ChatlistPreview{}, errors.Errorf("unexpected type %T", r)
+	}
+	return preview, nil
+}
+
+// JoinChatlist adds shared chat folder by given link or slug and joins all
+// its peers.
+//
+// See CheckChatlist for input examples.
+func (m *Manager) JoinChatlist(ctx context.Context, link string) (ChatlistPreview, error) {
+	preview, err := m.CheckChatlist(ctx, link)
+	if err != nil {
+		return ChatlistPreview{}, err
+	}
+	if err := m.JoinChatlistInvite(ctx, preview.Slug, preview.Peers...); err != nil {
+		return ChatlistPreview{}, err
+	}
+	return preview, nil
+}
+
+// JoinChatlistInvite adds shared chat folder with given slug and joins
+// given peers.
+func (m *Manager) JoinChatlistInvite(ctx context.Context, slug string, peers ...Peer) error {
+	if _, err := m.api.ChatlistsJoinChatlistInvite(ctx, &tg.ChatlistsJoinChatlistInviteRequest{
+		Slug:  slug,
+		Peers: inputPeers(peers),
+	}); err != nil {
+		return errors.Wrap(err, "join chatlist invite")
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package peers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestManager_CreateDialogFilter(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	ch := m.Channel(getTestChannel())
+	mock.ExpectCall(&tg.MessagesGetDialogFiltersRequest{}).ThenResult(&tg.MessagesDialogFilters{
+		Filters: []tg.DialogFilterClass{
+			&tg.DialogFilterDefault{},
+			&tg.DialogFilter{ID: 2, Title: tg.TextWithEntities{Text: "Work"}},
+			&tg.DialogFilterChatlist{ID: 3, Title: tg.TextWithEntities{Text: "Shared"}},
+		},
+	})
+
+	expected := &tg.DialogFilter{
+		Groups:       true,
+		ID:           4,
+		Title:        tg.TextWithEntities{Text: "Groups"},
+		PinnedPeers:  []tg.InputPeerClass{},
+		IncludePeers: []tg.InputPeerClass{ch.InputPeer()},
+		ExcludePeers: []tg.InputPeerClass{},
+	}
+	expected.SetEmoticon("👥")
+	req := &tg.MessagesUpdateDialogFilterRequest{ID: 4}
+	req.SetFilter(expected)
+	mock.ExpectCall(req).ThenTrue()
+
+	f, err := m.CreateDialogFilter(ctx, DialogFilterSpec{
+		Title:    "Groups",
+		Emoticon: "👥",
+		Flags:    DialogFilterFlags{Groups: true},
+		Include:  []Peer{ch},
+	})
+	a.NoError(err)
+	a.Equal(4, f.ID())
+	a.Equal("Groups", f.Title())
+	emoticon, ok := f.Emoticon()
+	a.True(ok)
+	a.Equal("👥", emoticon)
+	a.True(f.Flags().Groups)
+	a.Len(f.Include(), 1)
+}
+
+func TestDialogFilter_Invites(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	ch := m.Channel(getTestChannel())
+	f := DialogFilter{
+		raw: &tg.DialogFilter{ID: 2, Title: tg.TextWithEntities{Text: "Work"}},
+		m:   m,
+	}
+	chatlist := tg.InputChatlistDialogFilter{FilterID: 2}
+	invite := tg.ExportedChatlistInvite{
+		Title: "Invite",
+		URL:   "https://t.me/addlist/AAAAAAAAAAAAAAAA",
+		Peers: []tg.PeerClass{&tg.PeerChannel{ChannelID: ch.ID()}},
+	}
+
+	mock.ExpectCall(&tg.ChatlistsExportChatlistInviteRequest{
+		Chatlist: chatlist,
+		Title:    "Invite",
+		Peers:    []tg.InputPeerClass{ch.InputPeer()},
+	}).ThenResult(&tg.ChatlistsExportedChatlistInvite{
+		Filter: &tg.DialogFilterChatlist{ID: 2, Title: tg.TextWithEntities{Text: "Work"}},
+		Invite: invite,
+	})
+	exported, err := f.ExportInvite(ctx, "Invite", ch)
+	a.NoError(err)
+	slug, ok := exported.Slug()
+	a.True(ok)
+	a.Equal("AAAAAAAAAAAAAAAA", slug)
+	a.Equal(invite.URL, exported.URL())
+
+	mock.ExpectCall(&tg.ChatlistsGetExportedInvitesRequest{
+		Chatlist: chatlist,
+	}).ThenResult(&tg.ChatlistsExportedInvites{
+		Invites: []tg.ExportedChatlistInvite{invite},
+		Chats:   []tg.ChatClass{getTestChannel()},
+	})
+	invites, err := f.Invites(ctx)
+	a.NoError(err)
+	a.Len(invites, 1)
+	a.Equal("Invite", invites[0].Title())
+	a.Len(invites[0].Peers(), 1)
+	a.Equal(ch.ID(), invites[0].Peers()[0].ID())
+
+	edit := &tg.ChatlistsEditExportedInviteRequest{
+		Chatlist: chatlist,
+		Slug:     slug,
+	}
+	edit.SetTitle("Renamed")
+	edit.SetPeers([]tg.InputPeerClass{})
+	renamed := invite
+	renamed.Title = "Renamed"
+	mock.ExpectCall(edit).ThenResult(&renamed)
+	edited, err := f.EditInvite(ctx, slug, "Renamed")
+	a.NoError(err)
+	a.Equal("Renamed", edited.Title())
+
+	mock.ExpectCall(&tg.ChatlistsDeleteExportedInviteRequest{
+		Chatlist: chatlist,
+		Slug:     slug,
+	}).ThenTrue()
+	a.NoError(f.DeleteInvite(ctx, slug))
+}
+
+func TestManager_JoinChatlist(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	ch := m.Channel(getTestChannel())
+	mock.ExpectCall(&tg.ChatlistsCheckChatlistInviteRequest{
+		Slug: "AAAAAAAAAAAAAAAA",
+	}).ThenResult(&tg.ChatlistsChatlistInvite{
+		Title: tg.TextWithEntities{Text: "Shared"},
+		Peers: []tg.PeerClass{&tg.PeerChannel{ChannelID: ch.ID()}},
+		Chats: []tg.ChatClass{getTestChannel()},
+	})
+	mock.ExpectCall(&tg.ChatlistsJoinChatlistInviteRequest{
+		Slug:  "AAAAAAAAAAAAAAAA",
+		Peers: []tg.InputPeerClass{ch.InputPeer()},
+	}).ThenResult(&tg.Updates{})
+
+	preview, err := m.JoinChatlist(ctx, "https://t.me/addlist/AAAAAAAAAAAAAAAA")
+	a.NoError(err)
+	a.Equal("Shared", preview.Title)
+	a.False(preview.Already())
+	a.Len(preview.Peers, 1)
+
+	mock.ExpectCall(&tg.ChatlistsCheckChatlistInviteRequest{
+		Slug: "BBBB",
+	}).ThenResult(&tg.ChatlistsChatlistInviteAlready{
+		FilterID:     5,
+		MissingPeers: []tg.PeerClass{},
+		AlreadyPeers: []tg.PeerClass{&tg.PeerChannel{ChannelID: ch.ID()}},
+		Chats:        []tg.ChatClass{getTestChannel()},
+	})
+	preview, err = m.CheckChatlist(ctx, "BBBB")
+	a.NoError(err)
+	a.True(preview.Already())
+	a.Empty(preview.Peers)
+	a.Len(preview.Joined, 1)
+
+	_, err = m.CheckChatlist(ctx, "https://t.me/+AAAAAAAAAAAAAAAA")
+	a.Error(err)
+}
@@ -0,0 +1,439 @@
+package peers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// PhoneContact is a contact to import.
+type PhoneContact struct {
+	// Phone number of contact.
+	Phone string
+	// FirstName of contact.
+	FirstName string
+	// LastName of contact.
+	LastName string
+	// ClientID is an arbitrary ID used to match import results.
+	//
+	// Must be unique within one import. If zero, index of contact
+	// (starting from 1) is used.
+	ClientID int64
+}
+
+// ImportedContact is a contact imported by ImportContacts.
+type ImportedContact struct {
+	Contact PhoneContact
+	User    User
+}
+
+// PopularContact is a contact which is not registered in Telegram, but
+// imported by many users.
+type PopularContact struct {
+	Contact PhoneContact
+	// Importers is count of users that imported this contact.
+	Importers int
+}
+
+// ImportResult is a result of ImportContacts.
+type ImportResult struct {
+	// Imported contacts with resolved users.
+	Imported []ImportedContact
+	// NotFound contacts, which are not registered in Telegram.
+	NotFound []PhoneContact
+	// Popular contains not found contacts which are imported by many users.
+	Popular []PopularContact
+	// Failed contacts are contacts that server asked to retry, but retry
+	// limit was exceeded, or contacts that were not imported because
+	// FLOOD_WAIT exceeded ImportOptions.MaxFloodWait.
+	Failed []PhoneContact
+}
+
+// ImportOptions is options of ImportContacts.
+type ImportOptions struct {
+	// BatchSize is maximum count of contacts imported by one request.
+	//
+	// Defaults to 100.
+	BatchSize int
+	// MaxRetries is maximum count of retries of contacts that server
+	// returned in retry_contacts.
+	//
+	// Defaults to 3.
+	MaxRetries int
+	// RetryInterval is delay before retrying contacts.
+	//
+	// Defaults to 5 seconds.
+	RetryInterval time.Duration
+	// MaxFloodWait is maximum FLOOD_WAIT duration to wait for.
+	//
+	// If server asks to wait longer, import is stopped and partial result
+	// is returned along with FLOOD_WAIT error.
+	//
+	// Defaults to 1 minute.
+	MaxFloodWait time.Duration
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger to use. Defaults to zerolog.Nop.
+	Logger *zerolog.Logger
+}
+
+func (o *ImportOptions) setDefaults() {
+	if o.BatchSize == 0 {
+		o.BatchSize = 100
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 3
+	}
+	if o.RetryInterval == 0 {
+		o.RetryInterval = 5 * time.Second
+	}
+	if o.MaxFloodWait == 0 {
+		o.MaxFloodWait = time.Minute
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		nop := zerolog.Nop()
+		o.Logger = &nop
+	}
+}
+
+func sleep(ctx context.Context, c clock.Clock, d time.Duration) error {
+	timer := c.Timer(d)
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		clock.StopTimer(timer)
+		return ctx.Err()
+	}
+}
+
+func (m *Manager) importContacts(
+	ctx context.Context,
+	opts ImportOptions,
+	input []tg.InputPhoneContact,
+) (*tg.ContactsImportedContacts, error) {
+	for {
+		r, err := m.api.ContactsImportContacts(ctx, input)
+		if err == nil {
+			return r, nil
+		}
+
+		d, ok := tgerr.AsFloodWait(err)
+		if !ok || d > opts.MaxFloodWait {
+			return nil, err
+		}
+		opts.Logger.Debug().Dur("duration", d).Msg("Flood wait")
+
+		if err := sleep(ctx, opts.Clock, d); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ImportContacts imports given contacts in batches.
+//
+// FLOOD_WAIT errors are handled by waiting, and contacts that server asks
+// to retry are imported again after ImportOptions.RetryInterval.
+//
+// If FLOOD_WAIT exceeds ImportOptions.MaxFloodWait, partial result is
+// returned with remaining contacts in ImportResult.Failed.
+func (m *Manager) ImportContacts(ctx context.Context, contacts []PhoneContact, opts ImportOptions) (ImportResult, error) {
+	opts.setDefaults()
+
+	byID := make(map[int64]PhoneContact, len(contacts))
+	pending := make([]PhoneContact, 0, len(contacts))
+	for i, c := range contacts {
+		if c.ClientID == 0 {
+			c.ClientID = int64(i + 1)
+		}
+		if _, ok := byID[c.ClientID]; ok {
+			return ImportResult{}, errors.Errorf("duplicate client ID %d", c.ClientID)
+		}
+		byID[c.ClientID] = c
+		pending = append(pending, c)
+	}
+
+	var result ImportResult
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			if attempt > opts.MaxRetries {
+				result.Failed = pending
+				break
+			}
+			opts.Logger.Debug().
+				Int("attempt", attempt).
+				Int("contacts", len(pending)).
+				Msg("Retrying contacts")
+			if err := sleep(ctx, opts.Clock, opts.RetryInterval); err != nil {
+				return result, err
+			}
+		}
+
+		var retry []PhoneContact
+		for len(pending) > 0 {
+			batch := pending
+			if len(batch) > opts.BatchSize {
+				batch = batch[:opts.BatchSize]
+			}
+			pending = pending[len(batch):]
+
+			input := make([]tg.InputPhoneContact, len(batch))
+			for i, c := range batch {
+				input[i] = tg.InputPhoneContact{
+					ClientID:  c.ClientID,
+					Phone:     cleanupPhone(c.Phone),
+					FirstName: c.FirstName,
+					LastName:  c.LastName,
+				}
+			}
+
+			r, err := m.importContacts(ctx, opts, input)
+			if err != nil {
+				if _, ok := tgerr.AsFloodWait(err); ok {
+					result.Failed = append(result.Failed, batch...)
+					result.Failed = append(result.Failed, pending...)
+					result.Failed = append(result.Failed, retry...)
+				}
+				return result, errors.Wrap(err, "import contacts")
+			}
+			if err := m.applyUsers(ctx, r.Users...); err != nil {
+				return result, errors.Wrap(err, "apply users")
+			}
+			users := r.MapUsers().NotEmptyToMap()
+
+			done := make(map[int64]struct{}, len(batch))
+			for _, imported := range r.Imported {
+				u, ok := users[imported.UserID]
+				if !ok {
+					return result, errors.Errorf("user %d not found in result", imported.UserID)
+				}
+				done[imported.ClientID] = struct{}{}
+				result.Imported = append(result.Imported, ImportedContact{
+					Contact: byID[imported.ClientID],
+					User:    m.User(u),
+				})
+			}
+			for _, id := range r.RetryContacts {
+				done[id] = struct{}{}
+				if c, ok := byID[id]; ok {
+					retry = append(retry, c)
+				}
+			}
+			for _, popular := range r.PopularInvites {
+				result.Popular = append(result.Popular, PopularContact{
+					Contact:   byID[popular.ClientID],
+					Importers: popular.Importers,
+				})
+			}
+			for _, c := range batch {
+				if _, ok := done[c.ClientID]; !ok {
+					result.NotFound = append(result.NotFound, c)
+				}
+			}
+		}
+		pending = retry
+	}
+
+	return result, nil
+}
+
+// DeleteContacts deletes given users from contacts.
+func (m *Manager) DeleteContacts(ctx context.Context, users ...User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	input := make([]tg.InputUserClass, len(users))
+	for i, u := range users {
+		input[i] = u.InputUser()
+	}
+	if _, err := m.api.ContactsDeleteContacts(ctx, input); err != nil {
+		return errors.Wrap(err, "delete contacts")
+	}
+	return nil
+}
+
+// DeleteContactsByPhone deletes contacts with given phones.
+func (m *Manager) DeleteContactsByPhone(ctx context.Context, phones ...string) error {
+	if len(phones) == 0 {
+		return nil
+	}
+
+	clean := make([]string, len(phones))
+	for i, phone := range phones {
+		clean[i] = cleanupPhone(phone)
+	}
+	if _, err := m.api.ContactsDeleteByPhones(ctx, clean); err != nil {
+		return errors.Wrap(err, "delete by phones")
+	}
+	return nil
+}
+
+// ContactsSync is a result of SyncContacts.
+type ContactsSync struct {
+	// Modified is false if contact list was not changed.
+	//
+	// Other fields are empty in that case.
+	Modified bool
+	// Contacts is the full contact list.
+	Contacts []User
+	// Added contacts, which are not in known list.
+	Added []User
+	// Removed contact IDs, which are in known list, but not in contact list.
+	Removed []int64
+}
+
+// SyncContacts fetches contact list and compares it with known contact IDs.
+//
+// If known is nil, hash stored in Storage is used, so contact list is
+// considered modified if it was changed since last fetch by Manager.
+// Otherwise, hash is computed from known IDs.
+func (m *Manager) SyncContacts(ctx context.Context, known []int64) (ContactsSync, error) {
+	myID, haveID := m.myID()
+
+	var hash int64
+	switch {
+	case known == nil:
+		h, err := m.storage.GetContactsHash(ctx)
+		if err != nil {
+			return ContactsSync{}, errors.Wrap(err, "get contacts hash")
+		}
+		hash = h
+	case haveID:
+		hash = contactsHashIDs(myID, append([]int64(nil), known...))
+	}
+
+	r, err := m.api.ContactsGetContacts(ctx, hash)
+	if err != nil {
+		return ContactsSync{}, errors.Wrap(err, "get contacts")
+	}
+
+	c, ok := r.(*tg.ContactsContacts)
+	if !ok {
+		return ContactsSync{}, nil
+	}
+	if err := m.applyUsers(ctx, c.Users...); err != nil {
+		return ContactsSync{}, errors.Wrap(err, "apply users")
+	}
+	if haveID {
+		if err := m.storage.SaveContactsHash(ctx, contactsHash(myID, c)); err != nil {
+			return ContactsSync{}, errors.Wrap(err, "update contacts hash")
+		}
+	}
+
+	knownSet := make(map[int64]struct{}, len(known))
+	for _, id := range known {
+		knownSet[id] = struct{}{}
+	}
+
+	result := ContactsSync{Modified: true}
+	users := c.MapUsers().NotEmptyToMap()
+	for _, contact := range c.Contacts {
+		u, ok := users[contact.UserID]
+		if !ok {
+			continue
+		}
+		user := m.User(u)
+		result.Contacts = append(result.Contacts, user)
+
+		if _, ok := knownSet[contact.UserID]; ok {
+			delete(knownSet, contact.UserID)
+			continue
+		}
+		result.Added = append(result.Added, user)
+	}
+	for _, id := range known {
+		if _, ok := knownSet[id]; ok {
+			result.Removed = append(result.Removed, id)
+		}
+	}
+
+	return result, nil
+}
+
+// ResolvePhones resolves users by given phones.
+//
+// Returned map is keyed by given phone strings. Phones that are not
+// registered in Telegram or hidden by privacy settings are absent in it.
+//
+// Unlike ResolvePhone, it does not return PhoneNotFoundError and uses
+// contacts.resolvePhone for phones which are not in contact list.
+func (m *Manager) ResolvePhones(ctx context.Context, phones ...string) (map[string]User, error) {
+	result := make(map[string]User, len(phones))
+
+	var missing []string
+	for _, phone := range phones {
+		key, v, found, err := m.storage.FindPhone(ctx, cleanupPhone(phone))
+		if err != nil {
+			return nil, errors.Wrap(err, "find by phone")
+		}
+		if !found {
+			missing = append(missing, phone)
+			continue
+		}
+
+		u, err := m.GetUser(ctx, &tg.InputUser{
+			UserID:     key.ID,
+			AccessHash: v.AccessHash,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "get user %d", key.ID)
+		}
+		result[phone] = u
+	}
+	if len(missing) == 0 || m.selfIsBot() {
+		return result, nil
+	}
+
+	users, err := m.updateContacts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "update contacts")
+	}
+	byPhone := make(map[string]*tg.User, len(users))
+	for _, user := range users {
+		if u, ok := user.AsNotEmpty(); ok && u.Phone != "" {
+			byPhone[u.Phone] = u
+		}
+	}
+
+	for _, phone := range missing {
+		clean := cleanupPhone(phone)
+		if u, ok := byPhone[clean]; ok {
+			result[phone] = m.User(u)
+			continue
+		}
+
+		r, err := m.api.ContactsResolvePhone(ctx, clean)
+		if err != nil {
+			if tg.IsPhoneNotOccupied(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "resolve phone %q", phone)
+		}
+		if err := m.applyEntities(ctx, r.Users, r.Chats); err != nil {
+			return nil, errors.Wrap(err, "apply entities")
+		}
+
+		p, ok := r.Peer.(*tg.PeerUser)
+		if !ok {
+			continue
+		}
+		for _, user := range r.Users {
+			if u, ok := user.AsNotEmpty(); ok && u.ID == p.UserID {
+				result[phone] = m.User(u)
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,212 @@
+package peers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+func getTestContact(id int64, phone string) *tg.User {
+	return &tg.User{
+		ID:         id,
+		AccessHash: id,
+		Contact:    true,
+		FirstName:  "Contact",
+		Phone:      phone,
+	}
+}
+
+func TestManager_ImportContacts(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	contacts := []PhoneContact{
+		{Phone: "+1 (311) 555-0001", FirstName: "A"},
+		{Phone: "13115550002", FirstName: "B"},
+		{Phone: "13115550003", FirstName: "C"},
+	}
+	input := func(id int64) tg.InputPhoneContact {
+		c := contacts[id-1]
+		return tg.InputPhoneContact{
+			ClientID:  id,
+			Phone:     cleanupPhone(c.Phone),
+			FirstName: c.FirstName,
+		}
+	}
+
+	first := &tg.ContactsImportContactsRequest{
+		Contacts: []tg.InputPhoneContact{input(1), input(2)},
+	}
+	mock.ExpectCall(first).ThenErr(tgerr.New(420, "FLOOD_WAIT_0"))
+	mock.ExpectCall(first).ThenResult(&tg.ContactsImportedContacts{
+		Imported:      []tg.ImportedContact{{UserID: 11, ClientID: 1}},
+		RetryContacts: []int64{2},
+		Users:         []tg.UserClass{getTestContact(11, "13115550001")},
+	})
+	mock.ExpectCall(&tg.ContactsImportContactsRequest{
+		Contacts: []tg.InputPhoneContact{input(3)},
+	}).ThenResult(&tg.ContactsImportedContacts{
+		PopularInvites: []tg.PopularContact{{ClientID: 3, Importers: 5}},
+	})
+	mock.ExpectCall(&tg.ContactsImportContactsRequest{
+		Contacts: []tg.InputPhoneContact{input(2)},
+	}).ThenResult(&tg.ContactsImportedContacts{
+		Imported: []tg.ImportedContact{{UserID: 12, ClientID: 2}},
+		Users:    []tg.UserClass{getTestContact(12, "13115550002")},
+	})
+
+	r, err := m.ImportContacts(ctx, contacts, ImportOptions{
+		BatchSize:     2,
+		RetryInterval: time.Nanosecond,
+	})
+	a.NoError(err)
+	a.Len(r.Imported, 2)
+	a.Equal("A", r.Imported[0].Contact.FirstName)
+	a.Equal(int64(11), r.Imported[0].User.ID())
+	a.Equal("B", r.Imported[1].Contact.FirstName)
+	a.Equal(int64(12), r.Imported[1].User.ID())
+	a.Len(r.NotFound, 1)
+	a.Equal("C", r.NotFound[0].FirstName)
+	a.Equal([]PopularContact{{Contact: r.NotFound[0], Importers: 5}}, r.Popular)
+	a.Empty(r.Failed)
+
+	// Imported users are resolvable by phone.
+	u, err := m.ResolvePhone(ctx, contacts[1].Phone)
+	a.NoError(err)
+	a.Equal(int64(12), u.ID())
+
+	// Retry limit.
+	retry := &tg.ContactsImportContactsRequest{
+		Contacts: []tg.InputPhoneContact{input(1)},
+	}
+	mock.ExpectCall(retry).ThenResult(&tg.ContactsImportedContacts{RetryContacts: []int64{1}})
+	mock.ExpectCall(retry).ThenResult(&tg.ContactsImportedContacts{RetryContacts: []int64{1}})
+	r, err = m.ImportContacts(ctx, contacts[:1], ImportOptions{
+		MaxRetries:    1,
+		RetryInterval: time.Nanosecond,
+	})
+	a.NoError(err)
+	a.Empty(r.Imported)
+	a.Empty(r.NotFound)
+	a.Equal(contacts[0].Phone, r.Failed[0].Phone)
+
+	// Flood wait limit.
+	mock.ExpectCall(&tg.ContactsImportContactsRequest{
+		Contacts: []tg.InputPhoneContact{input(1), input(2)},
+	}).ThenResult(&tg.ContactsImportedContacts{
+		Imported: []tg.ImportedContact{{UserID: 11, ClientID: 1}},
+		Users:    []tg.UserClass{getTestContact(11, "13115550001")},
+	})
+	mock.ExpectCall(&tg.ContactsImportContactsRequest{
+		Contacts: []tg.InputPhoneContact{input(3)},
+	}).ThenErr(tgerr.New(420, "FLOOD_WAIT_3600"))
+	r, err = m.ImportContacts(ctx, contacts, ImportOptions{
+		BatchSize:    2,
+		MaxFloodWait: time.Minute,
+	})
+	d, ok := tgerr.AsFloodWait(err)
+	a.True(ok)
+	a.Equal(time.Hour, d)
+	a.Len(r.Imported, 1)
+	a.Equal("B", r.NotFound[0].FirstName)
+	a.Len(r.Failed, 1)
+	a.Equal("C", r.Failed[0].FirstName)
+
+	_, err = m.ImportContacts(ctx, []PhoneContact{{ClientID: 1}, {ClientID: 1}}, ImportOptions{})
+	a.Error(err)
+}
+
+func TestManager_DeleteContacts(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	u := m.User(getTestContact(11, "13115550001"))
+	mock.ExpectCall(&tg.ContactsDeleteContactsRequest{
+		ID: []tg.InputUserClass{u.InputUser()},
+	}).ThenResult(&tg.Updates{})
+	a.NoError(m.DeleteContacts(ctx, u))
+
+	mock.ExpectCall(&tg.ContactsDeleteByPhonesRequest{
+		Phones: []string{"13115550001"},
+	}).ThenTrue()
+	a.NoError(m.DeleteContactsByPhone(ctx, "+1 311 555-0001"))
+}
+
+func TestManager_SyncContacts(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+	m.me.Store(getTestSelf())
+	myID := getTestSelf().ID
+
+	result := &tg.ContactsContacts{
+		Contacts: []tg.Contact{{UserID: 11}, {UserID: 12}},
+		Users: []tg.UserClass{
+			getTestContact(11, "13115550001"),
+			getTestContact(12, "13115550002"),
+		},
+	}
+	mock.ExpectCall(&tg.ContactsGetContactsRequest{
+		Hash: contactsHashIDs(myID, []int64{11, 13}),
+	}).ThenResult(result)
+
+	sync, err := m.SyncContacts(ctx, []int64{13, 11})
+	a.NoError(err)
+	a.True(sync.Modified)
+	a.Len(sync.Contacts, 2)
+	a.Len(sync.Added, 1)
+	a.Equal(int64(12), sync.Added[0].ID())
+	a.Equal([]int64{13}, sync.Removed)
+
+	// Stored hash is used if known list is nil.
+	mock.ExpectCall(&tg.ContactsGetContactsRequest{
+		Hash: contactsHashIDs(myID, []int64{11, 12}),
+	}).ThenResult(&tg.ContactsContactsNotModified{})
+	sync, err = m.SyncContacts(ctx, nil)
+	a.NoError(err)
+	a.False(sync.Modified)
+}
+
+func TestManager_ResolvePhones(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+	self := getTestSelf()
+	self.Bot = false
+	m.me.Store(self)
+
+	stored := getTestContact(11, "13115550001")
+	a.NoError(m.Apply(ctx, []tg.UserClass{stored}, nil))
+
+	mock.ExpectCall(&tg.ContactsGetContactsRequest{}).ThenResult(&tg.ContactsContacts{
+		Contacts: []tg.Contact{{UserID: 11}, {UserID: 12}},
+		Users: []tg.UserClass{
+			stored,
+			getTestContact(12, "13115550002"),
+		},
+	})
+	mock.ExpectCall(&tg.ContactsResolvePhoneRequest{
+		Phone: "13115550003",
+	}).ThenResult(&tg.ContactsResolvedPeer{
+		Peer:  &tg.PeerUser{UserID: 13},
+		Users: []tg.UserClass{&tg.User{ID: 13, AccessHash: 13}},
+	})
+	mock.ExpectCall(&tg.ContactsResolvePhoneRequest{
+		Phone: "13115550004",
+	}).ThenRPCErr(&tgerr.Error{Code: 400, Message: tg.ErrPhoneNotOccupied, Type: tg.ErrPhoneNotOccupied})
+
+	phones := []string{"+13115550001", "+13115550002", "+1 311 555-0003", "13115550004"}
+	r, err := m.ResolvePhones(ctx, phones...)
+	a.NoError(err)
+	a.Len(r, 3)
+	a.Equal(int64(11), r[phones[0]].ID())
+	a.Equal(int64(12), r[phones[1]].ID())
+	a.Equal(int64(13), r[phones[2]].ID())
+}
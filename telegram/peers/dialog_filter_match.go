@@ -0,0 +1,93 @@
+package peers
+
+import (
+	"time"
+)
+
+// DialogState is a state of dialog used to match it against dialog filter
+// rules.
+type DialogState struct {
+	// Muted whether dialog notifications are muted.
+	Muted bool
+	// Unread whether dialog has unread messages or marked as unread.
+	Unread bool
+	// Archived whether dialog is in archive folder.
+	Archived bool
+}
+
+// State returns state of dialog at given time.
+//
+// Time is used to check whether mute period is over.
+func (d Dialog) State(now time.Time) DialogState {
+	muteUntil, _ := d.raw.NotifySettings.GetMuteUntil()
+	return DialogState{
+		Muted:    int64(muteUntil) > now.Unix(),
+		Unread:   d.raw.UnreadCount > 0 || d.raw.UnreadMark,
+		Archived: d.raw.FolderID == ArchiveFolder,
+	}
+}
+
+// MatchDialog whether given dialog belongs to filter at given time.
+//
+// Time is used to check whether mute period is over. See Match.
+func (f DialogFilter) MatchDialog(d Dialog, now time.Time) bool {
+	return f.Match(d.Peer(), d.State(now))
+}
+
+// Match whether peer with given dialog state belongs to filter.
+//
+// Matching is done locally, using the same rules as Telegram clients:
+// pinned and included peers always match, excluded peers never match,
+// then dialog state and peer type are checked against filter flags.
+//
+// See https://core.telegram.org/api/folders.
+func (f DialogFilter) Match(p Peer, s DialogState) bool {
+	id := p.TDLibPeerID()
+	for _, list := range [][]Peer{f.pinned, f.include} {
+		for _, included := range list {
+			if included.TDLibPeerID() == id {
+				return true
+			}
+		}
+	}
+	for _, excluded := range f.exclude {
+		if excluded.TDLibPeerID() == id {
+			return false
+		}
+	}
+
+	flags := f.Flags()
+	switch {
+	case flags.ExcludeMuted && s.Muted,
+		flags.ExcludeRead && !s.Unread,
+		flags.ExcludeArchived && s.Archived:
+		return false
+	}
+
+	switch p := p.(type) {
+	case Bot:
+		return flags.Bots
+	case Supergroup:
+		return flags.Groups
+	case Broadcast:
+		return flags.Broadcasts
+	case User:
+		switch {
+		case p.Raw().Bot:
+			return flags.Bots
+		case p.Contact() || p.Self():
+			return flags.Contacts
+		default:
+			return flags.NonContacts
+		}
+	case Chat:
+		return flags.Groups
+	case Channel:
+		if p.IsBroadcast() {
+			return flags.Broadcasts
+		}
+		return flags.Groups
+	default:
+		return false
+	}
+}
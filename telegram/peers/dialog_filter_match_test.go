@@ -0,0 +1,128 @@
+package peers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestDialogFilter_Match(t *testing.T) {
+	_, m := testManager(t)
+
+	contact := getTestUser()
+	contact.Contact = true
+	var (
+		user      = m.User(getTestUser())
+		bot       = m.User(getTestSelf())
+		friend    = m.User(contact)
+		chat      = m.Chat(getTestChat())
+		group     = m.Channel(getTestSuperGroup())
+		broadcast = m.Channel(getTestBroadcast())
+	)
+	unread := DialogState{Unread: true}
+
+	t.Run("Flags", func(t *testing.T) {
+		for _, tt := range []struct {
+			name  string
+			flags DialogFilterFlags
+			peer  Peer
+			match bool
+		}{
+			{"Contact", DialogFilterFlags{Contacts: true}, friend, true},
+			{"NotContact", DialogFilterFlags{Contacts: true}, user, false},
+			{"NonContact", DialogFilterFlags{NonContacts: true}, user, true},
+			{"Bot", DialogFilterFlags{Bots: true}, bot, true},
+			{"BotIsNotContact", DialogFilterFlags{NonContacts: true}, bot, false},
+			{"ToBot", DialogFilterFlags{Bots: true}, func() Peer { b, _ := bot.ToBot(); return b }(), true},
+			{"Chat", DialogFilterFlags{Groups: true}, chat, true},
+			{"Supergroup", DialogFilterFlags{Groups: true}, group, true},
+			{"Broadcast", DialogFilterFlags{Broadcasts: true}, broadcast, true},
+			{"BroadcastIsNotGroup", DialogFilterFlags{Groups: true}, broadcast, false},
+			{"Empty", DialogFilterFlags{}, user, false},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				f := DialogFilter{raw: DialogFilterSpec{Flags: tt.flags}.build(2)}
+				require.Equal(t, tt.match, f.Match(tt.peer, unread))
+			})
+		}
+	})
+	t.Run("State", func(t *testing.T) {
+		a := require.New(t)
+		f := DialogFilter{raw: DialogFilterSpec{Flags: DialogFilterFlags{
+			NonContacts:     true,
+			ExcludeMuted:    true,
+			ExcludeRead:     true,
+			ExcludeArchived: true,
+		}}.build(2)}
+
+		a.True(f.Match(user, unread))
+		a.False(f.Match(user, DialogState{}))
+		a.False(f.Match(user, DialogState{Unread: true, Muted: true}))
+		a.False(f.Match(user, DialogState{Unread: true, Archived: true}))
+	})
+	t.Run("Peers", func(t *testing.T) {
+		a := require.New(t)
+		f := DialogFilter{
+			raw: DialogFilterSpec{Flags: DialogFilterFlags{
+				Groups:      true,
+				ExcludeRead: true,
+			}}.build(2),
+			pinned:  []Peer{broadcast},
+			include: []Peer{user},
+			exclude: []Peer{group},
+		}
+
+		// Included peers ignore state.
+		a.True(f.Match(user, DialogState{}))
+		a.True(f.Match(broadcast, DialogState{}))
+		a.False(f.Match(group, unread))
+		a.True(f.Match(chat, unread))
+	})
+	t.Run("Chatlist", func(t *testing.T) {
+		a := require.New(t)
+		f := DialogFilter{
+			raw:     &tg.DialogFilterChatlist{ID: 2},
+			include: []Peer{group},
+		}
+		a.Equal(DialogFilterFlags{}, f.Flags())
+		a.True(f.Match(group, DialogState{}))
+		a.False(f.Match(chat, unread))
+	})
+}
+
+func TestDialog_State(t *testing.T) {
+	a := require.New(t)
+	now := time.Unix(1000, 0)
+
+	raw := &tg.Dialog{UnreadMark: true, FolderID: ArchiveFolder}
+	raw.NotifySettings.SetMuteUntil(2000)
+	a.Equal(DialogState{
+		Muted:    true,
+		Unread:   true,
+		Archived: true,
+	}, Dialog{raw: raw}.State(now))
+
+	raw = &tg.Dialog{UnreadCount: 0}
+	raw.NotifySettings.SetMuteUntil(500)
+	a.Equal(DialogState{}, Dialog{raw: raw}.State(now))
+}
+
+func TestDialogFilter_MatchDialog(t *testing.T) {
+	a := require.New(t)
+	_, m := testManager(t)
+
+	f := DialogFilter{raw: &tg.DialogFilter{
+		NonContacts:  true,
+		ExcludeMuted: true,
+	}}
+	raw := &tg.Dialog{}
+	raw.NotifySettings.SetMuteUntil(2000)
+	d := Dialog{raw: raw, peer: m.User(getTestUser())}
+
+	a.False(f.MatchDialog(d, time.Unix(1000, 0)))
+	// Mute is expired.
+	a.True(f.MatchDialog(d, time.Unix(3000, 0)))
+}
@@ -0,0 +1,376 @@
+package peers
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/constant"
+	"github.com/gotd/td/tg"
+)
+
+// DialogFilter represents dialog filter (chat folder) with resolved peers.
+//
+// See https://core.telegram.org/api/folders.
+type DialogFilter struct {
+	raw     tg.DialogFilterClass
+	pinned  []Peer
+	include []Peer
+	exclude []Peer
+	m       *Manager
+}
+
+// Raw returns raw tg.DialogFilterClass.
+//
+// It is either *tg.DialogFilter or *tg.DialogFilterChatlist.
+func (f DialogFilter) Raw() tg.DialogFilterClass {
+	return f.raw
+}
+
+// ID returns filter ID.
+func (f DialogFilter) ID() int {
+	switch raw := f.raw.(type) {
+	case *tg.DialogFilter:
+		return raw.ID
+	case *tg.DialogFilterChatlist:
+		return raw.ID
+	default:
+		return 0
+	}
+}
+
+// Title returns filter title.
+func (f DialogFilter) Title() string {
+	switch raw := f.raw.(type) {
+	case *tg.DialogFilter:
+		return raw.Title.Text
+	case *tg.DialogFilterChatlist:
+		return raw.Title.Text
+	default:
+		return ""
+	}
+}
+
+// Chatlist whether this filter is a shared chat folder.
+//
+// See https://core.telegram.org/api/folders#shared-folders.
+func (f DialogFilter) Chatlist() bool {
+	_, ok := f.raw.(*tg.DialogFilterChatlist)
+	return ok
+}
+
+// Emoticon returns filter emoticon, if any.
+func (f DialogFilter) Emoticon() (string, bool) {
+	switch raw := f.raw.(type) {
+	case *tg.DialogFilter:
+		return raw.GetEmoticon()
+	case *tg.DialogFilterChatlist:
+		return raw.GetEmoticon()
+	default:
+		return "", false
+	}
+}
+
+// Flags returns rules of filter.
+//
+// Shared chat folders have no rules, so zero value is returned for them.
+func (f DialogFilter) Flags() DialogFilterFlags {
+	raw, ok := f.raw.(*tg.DialogFilter)
+	if !ok {
+		return DialogFilterFlags{}
+	}
+	return DialogFilterFlags{
+		Contacts:        raw.Contacts,
+		NonContacts:     raw.NonContacts,
+		Groups:          raw.Groups,
+		Broadcasts:      raw.Broadcasts,
+		Bots:            raw.Bots,
+		ExcludeMuted:    raw.ExcludeMuted,
+		ExcludeRead:     raw.ExcludeRead,
+		ExcludeArchived: raw.ExcludeArchived,
+	}
+}
+
+// Pinned returns pinned peers of filter.
+func (f DialogFilter) Pinned() []Peer {
+	return f.pinned
+}
+
+// Include returns peers that are explicitly included to filter.
+func (f DialogFilter) Include() []Peer {
+	return f.include
+}
+
+// Exclude returns peers that are explicitly excluded from filter.
+func (f DialogFilter) Exclude() []Peer {
+	return f.exclude
+}
+
+func (m *Manager) fromInputPeers(ctx context.Context, input []tg.InputPeerClass) ([]Peer, error) {
+	r := make([]Peer, 0, len(input))
+	for i, p := range input {
+		resolved, err := m.FromInputPeer(ctx, p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolve %d (%+v)", i, p)
+		}
+		r = append(r, resolved)
+	}
+	return r, nil
+}
+
+func (m *Manager) dialogFilter(ctx context.Context, raw tg.DialogFilterClass) (f DialogFilter, err error) {
+	f = DialogFilter{
+		raw: raw,
+		m:   m,
+	}
+
+	var pinned, include, exclude []tg.InputPeerClass
+	switch raw := raw.(type) {
+	case *tg.DialogFilter:
+		pinned, include, exclude = raw.PinnedPeers, raw.IncludePeers, raw.ExcludePeers
+	case *tg.DialogFilterChatlist:
+		pinned, include = raw.PinnedPeers, raw.IncludePeers
+	default:
+		return DialogFilter{}, errors.Errorf("unexpected type %T", raw)
+	}
+
+	if f.pinned, err = m.fromInputPeers(ctx, pinned); err != nil {
+		return DialogFilter{}, errors.Wrap(err, "pinned")
+	}
+	if f.include, err = m.fromInputPeers(ctx, include); err != nil {
+		return DialogFilter{}, errors.Wrap(err, "include")
+	}
+	if f.exclude, err = m.fromInputPeers(ctx, exclude); err != nil {
+		return DialogFilter{}, errors.Wrap(err, "exclude")
+	}
+	return f, nil
+}
+
+// DialogFilters returns dialog filters of current user.
+//
+// Default "All chats" filter is skipped.
+func (m *Manager) DialogFilters(ctx context.Context) ([]DialogFilter, error) {
+	r, err := m.api.MessagesGetDialogFilters(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get dialog filters")
+	}
+
+	filters := make([]DialogFilter, 0, len(r.Filters))
+	for _, raw := range r.Filters {
+		if _, ok := raw.(*tg.DialogFilterDefault); ok {
+			continue
+		}
+
+		f, err := m.dialogFilter(ctx, raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "filter %d", len(filters))
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+// DialogFilterFlags are rules which match peers of dialog filter by type
+// or dialog state.
+type DialogFilterFlags struct {
+	// Contacts includes all contacts.
+	Contacts bool
+	// NonContacts includes all non-contact users.
+	NonContacts bool
+	// Groups includes all groups and supergroups.
+	Groups bool
+	// Broadcasts includes all channels.
+	Broadcasts bool
+	// Bots includes all bots.
+	Bots bool
+	// ExcludeMuted excludes muted dialogs.
+	ExcludeMuted bool
+	// ExcludeRead excludes read dialogs.
+	ExcludeRead bool
+	// ExcludeArchived excludes archived dialogs.
+	ExcludeArchived bool
+}
+
+// DialogFilterSpec describes dialog filter to create.
+type DialogFilterSpec struct {
+	// Title of filter.
+	Title string
+	// Emoticon of filter, optional.
+	Emoticon string
+	// Flags are rules of filter.
+	Flags DialogFilterFlags
+	// Pinned peers of filter.
+	Pinned []Peer
+	// Include peers explicitly.
+	Include []Peer
+	// Exclude peers explicitly.
+	Exclude []Peer
+}
+
+func (s DialogFilterSpec) build(id int) *tg.DialogFilter {
+	f := &tg.DialogFilter{
+		Contacts:        s.Flags.Contacts,
+		NonContacts:     s.Flags.NonContacts,
+		Groups:          s.Flags.Groups,
+		Broadcasts:      s.Flags.Broadcasts,
+		Bots:            s.Flags.Bots,
+		ExcludeMuted:    s.Flags.ExcludeMuted,
+		ExcludeRead:     s.Flags.ExcludeRead,
+		ExcludeArchived: s.Flags.ExcludeArchived,
+		ID:              id,
+		Title:           tg.TextWithEntities{Text: s.Title},
+		PinnedPeers:     inputPeers(s.Pinned),
+		IncludePeers:    inputPeers(s.Include),
+		ExcludePeers:    inputPeers(s.Exclude),
+	}
+	if s.Emoticon != "" {
+		f.SetEmoticon(s.Emoticon)
+	}
+	return f
+}
+
+// firstDialogFilterID is the minimal ID of user-defined dialog filter.
+//
+// IDs 0 and 1 are reserved for main and archive folders.
+const firstDialogFilterID = 2
+
+// CreateDialogFilter creates new dialog filter using first free ID.
+func (m *Manager) CreateDialogFilter(ctx context.Context, spec DialogFilterSpec) (DialogFilter, error) {
+	r, err := m.api.MessagesGetDialogFilters(ctx)
+	if err != nil {
+		return DialogFilter{}, errors.Wrap(err, "get dialog filters")
+	}
+
+	used := make(map[int]struct{}, len(r.Filters))
+	for _, f := range r.Filters {
+		switch f := f.(type) {
+		case *tg.DialogFilter:
+			used[f.ID] = struct{}{}
+		case *tg.DialogFilterChatlist:
+			used[f.ID] = struct{}{}
+		}
+	}
+	id := firstDialogFilterID
+	for {
+		if _, ok := used[id]; !ok {
+			break
+		}
+		id++
+	}
+
+	raw := spec.build(id)
+	if err := m.UpdateDialogFilter(ctx, raw); err != nil {
+		return DialogFilter{}, err
+	}
+	return DialogFilter{
+		raw:     raw,
+		pinned:  spec.Pinned,
+		include: spec.Include,
+		exclude: spec.Exclude,
+		m:       m,
+	}, nil
+}
+
+// UpdateDialogFilter creates or updates dialog filter with the same ID.
+func (m *Manager) UpdateDialogFilter(ctx context.Context, filter *tg.DialogFilter) error {
+	req := &tg.MessagesUpdateDialogFilterRequest{ID: filter.ID}
+	req.SetFilter(filter)
+	if _, err := m.api.MessagesUpdateDialogFilter(ctx, req); err != nil {
+		return errors.Wrap(err, "update dialog filter")
+	}
+	return nil
+}
+
+// DeleteDialogFilter deletes dialog filter by ID.
+func (m *Manager) DeleteDialogFilter(ctx context.Context, id int) error {
+	if _, err := m.api.MessagesUpdateDialogFilter(ctx, &tg.MessagesUpdateDialogFilterRequest{
+		ID: id,
+	}); err != nil {
+		return errors.Wrap(err, "delete dialog filter")
+	}
+	return nil
+}
+
+// ReorderDialogFilters sets order of dialog filters.
+func (m *Manager) ReorderDialogFilters(ctx context.Context, order ...int) error {
+	if _, err := m.api.MessagesUpdateDialogFiltersOrder(ctx, order); err != nil {
+		return errors.Wrap(err, "update dialog filters order")
+	}
+	return nil
+}
+
+func inputPeers(peers []Peer) []tg.InputPeerClass {
+	r := make([]tg.InputPeerClass, len(peers))
+	for i, p := range peers {
+		r[i] = p.InputPeer()
+	}
+	return r
+}
+
+func peerSet(peers []Peer) map[constant.TDLibPeerID]struct{} {
+	r := make(map[constant.TDLibPeerID]struct{}, len(peers))
+	for _, p := range peers {
+		r[p.TDLibPeerID()] = struct{}{}
+	}
+	return r
+}
+
+// without returns peers that are not in set.
+func without(peers []Peer, set map[constant.TDLibPeerID]struct{}) []Peer {
+	r := make([]Peer, 0, len(peers))
+	for _, p := range peers {
+		if _, ok := set[p.TDLibPeerID()]; !ok {
+			r = append(r, p)
+		}
+	}
+	return r
+}
+
+func (f DialogFilter) update(ctx context.Context, pinned, include, exclude []Peer) (DialogFilter, error) {
+	var raw tg.DialogFilterClass
+	switch r := f.raw.(type) {
+	case *tg.DialogFilter:
+		c := *r
+		c.PinnedPeers = inputPeers(pinned)
+		c.IncludePeers = inputPeers(include)
+		c.ExcludePeers = inputPeers(exclude)
+		raw = &c
+	case *tg.DialogFilterChatlist:
+		c := *r
+		c.PinnedPeers = inputPeers(pinned)
+		c.IncludePeers = inputPeers(include)
+		raw = &c
+	default:
+		return DialogFilter{}, errors.Errorf("unexpected type %T", f.raw)
+	}
+
+	req := &tg.MessagesUpdateDialogFilterRequest{ID: f.ID()}
+	req.SetFilter(raw)
+	if _, err := f.m.api.MessagesUpdateDialogFilter(ctx, req); err != nil {
+		return DialogFilter{}, errors.Wrap(err, "update dialog filter")
+	}
+
+	f.raw = raw
+	f.pinned = pinned
+	f.include = include
+	f.exclude = exclude
+	return f, nil
+}
+
+// AddPeers includes given peers to filter and returns updated filter.
+//
+// Peers are also removed from excluded peers.
+func (f DialogFilter) AddPeers(ctx context.Context, peers ...Peer) (DialogFilter, error) {
+	set := peerSet(peers)
+	include := append(without(f.include, set), peers...)
+	return f.update(ctx, f.pinned, include, without(f.exclude, set))
+}
+
+// RemovePeers removes given peers from included and pinned peers of filter
+// and returns updated filter.
+//
+// Note that peer still may match filter by its flags, like Contacts or Groups.
+func (f DialogFilter) RemovePeers(ctx context.Context, peers ...Peer) (DialogFilter, error) {
+	set := peerSet(peers)
+	return f.update(ctx, without(f.pinned, set), without(f.include, set), f.exclude)
+}
@@ -0,0 +1,181 @@
+package peers
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/constant"
+	"github.com/gotd/td/tg"
+)
+
+const (
+	// MainFolder is ID of main dialog list.
+	MainFolder = 0
+	// ArchiveFolder is ID of archived dialog list.
+	//
+	// See https://core.telegram.org/api/folders#archive.
+	ArchiveFolder = 1
+)
+
+// Dialog represents dialog with resolved peer.
+type Dialog struct {
+	raw  *tg.Dialog
+	peer Peer
+	last tg.NotEmptyMessage
+}
+
+// Raw returns raw tg.Dialog.
+func (d Dialog) Raw() *tg.Dialog {
+	return d.raw
+}
+
+// Peer returns dialog peer.
+func (d Dialog) Peer() Peer {
+	return d.peer
+}
+
+// Pinned whether the dialog is pinned.
+func (d Dialog) Pinned() bool {
+	return d.raw.Pinned
+}
+
+// FolderID returns ID of folder this dialog belongs to.
+func (d Dialog) FolderID() int {
+	return d.raw.FolderID
+}
+
+// UnreadCount returns count of unread messages.
+func (d Dialog) UnreadCount() int {
+	return d.raw.UnreadCount
+}
+
+// TopMessage returns ID of the last message in the dialog.
+func (d Dialog) TopMessage() int {
+	return d.raw.TopMessage
+}
+
+// Last returns the last message in the dialog, if any.
+func (d Dialog) Last() (tg.NotEmptyMessage, bool) {
+	return d.last, d.last != nil
+}
+
+type dialogMessageKey struct {
+	peer constant.TDLibPeerID
+	id   int
+}
+
+func collectDialogMessages(messages []tg.MessageClass) map[dialogMessageKey]tg.NotEmptyMessage {
+	r := make(map[dialogMessageKey]tg.NotEmptyMessage, len(messages))
+	for _, msg := range messages {
+		nonEmpty, ok := msg.AsNotEmpty()
+		if !ok {
+			continue
+		}
+		r[dialogMessageKey{
+			peer: peerIDFromPeerClass(nonEmpty.GetPeerID()),
+			id:   nonEmpty.GetID(),
+		}] = nonEmpty
+	}
+	return r
+}
+
+// PinnedDialogs returns pinned dialogs of given folder.
+//
+// Folders (like pinned archive) are skipped.
+func (m *Manager) PinnedDialogs(ctx context.Context, folderID int) ([]Dialog, error) {
+	r, err := m.api.MessagesGetPinnedDialogs(ctx, folderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pinned dialogs")
+	}
+	if err := m.applyEntities(ctx, r.Users, r.Chats); err != nil {
+		return nil, errors.Wrap(err, "apply entities")
+	}
+
+	messages := collectDialogMessages(r.Messages)
+	dialogs := make([]Dialog, 0, len(r.Dialogs))
+	for _, dlg := range r.Dialogs {
+		raw, ok := dlg.(*tg.Dialog)
+		if !ok {
+			continue
+		}
+
+		p, err := m.ResolvePeer(ctx, raw.Peer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolve %+v", raw.Peer)
+		}
+		dialogs = append(dialogs, Dialog{
+			raw:  raw,
+			peer: p,
+			last: messages[dialogMessageKey{
+				peer: peerIDFromPeerClass(raw.Peer),
+				id:   raw.TopMessage,
+			}],
+		})
+	}
+	return dialogs, nil
+}
+
+func inputDialogPeers(peers []Peer) []tg.InputDialogPeerClass {
+	r := make([]tg.InputDialogPeerClass, len(peers))
+	for i, p := range peers {
+		r[i] = &tg.InputDialogPeer{Peer: p.InputPeer()}
+	}
+	return r
+}
+
+// SetDialogPinned pins or unpins dialog with given peer.
+func (m *Manager) SetDialogPinned(ctx context.Context, p Peer, pinned bool) error {
+	if _, err := m.api.MessagesToggleDialogPin(ctx, &tg.MessagesToggleDialogPinRequest{
+		Pinned: pinned,
+		Peer:   &tg.InputDialogPeer{Peer: p.InputPeer()},
+	}); err != nil {
+		return errors.Wrap(err, "toggle dialog pin")
+	}
+	return nil
+}
+
+// ReorderPinnedDialogs sets order of pinned dialogs in given folder.
+//
+// If force is true, dialogs that are not in order are unpinned.
+func (m *Manager) ReorderPinnedDialogs(ctx context.Context, folderID int, force bool, order ...Peer) error {
+	if _, err := m.api.MessagesReorderPinnedDialogs(ctx, &tg.MessagesReorderPinnedDialogsRequest{
+		Force:    force,
+		FolderID: folderID,
+		Order:    inputDialogPeers(order),
+	}); err != nil {
+		return errors.Wrap(err, "reorder pinned dialogs")
+	}
+	return nil
+}
+
+// SetFolder moves given peers to folder.
+//
+// Only MainFolder and ArchiveFolder are supported by server.
+func (m *Manager) SetFolder(ctx context.Context, folderID int, peers ...Peer) error {
+	if len(peers) == 0 {
+		return nil
+	}
+
+	folderPeers := make([]tg.InputFolderPeer, len(peers))
+	for i, p := range peers {
+		folderPeers[i] = tg.InputFolderPeer{
+			Peer:     p.InputPeer(),
+			FolderID: folderID,
+		}
+	}
+	if _, err := m.api.FoldersEditPeerFolders(ctx, folderPeers); err != nil {
+		return errors.Wrap(err, "edit peer folders")
+	}
+	return nil
+}
+
+// Archive moves given peers to archive.
+func (m *Manager) Archive(ctx context.Context, peers ...Peer) error {
+	return m.SetFolder(ctx, ArchiveFolder, peers...)
+}
+
+// Unarchive moves given peers from archive to main dialog list.
+func (m *Manager) Unarchive(ctx context.Context, peers ...Peer) error {
+	return m.SetFolder(ctx, MainFolder, peers...)
+}
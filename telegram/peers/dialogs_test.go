@@ -0,0 +1,282 @@
+package peers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestManager_PinnedDialogs(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	user := getTestUser()
+	ch := getTestChannel()
+	mock.ExpectCall(&tg.MessagesGetPinnedDialogsRequest{
+		FolderID: ArchiveFolder,
+	}).ThenResult(&tg.MessagesPeerDialogs{
+		Dialogs: []tg.DialogClass{
+			&tg.Dialog{
+				Pinned:      true,
+				Peer:        &tg.PeerUser{UserID: user.ID},
+				TopMessage:  1,
+				UnreadCount: 2,
+				FolderID:    ArchiveFolder,
+			},
+			&tg.Dialog{
+				Pinned:     true,
+				Peer:       &tg.PeerChannel{ChannelID: ch.ID},
+				TopMessage: 1,
+			},
+			&tg.DialogFolder{
+				Folder: tg.Folder{ID: ArchiveFolder},
+				Peer:   &tg.PeerUser{UserID: user.ID},
+			},
+		},
+		Messages: []tg.MessageClass{
+			&tg.Message{ID: 1, PeerID: &tg.PeerUser{UserID: user.ID}, Message: "user"},
+			&tg.Message{ID: 1, PeerID: &tg.PeerChannel{ChannelID: ch.ID}, Message: "channel"},
+		},
+		Users: []tg.UserClass{user},
+		Chats: []tg.ChatClass{ch},
+	})
+
+	dialogs, err := m.PinnedDialogs(ctx, ArchiveFolder)
+	a.NoError(err)
+	a.Len(dialogs, 2)
+
+	a.Equal(user.ID, dialogs[0].Peer().ID())
+	a.True(dialogs[0].Pinned())
+	a.Equal(2, dialogs[0].UnreadCount())
+	a.Equal(ArchiveFolder, dialogs[0].FolderID())
+	last, ok := dialogs[0].Last()
+	a.True(ok)
+	a.Equal("user", last.(*tg.Message).Message)
+
+	a.Equal(ch.ID, dialogs[1].Peer().ID())
+	last, ok = dialogs[1].Last()
+	a.True(ok)
+	a.Equal("channel", last.(*tg.Message).Message)
+
+	mock.ExpectCall(&tg.MessagesReorderPinnedDialogsRequest{
+		Force:    true,
+		FolderID: ArchiveFolder,
+		Order: []tg.InputDialogPeerClass{
+			&tg.InputDialogPeer{Peer: dialogs[1].Peer().InputPeer()},
+			&tg.InputDialogPeer{Peer: dialogs[0].Peer().InputPeer()},
+		},
+	}).ThenTrue()
+	a.NoError(m.ReorderPinnedDialogs(ctx, ArchiveFolder, true, dialogs[1].Peer(), dialogs[0].Peer()))
+
+	mock.ExpectCall(&tg.MessagesToggleDialogPinRequest{
+		Peer: &tg.InputDialogPeer{Peer: dialogs[0].Peer().InputPeer()},
+	}).ThenTrue()
+	a.NoError(m.SetDialogPinned(ctx, dialogs[0].Peer(), false))
+}
+
+func TestManager_Archive(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	user := m.User(getTestUser())
+	ch := m.Channel(getTestChannel())
+
+	mock.ExpectCall(&tg.FoldersEditPeerFoldersRequest{
+		FolderPeers: []tg.InputFolderPeer{
+			{Peer: user.InputPeer(), FolderID: ArchiveFolder},
+			{Peer: ch.InputPeer(), FolderID: ArchiveFolder},
+		},
+	}).ThenResult(&tg.Updates{})
+	a.NoError(m.Archive(ctx, user, ch))
+
+	mock.ExpectCall(&tg.FoldersEditPeerFoldersRequest{
+		FolderPeers: []tg.InputFolderPeer{
+			{Peer: user.InputPeer(), FolderID: MainFolder},
+		},
+	}).ThenRPCErr(getTestError())
+	a.Error(m.Unarchive(ctx, user))
+
+	// No-op.
+	a.NoError(m.Archive(ctx))
+}
+
+func TestManager_SavedDialogs(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	user := getTestUser()
+	ch := getTestChannel()
+	result := &tg.MessagesSavedDialogs{
+		Dialogs: []tg.SavedDialogClass{
+			&tg.SavedDialog{
+				Pinned:     true,
+				Peer:       &tg.PeerUser{UserID: user.ID},
+				TopMessage: 2,
+			},
+			&tg.SavedDialog{
+				Peer:       &tg.PeerChannel{ChannelID: ch.ID},
+				TopMessage: 1,
+			},
+		},
+		Messages: []tg.MessageClass{
+			&tg.Message{ID: 2, PeerID: &tg.PeerUser{UserID: getTestSelf().ID}},
+			&tg.Message{ID: 1, PeerID: &tg.PeerUser{UserID: getTestSelf().ID}},
+		},
+		Users: []tg.UserClass{user},
+		Chats: []tg.ChatClass{ch},
+	}
+	mock.ExpectCall(&tg.MessagesGetSavedDialogsRequest{
+		ParentPeer: &tg.InputPeerEmpty{},
+		OffsetPeer: &tg.InputPeerEmpty{},
+		Limit:      100,
+	}).ThenResult(result)
+
+	var dialogs []SavedDialog
+	a.NoError(m.SavedDialogs(ctx, func(d SavedDialog) error {
+		dialogs = append(dialogs, d)
+		return nil
+	}))
+	a.Len(dialogs, 2)
+	a.Equal(user.ID, dialogs[0].Peer().ID())
+	a.True(dialogs[0].Pinned())
+	last, ok := dialogs[0].Last()
+	a.True(ok)
+	a.Equal(2, last.GetID())
+	a.Equal(ch.ID, dialogs[1].Peer().ID())
+	a.False(dialogs[1].Pinned())
+
+	mock.ExpectCall(&tg.MessagesGetPinnedSavedDialogsRequest{}).ThenResult(&tg.MessagesSavedDialogs{
+		Dialogs:  result.Dialogs[:1],
+		Messages: result.Messages[:1],
+		Users:    result.Users,
+	})
+	pinned, err := m.PinnedSavedDialogs(ctx)
+	a.NoError(err)
+	a.Len(pinned, 1)
+	a.Equal(user.ID, pinned[0].Peer().ID())
+
+	mock.ExpectCall(&tg.MessagesToggleSavedDialogPinRequest{
+		Pinned: true,
+		Peer:   &tg.InputDialogPeer{Peer: dialogs[1].Peer().InputPeer()},
+	}).ThenTrue()
+	a.NoError(m.SetSavedDialogPinned(ctx, dialogs[1].Peer(), true))
+}
+
+func TestDialogFilter_AddPeersTwice(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	a.NoError(m.Apply(ctx, []tg.UserClass{getTestUser()}, []tg.ChatClass{getTestChannel()}))
+	user := m.User(getTestUser())
+	ch := m.Channel(getTestChannel())
+
+	filter := &tg.DialogFilter{
+		ID:    2,
+		Title: tg.TextWithEntities{Text: "Work"},
+	}
+	f, err := m.dialogFilter(ctx, filter)
+	a.NoError(err)
+
+	expect := func(include ...Peer) {
+		updated := *filter
+		updated.PinnedPeers = []tg.InputPeerClass{}
+		updated.IncludePeers = inputPeers(include)
+		updated.ExcludePeers = []tg.InputPeerClass{}
+		req := &tg.MessagesUpdateDialogFilterRequest{ID: 2}
+		req.SetFilter(&updated)
+		mock.ExpectCall(req).ThenTrue()
+	}
+
+	expect(user)
+	f, err = f.AddPeers(ctx, user)
+	a.NoError(err)
+
+	expect(user, ch)
+	f, err = f.AddPeers(ctx, ch)
+	a.NoError(err)
+	a.Len(f.Include(), 2)
+}
+
+func TestManager_DialogFilters(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	a.NoError(m.Apply(ctx, []tg.UserClass{getTestUser()}, []tg.ChatClass{getTestChannel()}))
+	user := m.User(getTestUser())
+	ch := m.Channel(getTestChannel())
+
+	filter := &tg.DialogFilter{
+		ID:           2,
+		Title:        tg.TextWithEntities{Text: "Work"},
+		Groups:       true,
+		IncludePeers: []tg.InputPeerClass{ch.InputPeer()},
+		ExcludePeers: []tg.InputPeerClass{user.InputPeer()},
+	}
+	mock.ExpectCall(&tg.MessagesGetDialogFiltersRequest{}).ThenResult(&tg.MessagesDialogFilters{
+		Filters: []tg.DialogFilterClass{
+			&tg.DialogFilterDefault{},
+			filter,
+			&tg.DialogFilterChatlist{
+				ID:    3,
+				Title: tg.TextWithEntities{Text: "Shared"},
+			},
+		},
+	})
+
+	filters, err := m.DialogFilters(ctx)
+	a.NoError(err)
+	a.Len(filters, 2)
+
+	work := filters[0]
+	a.Equal(2, work.ID())
+	a.Equal("Work", work.Title())
+	a.False(work.Chatlist())
+	a.Len(work.Include(), 1)
+	a.Equal(ch.ID(), work.Include()[0].ID())
+	a.Len(work.Exclude(), 1)
+	a.Equal(user.ID(), work.Exclude()[0].ID())
+	a.True(filters[1].Chatlist())
+
+	// Adding excluded peer moves it to included peers.
+	updated := *filter
+	updated.PinnedPeers = []tg.InputPeerClass{}
+	updated.IncludePeers = []tg.InputPeerClass{ch.InputPeer(), user.InputPeer()}
+	updated.ExcludePeers = []tg.InputPeerClass{}
+	req := &tg.MessagesUpdateDialogFilterRequest{ID: 2}
+	req.SetFilter(&updated)
+	mock.ExpectCall(req).ThenTrue()
+	work, err = work.AddPeers(ctx, user)
+	a.NoError(err)
+	a.Len(work.Include(), 2)
+	a.Empty(work.Exclude())
+
+	// Second edit is based on result of the first one.
+	updated = *filter
+	updated.PinnedPeers = []tg.InputPeerClass{}
+	updated.IncludePeers = []tg.InputPeerClass{user.InputPeer()}
+	updated.ExcludePeers = []tg.InputPeerClass{}
+	req = &tg.MessagesUpdateDialogFilterRequest{ID: 2}
+	req.SetFilter(&updated)
+	mock.ExpectCall(req).ThenTrue()
+	work, err = work.RemovePeers(ctx, ch)
+	a.NoError(err)
+	a.Len(work.Include(), 1)
+	a.Equal(user.ID(), work.Include()[0].ID())
+	a.Equal(&updated, work.Raw())
+
+	mock.ExpectCall(&tg.MessagesUpdateDialogFilterRequest{ID: 2}).ThenTrue()
+	a.NoError(m.DeleteDialogFilter(ctx, 2))
+
+	mock.ExpectCall(&tg.MessagesUpdateDialogFiltersOrderRequest{
+		Order: []int{3, 2},
+	}).ThenTrue()
+	a.NoError(m.ReorderDialogFilters(ctx, 3, 2))
+}
@@ -39,8 +39,72 @@ func (c *ChannelMembers) query(ctx context.Context, offset, limit int) (*tg.Chan
 	return m, nil
 }
 
+func participantIDs(member tg.ChannelParticipantClass) (userID, inviterID int64, _ error) {
+	switch p := member.(type) {
+	case *tg.ChannelParticipant:
+		userID = p.UserID
+	case *tg.ChannelParticipantSelf:
+		userID = p.UserID
+		inviterID = p.InviterID
+	case *tg.ChannelParticipantCreator:
+		userID = p.UserID
+	case *tg.ChannelParticipantAdmin:
+		userID = p.UserID
+		inviterID = p.InviterID
+	case *tg.ChannelParticipantBanned:
+		userPeer, ok := p.Peer.(*tg.PeerUser)
+		if !ok {
+			return 0, 0, errors.Errorf("unexpected type %T", p.Peer)
+		}
+		userID = userPeer.UserID
+	case *tg.ChannelParticipantLeft:
+		userPeer, ok := p.Peer.(*tg.PeerUser)
+		if !ok {
+			return 0, 0, errors.Errorf("unexpected type %T", p.Peer)
+		}
+		userID = userPeer.UserID
+	default:
+		return 0, 0, errors.Errorf("unexpected type %T", p)
+	}
+	return userID, inviterID, nil
+}
+
+func (c *ChannelMembers) member(
+	ctx context.Context,
+	channelDate time.Time,
+	member tg.ChannelParticipantClass,
+) (ChannelMember, error) {
+	userID, inviterID, err := participantIDs(member)
+	if err != nil {
+		return ChannelMember{}, err
+	}
+
+	user, err := c.m.ResolveUserID(ctx, userID)
+	if err != nil {
+		return ChannelMember{}, errors.Wrapf(err, "get member %d", userID)
+	}
+	chm := ChannelMember{
+		parent:      c,
+		creatorDate: channelDate,
+		user:        user,
+		inviter:     peers.User{},
+		raw:         member,
+	}
+	if inviterID != 0 {
+		inviter, err := c.m.ResolveUserID(ctx, inviterID)
+		if err != nil {
+			return ChannelMember{}, errors.Wrapf(err, "get inviter %d", inviterID)
+		}
+		chm.inviter = inviter
+	}
+	return chm, nil
+}
+
 // ForEach calls cb for every member of channel.
 //
+// Server returns at most about 10000 recent members, use Enumerate to list
+// members of large supergroups.
+//
 // May return ChannelInfoUnavailableError.
 func (c *ChannelMembers) ForEach(ctx context.Context, cb Callback) error {
 	const limit = 100
@@ -65,57 +129,10 @@ func (c *ChannelMembers) ForEach(ctx context.Context, cb Callback) error {
 			return nil
 		}
 		for i, member := range m.Participants {
-			var (
-				userID    int64
-				inviterID int64
-				err       error
-			)
-			switch p := member.(type) {
-			case *tg.ChannelParticipant:
-				userID = p.UserID
-			case *tg.ChannelParticipantSelf:
-				userID = p.UserID
-				inviterID = p.InviterID
-			case *tg.ChannelParticipantCreator:
-				userID = p.UserID
-			case *tg.ChannelParticipantAdmin:
-				userID = p.UserID
-				inviterID = p.InviterID
-			case *tg.ChannelParticipantBanned:
-				userPeer, ok := p.Peer.(*tg.PeerUser)
-				if !ok {
-					return errors.Errorf("unexpected type %T", p.Peer)
-				}
-				userID = userPeer.UserID
-			case *tg.ChannelParticipantLeft:
-				userPeer, ok := p.Peer.(*tg.PeerUser)
-				if !ok {
-					return errors.Errorf("unexpected type %T", p.Peer)
-				}
-				userID = userPeer.UserID
-			default:
-				return errors.Errorf("unexpected type %T", p)
-			}
-
-			user, err := c.m.ResolveUserID(ctx, userID)
+			chm, err := c.member(ctx, channelDate, member)
 			if err != nil {
-				return errors.Wrapf(err, "get member %d", userID)
-			}
-			chm := ChannelMember{
-				parent:      c,
-				creatorDate: channelDate,
-				user:        user,
-				inviter:     peers.User{},
-				raw:         member,
+				return err
 			}
-			if inviterID != 0 {
-				inviter, err := c.m.ResolveUserID(ctx, inviterID)
-				if err != nil {
-					return errors.Wrapf(err, "get inviter %d", inviterID)
-				}
-				chm.inviter = inviter
-			}
-
 			if err := cb(chm); err != nil {
 				return errors.Wrapf(err, "callback (index: %d)", i)
 			}
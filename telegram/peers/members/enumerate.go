@@ -0,0 +1,330 @@
+package members
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// DefaultAlphabet is default set of search prefixes used by Enumerator.
+//
+// Covers Latin, digits, Cyrillic, Greek, Arabic and Hebrew.
+var DefaultAlphabet = []rune(
+	"abcdefghijklmnopqrstuvwxyz" +
+		"0123456789" +
+		"абвгдеёжзийклмнопрстуфхцчшщъыьэюяіїєґў" +
+		"αβγδεζηθικλμνξοπρστυφχψω" +
+		"ابتثجحخدذرزسشصضطظعغفقكلمنهوي" +
+		"אבגדהוזחטיכלמנסעפצקרשת",
+)
+
+// EnumerateOptions is options of Enumerator.
+type EnumerateOptions struct {
+	// Alphabet to extend saturated search queries with.
+	//
+	// Defaults to DefaultAlphabet.
+	Alphabet []rune
+	// MaxDepth is maximum length of search query.
+	//
+	// Defaults to 3.
+	MaxDepth int
+	// Checkpoint to resume enumeration from.
+	Checkpoint *Checkpoint
+	// OnCheckpoint is called after every CheckpointInterval processed pages
+	// and when enumeration is done.
+	//
+	// Can be used to persist progress.
+	OnCheckpoint func(ctx context.Context, c Checkpoint) error
+	// CheckpointInterval is count of pages between OnCheckpoint calls.
+	//
+	// Every checkpoint copies and sorts all seen user IDs, so calling it
+	// too often is expensive for large channels.
+	//
+	// Defaults to 50.
+	CheckpointInterval int
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger to use. Defaults to zerolog.Nop.
+	Logger *zerolog.Logger
+}
+
+func (o *EnumerateOptions) setDefaults() {
+	if len(o.Alphabet) == 0 {
+		o.Alphabet = DefaultAlphabet
+	}
+	if o.MaxDepth == 0 {
+		o.MaxDepth = 3
+	}
+	if o.CheckpointInterval == 0 {
+		o.CheckpointInterval = 50
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		nop := zerolog.Nop()
+		o.Logger = &nop
+	}
+}
+
+// Checkpoint is an Enumerator progress.
+type Checkpoint struct {
+	// Pending is queue of search queries to process.
+	//
+	// First query is the one being processed.
+	Pending []string
+	// Offset in first pending query.
+	Offset int
+	// Seen is a sorted list of already reported user IDs.
+	Seen []int64
+	// Stats collected so far.
+	Stats EnumerateStats
+}
+
+// EnumerateStats represents Enumerator coverage statistics.
+type EnumerateStats struct {
+	// Total is members count reported by server.
+	Total int
+	// Found is count of unique members found.
+	Found int
+	// Duplicates is count of members returned by more than one query.
+	Duplicates int
+	// Queries is count of processed search queries.
+	Queries int
+	// Saturated is count of queries that hit server-side cap and were split.
+	Saturated int
+	// Truncated is count of saturated queries that could not be split
+	// further because of MaxDepth.
+	Truncated int
+	// Requests is count of sent requests.
+	Requests int
+	// FloodWaits is count of FLOOD_WAIT errors.
+	FloodWaits int
+}
+
+// Coverage returns ratio of found members to Total.
+func (s EnumerateStats) Coverage() float64 {
+	if s.Total <= 0 {
+		return 0
+	}
+	return float64(s.Found) / float64(s.Total)
+}
+
+// Enumerator lists all members of channel, working around server-side
+// limit of channelParticipantsRecent.
+//
+// Enumerator shards channelParticipantsSearch queries by prefixes and
+// deduplicates results by user ID. Every query that returns less members
+// than server reports is extended with every letter of alphabet.
+type Enumerator struct {
+	channel  peers.Channel
+	m        *peers.Manager
+	alphabet []rune
+	maxDepth int
+	onCheck  func(ctx context.Context, c Checkpoint) error
+	interval int
+	clock    clock.Clock
+	logger   *zerolog.Logger
+
+	pending []string
+	offset  int
+	pages   int
+	seen    map[int64]struct{}
+	stats   EnumerateStats
+}
+
+// Enumerate creates new Enumerator for given channel.
+func Enumerate(channel peers.Channel, opts EnumerateOptions) *Enumerator {
+	opts.setDefaults()
+	e := &Enumerator{
+		channel:  channel,
+		m:        channel.Manager(),
+		alphabet: opts.Alphabet,
+		maxDepth: opts.MaxDepth,
+		onCheck:  opts.OnCheckpoint,
+		interval: opts.CheckpointInterval,
+		clock:    opts.Clock,
+		logger:   opts.Logger,
+		pending:  []string{""},
+		seen:     map[int64]struct{}{},
+	}
+	if c := opts.Checkpoint; c != nil {
+		e.pending = append([]string(nil), c.Pending...)
+		e.offset = c.Offset
+		for _, id := range c.Seen {
+			e.seen[id] = struct{}{}
+		}
+		e.stats = c.Stats
+	}
+	return e
+}
+
+// Stats returns coverage statistics.
+func (e *Enumerator) Stats() EnumerateStats {
+	return e.stats
+}
+
+// Checkpoint returns current progress.
+func (e *Enumerator) Checkpoint() Checkpoint {
+	seen := make([]int64, 0, len(e.seen))
+	for id := range e.seen {
+		seen = append(seen, id)
+	}
+	sort.Slice(seen, func(i, j int) bool { return seen[i] < seen[j] })
+
+	return Checkpoint{
+		Pending: append([]string(nil), e.pending...),
+		Offset:  e.offset,
+		Seen:    seen,
+		Stats:   e.stats,
+	}
+}
+
+// Done whether enumeration is finished.
+func (e *Enumerator) Done() bool {
+	return len(e.pending) == 0
+}
+
+func (e *Enumerator) query(
+	ctx context.Context,
+	members *ChannelMembers,
+	offset, limit int,
+) (*tg.ChannelsChannelParticipants, error) {
+	for {
+		e.stats.Requests++
+		m, err := members.query(ctx, offset, limit)
+		if err == nil {
+			return m, nil
+		}
+
+		d, ok := tgerr.AsFloodWait(err)
+		if !ok {
+			return nil, err
+		}
+		e.stats.FloodWaits++
+		e.logger.Debug().Dur("duration", d).Msg("Flood wait")
+
+		timer := e.clock.Timer(d)
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			clock.StopTimer(timer)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (e *Enumerator) checkpoint(ctx context.Context) error {
+	if e.onCheck == nil {
+		return nil
+	}
+	e.pages++
+	if e.pages%e.interval != 0 && !e.Done() {
+		return nil
+	}
+	if err := e.onCheck(ctx, e.Checkpoint()); err != nil {
+		return errors.Wrap(err, "checkpoint")
+	}
+	return nil
+}
+
+func (e *Enumerator) split(q string, count int) {
+	if e.offset >= count {
+		return
+	}
+	e.stats.Saturated++
+	if len([]rune(q)) >= e.maxDepth {
+		e.stats.Truncated++
+		e.logger.Debug().
+			Str("query", q).
+			Int("count", count).
+			Int("fetched", e.offset).
+			Msg("Query saturated at max depth")
+		return
+	}
+	for _, r := range e.alphabet {
+		e.pending = append(e.pending, q+string(r))
+	}
+}
+
+// ForEach calls cb for every unique member of channel.
+//
+// If enumeration was resumed from Checkpoint, members that are already seen
+// are skipped.
+//
+// May return ChannelInfoUnavailableError.
+func (e *Enumerator) ForEach(ctx context.Context, cb Callback) error {
+	const limit = 100
+
+	full, err := e.channel.FullRaw(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get full")
+	}
+	if !full.CanViewParticipants {
+		return &ChannelInfoUnavailableError{}
+	}
+	channelDate := time.Unix(int64(e.channel.Raw().Date), 0)
+	if count, ok := full.GetParticipantsCount(); ok && e.stats.Total == 0 {
+		e.stats.Total = count
+	}
+
+	for len(e.pending) > 0 {
+		q := e.pending[0]
+		members := ChannelQuery{Channel: e.channel}.Search(q)
+
+		m, err := e.query(ctx, members, e.offset, limit)
+		if err != nil {
+			return errors.Wrapf(err, "query %q", q)
+		}
+		if q == "" && e.stats.Total == 0 {
+			e.stats.Total = m.Count
+		}
+
+		for i, member := range m.Participants {
+			userID, _, err := participantIDs(member)
+			if err != nil {
+				return err
+			}
+			if _, ok := e.seen[userID]; ok {
+				e.stats.Duplicates++
+				continue
+			}
+
+			chm, err := members.member(ctx, channelDate, member)
+			if err != nil {
+				return err
+			}
+			if err := cb(chm); err != nil {
+				return errors.Wrapf(err, "callback (index: %d)", i)
+			}
+			e.seen[userID] = struct{}{}
+			e.stats.Found = len(e.seen)
+		}
+		e.offset += len(m.Participants)
+
+		if len(m.Participants) < 1 || e.offset >= m.Count {
+			e.split(q, m.Count)
+			e.pending = e.pending[1:]
+			e.offset = 0
+			e.stats.Queries++
+		}
+		if err := e.checkpoint(ctx); err != nil {
+			return err
+		}
+	}
+
+	e.logger.Debug().
+		Int("found", e.stats.Found).
+		Int("total", e.stats.Total).
+		Int("queries", e.stats.Queries).
+		Msg("Enumeration done")
+	return nil
+}
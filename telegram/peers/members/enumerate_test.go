@@ -0,0 +1,126 @@
+package members
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestEnumerator(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	ch := m.Channel(getTestChannel())
+	full := getTestChannelFull()
+	full.ParticipantsCount = 3
+	mock.ExpectCall(&tg.ChannelsGetFullChannelRequest{
+		Channel: ch.InputChannel(),
+	}).ThenResult(&tg.MessagesChatFull{
+		FullChat: full,
+	})
+
+	users := make([]tg.UserClass, 0, 3)
+	for id := int64(1); id <= 3; id++ {
+		u := getTestUser()
+		u.ID = id
+		users = append(users, u)
+	}
+	participants := func(count int, ids ...int64) *tg.ChannelsChannelParticipants {
+		r := &tg.ChannelsChannelParticipants{
+			Count: count,
+			Users: users,
+		}
+		for _, id := range ids {
+			r.Participants = append(r.Participants, &tg.ChannelParticipant{UserID: id})
+		}
+		return r
+	}
+	expect := func(q string, offset int) *tgmock.RequestBuilder {
+		return mock.ExpectCall(&tg.ChannelsGetParticipantsRequest{
+			Channel: ch.InputChannel(),
+			Filter:  &tg.ChannelParticipantsSearch{Q: q},
+			Offset:  offset,
+			Limit:   100,
+		})
+	}
+
+	// Empty query is capped at one member.
+	expect("", 0).ThenResult(participants(3, 1))
+	expect("", 1).ThenResult(participants(3))
+	// Saturated query is split by alphabet.
+	expect("a", 0).ThenResult(participants(2, 1, 2))
+	expect("b", 0).ThenErr(tgerr.New(420, "FLOOD_WAIT_0"))
+	expect("b", 0).ThenResult(participants(1, 3))
+
+	var checkpoints []Checkpoint
+	e := Enumerate(ch, EnumerateOptions{
+		Alphabet:           []rune("ab"),
+		MaxDepth:           1,
+		CheckpointInterval: 1,
+		OnCheckpoint: func(ctx context.Context, c Checkpoint) error {
+			checkpoints = append(checkpoints, c)
+			return nil
+		},
+	})
+
+	var ids []int64
+	a.NoError(e.ForEach(ctx, func(p Member) error {
+		ids = append(ids, p.User().ID())
+		return nil
+	}))
+	a.Equal([]int64{1, 2, 3}, ids)
+	a.True(e.Done())
+
+	stats := e.Stats()
+	a.Equal(EnumerateStats{
+		Total:      3,
+		Found:      3,
+		Duplicates: 1,
+		Queries:    3,
+		Saturated:  1,
+		Requests:   5,
+		FloodWaits: 1,
+	}, stats)
+	a.Equal(1.0, stats.Coverage())
+
+	a.Len(checkpoints, 4)
+	a.Equal(Checkpoint{
+		Pending: []string{"a", "b"},
+		Seen:    []int64{1},
+		Stats:   checkpoints[1].Stats,
+	}, checkpoints[1])
+
+	// Resume from checkpoint skips seen members.
+	expect("a", 0).ThenResult(participants(2, 1, 2))
+	expect("b", 0).ThenResult(participants(1, 3))
+
+	checkpoint := checkpoints[1]
+	checkpoints = checkpoints[:0]
+	e = Enumerate(ch, EnumerateOptions{
+		Alphabet:   []rune("ab"),
+		MaxDepth:   1,
+		Checkpoint: &checkpoint,
+		// Only final checkpoint is reported.
+		CheckpointInterval: 10,
+		OnCheckpoint: func(ctx context.Context, c Checkpoint) error {
+			checkpoints = append(checkpoints, c)
+			return nil
+		},
+	})
+	ids = ids[:0]
+	a.NoError(e.ForEach(ctx, func(p Member) error {
+		ids = append(ids, p.User().ID())
+		return nil
+	}))
+	a.Equal([]int64{2, 3}, ids)
+	a.Equal(3, e.Stats().Found)
+	a.Len(checkpoints, 1)
+	a.Empty(checkpoints[0].Pending)
+	a.Equal([]int64{1, 2, 3}, checkpoints[0].Seen)
+}
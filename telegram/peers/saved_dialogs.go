@@ -0,0 +1,171 @@
+package peers
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/query/dialogs/saved"
+	"github.com/gotd/td/tg"
+)
+
+// SavedDialog represents Saved Messages dialog with resolved peer.
+//
+// See https://core.telegram.org/api/saved-messages.
+type SavedDialog struct {
+	raw  tg.SavedDialogClass
+	peer Peer
+	last tg.NotEmptyMessage
+}
+
+// Raw returns raw tg.SavedDialogClass.
+func (d SavedDialog) Raw() tg.SavedDialogClass {
+	return d.raw
+}
+
+// Peer returns peer messages were saved from.
+func (d SavedDialog) Peer() Peer {
+	return d.peer
+}
+
+// Pinned whether the dialog is pinned.
+func (d SavedDialog) Pinned() bool {
+	s, ok := d.raw.(*tg.SavedDialog)
+	return ok && s.Pinned
+}
+
+// TopMessage returns ID of the last message in the dialog.
+func (d SavedDialog) TopMessage() int {
+	return d.raw.GetTopMessage()
+}
+
+// Last returns the last message in the dialog, if any.
+func (d SavedDialog) Last() (tg.NotEmptyMessage, bool) {
+	return d.last, d.last != nil
+}
+
+func (m *Manager) applySavedDialogs(ctx context.Context, r tg.MessagesSavedDialogsClass) error {
+	var (
+		users []tg.UserClass
+		chats []tg.ChatClass
+	)
+	switch r := r.(type) {
+	case *tg.MessagesSavedDialogs:
+		users, chats = r.Users, r.Chats
+	case *tg.MessagesSavedDialogsSlice:
+		users, chats = r.Users, r.Chats
+	}
+	return m.applyEntities(ctx, users, chats)
+}
+
+func (m *Manager) savedDialog(ctx context.Context, raw tg.SavedDialogClass, last tg.NotEmptyMessage) (SavedDialog, error) {
+	p, err := m.ResolvePeer(ctx, raw.GetPeer())
+	if err != nil {
+		return SavedDialog{}, errors.Wrapf(err, "resolve %+v", raw.GetPeer())
+	}
+	return SavedDialog{
+		raw:  raw,
+		peer: p,
+		last: last,
+	}, nil
+}
+
+// SavedDialogs calls cb for every Saved Messages dialog.
+func (m *Manager) SavedDialogs(ctx context.Context, cb func(SavedDialog) error) error {
+	const limit = 100
+
+	b := saved.NewQueryBuilder(m.api).GetSavedDialogs()
+	iter := saved.NewIterator(saved.QueryFunc(func(ctx context.Context, req saved.Request) (tg.MessagesSavedDialogsClass, error) {
+		r, err := b.Query(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.applySavedDialogs(ctx, r); err != nil {
+			return nil, errors.Wrap(err, "apply entities")
+		}
+		return r, nil
+	}), limit)
+
+	i := 0
+	for iter.Next(ctx) {
+		e := iter.Value()
+		d, err := m.savedDialog(ctx, e.Dialog, e.Last)
+		if err != nil {
+			return err
+		}
+		if err := cb(d); err != nil {
+			return errors.Wrapf(err, "callback (index: %d)", i)
+		}
+		i++
+	}
+	if err := iter.Err(); err != nil {
+		return errors.Wrap(err, "get saved dialogs")
+	}
+	return nil
+}
+
+// PinnedSavedDialogs returns pinned Saved Messages dialogs.
+func (m *Manager) PinnedSavedDialogs(ctx context.Context) ([]SavedDialog, error) {
+	r, err := m.api.MessagesGetPinnedSavedDialogs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pinned saved dialogs")
+	}
+	if err := m.applySavedDialogs(ctx, r); err != nil {
+		return nil, errors.Wrap(err, "apply entities")
+	}
+
+	var (
+		dialogs  []tg.SavedDialogClass
+		messages []tg.MessageClass
+	)
+	switch r := r.(type) {
+	case *tg.MessagesSavedDialogs:
+		dialogs, messages = r.Dialogs, r.Messages
+	case *tg.MessagesSavedDialogsSlice:
+		dialogs, messages = r.Dialogs, r.Messages
+	default:
+		return nil, errors.Errorf("unexpected type %T", r)
+	}
+
+	// All saved messages are in the same chat, so they are identified by ID.
+	last := make(map[int]tg.NotEmptyMessage, len(messages))
+	for _, msg := range messages {
+		if nonEmpty, ok := msg.AsNotEmpty(); ok {
+			last[nonEmpty.GetID()] = nonEmpty
+		}
+	}
+
+	result := make([]SavedDialog, 0, len(dialogs))
+	for _, raw := range dialogs {
+		d, err := m.savedDialog(ctx, raw, last[raw.GetTopMessage()])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// SetSavedDialogPinned pins or unpins Saved Messages dialog with given peer.
+func (m *Manager) SetSavedDialogPinned(ctx context.Context, p Peer, pinned bool) error {
+	if _, err := m.api.MessagesToggleSavedDialogPin(ctx, &tg.MessagesToggleSavedDialogPinRequest{
+		Pinned: pinned,
+		Peer:   &tg.InputDialogPeer{Peer: p.InputPeer()},
+	}); err != nil {
+		return errors.Wrap(err, "toggle saved dialog pin")
+	}
+	return nil
+}
+
+// ReorderPinnedSavedDialogs sets order of pinned Saved Messages dialogs.
+//
+// If force is true, dialogs that are not in order are unpinned.
+func (m *Manager) ReorderPinnedSavedDialogs(ctx context.Context, force bool, order ...Peer) error {
+	if _, err := m.api.MessagesReorderPinnedSavedDialogs(ctx, &tg.MessagesReorderPinnedSavedDialogsRequest{
+		Force: force,
+		Order: inputDialogPeers(order),
+	}); err != nil {
+		return errors.Wrap(err, "reorder pinned saved dialogs")
+	}
+	return nil
+}
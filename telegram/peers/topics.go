@@ -0,0 +1,336 @@
+package peers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/telegram/message/unpack"
+	"github.com/gotd/td/tg"
+)
+
+// IsForum whether this supergroup is a forum.
+//
+// See https://core.telegram.org/api/forum.
+func (c Supergroup) IsForum() bool {
+	return c.raw.Forum
+}
+
+// ToggleForum enables or disables forum functionality of this supergroup.
+func (c Supergroup) ToggleForum(ctx context.Context, enabled bool) error {
+	if _, err := c.m.api.ChannelsToggleForum(ctx, &tg.ChannelsToggleForumRequest{
+		Channel: c.InputChannel(),
+		Enabled: enabled,
+	}); err != nil {
+		return errors.Wrap(err, "toggle forum")
+	}
+
+	return nil
+}
+
+// Topics returns Topics of this forum supergroup.
+func (c Supergroup) Topics() Topics {
+	return Topics{
+		channel: c,
+		m:       c.m,
+	}
+}
+
+// Topics represents forum topics of Supergroup.
+//
+// See https://core.telegram.org/api/forum#forum-topics.
+type Topics struct {
+	channel Supergroup
+	m       *Manager
+}
+
+// Topic represents forum topic.
+type Topic struct {
+	raw tg.ForumTopic
+}
+
+// Raw returns raw tg.ForumTopic.
+func (t Topic) Raw() *tg.ForumTopic {
+	return &t.raw
+}
+
+// ID returns topic ID, which is an ID of topic service message.
+func (t Topic) ID() int {
+	return t.raw.ID
+}
+
+// Title returns topic title.
+func (t Topic) Title() string {
+	return t.raw.Title
+}
+
+// Date returns topic creation date.
+func (t Topic) Date() time.Time {
+	return time.Unix(int64(t.raw.Date), 0)
+}
+
+// IconEmojiID returns ID of custom emoji used as topic icon, if any.
+func (t Topic) IconEmojiID() (int64, bool) {
+	return t.raw.GetIconEmojiID()
+}
+
+// TopMessage returns ID of the last message in the topic.
+func (t Topic) TopMessage() int {
+	return t.raw.TopMessage
+}
+
+// Closed whether the topic is closed (no messages can be sent to it).
+func (t Topic) Closed() bool {
+	return t.raw.Closed
+}
+
+// Pinned whether the topic is pinned.
+func (t Topic) Pinned() bool {
+	return t.raw.Pinned
+}
+
+// Hidden whether the topic is hidden. Only the "General" topic can be hidden.
+func (t Topic) Hidden() bool {
+	return t.raw.Hidden
+}
+
+// My whether the topic was created by the current user.
+func (t Topic) My() bool {
+	return t.raw.My
+}
+
+// CreateTopicOptions is options for Topics.Create.
+type CreateTopicOptions struct {
+	// Title of topic.
+	Title string
+	// Color of topic icon in RGB format.
+	//
+	// If zero, will not be used.
+	IconColor int
+	// ID of custom emoji used as topic icon.
+	//
+	// If zero, will not be used.
+	IconEmojiID int64
+	// SendAs sets peer to create topic as.
+	//
+	// If nil, will not be used.
+	SendAs tg.InputPeerClass
+}
+
+// Create creates new forum topic and returns its ID.
+func (t Topics) Create(ctx context.Context, opts CreateTopicOptions) (int, error) {
+	randomID, err := crypto.RandInt64(crypto.DefaultRand())
+	if err != nil {
+		return 0, errors.Wrap(err, "generate random id")
+	}
+
+	req := &tg.ChannelsCreateForumTopicRequest{
+		Channel:  t.channel.InputChannel(),
+		Title:    opts.Title,
+		RandomID: randomID,
+	}
+	if opts.IconColor != 0 {
+		req.SetIconColor(opts.IconColor)
+	}
+	if opts.IconEmojiID != 0 {
+		req.SetIconEmojiID(opts.IconEmojiID)
+	}
+	if opts.SendAs != nil {
+		req.SetSendAs(opts.SendAs)
+	}
+
+	id, err := unpack.MessageID(t.m.api.ChannelsCreateForumTopic(ctx, req))
+	if err != nil {
+		return 0, errors.Wrap(err, "create forum topic")
+	}
+	return id, nil
+}
+
+func (t Topics) edit(ctx context.Context, msg string, req *tg.ChannelsEditForumTopicRequest) error {
+	req.Channel = t.channel.InputChannel()
+	if _, err := t.m.api.ChannelsEditForumTopic(ctx, req); err != nil {
+		return errors.Wrap(err, msg)
+	}
+
+	return nil
+}
+
+// Rename sets title of topic.
+func (t Topics) Rename(ctx context.Context, id int, title string) error {
+	req := &tg.ChannelsEditForumTopicRequest{TopicID: id}
+	req.SetTitle(title)
+	return t.edit(ctx, "rename topic", req)
+}
+
+// SetIcon sets custom emoji icon of topic. Zero ID removes icon.
+func (t Topics) SetIcon(ctx context.Context, id int, emojiID int64) error {
+	req := &tg.ChannelsEditForumTopicRequest{TopicID: id}
+	req.SetIconEmojiID(emojiID)
+	return t.edit(ctx, "set topic icon", req)
+}
+
+// Close closes topic, so no new messages can be sent to it.
+func (t Topics) Close(ctx context.Context, id int) error {
+	return t.toggleClosed(ctx, id, true)
+}
+
+// Reopen reopens closed topic.
+func (t Topics) Reopen(ctx context.Context, id int) error {
+	return t.toggleClosed(ctx, id, false)
+}
+
+func (t Topics) toggleClosed(ctx context.Context, id int, closed bool) error {
+	req := &tg.ChannelsEditForumTopicRequest{TopicID: id}
+	req.SetClosed(closed)
+	return t.edit(ctx, "toggle topic closed", req)
+}
+
+// ToggleGeneralHidden hides or unhides the "General" topic.
+func (t Topics) ToggleGeneralHidden(ctx context.Context, hidden bool) error {
+	// General topic always has ID 1.
+	req := &tg.ChannelsEditForumTopicRequest{TopicID: 1}
+	req.SetHidden(hidden)
+	return t.edit(ctx, "toggle general topic hidden", req)
+}
+
+// Pin pins topic.
+func (t Topics) Pin(ctx context.Context, id int) error {
+	return t.updatePinned(ctx, id, true)
+}
+
+// Unpin unpins topic.
+func (t Topics) Unpin(ctx context.Context, id int) error {
+	return t.updatePinned(ctx, id, false)
+}
+
+func (t Topics) updatePinned(ctx context.Context, id int, pinned bool) error {
+	if _, err := t.m.api.ChannelsUpdatePinnedForumTopic(ctx, &tg.ChannelsUpdatePinnedForumTopicRequest{
+		Channel: t.channel.InputChannel(),
+		TopicID: id,
+		Pinned:  pinned,
+	}); err != nil {
+		return errors.Wrapf(err, "update pinned (pinned: %t)", pinned)
+	}
+
+	return nil
+}
+
+// ReorderPinned sets order of pinned topics.
+//
+// If force is true, topics missing in order are unpinned.
+func (t Topics) ReorderPinned(ctx context.Context, force bool, order ...int) error {
+	if _, err := t.m.api.ChannelsReorderPinnedForumTopics(ctx, &tg.ChannelsReorderPinnedForumTopicsRequest{
+		Force:   force,
+		Channel: t.channel.InputChannel(),
+		Order:   order,
+	}); err != nil {
+		return errors.Wrap(err, "reorder pinned topics")
+	}
+
+	return nil
+}
+
+// Delete deletes topic with all its messages.
+func (t Topics) Delete(ctx context.Context, id int) error {
+	for {
+		r, err := t.m.api.ChannelsDeleteTopicHistory(ctx, &tg.ChannelsDeleteTopicHistoryRequest{
+			Channel:  t.channel.InputChannel(),
+			TopMsgID: id,
+		})
+		if err != nil {
+			return errors.Wrap(err, "delete topic history")
+		}
+		// Server deletes messages in batches, repeat until offset is zero.
+		if r.Offset <= 0 {
+			return nil
+		}
+	}
+}
+
+func (t Topics) apply(ctx context.Context, r *tg.MessagesForumTopics) ([]Topic, error) {
+	if err := t.m.applyEntities(ctx, r.Users, r.Chats); err != nil {
+		return nil, errors.Wrap(err, "apply entities")
+	}
+
+	topics := make([]Topic, 0, len(r.Topics))
+	for _, topic := range r.Topics {
+		v, ok := topic.(*tg.ForumTopic)
+		if !ok {
+			// Deleted topic.
+			continue
+		}
+		topics = append(topics, Topic{raw: *v})
+	}
+	return topics, nil
+}
+
+// Get returns topics by ID. Deleted topics are skipped.
+func (t Topics) Get(ctx context.Context, ids ...int) ([]Topic, error) {
+	r, err := t.m.api.ChannelsGetForumTopicsByID(ctx, &tg.ChannelsGetForumTopicsByIDRequest{
+		Channel: t.channel.InputChannel(),
+		Topics:  ids,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "get forum topics")
+	}
+
+	return t.apply(ctx, r)
+}
+
+// ForEach calls cb for every topic of forum, in order returned by server.
+//
+// If query is not empty, only topics with matching titles are returned.
+func (t Topics) ForEach(ctx context.Context, query string, cb func(Topic) error) error {
+	const limit = 100
+
+	req := &tg.ChannelsGetForumTopicsRequest{
+		Channel: t.channel.InputChannel(),
+		Limit:   limit,
+	}
+	if query != "" {
+		req.SetQ(query)
+	}
+
+	seen := 0
+	for {
+		r, err := t.m.api.ChannelsGetForumTopics(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "get forum topics")
+		}
+		topics, err := t.apply(ctx, r)
+		if err != nil {
+			return err
+		}
+		for i, topic := range topics {
+			if err := cb(topic); err != nil {
+				return errors.Wrapf(err, "callback (index: %d)", seen+i)
+			}
+		}
+		seen += len(r.Topics)
+		if len(r.Topics) < 1 || seen >= r.Count {
+			return nil
+		}
+
+		// Offset is the last topic and date of its top message. Deleted
+		// topics have no top message, so only their ID is used.
+		req.OffsetTopic = r.Topics[len(r.Topics)-1].GetID()
+		req.OffsetID = 0
+		req.OffsetDate = 0
+		last, ok := r.Topics[len(r.Topics)-1].(*tg.ForumTopic)
+		if !ok {
+			continue
+		}
+		req.OffsetID = last.TopMessage
+		for _, msg := range r.Messages {
+			if msg.GetID() != last.TopMessage {
+				continue
+			}
+			if m, ok := msg.(interface{ GetDate() int }); ok {
+				req.OffsetDate = m.GetDate()
+			}
+			break
+		}
+	}
+}
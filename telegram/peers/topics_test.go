@@ -0,0 +1,264 @@
+package peers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+)
+
+func getTestForum(t *testing.T, m *Manager) Supergroup {
+	ch := getTestSuperGroup()
+	ch.Forum = true
+	s, ok := m.Channel(ch).ToSupergroup()
+	require.True(t, ok)
+	require.True(t, s.IsForum())
+	return s
+}
+
+func getTestTopic(id int, title string, top int) *tg.ForumTopic {
+	return &tg.ForumTopic{
+		ID:         id,
+		Title:      title,
+		TopMessage: top,
+		FromID:     &tg.PeerUser{UserID: 10},
+	}
+}
+
+func TestTopics_Create(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	s := getTestForum(t, m)
+	topics := s.Topics()
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.ChannelsCreateForumTopicRequest)
+		a.True(ok)
+		a.Equal(s.InputChannel(), req.Channel)
+		a.Equal("Topic", req.Title)
+		a.Equal(0xff0000, req.IconColor)
+		a.NotZero(req.RandomID)
+	}).ThenResult(&tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateNewChannelMessage{
+				Message: &tg.MessageService{
+					ID:     10,
+					PeerID: &tg.PeerChannel{ChannelID: s.ID()},
+					Action: &tg.MessageActionTopicCreate{Title: "Topic"},
+				},
+			},
+		},
+	})
+	id, err := topics.Create(ctx, CreateTopicOptions{
+		Title:     "Topic",
+		IconColor: 0xff0000,
+	})
+	a.NoError(err)
+	a.Equal(10, id)
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		_, ok := b.(*tg.ChannelsCreateForumTopicRequest)
+		a.True(ok)
+	}).ThenRPCErr(getTestError())
+	_, err = topics.Create(ctx, CreateTopicOptions{Title: "Topic"})
+	a.Error(err)
+}
+
+func TestTopics_Edit(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	s := getTestForum(t, m)
+	topics := s.Topics()
+
+	edit := func(f func(req *tg.ChannelsEditForumTopicRequest)) *tg.ChannelsEditForumTopicRequest {
+		req := &tg.ChannelsEditForumTopicRequest{
+			Channel: s.InputChannel(),
+			TopicID: 10,
+		}
+		f(req)
+		return req
+	}
+
+	mock.ExpectCall(edit(func(req *tg.ChannelsEditForumTopicRequest) {
+		req.SetTitle("Renamed")
+	})).ThenRPCErr(getTestError())
+	a.Error(topics.Rename(ctx, 10, "Renamed"))
+
+	mock.ExpectCall(edit(func(req *tg.ChannelsEditForumTopicRequest) {
+		req.SetTitle("Renamed")
+	})).ThenResult(&tg.Updates{})
+	a.NoError(topics.Rename(ctx, 10, "Renamed"))
+
+	mock.ExpectCall(edit(func(req *tg.ChannelsEditForumTopicRequest) {
+		req.SetIconEmojiID(42)
+	})).ThenResult(&tg.Updates{})
+	a.NoError(topics.SetIcon(ctx, 10, 42))
+
+	mock.ExpectCall(edit(func(req *tg.ChannelsEditForumTopicRequest) {
+		req.SetClosed(true)
+	})).ThenResult(&tg.Updates{})
+	a.NoError(topics.Close(ctx, 10))
+
+	mock.ExpectCall(edit(func(req *tg.ChannelsEditForumTopicRequest) {
+		req.SetClosed(false)
+	})).ThenResult(&tg.Updates{})
+	a.NoError(topics.Reopen(ctx, 10))
+
+	mock.ExpectCall(edit(func(req *tg.ChannelsEditForumTopicRequest) {
+		req.TopicID = 1
+		req.SetHidden(true)
+	})).ThenResult(&tg.Updates{})
+	a.NoError(topics.ToggleGeneralHidden(ctx, true))
+
+	mock.ExpectCall(&tg.ChannelsUpdatePinnedForumTopicRequest{
+		Channel: s.InputChannel(),
+		TopicID: 10,
+		Pinned:  true,
+	}).ThenResult(&tg.Updates{})
+	a.NoError(topics.Pin(ctx, 10))
+
+	mock.ExpectCall(&tg.ChannelsUpdatePinnedForumTopicRequest{
+		Channel: s.InputChannel(),
+		TopicID: 10,
+		Pinned:  false,
+	}).ThenRPCErr(getTestError())
+	a.Error(topics.Unpin(ctx, 10))
+
+	mock.ExpectCall(&tg.ChannelsReorderPinnedForumTopicsRequest{
+		Force:   true,
+		Channel: s.InputChannel(),
+		Order:   []int{10, 5},
+	}).ThenResult(&tg.Updates{})
+	a.NoError(topics.ReorderPinned(ctx, true, 10, 5))
+}
+
+func TestTopics_Delete(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	s := getTestForum(t, m)
+	req := &tg.ChannelsDeleteTopicHistoryRequest{
+		Channel:  s.InputChannel(),
+		TopMsgID: 10,
+	}
+
+	mock.ExpectCall(req).ThenRPCErr(getTestError())
+	a.Error(s.Topics().Delete(ctx, 10))
+
+	mock.ExpectCall(req).ThenResult(&tg.MessagesAffectedHistory{Offset: 100})
+	mock.ExpectCall(req).ThenResult(&tg.MessagesAffectedHistory{})
+	a.NoError(s.Topics().Delete(ctx, 10))
+}
+
+func TestTopics_ForEach(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	s := getTestForum(t, m)
+	topics := s.Topics()
+
+	mock.ExpectCall(&tg.ChannelsGetForumTopicsRequest{
+		Channel: s.InputChannel(),
+		Limit:   100,
+	}).ThenResult(&tg.MessagesForumTopics{
+		Count: 4,
+		Topics: []tg.ForumTopicClass{
+			getTestTopic(10, "First", 20),
+			getTestTopic(5, "Second", 15),
+			&tg.ForumTopicDeleted{ID: 3},
+		},
+		Messages: []tg.MessageClass{
+			&tg.Message{ID: 20, Date: 200, PeerID: &tg.PeerChannel{}},
+			&tg.Message{ID: 15, Date: 150, PeerID: &tg.PeerChannel{}},
+		},
+	})
+	mock.ExpectCall(&tg.ChannelsGetForumTopicsRequest{
+		Channel:     s.InputChannel(),
+		OffsetTopic: 3,
+		Limit:       100,
+	}).ThenResult(&tg.MessagesForumTopics{
+		Count: 4,
+		Topics: []tg.ForumTopicClass{
+			getTestTopic(1, "General", 2),
+		},
+	})
+
+	var got []string
+	a.NoError(topics.ForEach(ctx, "", func(topic Topic) error {
+		got = append(got, topic.Title())
+		return nil
+	}))
+	a.Equal([]string{"First", "Second", "General"}, got)
+
+	// Page with deleted topics only.
+	mock.ExpectCall(&tg.ChannelsGetForumTopicsRequest{
+		Channel: s.InputChannel(),
+		Limit:   100,
+	}).ThenResult(&tg.MessagesForumTopics{
+		Count: 4,
+		Topics: []tg.ForumTopicClass{
+			&tg.ForumTopicDeleted{ID: 8},
+			&tg.ForumTopicDeleted{ID: 7},
+		},
+	})
+	mock.ExpectCall(&tg.ChannelsGetForumTopicsRequest{
+		Channel:     s.InputChannel(),
+		OffsetTopic: 7,
+		Limit:       100,
+	}).ThenResult(&tg.MessagesForumTopics{
+		Count: 4,
+		Topics: []tg.ForumTopicClass{
+			getTestTopic(4, "Fourth", 9),
+		},
+		Messages: []tg.MessageClass{
+			&tg.Message{ID: 9, Date: 90, PeerID: &tg.PeerChannel{}},
+		},
+	})
+	mock.ExpectCall(&tg.ChannelsGetForumTopicsRequest{
+		Channel:     s.InputChannel(),
+		OffsetDate:  90,
+		OffsetID:    9,
+		OffsetTopic: 4,
+		Limit:       100,
+	}).ThenResult(&tg.MessagesForumTopics{
+		Count: 4,
+		Topics: []tg.ForumTopicClass{
+			getTestTopic(1, "General", 2),
+		},
+	})
+
+	got = got[:0]
+	a.NoError(topics.ForEach(ctx, "", func(topic Topic) error {
+		got = append(got, topic.Title())
+		return nil
+	}))
+	a.Equal([]string{"Fourth", "General"}, got)
+
+	mock.ExpectCall(&tg.ChannelsGetForumTopicsByIDRequest{
+		Channel: s.InputChannel(),
+		Topics:  []int{10},
+	}).ThenResult(&tg.MessagesForumTopics{
+		Count: 1,
+		Topics: []tg.ForumTopicClass{
+			func() *tg.ForumTopic {
+				topic := getTestTopic(10, "First", 20)
+				topic.Pinned = true
+				return topic
+			}(),
+		},
+	})
+	r, err := topics.Get(ctx, 10)
+	a.NoError(err)
+	a.Len(r, 1)
+	a.Equal(10, r[0].ID())
+	a.True(r[0].Pinned())
+}
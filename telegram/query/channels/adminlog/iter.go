@@ -0,0 +1,124 @@
+// Package adminlog contains channel admin log iteration helper.
+package adminlog
+
+import (
+	"context"
+
+	"github.com/gotd/td/telegram/message/peer"
+	"github.com/gotd/td/tg"
+)
+
+// Elem is an admin log iterator element.
+type Elem struct {
+	Event    tg.ChannelAdminLogEvent
+	Entities peer.Entities
+}
+
+// Iterator is an admin log stream iterator.
+//
+// Events are returned from newest to oldest.
+type Iterator struct {
+	// Current state.
+	lastErr error
+	// Buffer state.
+	buf    []Elem
+	bufCur int
+	// Request state.
+	limit     int
+	lastBatch bool
+	// Offset parameters state.
+	maxID int64
+
+	// Query builder.
+	query Query
+}
+
+// NewIterator creates new iterator.
+func NewIterator(query Query, limit int) *Iterator {
+	return &Iterator{
+		buf:    make([]Elem, 0, limit),
+		bufCur: -1,
+		limit:  limit,
+		query:  query,
+	}
+}
+
+// MaxID sets MaxID request parameter: only events with lesser ID
+// will be returned.
+func (m *Iterator) MaxID(maxID int64) *Iterator {
+	m.maxID = maxID
+	return m
+}
+
+func (m *Iterator) apply(r *tg.ChannelsAdminLogResults) error {
+	entities := peer.EntitiesFromResult(r)
+	m.lastBatch = len(r.Events) < m.limit
+
+	m.bufCur = -1
+	m.buf = m.buf[:0]
+	for _, event := range r.Events {
+		if m.maxID == 0 || event.ID < m.maxID {
+			m.maxID = event.ID
+		}
+		m.buf = append(m.buf, Elem{Event: event, Entities: entities})
+	}
+
+	return nil
+}
+
+func (m *Iterator) requestNext(ctx context.Context) error {
+	if m.lastBatch {
+		m.buf = m.buf[:0]
+		return nil
+	}
+
+	r, err := m.query.Query(ctx, Request{
+		MaxID: m.maxID,
+		Limit: m.limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.apply(r)
+}
+
+func (m *Iterator) bufNext() bool {
+	if len(m.buf)-1 <= m.bufCur {
+		return false
+	}
+
+	m.bufCur++
+	return true
+}
+
+// Next prepares the next event for reading with the Value method.
+// It returns true on success, or false if there is no next event or an error happened while preparing it.
+// Err should be consulted to distinguish between the two cases.
+func (m *Iterator) Next(ctx context.Context) bool {
+	if m.lastErr != nil {
+		return false
+	}
+
+	if !m.bufNext() {
+		// If buffer is empty, we should fetch next batch.
+		if err := m.requestNext(ctx); err != nil {
+			m.lastErr = err
+			return false
+		}
+		// Try again with new buffer.
+		return m.bufNext()
+	}
+
+	return true
+}
+
+// Value returns current event.
+func (m *Iterator) Value() Elem {
+	return m.buf[m.bufCur]
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (m *Iterator) Err() error {
+	return m.lastErr
+}
@@ -0,0 +1,99 @@
+package adminlog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func generateEvents(count int) []tg.ChannelAdminLogEvent {
+	r := make([]tg.ChannelAdminLogEvent, 0, count)
+
+	// Events are returned from newest to oldest.
+	for i := count; i > 0; i-- {
+		r = append(r, tg.ChannelAdminLogEvent{
+			ID:     int64(i),
+			Date:   i,
+			UserID: 10,
+			Action: &tg.ChannelAdminLogEventActionChangeTitle{
+				PrevValue: "old",
+				NewValue:  "new",
+			},
+		})
+	}
+
+	return r
+}
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	limit := 10
+	totalRecords := 2*limit + 5
+	expected := generateEvents(totalRecords)
+	raw := tg.NewClient(mock)
+	channel := &tg.InputChannel{ChannelID: 1, AccessHash: 1}
+	filter := tg.ChannelAdminLogEventsFilter{Info: true}
+
+	request := func(maxID int64) *tg.ChannelsGetAdminLogRequest {
+		return &tg.ChannelsGetAdminLogRequest{
+			Channel:      channel,
+			Q:            "new",
+			EventsFilter: filter,
+			MaxID:        maxID,
+			Limit:        limit,
+		}
+	}
+	mock.ExpectCall(request(0)).ThenResult(&tg.ChannelsAdminLogResults{
+		Events: expected[:limit],
+		Users:  []tg.UserClass{&tg.User{ID: 10}},
+	})
+	mock.ExpectCall(request(expected[limit-1].ID)).ThenResult(&tg.ChannelsAdminLogResults{
+		Events: expected[limit : 2*limit],
+	})
+	mock.ExpectCall(request(expected[2*limit-1].ID)).ThenResult(&tg.ChannelsAdminLogResults{
+		Events: expected[2*limit:],
+	})
+
+	iter := NewQueryBuilder(raw).GetAdminLog(channel).
+		Q("new").
+		EventsFilter(filter).
+		BatchSize(limit).
+		Iter()
+	i := 0
+	for iter.Next(ctx) {
+		require.Equal(t, expected[i], iter.Value().Event)
+		i++
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, totalRecords, i)
+}
+
+func TestCollect(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	channel := &tg.InputChannel{ChannelID: 1, AccessHash: 1}
+	expected := generateEvents(3)
+
+	mock.ExpectCall(&tg.ChannelsGetAdminLogRequest{
+		Channel: channel,
+		MaxID:   5,
+		Limit:   1,
+	}).ThenResult(&tg.ChannelsAdminLogResults{
+		Events: expected[:1],
+	})
+	mock.ExpectCall(&tg.ChannelsGetAdminLogRequest{
+		Channel: channel,
+		MaxID:   expected[0].ID,
+		Limit:   1,
+	}).ThenResult(&tg.ChannelsAdminLogResults{})
+
+	r, err := NewQueryBuilder(raw).GetAdminLog(channel).MaxID(5).Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, r, 1)
+}
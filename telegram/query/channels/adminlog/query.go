@@ -0,0 +1,3 @@
+package adminlog
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=ChannelsAdminLogResults -package=adminlog -prefix=Channels -max-id -no-total -out=queries.gen.go
@@ -0,0 +1,71 @@
+package saved
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func result(count int, ids ...int64) tg.MessagesSavedDialogsClass {
+	r := &tg.MessagesSavedDialogsSlice{Count: count}
+	for _, id := range ids {
+		// Use user ID as top message ID and date.
+		r.Dialogs = append(r.Dialogs, &tg.SavedDialog{
+			Peer:       &tg.PeerUser{UserID: id},
+			TopMessage: int(id),
+		})
+		r.Messages = append(r.Messages, &tg.Message{
+			ID:          int(id),
+			Date:        int(id),
+			PeerID:      &tg.PeerUser{UserID: 1},
+			SavedPeerID: &tg.PeerUser{UserID: id},
+		})
+		r.Users = append(r.Users, &tg.User{ID: id, AccessHash: id * 10})
+	}
+	return r
+}
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	limit := 2
+
+	request := func(offsetID, offsetDate int, offsetPeer tg.InputPeerClass) *tg.MessagesGetSavedDialogsRequest {
+		return &tg.MessagesGetSavedDialogsRequest{
+			ParentPeer: &tg.InputPeerEmpty{},
+			OffsetDate: offsetDate,
+			OffsetID:   offsetID,
+			OffsetPeer: offsetPeer,
+			Limit:      limit,
+		}
+	}
+	mock.ExpectCall(request(0, 0, &tg.InputPeerEmpty{})).ThenResult(result(3, 30, 20))
+	mock.ExpectCall(request(20, 20, &tg.InputPeerUser{
+		UserID:     20,
+		AccessHash: 200,
+	})).ThenResult(result(3, 10))
+	mock.ExpectCall(request(10, 10, &tg.InputPeerUser{
+		UserID:     10,
+		AccessHash: 100,
+	})).ThenResult(result(3))
+
+	iter := NewQueryBuilder(raw).GetSavedDialogs().BatchSize(limit).Iter()
+	var ids []int
+	for iter.Next(ctx) {
+		e := iter.Value()
+		require.NotNil(t, e.Last)
+		require.Equal(t, e.Dialog.GetTopMessage(), e.Last.GetID())
+		ids = append(ids, e.Last.GetID())
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, []int{30, 20, 10}, ids)
+
+	total, err := iter.Total(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, total)
+}
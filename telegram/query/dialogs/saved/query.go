@@ -0,0 +1,3 @@
+package saved
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=MessagesSavedDialogsClass -package=saved -out=queries.gen.go
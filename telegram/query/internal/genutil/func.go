@@ -22,6 +22,26 @@ func (f Func) Args() *types.Tuple {
 	return f.Sig.Params()
 }
 
+// Method reports whether function is a method of given named type
+// (or pointer to it).
+//
+// Interface methods, like methods of tg.Server, are not matched.
+func (f Func) Method(typeName string) bool {
+	recv := f.Sig.Recv()
+	if recv == nil {
+		return false
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	return named.Obj().Name() == typeName
+}
+
 // Funcs collects all function from package using given filter.
 // Parameter keep may be nil.
 func Funcs(pkg *packages.Package, keep func(f Func) bool) []Func {
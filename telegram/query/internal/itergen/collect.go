@@ -35,6 +35,8 @@ type collector struct {
 
 	iface          *types.Interface
 	resultTypeName string
+	resultType     string
+	noTotal        bool
 	elemName       string
 	prefix         string
 	pkgName        string
@@ -46,6 +48,8 @@ type collectorConfig struct {
 	ElemName   string
 	Prefix     string
 	PkgName    string
+	MaxID      bool
+	NoTotal    bool
 }
 
 func (c *collectorConfig) fromFlags(set *flag.FlagSet) {
@@ -53,6 +57,8 @@ func (c *collectorConfig) fromFlags(set *flag.FlagSet) {
 	set.StringVar(&c.ElemName, "elem", "Elem", "element type name")
 	set.StringVar(&c.Prefix, "prefix", "Messages", "prefix of methods to trim")
 	set.StringVar(&c.PkgName, "package", "messages", "name of package name to generate")
+	set.BoolVar(&c.MaxID, "max-id", false, "use MaxID field as iterator offset")
+	set.BoolVar(&c.NoTotal, "no-total", false, "result has no total count of elements")
 }
 
 func newCollector(pkg *packages.Package, cfg collectorConfig) *collector {
@@ -88,6 +94,11 @@ func newCollector(pkg *packages.Package, cfg collectorConfig) *collector {
 		"OffsetDate",
 		"Offset",
 	}
+	if cfg.MaxID {
+		delete(ignoreFields, "MaxID")
+		canFillFromRequest["MaxID"] = struct{}{}
+		requiredByIter = append(requiredByIter, "MaxID")
+	}
 	required := map[string]string{
 		"Peer":    "InputPeerClass",
 		"Channel": "InputChannelClass",
@@ -103,6 +114,8 @@ func newCollector(pkg *packages.Package, cfg collectorConfig) *collector {
 		pkg:                pkg,
 		iface:              match,
 		resultTypeName:     cfg.ResultName,
+		resultType:         "tg." + cfg.ResultName,
+		noTotal:            cfg.NoTotal,
 		elemName:           cfg.ElemName,
 		prefix:             cfg.Prefix,
 		pkgName:            cfg.PkgName,
@@ -113,7 +126,7 @@ func (c *collector) methods() ([]method, error) { // nolint:gocognit
 	var result []method
 
 	for _, def := range genutil.Funcs(c.pkg, func(f genutil.Func) bool {
-		return f.Args().Len() == 2 && f.Results().Len() == 2
+		return f.Method("Client") && f.Args().Len() == 2 && f.Results().Len() == 2
 	}) {
 		args := def.Args()
 		results := def.Results()
@@ -124,14 +137,23 @@ func (c *collector) methods() ([]method, error) { // nolint:gocognit
 		}
 		reqType := ptr.Elem()
 
-		resultType, ok := results.At(0).Type().(*types.Named)
+		resultType := results.At(0).Type()
+		named, ok := resultType.(*types.Named)
+		ptr, isPtr := resultType.(*types.Pointer)
+		if isPtr {
+			// Result is a struct, not a class interface.
+			named, ok = ptr.Elem().(*types.Named)
+		}
 		if !ok {
 			continue
 		}
 
-		if resultType.Obj().Name() != c.resultTypeName {
+		if named.Obj().Name() != c.resultTypeName {
 			continue
 		}
+		if isPtr {
+			c.resultType = "*tg." + c.resultTypeName
+		}
 		name := strings.TrimPrefix(def.Decl.Name(), c.prefix)
 
 		m := method{
@@ -165,7 +187,7 @@ func (c *collector) methods() ([]method, error) { // nolint:gocognit
 				}
 				m.fromRequest = append(m.fromRequest, RequestArgument{
 					Arg:            param,
-					Chain:          field.Name() == "OffsetID" || field.Name() == "OffsetDate",
+					Chain:          field.Name() == "OffsetID" || field.Name() == "OffsetDate" || field.Name() == "MaxID",
 					RequiredByIter: requiredByIter,
 				})
 
@@ -204,6 +226,8 @@ func (c *collector) Config() (Config, error) {
 		Methods:       methods,
 		Package:       c.pkgName,
 		ResultName:    c.resultTypeName,
+		ResultType:    c.resultType,
+		NoTotal:       c.noTotal,
 		RequestFields: sortParams(c.requestFields),
 	}, nil
 }
@@ -230,6 +254,7 @@ func (c *collector) collect() ([]Method, error) {
 			AdditionalParams:  sortParams(method.params),
 			IteratorName:      "Iterator",
 			ElemName:          c.elemName,
+			NoTotal:           c.noTotal,
 		}
 
 		for _, field := range method.params {
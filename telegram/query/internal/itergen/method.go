@@ -94,6 +94,8 @@ type Method struct {
 	IteratorName string
 	// ElemName  is name of iterator elem.
 	ElemName string
+	// NoTotal is flag that result has no total count of elements.
+	NoTotal bool
 }
 
 // Config is codegeneration config to use.
@@ -104,6 +106,10 @@ type Config struct {
 	Package string
 	// ResultName is name of result type.
 	ResultName string
+	// ResultType is a Go type of result.
+	ResultType string
+	// NoTotal is flag that result has no total count of elements.
+	NoTotal bool
 	// RequestFields is a slice of request struct fields.
 	RequestFields []Param
 }
@@ -0,0 +1,166 @@
+// Package calendar contains search results calendar iteration helper.
+package calendar
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// Elem is a calendar iterator element.
+type Elem struct {
+	Period tg.SearchResultsCalendarPeriod
+}
+
+// Iterator is a search results calendar stream iterator.
+//
+// Periods are returned from newest to oldest day. Day on the boundary of
+// two batches may be returned twice, each time with its part of messages.
+type Iterator struct {
+	// Current state.
+	lastErr error
+	// Buffer state.
+	buf    []Elem
+	bufCur int
+	// Request state.
+	lastBatch bool
+	// Offset parameters state.
+	offsetID   int
+	offsetDate int
+	// Remote state.
+	count    int
+	inexact  bool
+	totalGot bool
+
+	// Query builder.
+	query Query
+}
+
+// NewIterator creates new iterator.
+func NewIterator(query Query) *Iterator {
+	return &Iterator{
+		bufCur: -1,
+		query:  query,
+	}
+}
+
+// OffsetID sets OffsetID request parameter.
+func (m *Iterator) OffsetID(offsetID int) *Iterator {
+	m.offsetID = offsetID
+	return m
+}
+
+// OffsetDate sets OffsetDate request parameter.
+func (m *Iterator) OffsetDate(offsetDate int) *Iterator {
+	m.offsetDate = offsetDate
+	return m
+}
+
+func (m *Iterator) apply(r *tg.MessagesSearchResultsCalendar) error {
+	m.count = r.Count
+	m.inexact = r.Inexact
+	m.totalGot = true
+
+	// Server returns min_msg_id of the whole batch, which is an offset
+	// of the next one. Stop if it does not move.
+	if len(r.Periods) == 0 || r.MinMsgID <= 1 || r.MinMsgID == m.offsetID {
+		m.lastBatch = true
+	}
+	m.offsetID = r.MinMsgID
+	m.offsetDate = r.MinDate
+
+	m.bufCur = -1
+	m.buf = m.buf[:0]
+	for _, p := range r.Periods {
+		m.buf = append(m.buf, Elem{Period: p})
+	}
+
+	return nil
+}
+
+func (m *Iterator) requestNext(ctx context.Context) error {
+	if m.lastBatch {
+		m.buf = m.buf[:0]
+		return nil
+	}
+
+	r, err := m.query.Query(ctx, Request{
+		OffsetID:   m.offsetID,
+		OffsetDate: m.offsetDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.apply(r)
+}
+
+func (m *Iterator) bufNext() bool {
+	if len(m.buf)-1 <= m.bufCur {
+		return false
+	}
+
+	m.bufCur++
+	return true
+}
+
+// Total returns last fetched count of messages.
+// If count was not fetched before, it requests server using FetchTotal.
+func (m *Iterator) Total(ctx context.Context) (int, error) {
+	if m.totalGot {
+		return m.count, nil
+	}
+
+	return m.FetchTotal(ctx)
+}
+
+// FetchTotal fetches and returns count of messages.
+func (m *Iterator) FetchTotal(ctx context.Context) (int, error) {
+	r, err := m.query.Query(ctx, Request{})
+	if err != nil {
+		return 0, errors.Wrap(err, "fetch total")
+	}
+
+	m.count = r.Count
+	m.inexact = r.Inexact
+	m.totalGot = true
+	return m.count, nil
+}
+
+// Inexact reports whether last fetched count may be inexact.
+func (m *Iterator) Inexact() bool {
+	return m.inexact
+}
+
+// Next prepares the next period for reading with the Value method.
+// It returns true on success, or false if there is no next period or an error happened while preparing it.
+// Err should be consulted to distinguish between the two cases.
+func (m *Iterator) Next(ctx context.Context) bool {
+	if m.lastErr != nil {
+		return false
+	}
+
+	if !m.bufNext() {
+		// If buffer is empty, we should fetch next batch.
+		if err := m.requestNext(ctx); err != nil {
+			m.lastErr = err
+			return false
+		}
+		// Try again with new buffer.
+		return m.bufNext()
+	}
+
+	return true
+}
+
+// Value returns current period.
+func (m *Iterator) Value() Elem {
+	return m.buf[m.bufCur]
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (m *Iterator) Err() error {
+	return m.lastErr
+}
@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/query/messages"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+
+	request := func(offsetID, offsetDate int) *tg.MessagesGetSearchResultsCalendarRequest {
+		return &tg.MessagesGetSearchResultsCalendarRequest{
+			Peer:       &tg.InputPeerSelf{},
+			Filter:     &tg.InputMessagesFilterPhotoVideo{},
+			OffsetID:   offsetID,
+			OffsetDate: offsetDate,
+		}
+	}
+	period := func(date, minID, maxID int) tg.SearchResultsCalendarPeriod {
+		return tg.SearchResultsCalendarPeriod{
+			Date:     date,
+			MinMsgID: minID,
+			MaxMsgID: maxID,
+			Count:    maxID - minID + 1,
+		}
+	}
+
+	mock.ExpectCall(request(0, 0)).ThenResult(&tg.MessagesSearchResultsCalendar{
+		Count:    30,
+		MinDate:  2000,
+		MinMsgID: 20,
+		Periods: []tg.SearchResultsCalendarPeriod{
+			period(3000, 25, 30),
+			period(2000, 20, 24),
+		},
+	})
+	mock.ExpectCall(request(20, 2000)).ThenResult(&tg.MessagesSearchResultsCalendar{
+		Count:    30,
+		MinDate:  1000,
+		MinMsgID: 1,
+		Periods: []tg.SearchResultsCalendarPeriod{
+			period(1000, 1, 19),
+		},
+	})
+
+	elems, err := NewQueryBuilder(raw).
+		GetSearchResultsCalendar(&tg.InputPeerSelf{}, &tg.InputMessagesFilterPhotos{}).
+		Kind(messages.MediaPhotoVideo).
+		Collect(ctx)
+	require.NoError(t, err)
+
+	var dates []int
+	for _, e := range elems {
+		dates = append(dates, e.Period.Date)
+	}
+	require.Equal(t, []int{3000, 2000, 1000}, dates)
+}
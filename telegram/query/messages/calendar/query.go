@@ -0,0 +1,147 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/query/messages"
+	"github.com/gotd/td/tg"
+)
+
+// Request is a parameter for Query.
+type Request struct {
+	OffsetID   int
+	OffsetDate int
+}
+
+// Query is an abstraction for calendar request.
+type Query interface {
+	Query(ctx context.Context, req Request) (*tg.MessagesSearchResultsCalendar, error)
+}
+
+// QueryFunc is a function adapter for Query.
+type QueryFunc func(ctx context.Context, req Request) (*tg.MessagesSearchResultsCalendar, error)
+
+// Query implements Query interface.
+func (q QueryFunc) Query(ctx context.Context, req Request) (*tg.MessagesSearchResultsCalendar, error) {
+	return q(ctx, req)
+}
+
+// QueryBuilder is a helper to create calendar queries.
+//
+// Unlike sibling packages, like positions, builder is not generated by
+// itergen: messages.getSearchResultsCalendar has no limit and is paginated
+// by min_msg_id and min_date of previous batch instead of the last element,
+// which itergen iterators do not support.
+type QueryBuilder struct {
+	raw *tg.Client
+}
+
+// NewQueryBuilder creates new QueryBuilder.
+func NewQueryBuilder(raw *tg.Client) *QueryBuilder {
+	return &QueryBuilder{raw: raw}
+}
+
+// GetSearchResultsCalendarQueryBuilder is query builder of MessagesGetSearchResultsCalendar.
+type GetSearchResultsCalendarQueryBuilder struct {
+	raw        *tg.Client
+	req        tg.MessagesGetSearchResultsCalendarRequest
+	offsetID   int
+	offsetDate int
+}
+
+// GetSearchResultsCalendar creates query builder of MessagesGetSearchResultsCalendar.
+//
+// Filter is required: inputMessagesFilterEmpty and inputMessagesFilterMyMentions
+// are not supported by server.
+func (q *QueryBuilder) GetSearchResultsCalendar(
+	peer tg.InputPeerClass,
+	filter tg.MessagesFilterClass,
+) *GetSearchResultsCalendarQueryBuilder {
+	return &GetSearchResultsCalendarQueryBuilder{
+		raw: q.raw,
+		req: tg.MessagesGetSearchResultsCalendarRequest{
+			Peer:   peer,
+			Filter: filter,
+		},
+	}
+}
+
+// OffsetID sets message ID from which iteration starts.
+func (b *GetSearchResultsCalendarQueryBuilder) OffsetID(offsetID int) *GetSearchResultsCalendarQueryBuilder {
+	b.offsetID = offsetID
+	return b
+}
+
+// OffsetDate sets date from which iteration starts.
+func (b *GetSearchResultsCalendarQueryBuilder) OffsetDate(offsetDate int) *GetSearchResultsCalendarQueryBuilder {
+	b.offsetDate = offsetDate
+	return b
+}
+
+// Filter sets messages filter.
+func (b *GetSearchResultsCalendarQueryBuilder) Filter(filter tg.MessagesFilterClass) *GetSearchResultsCalendarQueryBuilder {
+	b.req.Filter = filter
+	return b
+}
+
+// Kind sets messages filter using MediaKind.
+func (b *GetSearchResultsCalendarQueryBuilder) Kind(kind messages.MediaKind) *GetSearchResultsCalendarQueryBuilder {
+	return b.Filter(kind.Filter())
+}
+
+// Peer sets peer to search in.
+func (b *GetSearchResultsCalendarQueryBuilder) Peer(peer tg.InputPeerClass) *GetSearchResultsCalendarQueryBuilder {
+	b.req.Peer = peer
+	return b
+}
+
+// SavedPeerID sets Saved Messages dialog to search in.
+func (b *GetSearchResultsCalendarQueryBuilder) SavedPeerID(peer tg.InputPeerClass) *GetSearchResultsCalendarQueryBuilder {
+	b.req.SetSavedPeerID(peer)
+	return b
+}
+
+// Query implements Query interface.
+func (b *GetSearchResultsCalendarQueryBuilder) Query(ctx context.Context, req Request) (*tg.MessagesSearchResultsCalendar, error) {
+	r := b.req
+	r.OffsetID = req.OffsetID
+	r.OffsetDate = req.OffsetDate
+	return b.raw.MessagesGetSearchResultsCalendar(ctx, &r)
+}
+
+// Iter returns iterator using built query.
+func (b *GetSearchResultsCalendarQueryBuilder) Iter() *Iterator {
+	return NewIterator(b).OffsetID(b.offsetID).OffsetDate(b.offsetDate)
+}
+
+// ForEach calls given callback on each period.
+func (b *GetSearchResultsCalendarQueryBuilder) ForEach(ctx context.Context, cb func(context.Context, Elem) error) error {
+	iter := b.Iter()
+	for iter.Next(ctx) {
+		if err := cb(ctx, iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// Count fetches number of matching messages.
+func (b *GetSearchResultsCalendarQueryBuilder) Count(ctx context.Context) (int, error) {
+	c, err := b.Iter().Total(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "get total")
+	}
+	return c, nil
+}
+
+// Collect returns all periods.
+func (b *GetSearchResultsCalendarQueryBuilder) Collect(ctx context.Context) ([]Elem, error) {
+	var r []Elem
+	err := b.ForEach(ctx, func(ctx context.Context, e Elem) error {
+		r = append(r, e)
+		return nil
+	})
+	return r, err
+}
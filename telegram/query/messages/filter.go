@@ -0,0 +1,195 @@
+package messages
+
+import (
+	"strconv"
+
+	"github.com/gotd/td/tg"
+)
+
+// MediaKind is a typed kind of messages filter.
+type MediaKind int
+
+const (
+	// MediaAny matches all messages.
+	MediaAny MediaKind = iota
+	// MediaPhotos matches messages with photos.
+	MediaPhotos
+	// MediaVideo matches messages with videos.
+	MediaVideo
+	// MediaPhotoVideo matches messages with photos or videos.
+	MediaPhotoVideo
+	// MediaDocument matches messages with documents.
+	MediaDocument
+	// MediaURL matches messages with links.
+	MediaURL
+	// MediaGif matches messages with GIFs.
+	MediaGif
+	// MediaVoice matches messages with voice notes.
+	MediaVoice
+	// MediaMusic matches messages with music.
+	MediaMusic
+	// MediaChatPhotos matches chat photo change service messages.
+	MediaChatPhotos
+	// MediaPhoneCalls matches phone call service messages.
+	MediaPhoneCalls
+	// MediaMissedCalls matches missed phone call service messages.
+	MediaMissedCalls
+	// MediaRoundVoice matches messages with round videos or voice notes.
+	MediaRoundVoice
+	// MediaRoundVideo matches messages with round videos.
+	MediaRoundVideo
+	// MediaMyMentions matches messages mentioning current user.
+	MediaMyMentions
+	// MediaGeo matches messages with locations.
+	MediaGeo
+	// MediaContacts matches messages with contacts.
+	MediaContacts
+	// MediaPinned matches pinned messages.
+	MediaPinned
+)
+
+// String implements fmt.Stringer.
+func (k MediaKind) String() string {
+	switch k {
+	case MediaAny:
+		return "Any"
+	case MediaPhotos:
+		return "Photos"
+	case MediaVideo:
+		return "Video"
+	case MediaPhotoVideo:
+		return "PhotoVideo"
+	case MediaDocument:
+		return "Document"
+	case MediaURL:
+		return "URL"
+	case MediaGif:
+		return "Gif"
+	case MediaVoice:
+		return "Voice"
+	case MediaMusic:
+		return "Music"
+	case MediaChatPhotos:
+		return "ChatPhotos"
+	case MediaPhoneCalls:
+		return "PhoneCalls"
+	case MediaMissedCalls:
+		return "MissedCalls"
+	case MediaRoundVoice:
+		return "RoundVoice"
+	case MediaRoundVideo:
+		return "RoundVideo"
+	case MediaMyMentions:
+		return "MyMentions"
+	case MediaGeo:
+		return "Geo"
+	case MediaContacts:
+		return "Contacts"
+	case MediaPinned:
+		return "Pinned"
+	default:
+		return "MediaKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Filter returns messages filter of kind.
+func (k MediaKind) Filter() tg.MessagesFilterClass {
+	switch k {
+	case MediaPhotos:
+		return &tg.InputMessagesFilterPhotos{}
+	case MediaVideo:
+		return &tg.InputMessagesFilterVideo{}
+	case MediaPhotoVideo:
+		return &tg.InputMessagesFilterPhotoVideo{}
+	case MediaDocument:
+		return &tg.InputMessagesFilterDocument{}
+	case MediaURL:
+		return &tg.InputMessagesFilterURL{}
+	case MediaGif:
+		return &tg.InputMessagesFilterGif{}
+	case MediaVoice:
+		return &tg.InputMessagesFilterVoice{}
+	case MediaMusic:
+		return &tg.InputMessagesFilterMusic{}
+	case MediaChatPhotos:
+		return &tg.InputMessagesFilterChatPhotos{}
+	case MediaPhoneCalls:
+		return &tg.InputMessagesFilterPhoneCalls{}
+	case MediaMissedCalls:
+		return &tg.InputMessagesFilterPhoneCalls{Missed: true}
+	case MediaRoundVoice:
+		return &tg.InputMessagesFilterRoundVoice{}
+	case MediaRoundVideo:
+		return &tg.InputMessagesFilterRoundVideo{}
+	case MediaMyMentions:
+		return &tg.InputMessagesFilterMyMentions{}
+	case MediaGeo:
+		return &tg.InputMessagesFilterGeo{}
+	case MediaContacts:
+		return &tg.InputMessagesFilterContacts{}
+	case MediaPinned:
+		return &tg.InputMessagesFilterPinned{}
+	default:
+		return &tg.InputMessagesFilterEmpty{}
+	}
+}
+
+// KindOf returns MediaKind of given messages filter.
+func KindOf(filter tg.MessagesFilterClass) (MediaKind, bool) {
+	switch f := filter.(type) {
+	case nil, *tg.InputMessagesFilterEmpty:
+		return MediaAny, true
+	case *tg.InputMessagesFilterPhotos:
+		return MediaPhotos, true
+	case *tg.InputMessagesFilterVideo:
+		return MediaVideo, true
+	case *tg.InputMessagesFilterPhotoVideo:
+		return MediaPhotoVideo, true
+	case *tg.InputMessagesFilterDocument:
+		return MediaDocument, true
+	case *tg.InputMessagesFilterURL:
+		return MediaURL, true
+	case *tg.InputMessagesFilterGif:
+		return MediaGif, true
+	case *tg.InputMessagesFilterVoice:
+		return MediaVoice, true
+	case *tg.InputMessagesFilterMusic:
+		return MediaMusic, true
+	case *tg.InputMessagesFilterChatPhotos:
+		return MediaChatPhotos, true
+	case *tg.InputMessagesFilterPhoneCalls:
+		if f.Missed {
+			return MediaMissedCalls, true
+		}
+		return MediaPhoneCalls, true
+	case *tg.InputMessagesFilterRoundVoice:
+		return MediaRoundVoice, true
+	case *tg.InputMessagesFilterRoundVideo:
+		return MediaRoundVideo, true
+	case *tg.InputMessagesFilterMyMentions:
+		return MediaMyMentions, true
+	case *tg.InputMessagesFilterGeo:
+		return MediaGeo, true
+	case *tg.InputMessagesFilterContacts:
+		return MediaContacts, true
+	case *tg.InputMessagesFilterPinned:
+		return MediaPinned, true
+	default:
+		return 0, false
+	}
+}
+
+// Kind sets Filter field of Search query using MediaKind.
+func (b *SearchQueryBuilder) Kind(kind MediaKind) *SearchQueryBuilder {
+	return b.Filter(kind.Filter())
+}
+
+// Kind sets Filter field of SearchGlobal query using MediaKind.
+func (b *SearchGlobalQueryBuilder) Kind(kind MediaKind) *SearchGlobalQueryBuilder {
+	return b.Filter(kind.Filter())
+}
+
+// Kind sets Filter field of SearchSentMedia query using MediaKind.
+func (b *SearchSentMediaQueryBuilder) Kind(kind MediaKind) *SearchSentMediaQueryBuilder {
+	return b.Filter(kind.Filter())
+}
@@ -0,0 +1,27 @@
+package messages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestMediaKind(t *testing.T) {
+	for kind := MediaAny; kind <= MediaPinned; kind++ {
+		t.Run(kind.String(), func(t *testing.T) {
+			a := require.New(t)
+			got, ok := KindOf(kind.Filter())
+			a.True(ok)
+			a.Equal(kind, got)
+		})
+	}
+
+	a := require.New(t)
+	a.Equal(&tg.InputMessagesFilterPhoneCalls{Missed: true}, MediaMissedCalls.Filter())
+	kind, ok := KindOf(nil)
+	a.True(ok)
+	a.Equal(MediaAny, kind)
+	a.Equal("MediaKind(100)", MediaKind(100).String())
+}
@@ -84,6 +84,8 @@ func (m *Iterator) apply(r tg.MessagesMessagesClass) error {
 	var (
 		messages tg.MessageClassArray
 		entities peer.Entities
+		// Global search results are ordered by rate, not by ID.
+		ordered bool
 	)
 	switch msgs := r.(type) {
 	case *tg.MessagesMessages: // messages.messages#8c718e87
@@ -96,7 +98,10 @@ func (m *Iterator) apply(r tg.MessagesMessagesClass) error {
 		messages = msgs.Messages
 		entities = peer.EntitiesFromResult(msgs)
 
-		m.offsetRate = msgs.NextRate
+		if rate, ok := msgs.GetNextRate(); ok {
+			m.offsetRate = rate
+			ordered = true
+		}
 		m.count = msgs.Count
 		m.lastBatch = len(msgs.Messages) < m.limit
 	case *tg.MessagesChannelMessages: // messages.channelMessages#64479808
@@ -111,9 +116,14 @@ func (m *Iterator) apply(r tg.MessagesMessagesClass) error {
 	m.totalGot = true
 
 	// Sort messages to guarantee order and find the last message.
-	messages = messages.SortStable(func(a, b tg.MessageClass) bool {
-		return a.GetID() > b.GetID()
-	})
+	//
+	// Messages from different chats can not be ordered by ID, so server
+	// order is kept for them.
+	if !ordered {
+		messages = messages.SortStable(func(a, b tg.MessageClass) bool {
+			return a.GetID() > b.GetID()
+		})
+	}
 
 	// Get the last message (with smallest ID).
 	msg, ok := messages.Last()
@@ -105,3 +105,113 @@ func TestIterator(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, totalMessages, total)
 }
+
+func TestTopicQueries(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	q := NewQueryBuilder(tg.NewClient(mock))
+
+	msg := &tg.Message{
+		ID:     15,
+		PeerID: &tg.PeerChannel{ChannelID: 10},
+		ReplyTo: &tg.MessageReplyHeader{
+			ForumTopic:   true,
+			ReplyToMsgID: 10,
+		},
+	}
+	mock.ExpectCall(&tg.MessagesGetRepliesRequest{
+		Peer:  &tg.InputPeerSelf{},
+		MsgID: 10,
+		Limit: 1,
+	}).ThenResult(messagesClass([]tg.MessageClass{msg}, 1))
+
+	iter := q.GetTopicHistory(&tg.InputPeerSelf{}, 10).Iter()
+	require.True(t, iter.Next(ctx))
+	require.NoError(t, iter.Err())
+	id, ok := iter.Value().TopicID()
+	require.True(t, ok)
+	require.Equal(t, 10, id)
+
+	mock.ExpectCall(&tg.MessagesSearchRequest{
+		Q:           "query",
+		Peer:        &tg.InputPeerSelf{},
+		TopMsgID:    10,
+		FromID:      &tg.InputPeerEmpty{},
+		Filter:      &tg.InputMessagesFilterEmpty{},
+		SavedPeerID: &tg.InputPeerEmpty{},
+		Limit:       1,
+	}).ThenResult(messagesClass(nil, 0))
+
+	iter = q.SearchTopic(&tg.InputPeerSelf{}, 10).Q("query").Iter()
+	require.False(t, iter.Next(ctx))
+	require.NoError(t, iter.Err())
+}
+
+func TestIteratorSearchGlobal(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	limit := 2
+
+	msg := func(id int, userID int64) *tg.Message {
+		return &tg.Message{
+			ID:      id,
+			Date:    id,
+			PeerID:  &tg.PeerUser{UserID: userID},
+			Message: strconv.Itoa(id),
+		}
+	}
+	users := []tg.UserClass{
+		&tg.User{ID: 1, AccessHash: 10},
+		&tg.User{ID: 2, AccessHash: 20},
+	}
+	request := func(rate, id int, offsetPeer tg.InputPeerClass) *tg.MessagesSearchGlobalRequest {
+		return &tg.MessagesSearchGlobalRequest{
+			Q:          "query",
+			Filter:     &tg.InputMessagesFilterPhotos{},
+			FolderID:   1,
+			MinDate:    5,
+			MaxDate:    100,
+			OffsetRate: rate,
+			OffsetPeer: offsetPeer,
+			OffsetID:   id,
+			Limit:      limit,
+		}
+	}
+
+	// Results from different chats are not ordered by ID.
+	mock.ExpectCall(request(0, 0, &tg.InputPeerEmpty{})).ThenResult(&tg.MessagesMessagesSlice{
+		Count:    3,
+		NextRate: 50,
+		Messages: []tg.MessageClass{msg(10, 1), msg(20, 2)},
+		Users:    users,
+	})
+	mock.ExpectCall(request(50, 20, &tg.InputPeerUser{
+		UserID:     2,
+		AccessHash: 20,
+	})).ThenResult(&tg.MessagesMessagesSlice{
+		Count:    3,
+		Messages: []tg.MessageClass{msg(5, 1)},
+		Users:    users,
+	})
+	mock.ExpectCall(request(50, 5, &tg.InputPeerUser{
+		UserID:     1,
+		AccessHash: 10,
+	})).ThenResult(&tg.MessagesMessagesSlice{
+		Count: 3,
+	})
+
+	var ids []int
+	require.NoError(t, NewQueryBuilder(raw).SearchGlobal().
+		Q("query").
+		Kind(MediaPhotos).
+		FolderID(1).
+		MinDate(5).
+		MaxDate(100).
+		BatchSize(limit).
+		ForEach(ctx, func(ctx context.Context, e Elem) error {
+			ids = append(ids, e.Msg.GetID())
+			return nil
+		}))
+	require.Equal(t, []int{10, 20, 5}, ids)
+}
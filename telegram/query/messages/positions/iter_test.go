@@ -0,0 +1,56 @@
+package positions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/query/messages"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	limit := 2
+
+	request := func(offsetID int) *tg.MessagesGetSearchResultsPositionsRequest {
+		return &tg.MessagesGetSearchResultsPositionsRequest{
+			Peer:        &tg.InputPeerSelf{},
+			SavedPeerID: &tg.InputPeerEmpty{},
+			Filter:      &tg.InputMessagesFilterPhotos{},
+			OffsetID:    offsetID,
+			Limit:       limit,
+		}
+	}
+	position := func(id, offset int) tg.SearchResultPosition {
+		return tg.SearchResultPosition{MsgID: id, Date: id, Offset: offset}
+	}
+
+	mock.ExpectCall(request(0)).ThenResult(&tg.MessagesSearchResultsPositions{
+		Count:     300,
+		Positions: []tg.SearchResultPosition{position(500, 0), position(400, 100)},
+	})
+	mock.ExpectCall(request(400)).ThenResult(&tg.MessagesSearchResultsPositions{
+		Count:     300,
+		Positions: []tg.SearchResultPosition{position(300, 200)},
+	})
+
+	var offsets []int
+	iter := NewQueryBuilder(raw).GetSearchResultsPositions(&tg.InputPeerSelf{}).
+		Kind(messages.MediaPhotos).
+		BatchSize(limit).
+		Iter()
+	for iter.Next(ctx) {
+		offsets = append(offsets, iter.Value().Position.Offset)
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, []int{0, 100, 200}, offsets)
+
+	total, err := iter.Total(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 300, total)
+}
@@ -0,0 +1,12 @@
+package positions
+
+import (
+	"github.com/gotd/td/telegram/query/messages"
+)
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=MessagesSearchResultsPositions -package=positions -out=queries.gen.go
+
+// Kind sets Filter field of GetSearchResultsPositions query using MediaKind.
+func (b *GetSearchResultsPositionsQueryBuilder) Kind(kind messages.MediaKind) *GetSearchResultsPositionsQueryBuilder {
+	return b.Filter(kind.Filter())
+}
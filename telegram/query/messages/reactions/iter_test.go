@@ -0,0 +1,55 @@
+package reactions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	self := &tg.InputPeerSelf{}
+	like := &tg.ReactionEmoji{Emoticon: "👍"}
+
+	reaction := func(userID int64) tg.MessagePeerReaction {
+		return tg.MessagePeerReaction{
+			PeerID:   &tg.PeerUser{UserID: userID},
+			Reaction: like,
+		}
+	}
+	first := &tg.MessagesMessageReactionsList{
+		Count:     2,
+		Reactions: []tg.MessagePeerReaction{reaction(1)},
+	}
+	first.SetNextOffset("next")
+	mock.ExpectCall(&tg.MessagesGetMessageReactionsListRequest{
+		Peer:     self,
+		ID:       10,
+		Reaction: like,
+		Limit:    1,
+	}).ThenResult(first)
+	mock.ExpectCall(&tg.MessagesGetMessageReactionsListRequest{
+		Peer:     self,
+		ID:       10,
+		Reaction: like,
+		Offset:   "next",
+		Limit:    1,
+	}).ThenResult(&tg.MessagesMessageReactionsList{
+		Count:     2,
+		Reactions: []tg.MessagePeerReaction{reaction(2)},
+	})
+
+	var got []tg.MessagePeerReaction
+	require.NoError(t, NewQueryBuilder(raw).GetMessageReactionsList(self).ID(10).Reaction(like).
+		ForEach(ctx, func(ctx context.Context, elem Elem) error {
+			got = append(got, elem.Reaction)
+			return nil
+		}))
+	require.Equal(t, []tg.MessagePeerReaction{reaction(1), reaction(2)}, got)
+}
@@ -0,0 +1,3 @@
+package reactions
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=MessagesMessageReactionsList -package=reactions -prefix=Messages -out=queries.gen.go
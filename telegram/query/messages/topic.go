@@ -0,0 +1,31 @@
+package messages
+
+import (
+	"github.com/gotd/td/telegram/message/unpack"
+	"github.com/gotd/td/tg"
+)
+
+// GetTopicHistory creates query builder of messages of forum topic.
+//
+// It is a shorthand for GetReplies with topic ID as message ID.
+//
+// See https://core.telegram.org/api/forum#forum-topics.
+func (q *QueryBuilder) GetTopicHistory(paramPeer tg.InputPeerClass, topicID int) *GetRepliesQueryBuilder {
+	return q.GetReplies(paramPeer).MsgID(topicID)
+}
+
+// SearchTopic creates query builder to search messages of forum topic.
+//
+// It is a shorthand for Search with topic ID as TopMsgID.
+func (q *QueryBuilder) SearchTopic(paramPeer tg.InputPeerClass, topicID int) *SearchQueryBuilder {
+	return q.Search(paramPeer).TopMsgID(topicID)
+}
+
+// TopicID returns forum topic ID of message, if any.
+func (e Elem) TopicID() (int, bool) {
+	msg, ok := e.Msg.(tg.MessageClass)
+	if !ok {
+		return 0, false
+	}
+	return unpack.TopicID(msg)
+}
@@ -2,12 +2,21 @@
 package query
 
 import (
+	"github.com/gotd/td/telegram/query/channels/adminlog"
 	"github.com/gotd/td/telegram/query/channels/participants"
 	"github.com/gotd/td/telegram/query/contacts/blocked"
 	"github.com/gotd/td/telegram/query/dialogs"
+	"github.com/gotd/td/telegram/query/dialogs/saved"
 	"github.com/gotd/td/telegram/query/messages"
+	"github.com/gotd/td/telegram/query/messages/calendar"
+	"github.com/gotd/td/telegram/query/messages/positions"
+	msgreactions "github.com/gotd/td/telegram/query/messages/reactions"
 	"github.com/gotd/td/telegram/query/messages/stickers/featured"
 	"github.com/gotd/td/telegram/query/photos"
+	"github.com/gotd/td/telegram/query/stats/forwards"
+	"github.com/gotd/td/telegram/query/stories"
+	"github.com/gotd/td/telegram/query/stories/reactions"
+	"github.com/gotd/td/telegram/query/stories/views"
 	"github.com/gotd/td/tg"
 )
 
@@ -26,6 +35,11 @@ func (q *Query) Participants() *participants.QueryBuilder {
 	return participants.NewQueryBuilder(q.raw)
 }
 
+// AdminLog creates adminlog.QueryBuilder
+func (q *Query) AdminLog() *adminlog.QueryBuilder {
+	return adminlog.NewQueryBuilder(q.raw)
+}
+
 // Blocked creates blocked.QueryBuilder
 func (q *Query) Blocked() *blocked.QueryBuilder {
 	return blocked.NewQueryBuilder(q.raw)
@@ -36,16 +50,56 @@ func (q *Query) Photos() *photos.QueryBuilder {
 	return photos.NewQueryBuilder(q.raw)
 }
 
+// Stories creates stories.QueryBuilder
+func (q *Query) Stories() *stories.QueryBuilder {
+	return stories.NewQueryBuilder(q.raw)
+}
+
+// StoryViews creates views.QueryBuilder
+func (q *Query) StoryViews() *views.QueryBuilder {
+	return views.NewQueryBuilder(q.raw)
+}
+
+// StoryReactions creates reactions.QueryBuilder
+func (q *Query) StoryReactions() *reactions.QueryBuilder {
+	return reactions.NewQueryBuilder(q.raw)
+}
+
+// MessageReactions creates reactions.QueryBuilder of message reactions.
+func (q *Query) MessageReactions() *msgreactions.QueryBuilder {
+	return msgreactions.NewQueryBuilder(q.raw)
+}
+
+// PublicForwards creates forwards.QueryBuilder
+func (q *Query) PublicForwards() *forwards.QueryBuilder {
+	return forwards.NewQueryBuilder(q.raw)
+}
+
 // Dialogs creates dialogs.QueryBuilder
 func (q *Query) Dialogs() *dialogs.QueryBuilder {
 	return dialogs.NewQueryBuilder(q.raw)
 }
 
+// SavedDialogs creates saved.QueryBuilder.
+func (q *Query) SavedDialogs() *saved.QueryBuilder {
+	return saved.NewQueryBuilder(q.raw)
+}
+
 // Messages creates messages.QueryBuilder.
 func (q *Query) Messages() *messages.QueryBuilder {
 	return messages.NewQueryBuilder(q.raw)
 }
 
+// SearchResultsPositions creates positions.QueryBuilder.
+func (q *Query) SearchResultsPositions() *positions.QueryBuilder {
+	return positions.NewQueryBuilder(q.raw)
+}
+
+// SearchResultsCalendar creates calendar.QueryBuilder.
+func (q *Query) SearchResultsCalendar() *calendar.QueryBuilder {
+	return calendar.NewQueryBuilder(q.raw)
+}
+
 // Featured creates featured.QueryBuilder
 func (q *Query) Featured() *featured.QueryBuilder {
 	return featured.NewQueryBuilder(q.raw)
@@ -64,6 +118,19 @@ func GetParticipants(raw *tg.Client, channel tg.InputChannelClass) *participants
 	return NewQuery(raw).GetParticipants(channel)
 }
 
+// GetAdminLog creates adminlog.GetAdminLogQueryBuilder.
+func (q *Query) GetAdminLog(channel tg.InputChannelClass) *adminlog.GetAdminLogQueryBuilder {
+	return adminlog.NewQueryBuilder(q.raw).GetAdminLog(channel)
+}
+
+// GetAdminLog creates adminlog.GetAdminLogQueryBuilder.
+// Shorthand for
+//
+//	query.NewQuery(raw).GetAdminLog(channel)
+func GetAdminLog(raw *tg.Client, channel tg.InputChannelClass) *adminlog.GetAdminLogQueryBuilder {
+	return NewQuery(raw).GetAdminLog(channel)
+}
+
 // GetBlocked creates blocked.GetBlockedQueryBuilder.
 func (q *Query) GetBlocked() *blocked.GetBlockedQueryBuilder {
 	return blocked.NewQueryBuilder(q.raw).GetBlocked()
@@ -90,6 +157,32 @@ func GetUserPhotos(raw *tg.Client, user tg.InputUserClass) *photos.GetUserPhotos
 	return NewQuery(raw).GetUserPhotos(user)
 }
 
+// GetPinnedStories creates stories.GetPinnedStoriesQueryBuilder.
+func (q *Query) GetPinnedStories(peer tg.InputPeerClass) *stories.GetPinnedStoriesQueryBuilder {
+	return stories.NewQueryBuilder(q.raw).GetPinnedStories(peer)
+}
+
+// GetPinnedStories creates stories.GetPinnedStoriesQueryBuilder.
+// Shorthand for
+//
+//	query.NewQuery(raw).GetPinnedStories(peer)
+func GetPinnedStories(raw *tg.Client, peer tg.InputPeerClass) *stories.GetPinnedStoriesQueryBuilder {
+	return NewQuery(raw).GetPinnedStories(peer)
+}
+
+// GetStoriesArchive creates stories.GetStoriesArchiveQueryBuilder.
+func (q *Query) GetStoriesArchive(peer tg.InputPeerClass) *stories.GetStoriesArchiveQueryBuilder {
+	return stories.NewQueryBuilder(q.raw).GetStoriesArchive(peer)
+}
+
+// GetStoriesArchive creates stories.GetStoriesArchiveQueryBuilder.
+// Shorthand for
+//
+//	query.NewQuery(raw).GetStoriesArchive(peer)
+func GetStoriesArchive(raw *tg.Client, peer tg.InputPeerClass) *stories.GetStoriesArchiveQueryBuilder {
+	return NewQuery(raw).GetStoriesArchive(peer)
+}
+
 // GetDialogs creates dialogs.GetDialogsQueryBuilder.
 func (q *Query) GetDialogs() *dialogs.GetDialogsQueryBuilder {
 	return dialogs.NewQueryBuilder(q.raw).GetDialogs()
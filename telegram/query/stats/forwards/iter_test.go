@@ -0,0 +1,51 @@
+package forwards
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	channel := &tg.InputChannel{ChannelID: 1, AccessHash: 1}
+
+	forward := func(id int) tg.PublicForwardClass {
+		return &tg.PublicForwardMessage{
+			Message: &tg.Message{ID: id, PeerID: &tg.PeerChannel{ChannelID: 2}},
+		}
+	}
+	first := &tg.StatsPublicForwards{
+		Count:    2,
+		Forwards: []tg.PublicForwardClass{forward(1)},
+	}
+	first.SetNextOffset("next")
+	mock.ExpectCall(&tg.StatsGetMessagePublicForwardsRequest{
+		Channel: channel,
+		MsgID:   10,
+		Limit:   1,
+	}).ThenResult(first)
+	mock.ExpectCall(&tg.StatsGetMessagePublicForwardsRequest{
+		Channel: channel,
+		MsgID:   10,
+		Offset:  "next",
+		Limit:   1,
+	}).ThenResult(&tg.StatsPublicForwards{
+		Count:    2,
+		Forwards: []tg.PublicForwardClass{forward(2)},
+	})
+
+	var got []tg.PublicForwardClass
+	require.NoError(t, NewQueryBuilder(raw).GetMessagePublicForwards(channel).MsgID(10).
+		ForEach(ctx, func(ctx context.Context, elem Elem) error {
+			got = append(got, elem.Forward)
+			return nil
+		}))
+	require.Equal(t, []tg.PublicForwardClass{forward(1), forward(2)}, got)
+}
@@ -0,0 +1,3 @@
+package forwards
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=StatsPublicForwards -package=forwards -prefix=Stats -out=queries.gen.go
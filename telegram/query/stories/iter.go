@@ -0,0 +1,172 @@
+// Package stories contains stories iteration helper.
+package stories
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/message/peer"
+	"github.com/gotd/td/tg"
+)
+
+// Elem is a story iterator element.
+type Elem struct {
+	Story    tg.StoryItemClass
+	Entities peer.Entities
+	// PinnedToTop denotes that story is pinned to the top of profile.
+	PinnedToTop bool
+}
+
+// Iterator is a story stream iterator.
+type Iterator struct {
+	// Current state.
+	lastErr error
+	// Buffer state.
+	buf    []Elem
+	bufCur int
+	// Request state.
+	limit     int
+	lastBatch bool
+	// Offset parameters state.
+	offset   int
+	offsetID int
+	// Remote state.
+	count    int
+	totalGot bool
+
+	// Query builder.
+	query Query
+}
+
+// NewIterator creates new iterator.
+func NewIterator(query Query, limit int) *Iterator {
+	return &Iterator{
+		buf:    make([]Elem, 0, limit),
+		bufCur: -1,
+		limit:  limit,
+		query:  query,
+	}
+}
+
+// Offset sets Offset request parameter.
+func (m *Iterator) Offset(offset int) *Iterator {
+	m.offset = offset
+	return m
+}
+
+// OffsetID sets OffsetID request parameter.
+func (m *Iterator) OffsetID(offsetID int) *Iterator {
+	m.offsetID = offsetID
+	return m
+}
+
+func (m *Iterator) apply(r *tg.StoriesStories) error {
+	entities := peer.EntitiesFromResult(r)
+
+	m.count = r.Count
+	m.totalGot = true
+	m.offset += len(r.Stories)
+	m.lastBatch = len(r.Stories) < m.limit
+
+	pinned := make(map[int]struct{}, len(r.PinnedToTop))
+	for _, id := range r.PinnedToTop {
+		pinned[id] = struct{}{}
+	}
+
+	m.bufCur = -1
+	m.buf = m.buf[:0]
+	for _, story := range r.Stories {
+		_, pinnedToTop := pinned[story.GetID()]
+		m.offsetID = story.GetID()
+		m.buf = append(m.buf, Elem{
+			Story:       story,
+			Entities:    entities,
+			PinnedToTop: pinnedToTop,
+		})
+	}
+
+	return nil
+}
+
+func (m *Iterator) requestNext(ctx context.Context) error {
+	if m.lastBatch {
+		m.buf = m.buf[:0]
+		return nil
+	}
+
+	r, err := m.query.Query(ctx, Request{
+		Offset:   m.offset,
+		OffsetID: m.offsetID,
+		Limit:    m.limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.apply(r)
+}
+
+func (m *Iterator) bufNext() bool {
+	if len(m.buf)-1 <= m.bufCur {
+		return false
+	}
+
+	m.bufCur++
+	return true
+}
+
+// Total returns last fetched count of elements.
+// If count was not fetched before, it requests server using FetchTotal.
+func (m *Iterator) Total(ctx context.Context) (int, error) {
+	if m.totalGot {
+		return m.count, nil
+	}
+
+	return m.FetchTotal(ctx)
+}
+
+// FetchTotal fetches and returns count of elements.
+func (m *Iterator) FetchTotal(ctx context.Context) (int, error) {
+	r, err := m.query.Query(ctx, Request{
+		Limit: 1,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "fetch total")
+	}
+
+	m.count = r.Count
+	m.totalGot = true
+	return m.count, nil
+}
+
+// Next prepares the next story for reading with the Value method.
+// It returns true on success, or false if there is no next story or an error happened while preparing it.
+// Err should be consulted to distinguish between the two cases.
+func (m *Iterator) Next(ctx context.Context) bool {
+	if m.lastErr != nil {
+		return false
+	}
+
+	if !m.bufNext() {
+		// If buffer is empty, we should fetch next batch.
+		if err := m.requestNext(ctx); err != nil {
+			m.lastErr = err
+			return false
+		}
+		// Try again with new buffer.
+		return m.bufNext()
+	}
+
+	return true
+}
+
+// Value returns current story.
+func (m *Iterator) Value() Elem {
+	return m.buf[m.bufCur]
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (m *Iterator) Err() error {
+	return m.lastErr
+}
@@ -0,0 +1,85 @@
+package stories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func generateStories(count int) []tg.StoryItemClass {
+	r := make([]tg.StoryItemClass, 0, count)
+	// Stories are returned from newest to oldest.
+	for i := count; i > 0; i-- {
+		r = append(r, &tg.StoryItem{
+			ID:    i,
+			Media: &tg.MessageMediaEmpty{},
+		})
+	}
+	return r
+}
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	limit := 10
+	totalRecords := 2*limit + 5
+	expected := generateStories(totalRecords)
+	raw := tg.NewClient(mock)
+	self := &tg.InputPeerSelf{}
+
+	result := func(from, to int) *tg.StoriesStories {
+		return &tg.StoriesStories{
+			Count:       totalRecords,
+			Stories:     expected[from:to],
+			PinnedToTop: []int{totalRecords},
+		}
+	}
+	mock.ExpectCall(&tg.StoriesGetPinnedStoriesRequest{
+		Peer:  self,
+		Limit: limit,
+	}).ThenResult(result(0, limit))
+	mock.ExpectCall(&tg.StoriesGetPinnedStoriesRequest{
+		Peer:     self,
+		OffsetID: totalRecords - limit + 1,
+		Limit:    limit,
+	}).ThenResult(result(limit, 2*limit))
+	mock.ExpectCall(&tg.StoriesGetPinnedStoriesRequest{
+		Peer:     self,
+		OffsetID: totalRecords - 2*limit + 1,
+		Limit:    limit,
+	}).ThenResult(result(2*limit, totalRecords))
+
+	iter := NewQueryBuilder(raw).GetPinnedStories(self).BatchSize(limit).Iter()
+	i := 0
+	for iter.Next(ctx) {
+		v := iter.Value()
+		require.Equal(t, expected[i], v.Story)
+		require.Equal(t, i == 0, v.PinnedToTop)
+		i++
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, totalRecords, i)
+
+	total, err := iter.Total(ctx)
+	require.NoError(t, err)
+	require.Equal(t, totalRecords, total)
+}
+
+func TestCount(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+
+	mock.ExpectCall(&tg.StoriesGetStoriesArchiveRequest{
+		Peer:  &tg.InputPeerSelf{},
+		Limit: 1,
+	}).ThenResult(&tg.StoriesStories{Count: 42})
+
+	count, err := NewQueryBuilder(raw).GetStoriesArchive(&tg.InputPeerSelf{}).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 42, count)
+}
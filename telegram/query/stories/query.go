@@ -0,0 +1,3 @@
+package stories
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=StoriesStories -package=stories -prefix=Stories -out=queries.gen.go
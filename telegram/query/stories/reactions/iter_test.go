@@ -0,0 +1,52 @@
+package reactions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	self := &tg.InputPeerSelf{}
+
+	reaction := func(userID int64) tg.StoryReactionClass {
+		return &tg.StoryReaction{
+			PeerID:   &tg.PeerUser{UserID: userID},
+			Reaction: &tg.ReactionEmoji{Emoticon: "👍"},
+		}
+	}
+	first := &tg.StoriesStoryReactionsList{
+		Count:     2,
+		Reactions: []tg.StoryReactionClass{reaction(1)},
+	}
+	first.SetNextOffset("next")
+	mock.ExpectCall(&tg.StoriesGetStoryReactionsListRequest{
+		Peer:  self,
+		ID:    10,
+		Limit: 1,
+	}).ThenResult(first)
+	mock.ExpectCall(&tg.StoriesGetStoryReactionsListRequest{
+		Peer:   self,
+		ID:     10,
+		Offset: "next",
+		Limit:  1,
+	}).ThenResult(&tg.StoriesStoryReactionsList{
+		Count:     2,
+		Reactions: []tg.StoryReactionClass{reaction(2)},
+	})
+
+	var got []tg.StoryReactionClass
+	require.NoError(t, NewQueryBuilder(raw).GetStoryReactionsList(self).ID(10).
+		ForEach(ctx, func(ctx context.Context, elem Elem) error {
+			got = append(got, elem.Reaction)
+			return nil
+		}))
+	require.Equal(t, []tg.StoryReactionClass{reaction(1), reaction(2)}, got)
+}
@@ -0,0 +1,3 @@
+package reactions
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=StoriesStoryReactionsList -package=reactions -prefix=Stories -out=queries.gen.go
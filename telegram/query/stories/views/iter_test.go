@@ -0,0 +1,55 @@
+package views
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	mock := tgmock.NewRequire(t)
+	raw := tg.NewClient(mock)
+	self := &tg.InputPeerSelf{}
+
+	view := func(userID int64) tg.StoryViewClass {
+		return &tg.StoryView{UserID: userID, Date: 1}
+	}
+	// Collect fetches total count first.
+	mock.ExpectCall(&tg.StoriesGetStoryViewsListRequest{
+		Peer:  self,
+		ID:    10,
+		Limit: 1,
+	}).ThenResult(&tg.StoriesStoryViewsList{Count: 3})
+
+	first := &tg.StoriesStoryViewsList{
+		Count: 3,
+		Views: []tg.StoryViewClass{view(1), view(2)},
+	}
+	first.SetNextOffset("next")
+	mock.ExpectCall(&tg.StoriesGetStoryViewsListRequest{
+		Peer:  self,
+		ID:    10,
+		Limit: 2,
+	}).ThenResult(first)
+	mock.ExpectCall(&tg.StoriesGetStoryViewsListRequest{
+		Peer:   self,
+		ID:     10,
+		Offset: "next",
+		Limit:  2,
+	}).ThenResult(&tg.StoriesStoryViewsList{
+		Count: 3,
+		Views: []tg.StoryViewClass{view(3)},
+	})
+
+	elems, err := NewQueryBuilder(raw).GetStoryViewsList(self).ID(10).BatchSize(2).Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, elems, 3)
+	for i, elem := range elems {
+		require.Equal(t, view(int64(i+1)), elem.View)
+	}
+}
@@ -0,0 +1,3 @@
+package views
+
+//go:generate go run github.com/gotd/td/telegram/query/internal/itergen -result=StoriesStoryViewsList -package=views -prefix=Stories -out=queries.gen.go
@@ -0,0 +1,192 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+
+	"github.com/gotd/td/telegram/tljson"
+	"github.com/gotd/td/tg"
+)
+
+// Series is a single line (or bar, area, etc.) of graph.
+type Series struct {
+	// ID of series, like "y0".
+	ID string
+	// Type of series, like "line", "bar", "area" or "step".
+	Type string
+	// Name of series, shown in legend.
+	Name string
+	// Color of series, like "#3497ED".
+	Color string
+	// Values of series, one for every Graph.X value.
+	Values []float64
+}
+
+// Graph is a decoded statistics graph.
+//
+// See https://core.telegram.org/api/stats.
+type Graph struct {
+	// Title of graph, if any.
+	Title string
+	// X values of graph, usually timestamps in milliseconds.
+	X []float64
+	// Series of graph.
+	Series []Series
+	// Percentage whether values should be shown as percentage.
+	Percentage bool
+	// Stacked whether series should be stacked.
+	Stacked bool
+	// YScaled whether every series has its own Y axis.
+	YScaled bool
+	// ZoomToken is token to load zoomed graph, if any.
+	ZoomToken string
+}
+
+// Time returns i-th X value as time.
+func (g Graph) Time(i int) time.Time {
+	return time.UnixMilli(int64(g.X[i]))
+}
+
+// Find returns series with given ID.
+func (g Graph) Find(id string) (Series, bool) {
+	for _, s := range g.Series {
+		if s.ID == id {
+			return s, true
+		}
+	}
+	return Series{}, false
+}
+
+// GraphError is returned when server failed to generate graph.
+type GraphError struct {
+	Message string
+}
+
+// Error implements error.
+func (e *GraphError) Error() string {
+	return "graph error: " + e.Message
+}
+
+// ParseGraph decodes graph from tg.StatsGraph JSON.
+func ParseGraph(data tg.DataJSON) (Graph, error) {
+	v, err := tljson.Decode(jx.DecodeStr(data.Data))
+	if err != nil {
+		return Graph{}, errors.Wrap(err, "decode JSON")
+	}
+	return GraphFromJSON(v)
+}
+
+func jsonObject(v tg.JSONValueClass) (map[string]tg.JSONValueClass, error) {
+	obj, ok := v.(*tg.JSONObject)
+	if !ok {
+		return nil, errors.Errorf("expected object, got %T", v)
+	}
+	r := make(map[string]tg.JSONValueClass, len(obj.Value))
+	for _, kv := range obj.Value {
+		r[kv.Key] = kv.Value
+	}
+	return r, nil
+}
+
+// jsonStrings converts object with string values to map.
+func jsonStrings(v tg.JSONValueClass) (map[string]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	obj, err := jsonObject(v)
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]string, len(obj))
+	for k, v := range obj {
+		s, ok := v.(*tg.JSONString)
+		if !ok {
+			return nil, errors.Errorf("%q: expected string, got %T", k, v)
+		}
+		r[k] = s.Value
+	}
+	return r, nil
+}
+
+func jsonBool(v tg.JSONValueClass) bool {
+	b, ok := v.(*tg.JSONBool)
+	return ok && b.Value
+}
+
+// jsonColumn decodes column like ["y0", 1, 2, 3].
+func jsonColumn(v tg.JSONValueClass) (string, []float64, error) {
+	arr, ok := v.(*tg.JSONArray)
+	if !ok || len(arr.Value) < 1 {
+		return "", nil, errors.Errorf("expected non-empty array, got %T", v)
+	}
+	id, ok := arr.Value[0].(*tg.JSONString)
+	if !ok {
+		return "", nil, errors.Errorf("expected column ID, got %T", arr.Value[0])
+	}
+
+	values := make([]float64, 0, len(arr.Value)-1)
+	for i, v := range arr.Value[1:] {
+		switch v := v.(type) {
+		case *tg.JSONNumber:
+			values = append(values, v.Value)
+		case *tg.JSONNull:
+			values = append(values, 0)
+		default:
+			return "", nil, errors.Errorf("%q: expected number at %d, got %T", id.Value, i, v)
+		}
+	}
+	return id.Value, values, nil
+}
+
+// GraphFromJSON converts graph JSON to Graph.
+func GraphFromJSON(v tg.JSONValueClass) (Graph, error) {
+	obj, err := jsonObject(v)
+	if err != nil {
+		return Graph{}, err
+	}
+
+	var (
+		g     Graph
+		names = map[string]map[string]string{}
+	)
+	for _, key := range []string{"types", "names", "colors"} {
+		m, err := jsonStrings(obj[key])
+		if err != nil {
+			return Graph{}, errors.Wrapf(err, "decode %s", key)
+		}
+		names[key] = m
+	}
+	if title, ok := obj["title"].(*tg.JSONString); ok {
+		g.Title = title.Value
+	}
+	g.Percentage = jsonBool(obj["percentage"])
+	g.Stacked = jsonBool(obj["stacked"])
+	g.YScaled = jsonBool(obj["y_scaled"])
+
+	columns, ok := obj["columns"].(*tg.JSONArray)
+	if !ok {
+		return Graph{}, errors.New("columns not found")
+	}
+	for i, c := range columns.Value {
+		id, values, err := jsonColumn(c)
+		if err != nil {
+			return Graph{}, errors.Wrapf(err, "decode column %d", i)
+		}
+
+		typ := names["types"][id]
+		if typ == "x" {
+			g.X = values
+			continue
+		}
+		g.Series = append(g.Series, Series{
+			ID:     id,
+			Type:   typ,
+			Name:   names["names"][id],
+			Color:  names["colors"][id],
+			Values: values,
+		})
+	}
+	return g, nil
+}
@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+const testGraph = `{
+	"columns": [["x", 1700000000000, 1700086400000], ["y0", 10, null], ["y1", 3, 4]],
+	"types": {"x": "x", "y0": "line", "y1": "bar"},
+	"names": {"y0": "Views", "y1": "Shares"},
+	"colors": {"y0": "#3497ED", "y1": "#F34C44"},
+	"title": "Interactions",
+	"y_scaled": true
+}`
+
+func TestParseGraph(t *testing.T) {
+	a := require.New(t)
+
+	g, err := ParseGraph(tg.DataJSON{Data: testGraph})
+	a.NoError(err)
+	a.Equal("Interactions", g.Title)
+	a.True(g.YScaled)
+	a.False(g.Stacked)
+	a.False(g.Percentage)
+	a.Equal([]float64{1700000000000, 1700086400000}, g.X)
+	a.Equal(int64(1700086400), g.Time(1).Unix())
+	a.Equal([]Series{
+		{ID: "y0", Type: "line", Name: "Views", Color: "#3497ED", Values: []float64{10, 0}},
+		{ID: "y1", Type: "bar", Name: "Shares", Color: "#F34C44", Values: []float64{3, 4}},
+	}, g.Series)
+
+	s, ok := g.Find("y1")
+	a.True(ok)
+	a.Equal("Shares", s.Name)
+	_, ok = g.Find("y2")
+	a.False(ok)
+}
+
+func TestParseGraphError(t *testing.T) {
+	for _, input := range []string{
+		`[]`,
+		`{`,
+		`{"types": {}}`,
+		`{"columns": [[1, 2]]}`,
+		`{"columns": [["y0", "a"]]}`,
+		`{"columns": [], "types": []}`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseGraph(tg.DataJSON{Data: input})
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// Package stats contains helpers for channel, supergroup and message
+// statistics.
+//
+// See https://core.telegram.org/api/stats.
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// Value is a statistics value with value of previous period.
+type Value struct {
+	Current  float64
+	Previous float64
+}
+
+// Delta returns change of value since previous period.
+func (v Value) Delta() float64 {
+	return v.Current - v.Previous
+}
+
+func newValue(v tg.StatsAbsValueAndPrev) Value {
+	return Value{
+		Current:  v.Current,
+		Previous: v.Previous,
+	}
+}
+
+// Percent is a part of total value.
+type Percent struct {
+	Part  float64
+	Total float64
+}
+
+// Ratio returns part/total ratio, or zero if total is zero.
+func (p Percent) Ratio() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Part / p.Total
+}
+
+// Period is a statistics period.
+type Period struct {
+	Min time.Time
+	Max time.Time
+}
+
+func newPeriod(p tg.StatsDateRangeDays) Period {
+	return Period{
+		Min: time.Unix(int64(p.MinDate), 0),
+		Max: time.Unix(int64(p.MaxDate), 0),
+	}
+}
+
+// BroadcastStats is a channel statistics.
+//
+// Graphs can be loaded using Manager.Graph.
+type BroadcastStats struct {
+	Period               Period
+	Followers            Value
+	ViewsPerPost         Value
+	SharesPerPost        Value
+	ReactionsPerPost     Value
+	ViewsPerStory        Value
+	SharesPerStory       Value
+	ReactionsPerStory    Value
+	EnabledNotifications Percent
+
+	// Raw is raw statistics, containing graphs and recent interactions.
+	Raw *tg.StatsBroadcastStats
+}
+
+// MegagroupStats is a supergroup statistics.
+//
+// Graphs can be loaded using Manager.Graph.
+type MegagroupStats struct {
+	Period   Period
+	Members  Value
+	Messages Value
+	Viewers  Value
+	Posters  Value
+
+	// Raw is raw statistics, containing graphs and top members.
+	Raw *tg.StatsMegagroupStats
+}
+
+// Manager is a statistics helper.
+type Manager struct {
+	raw *tg.Client
+}
+
+// NewManager creates new Manager.
+func NewManager(raw *tg.Client) *Manager {
+	return &Manager{raw: raw}
+}
+
+// Broadcast returns statistics of channel.
+func (m *Manager) Broadcast(ctx context.Context, channel tg.InputChannelClass) (BroadcastStats, error) {
+	r, err := m.raw.StatsGetBroadcastStats(ctx, &tg.StatsGetBroadcastStatsRequest{
+		Channel: channel,
+	})
+	if err != nil {
+		return BroadcastStats{}, errors.Wrap(err, "get broadcast stats")
+	}
+	return BroadcastStats{
+		Period:            newPeriod(r.Period),
+		Followers:         newValue(r.Followers),
+		ViewsPerPost:      newValue(r.ViewsPerPost),
+		SharesPerPost:     newValue(r.SharesPerPost),
+		ReactionsPerPost:  newValue(r.ReactionsPerPost),
+		ViewsPerStory:     newValue(r.ViewsPerStory),
+		SharesPerStory:    newValue(r.SharesPerStory),
+		ReactionsPerStory: newValue(r.ReactionsPerStory),
+		EnabledNotifications: Percent{
+			Part:  r.EnabledNotifications.Part,
+			Total: r.EnabledNotifications.Total,
+		},
+		Raw: r,
+	}, nil
+}
+
+// Megagroup returns statistics of supergroup.
+func (m *Manager) Megagroup(ctx context.Context, channel tg.InputChannelClass) (MegagroupStats, error) {
+	r, err := m.raw.StatsGetMegagroupStats(ctx, &tg.StatsGetMegagroupStatsRequest{
+		Channel: channel,
+	})
+	if err != nil {
+		return MegagroupStats{}, errors.Wrap(err, "get megagroup stats")
+	}
+	return MegagroupStats{
+		Period:   newPeriod(r.Period),
+		Members:  newValue(r.Members),
+		Messages: newValue(r.Messages),
+		Viewers:  newValue(r.Viewers),
+		Posters:  newValue(r.Posters),
+		Raw:      r,
+	}, nil
+}
+
+// Message returns views graph of channel message.
+func (m *Manager) Message(ctx context.Context, channel tg.InputChannelClass, msgID int) (Graph, error) {
+	r, err := m.raw.StatsGetMessageStats(ctx, &tg.StatsGetMessageStatsRequest{
+		Channel: channel,
+		MsgID:   msgID,
+	})
+	if err != nil {
+		return Graph{}, errors.Wrap(err, "get message stats")
+	}
+	return m.Graph(ctx, r.ViewsGraph)
+}
+
+// Graph decodes given graph, loading it first if it is asynchronous.
+//
+// Returns *GraphError if server failed to generate graph.
+func (m *Manager) Graph(ctx context.Context, g tg.StatsGraphClass) (Graph, error) {
+	if async, ok := g.(*tg.StatsGraphAsync); ok {
+		r, err := m.raw.StatsLoadAsyncGraph(ctx, &tg.StatsLoadAsyncGraphRequest{
+			Token: async.Token,
+		})
+		if err != nil {
+			return Graph{}, errors.Wrap(err, "load async graph")
+		}
+		g = r
+	}
+	return decodeGraph(g)
+}
+
+// Zoom loads detailed graph for given X value, like a day of hourly graph.
+func (m *Manager) Zoom(ctx context.Context, g Graph, x float64) (Graph, error) {
+	if g.ZoomToken == "" {
+		return Graph{}, errors.New("graph is not zoomable")
+	}
+
+	req := &tg.StatsLoadAsyncGraphRequest{Token: g.ZoomToken}
+	req.SetX(int64(x))
+	r, err := m.raw.StatsLoadAsyncGraph(ctx, req)
+	if err != nil {
+		return Graph{}, errors.Wrap(err, "load zoomed graph")
+	}
+	return decodeGraph(r)
+}
+
+func decodeGraph(g tg.StatsGraphClass) (Graph, error) {
+	switch g := g.(type) {
+	case *tg.StatsGraph:
+		r, err := ParseGraph(g.JSON)
+		if err != nil {
+			return Graph{}, errors.Wrap(err, "parse graph")
+		}
+		r.ZoomToken = g.ZoomToken
+		return r, nil
+	case *tg.StatsGraphError:
+		return Graph{}, &GraphError{Message: g.Error}
+	default:
+		return Graph{}, errors.Errorf("unexpected type %T", g)
+	}
+}
+
+// MessageViews is a view counters of message.
+type MessageViews struct {
+	// ID of message.
+	ID int
+	// Views count, if available.
+	Views int
+	// Forwards count, if available.
+	Forwards int
+	// Replies count, if available.
+	Replies int
+}
+
+// Views returns view counters of given messages.
+//
+// If increment is true, views of messages are incremented, like
+// when user opens messages.
+func (m *Manager) Views(ctx context.Context, peer tg.InputPeerClass, increment bool, ids ...int) ([]MessageViews, error) {
+	r, err := m.raw.MessagesGetMessagesViews(ctx, &tg.MessagesGetMessagesViewsRequest{
+		Peer:      peer,
+		ID:        ids,
+		Increment: increment,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "get messages views")
+	}
+	if len(r.Views) != len(ids) {
+		return nil, errors.Errorf("expected %d views, got %d", len(ids), len(r.Views))
+	}
+
+	result := make([]MessageViews, len(ids))
+	for i, v := range r.Views {
+		replies, _ := v.GetReplies()
+		result[i] = MessageViews{
+			ID:       ids[i],
+			Views:    v.Views,
+			Forwards: v.Forwards,
+			Replies:  replies.Replies,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,156 @@
+package stats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgmock"
+)
+
+var emptyGraph = &tg.StatsGraphError{Error: "empty"}
+
+func testManager(t *testing.T) (*tgmock.Mock, *Manager) {
+	mock := tgmock.New(t)
+	return mock, NewManager(tg.NewClient(mock))
+}
+
+func TestManager_Broadcast(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	channel := &tg.InputChannel{ChannelID: 10, AccessHash: 10}
+	mock.ExpectCall(&tg.StatsGetBroadcastStatsRequest{
+		Channel: channel,
+	}).ThenResult(&tg.StatsBroadcastStats{
+		Period:                       tg.StatsDateRangeDays{MinDate: 10, MaxDate: 20},
+		Followers:                    tg.StatsAbsValueAndPrev{Current: 15, Previous: 10},
+		EnabledNotifications:         tg.StatsPercentValue{Part: 1, Total: 4},
+		GrowthGraph:                  &tg.StatsGraphAsync{Token: "growth"},
+		FollowersGraph:               emptyGraph,
+		MuteGraph:                    emptyGraph,
+		TopHoursGraph:                emptyGraph,
+		InteractionsGraph:            emptyGraph,
+		IvInteractionsGraph:          emptyGraph,
+		ViewsBySourceGraph:           emptyGraph,
+		NewFollowersBySourceGraph:    emptyGraph,
+		LanguagesGraph:               emptyGraph,
+		ReactionsByEmotionGraph:      emptyGraph,
+		StoryInteractionsGraph:       emptyGraph,
+		StoryReactionsByEmotionGraph: emptyGraph,
+	})
+	s, err := m.Broadcast(ctx, channel)
+	a.NoError(err)
+	a.Equal(int64(20), s.Period.Max.Unix())
+	a.Equal(float64(5), s.Followers.Delta())
+	a.Equal(0.25, s.EnabledNotifications.Ratio())
+	a.Zero(Percent{}.Ratio())
+
+	mock.ExpectCall(&tg.StatsLoadAsyncGraphRequest{
+		Token: "growth",
+	}).ThenResult(&tg.StatsGraph{
+		JSON:      tg.DataJSON{Data: testGraph},
+		ZoomToken: "zoom",
+	})
+	g, err := m.Graph(ctx, s.Raw.GrowthGraph)
+	a.NoError(err)
+	a.Equal("zoom", g.ZoomToken)
+	a.Len(g.Series, 2)
+
+	req := &tg.StatsLoadAsyncGraphRequest{Token: "zoom"}
+	req.SetX(1700000000000)
+	mock.ExpectCall(req).ThenResult(&tg.StatsGraphError{Error: "not enough data"})
+	_, err = m.Zoom(ctx, g, g.X[0])
+	var graphErr *GraphError
+	a.True(errors.As(err, &graphErr))
+	a.Equal("not enough data", graphErr.Message)
+
+	_, err = m.Zoom(ctx, Graph{}, 0)
+	a.Error(err)
+}
+
+func TestManager_Megagroup(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	channel := &tg.InputChannel{ChannelID: 10, AccessHash: 10}
+	mock.ExpectCall(&tg.StatsGetMegagroupStatsRequest{
+		Channel: channel,
+	}).ThenResult(&tg.StatsMegagroupStats{
+		Members:  tg.StatsAbsValueAndPrev{Current: 100, Previous: 120},
+		Messages: tg.StatsAbsValueAndPrev{Current: 7},
+
+		GrowthGraph:             emptyGraph,
+		MembersGraph:            emptyGraph,
+		NewMembersBySourceGraph: emptyGraph,
+		LanguagesGraph:          emptyGraph,
+		MessagesGraph:           emptyGraph,
+		ActionsGraph:            emptyGraph,
+		TopHoursGraph:           emptyGraph,
+		WeekdaysGraph:           emptyGraph,
+	})
+	s, err := m.Megagroup(ctx, channel)
+	a.NoError(err)
+	a.Equal(float64(-20), s.Members.Delta())
+	a.Equal(Value{Current: 7}, s.Messages)
+
+	mock.ExpectCall(&tg.StatsGetMegagroupStatsRequest{
+		Channel: channel,
+	}).ThenRPCErr(&tgerr.Error{Code: 400, Type: "CHAT_ADMIN_REQUIRED"})
+	_, err = m.Megagroup(ctx, channel)
+	a.Error(err)
+}
+
+func TestManager_Message(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	channel := &tg.InputChannel{ChannelID: 10, AccessHash: 10}
+	mock.ExpectCall(&tg.StatsGetMessageStatsRequest{
+		Channel: channel,
+		MsgID:   5,
+	}).ThenResult(&tg.StatsMessageStats{
+		ViewsGraph:              &tg.StatsGraph{JSON: tg.DataJSON{Data: testGraph}},
+		ReactionsByEmotionGraph: emptyGraph,
+	})
+	g, err := m.Message(ctx, channel, 5)
+	a.NoError(err)
+	a.Equal("Interactions", g.Title)
+}
+
+func TestManager_Views(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, m := testManager(t)
+
+	peer := &tg.InputPeerChannel{ChannelID: 10, AccessHash: 10}
+	replies := tg.MessageViews{Views: 10, Forwards: 1}
+	replies.SetReplies(tg.MessageReplies{Replies: 3})
+	mock.ExpectCall(&tg.MessagesGetMessagesViewsRequest{
+		Peer:      peer,
+		ID:        []int{1, 2},
+		Increment: true,
+	}).ThenResult(&tg.MessagesMessageViews{
+		Views: []tg.MessageViews{replies, {Views: 5}},
+	})
+	views, err := m.Views(ctx, peer, true, 1, 2)
+	a.NoError(err)
+	a.Equal([]MessageViews{
+		{ID: 1, Views: 10, Forwards: 1, Replies: 3},
+		{ID: 2, Views: 5},
+	}, views)
+
+	mock.ExpectCall(&tg.MessagesGetMessagesViewsRequest{
+		Peer: peer,
+		ID:   []int{1},
+	}).ThenResult(&tg.MessagesMessageViews{})
+	_, err = m.Views(ctx, peer, false, 1)
+	a.Error(err)
+}
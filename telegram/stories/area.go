@@ -0,0 +1,87 @@
+package stories
+
+import "github.com/gotd/td/tg"
+
+// Coordinates of media area, in percent of media width and height.
+type Coordinates struct {
+	// X and Y of area center.
+	X, Y float64
+	// W and H of area.
+	W, H float64
+	// Rotation is clockwise rotation angle, in degrees.
+	Rotation float64
+}
+
+func (c Coordinates) raw() tg.MediaAreaCoordinates {
+	return tg.MediaAreaCoordinates{
+		X:        c.X,
+		Y:        c.Y,
+		W:        c.W,
+		H:        c.H,
+		Rotation: c.Rotation,
+	}
+}
+
+// Areas adds given media areas to story.
+//
+// See https://core.telegram.org/api/stories#media-areas.
+func (b *Builder) Areas(areas ...tg.MediaAreaClass) *Builder {
+	b.areas = append(b.areas, areas...)
+	return b
+}
+
+// Location adds geolocation area to story.
+func (b *Builder) Location(c Coordinates, lat, long float64) *Builder {
+	return b.Areas(&tg.MediaAreaGeoPoint{
+		Coordinates: c.raw(),
+		Geo: &tg.GeoPoint{
+			Lat:  lat,
+			Long: long,
+		},
+	})
+}
+
+// Venue adds venue area to story.
+func (b *Builder) Venue(c Coordinates, venue tg.InputMediaVenue) *Builder {
+	area := &tg.MediaAreaVenue{
+		Coordinates: c.raw(),
+		Geo:         &tg.GeoPointEmpty{},
+		Title:       venue.Title,
+		Address:     venue.Address,
+		Provider:    venue.Provider,
+		VenueID:     venue.VenueID,
+		VenueType:   venue.VenueType,
+	}
+	if p, ok := venue.GeoPoint.(*tg.InputGeoPoint); ok {
+		area.Geo = &tg.GeoPoint{
+			Lat:  p.Lat,
+			Long: p.Long,
+		}
+	}
+	return b.Areas(area)
+}
+
+// Reaction adds suggested reaction area to story.
+func (b *Builder) Reaction(c Coordinates, reaction tg.ReactionClass) *Builder {
+	return b.Areas(&tg.MediaAreaSuggestedReaction{
+		Coordinates: c.raw(),
+		Reaction:    reaction,
+	})
+}
+
+// URL adds link area to story.
+func (b *Builder) URL(c Coordinates, url string) *Builder {
+	return b.Areas(&tg.MediaAreaURL{
+		Coordinates: c.raw(),
+		URL:         url,
+	})
+}
+
+// ChannelPost adds channel post area to story.
+func (b *Builder) ChannelPost(c Coordinates, channel tg.InputChannelClass, msgID int) *Builder {
+	return b.Areas(&tg.InputMediaAreaChannelPost{
+		Coordinates: c.raw(),
+		Channel:     channel,
+		MsgID:       msgID,
+	})
+}
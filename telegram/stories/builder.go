@@ -0,0 +1,316 @@
+package stories
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/telegram/message/probe"
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/gotd/td/tg"
+)
+
+// Builder is a story builder.
+type Builder struct {
+	sender *Sender
+	peer   tg.InputPeerClass
+
+	caption    []styling.StyledTextOption
+	captionSet bool
+	privacy    []tg.InputPrivacyRuleClass
+	areas      []tg.MediaAreaClass
+	period     time.Duration
+	pinned     bool
+	noForwards bool
+
+	mime      string
+	streaming bool
+}
+
+// Caption sets plain text caption of story.
+func (b *Builder) Caption(text string) *Builder {
+	return b.StyledCaption(styling.Plain(text))
+}
+
+// StyledCaption sets styled caption of story.
+func (b *Builder) StyledCaption(texts ...styling.StyledTextOption) *Builder {
+	b.caption = texts
+	b.captionSet = true
+	return b
+}
+
+func (b *Builder) captionText() (string, []tg.MessageEntityClass, error) {
+	tb := entity.Builder{}
+	if err := styling.Perform(&tb, b.caption...); err != nil {
+		return "", nil, errors.Wrap(err, "caption")
+	}
+	text, entities := tb.Complete()
+	return text, entities, nil
+}
+
+// Period sets story expiration period.
+//
+// Allowed values are 6, 12, 24 and 48 hours, 48 hours requires Premium.
+// If zero, default period (24 hours) is used.
+func (b *Builder) Period(d time.Duration) *Builder {
+	b.period = d
+	return b
+}
+
+// Pinned sets flag to keep story in profile after expiration.
+func (b *Builder) Pinned() *Builder {
+	b.pinned = true
+	return b
+}
+
+// NoForwards sets flag to disallow screenshots and forwards of story.
+func (b *Builder) NoForwards() *Builder {
+	b.noForwards = true
+	return b
+}
+
+// MIME sets MIME type of video story.
+//
+// If not set, type detected by Upload or message.DefaultVideoMIME is used.
+func (b *Builder) MIME(mime string) *Builder {
+	b.mime = mime
+	return b
+}
+
+// SupportsStreaming sets flag that video story supports streaming.
+//
+// Upload sets this flag automatically if video is suitable for streaming.
+func (b *Builder) SupportsStreaming() *Builder {
+	b.streaming = true
+	return b
+}
+
+// Media posts story with given media and returns its ID.
+func (b *Builder) Media(ctx context.Context, media tg.InputMediaClass) (int, error) {
+	caption, entities, err := b.captionText()
+	if err != nil {
+		return 0, err
+	}
+	randomID, err := crypto.RandInt64(b.sender.rand)
+	if err != nil {
+		return 0, errors.Wrap(err, "generate random ID")
+	}
+
+	req := &tg.StoriesSendStoryRequest{
+		Pinned:       b.pinned,
+		Noforwards:   b.noForwards,
+		Peer:         b.peer,
+		Media:        media,
+		PrivacyRules: b.privacyRules(),
+		RandomID:     randomID,
+	}
+	if len(b.areas) > 0 {
+		req.SetMediaAreas(b.areas)
+	}
+	if caption != "" {
+		req.SetCaption(caption)
+	}
+	if len(entities) > 0 {
+		req.SetEntities(entities)
+	}
+	if b.period > 0 {
+		req.SetPeriod(int(b.period.Seconds()))
+	}
+
+	u, err := b.sender.raw.StoriesSendStory(ctx, req)
+	if err != nil {
+		return 0, errors.Wrap(err, "send story")
+	}
+	return storyID(u, b.peer, randomID)
+}
+
+// storyID finds ID of sent story in updates.
+//
+// UpdateStoryID with given random ID is preferred. Otherwise, single
+// UpdateStory of given peer is used.
+func storyID(u tg.UpdatesClass, peer tg.InputPeerClass, randomID int64) (int, error) {
+	var updates []tg.UpdateClass
+	switch u := u.(type) {
+	case *tg.Updates:
+		updates = u.Updates
+	case *tg.UpdatesCombined:
+		updates = u.Updates
+	case *tg.UpdateShort:
+		updates = []tg.UpdateClass{u.Update}
+	}
+
+	var stories []*tg.UpdateStory
+	for _, update := range updates {
+		switch update := update.(type) {
+		case *tg.UpdateStoryID:
+			if update.RandomID == randomID {
+				return update.ID, nil
+			}
+		case *tg.UpdateStory:
+			stories = append(stories, update)
+		}
+	}
+	if len(stories) == 1 && peerMatches(peer, stories[0].Peer) {
+		return stories[0].Story.GetID(), nil
+	}
+	return 0, errors.Errorf("story ID not found in %T", u)
+}
+
+// peerMatches reports whether input peer may refer to given peer.
+func peerMatches(input tg.InputPeerClass, p tg.PeerClass) bool {
+	switch input := input.(type) {
+	case *tg.InputPeerSelf:
+		_, ok := p.(*tg.PeerUser)
+		return ok
+	case *tg.InputPeerUser:
+		user, ok := p.(*tg.PeerUser)
+		return ok && user.UserID == input.UserID
+	case *tg.InputPeerUserFromMessage:
+		user, ok := p.(*tg.PeerUser)
+		return ok && user.UserID == input.UserID
+	case *tg.InputPeerChannel:
+		channel, ok := p.(*tg.PeerChannel)
+		return ok && channel.ChannelID == input.ChannelID
+	case *tg.InputPeerChannelFromMessage:
+		channel, ok := p.(*tg.PeerChannel)
+		return ok && channel.ChannelID == input.ChannelID
+	case *tg.InputPeerChat:
+		chat, ok := p.(*tg.PeerChat)
+		return ok && chat.ChatID == input.ChatID
+	default:
+		return false
+	}
+}
+
+// Photo posts photo story using uploaded file.
+func (b *Builder) Photo(ctx context.Context, file tg.InputFileClass) (int, error) {
+	return b.Media(ctx, &tg.InputMediaUploadedPhoto{
+		File: file,
+	})
+}
+
+// Video posts video story using uploaded file.
+//
+// Story videos must be MPEG4 with H.264 codec, at most 60 seconds long.
+// MIME type and streaming flag are set by MIME and SupportsStreaming.
+func (b *Builder) Video(ctx context.Context, file tg.InputFileClass, duration time.Duration, w, h int) (int, error) {
+	return b.video(ctx, file, b.mime, b.streaming, duration, w, h)
+}
+
+func (b *Builder) video(
+	ctx context.Context,
+	file tg.InputFileClass,
+	mime string, streaming bool,
+	duration time.Duration, w, h int,
+) (int, error) {
+	if mime == "" {
+		mime = message.DefaultVideoMIME
+	}
+	return b.Media(ctx, &tg.InputMediaUploadedDocument{
+		File:     file,
+		MimeType: mime,
+		Attributes: []tg.DocumentAttributeClass{
+			&tg.DocumentAttributeVideo{
+				SupportsStreaming: streaming,
+				Duration:          duration.Seconds(),
+				W:                 w,
+				H:                 h,
+			},
+		},
+	})
+}
+
+// Upload uploads given media file and posts it as story.
+//
+// Kind of story, MIME type and video attributes are detected using probe
+// package.
+func (b *Builder) Upload(ctx context.Context, name string, r io.ReaderAt, size int64) (int, error) {
+	if b.sender.uploader == nil {
+		return 0, errors.New("uploader is not set")
+	}
+
+	info, err := probe.Probe(r, size)
+	if err != nil {
+		return 0, errors.Wrap(err, "probe")
+	}
+
+	f, err := b.sender.uploader.FromReader(ctx, name, io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return 0, errors.Wrap(err, "upload")
+	}
+
+	switch info.Kind {
+	case probe.Image:
+		return b.Photo(ctx, f)
+	case probe.Video:
+		mime := b.mime
+		if mime == "" {
+			mime = info.MIME
+		}
+		streaming := b.streaming || info.Streaming
+		return b.video(ctx, f, mime, streaming, info.Duration, info.Width, info.Height)
+	default:
+		return 0, errors.Errorf("unsupported story media %q", info.Format)
+	}
+}
+
+// UploadPath uploads media file from given path and posts it as story.
+//
+// See Upload.
+func (b *Builder) UploadPath(ctx context.Context, path string) (_ int, rErr error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, errors.Wrap(err, "open")
+	}
+	defer func() {
+		if err := f.Close(); err != nil && rErr == nil {
+			rErr = errors.Wrap(err, "close")
+		}
+	}()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return 0, errors.Wrap(err, "stat")
+	}
+	return b.Upload(ctx, filepath.Base(path), f, stat.Size())
+}
+
+// Edit edits story with given ID.
+//
+// Only caption, privacy rules and media areas that were set in builder are
+// changed. If media is nil, story media is not changed.
+func (b *Builder) Edit(ctx context.Context, id int, media tg.InputMediaClass) error {
+	req := &tg.StoriesEditStoryRequest{
+		Peer: b.peer,
+		ID:   id,
+	}
+	if media != nil {
+		req.SetMedia(media)
+	}
+	if len(b.areas) > 0 {
+		req.SetMediaAreas(b.areas)
+	}
+	if b.captionSet {
+		caption, entities, err := b.captionText()
+		if err != nil {
+			return err
+		}
+		req.SetCaption(caption)
+		req.SetEntities(entities)
+	}
+	if len(b.privacy) > 0 {
+		req.SetPrivacyRules(b.privacy)
+	}
+
+	if _, err := b.sender.raw.StoriesEditStory(ctx, req); err != nil {
+		return errors.Wrap(err, "edit story")
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package stories
+
+import (
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/tg"
+)
+
+type sizedPhoto interface {
+	GetW() int
+	GetH() int
+	GetType() string
+}
+
+// Location returns file location of story media.
+//
+// For photos, the largest size is chosen.
+func Location(story tg.StoryItemClass) (tg.InputFileLocationClass, bool) {
+	item, ok := story.(*tg.StoryItem)
+	if !ok {
+		return nil, false
+	}
+
+	switch media := item.Media.(type) {
+	case *tg.MessageMediaPhoto:
+		if media.Photo == nil {
+			return nil, false
+		}
+		photo, ok := media.Photo.AsNotEmpty()
+		if !ok {
+			return nil, false
+		}
+
+		var (
+			thumbSize string
+			maxArea   int
+		)
+		for _, size := range photo.Sizes {
+			if sz, ok := size.(sizedPhoto); ok && sz.GetW()*sz.GetH() > maxArea {
+				maxArea = sz.GetW() * sz.GetH()
+				thumbSize = sz.GetType()
+			}
+		}
+		if thumbSize == "" {
+			return nil, false
+		}
+
+		return &tg.InputPhotoFileLocation{
+			ID:            photo.ID,
+			AccessHash:    photo.AccessHash,
+			FileReference: photo.FileReference,
+			ThumbSize:     thumbSize,
+		}, true
+	case *tg.MessageMediaDocument:
+		if media.Document == nil {
+			return nil, false
+		}
+		doc, ok := media.Document.AsNotEmpty()
+		if !ok {
+			return nil, false
+		}
+		return doc.AsInputDocumentFileLocation(), true
+	default:
+		return nil, false
+	}
+}
+
+// Download creates downloader.Builder to download story media.
+func (s *Sender) Download(d *downloader.Downloader, story tg.StoryItemClass) (*downloader.Builder, error) {
+	loc, ok := Location(story)
+	if !ok {
+		return nil, errors.Errorf("story %d has no downloadable media", story.GetID())
+	}
+	return d.Download(s.raw, loc), nil
+}
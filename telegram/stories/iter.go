@@ -0,0 +1,28 @@
+package stories
+
+import (
+	"github.com/gotd/td/telegram/query/stories"
+	"github.com/gotd/td/telegram/query/stories/reactions"
+	"github.com/gotd/td/telegram/query/stories/views"
+	"github.com/gotd/td/tg"
+)
+
+// Pinned returns query builder of stories pinned to profile of given peer.
+func (s *Sender) Pinned(peer tg.InputPeerClass) *stories.GetPinnedStoriesQueryBuilder {
+	return stories.NewQueryBuilder(s.raw).GetPinnedStories(peer)
+}
+
+// Archive returns query builder of stories archive of given peer.
+func (s *Sender) Archive(peer tg.InputPeerClass) *stories.GetStoriesArchiveQueryBuilder {
+	return stories.NewQueryBuilder(s.raw).GetStoriesArchive(peer)
+}
+
+// Viewers returns query builder of story viewers.
+func (s *Sender) Viewers(peer tg.InputPeerClass, id int) *views.GetStoryViewsListQueryBuilder {
+	return views.NewQueryBuilder(s.raw).GetStoryViewsList(peer).ID(id)
+}
+
+// Reactions returns query builder of story reactions.
+func (s *Sender) Reactions(peer tg.InputPeerClass, id int) *reactions.GetStoryReactionsListQueryBuilder {
+	return reactions.NewQueryBuilder(s.raw).GetStoryReactionsList(peer).ID(id)
+}
@@ -0,0 +1,80 @@
+package stories
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// Delete deletes stories of given peer and returns IDs of deleted stories.
+func (s *Sender) Delete(ctx context.Context, peer tg.InputPeerClass, ids ...int) ([]int, error) {
+	r, err := s.raw.StoriesDeleteStories(ctx, &tg.StoriesDeleteStoriesRequest{
+		Peer: peer,
+		ID:   ids,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "delete stories")
+	}
+	return r, nil
+}
+
+// TogglePinned pins or unpins stories of given peer to profile.
+func (s *Sender) TogglePinned(ctx context.Context, peer tg.InputPeerClass, pinned bool, ids ...int) ([]int, error) {
+	r, err := s.raw.StoriesTogglePinned(ctx, &tg.StoriesTogglePinnedRequest{
+		Peer:   peer,
+		ID:     ids,
+		Pinned: pinned,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "toggle pinned")
+	}
+	return r, nil
+}
+
+// Read marks all stories of given peer up to maxID as read.
+func (s *Sender) Read(ctx context.Context, peer tg.InputPeerClass, maxID int) error {
+	if _, err := s.raw.StoriesReadStories(ctx, &tg.StoriesReadStoriesRequest{
+		Peer:  peer,
+		MaxID: maxID,
+	}); err != nil {
+		return errors.Wrap(err, "read stories")
+	}
+	return nil
+}
+
+// React sends reaction to story.
+//
+// Use tg.ReactionEmpty to remove reaction.
+func (s *Sender) React(ctx context.Context, peer tg.InputPeerClass, id int, reaction tg.ReactionClass) error {
+	if _, err := s.raw.StoriesSendReaction(ctx, &tg.StoriesSendReactionRequest{
+		Peer:     peer,
+		StoryID:  id,
+		Reaction: reaction,
+	}); err != nil {
+		return errors.Wrap(err, "send reaction")
+	}
+	return nil
+}
+
+// Active returns active stories of given peer.
+func (s *Sender) Active(ctx context.Context, peer tg.InputPeerClass) (*tg.StoriesPeerStories, error) {
+	r, err := s.raw.StoriesGetPeerStories(ctx, peer)
+	if err != nil {
+		return nil, errors.Wrap(err, "get peer stories")
+	}
+	return r, nil
+}
+
+// Get returns stories of given peer by IDs.
+func (s *Sender) Get(ctx context.Context, peer tg.InputPeerClass, ids ...int) (*tg.StoriesStories, error) {
+	r, err := s.raw.StoriesGetStoriesByID(ctx, &tg.StoriesGetStoriesByIDRequest{
+		Peer: peer,
+		ID:   ids,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "get stories")
+	}
+	return r, nil
+}
@@ -0,0 +1,45 @@
+package stories
+
+import "github.com/gotd/td/tg"
+
+// Privacy sets story privacy rules.
+//
+// If not set, story is visible to everyone.
+//
+// See https://core.telegram.org/api/privacy.
+func (b *Builder) Privacy(rules ...tg.InputPrivacyRuleClass) *Builder {
+	b.privacy = append(b.privacy, rules...)
+	return b
+}
+
+// Everyone makes story visible to everyone.
+func (b *Builder) Everyone() *Builder {
+	return b.Privacy(&tg.InputPrivacyValueAllowAll{})
+}
+
+// Contacts makes story visible only to contacts.
+func (b *Builder) Contacts() *Builder {
+	return b.Privacy(&tg.InputPrivacyValueAllowContacts{})
+}
+
+// CloseFriends makes story visible only to close friends.
+func (b *Builder) CloseFriends() *Builder {
+	return b.Privacy(&tg.InputPrivacyValueAllowCloseFriends{})
+}
+
+// AllowUsers makes story visible to given users.
+func (b *Builder) AllowUsers(users ...tg.InputUserClass) *Builder {
+	return b.Privacy(&tg.InputPrivacyValueAllowUsers{Users: users})
+}
+
+// DisallowUsers hides story from given users.
+func (b *Builder) DisallowUsers(users ...tg.InputUserClass) *Builder {
+	return b.Privacy(&tg.InputPrivacyValueDisallowUsers{Users: users})
+}
+
+func (b *Builder) privacyRules() []tg.InputPrivacyRuleClass {
+	if len(b.privacy) == 0 {
+		return []tg.InputPrivacyRuleClass{&tg.InputPrivacyValueAllowAll{}}
+	}
+	return b.privacy
+}
@@ -0,0 +1,60 @@
+// Package stories contains helpers for posting, managing and iterating stories.
+//
+// See https://core.telegram.org/api/stories.
+package stories
+
+import (
+	"context"
+	"io"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg"
+)
+
+// Uploader is an abstraction for story media uploader.
+//
+// It is implemented by *uploader.Uploader.
+type Uploader interface {
+	FromPath(ctx context.Context, path string) (tg.InputFileClass, error)
+	FromReader(ctx context.Context, name string, f io.Reader) (tg.InputFileClass, error)
+}
+
+// Sender is a stories helper.
+type Sender struct {
+	raw      *tg.Client
+	rand     io.Reader
+	uploader Uploader
+}
+
+// NewSender creates a new Sender.
+func NewSender(raw *tg.Client) *Sender {
+	return &Sender{
+		raw:  raw,
+		rand: crypto.DefaultRand(),
+	}
+}
+
+// WithUploader sets file uploader to use.
+func (s *Sender) WithUploader(u Uploader) *Sender {
+	s.uploader = u
+	return s
+}
+
+// WithRand sets random ID source.
+func (s *Sender) WithRand(r io.Reader) *Sender {
+	s.rand = r
+	return s
+}
+
+// To creates new Builder to post stories to given peer.
+func (s *Sender) To(peer tg.InputPeerClass) *Builder {
+	return &Builder{
+		sender: s,
+		peer:   peer,
+	}
+}
+
+// Self creates new Builder to post stories to current user profile.
+func (s *Sender) Self() *Builder {
+	return s.To(&tg.InputPeerSelf{})
+}
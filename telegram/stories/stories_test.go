@@ -0,0 +1,350 @@
+package stories
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/probe"
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+type mockUploader struct {
+	name string
+	data []byte
+}
+
+func (m *mockUploader) FromPath(ctx context.Context, path string) (tg.InputFileClass, error) {
+	return nil, io.ErrUnexpectedEOF
+}
+
+func (m *mockUploader) FromReader(ctx context.Context, name string, f io.Reader) (tg.InputFileClass, error) {
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	m.name = name
+	m.data = data
+	return &tg.InputFile{ID: 1, Name: name, Parts: 1}, nil
+}
+
+func storyUpdates(randomID int64, id int) tg.UpdatesClass {
+	return &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateStoryID{ID: id, RandomID: randomID},
+		},
+	}
+}
+
+func testSender(t *testing.T) (*Sender, *tgmock.Mock, int64) {
+	seed := bytes.Repeat([]byte{1}, 8)
+	randomID, err := crypto.RandInt64(bytes.NewReader(seed))
+	require.NoError(t, err)
+
+	mock := tgmock.New(t)
+	return NewSender(tg.NewClient(mock)).WithRand(bytes.NewReader(seed)), mock, randomID
+}
+
+func TestBuilder_Media(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	s, mock, randomID := testSender(t)
+
+	user := &tg.InputUser{UserID: 10, AccessHash: 10}
+	channel := &tg.InputChannel{ChannelID: 20, AccessHash: 20}
+	area := Coordinates{X: 50, Y: 50, W: 10, H: 10}
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.StoriesSendStoryRequest)
+		a.True(ok)
+		a.Equal(&tg.InputPeerSelf{}, req.Peer)
+		a.True(req.Pinned)
+		a.Equal("hello", req.Caption)
+		a.Len(req.Entities, 1)
+		a.Equal(6*60*60, req.Period)
+		a.Equal([]tg.InputPrivacyRuleClass{
+			&tg.InputPrivacyValueAllowContacts{},
+			&tg.InputPrivacyValueDisallowUsers{Users: []tg.InputUserClass{user}},
+		}, req.PrivacyRules)
+		a.Equal([]tg.MediaAreaClass{
+			&tg.MediaAreaURL{Coordinates: area.raw(), URL: "https://gotd.dev"},
+			&tg.InputMediaAreaChannelPost{Coordinates: area.raw(), Channel: channel, MsgID: 7},
+		}, req.MediaAreas)
+	}).ThenResult(storyUpdates(randomID, 5))
+
+	id, err := s.Self().
+		StyledCaption(styling.Bold("hello")).
+		Pinned().
+		Period(6*time.Hour).
+		Contacts().
+		DisallowUsers(user).
+		URL(area, "https://gotd.dev").
+		ChannelPost(area, channel, 7).
+		Media(ctx, &tg.InputMediaEmpty{})
+	a.NoError(err)
+	a.Equal(5, id)
+}
+
+func TestBuilder_Upload(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	s, mock, randomID := testSender(t)
+	u := &mockUploader{}
+	s.WithUploader(u)
+
+	var img bytes.Buffer
+	a.NoError(png.Encode(&img, image.NewGray(image.Rect(0, 0, 36, 64))))
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.StoriesSendStoryRequest)
+		a.True(ok)
+		a.Equal(&tg.InputMediaUploadedPhoto{
+			File: &tg.InputFile{ID: 1, Name: "story.png", Parts: 1},
+		}, req.Media)
+		a.Equal([]tg.InputPrivacyRuleClass{&tg.InputPrivacyValueAllowAll{}}, req.PrivacyRules)
+	}).ThenResult(storyUpdates(randomID, 6))
+
+	data := img.Bytes()
+	id, err := s.Self().Upload(ctx, "story.png", bytes.NewReader(data), int64(len(data)))
+	a.NoError(err)
+	a.Equal(6, id)
+	a.Equal("story.png", u.name)
+	a.Equal(data, u.data)
+
+	_, err = s.Self().Upload(ctx, "story.txt", bytes.NewReader([]byte("text")), 4)
+	a.Error(err)
+}
+
+func TestStoryID(t *testing.T) {
+	a := require.New(t)
+	self := &tg.InputPeerSelf{}
+	channel := &tg.InputPeerChannel{ChannelID: 2, AccessHash: 2}
+	story := func(peer tg.PeerClass, id int) *tg.UpdateStory {
+		return &tg.UpdateStory{
+			Peer:  peer,
+			Story: &tg.StoryItem{ID: id, Media: &tg.MessageMediaEmpty{}},
+		}
+	}
+
+	id, err := storyID(storyUpdates(10, 5), self, 10)
+	a.NoError(err)
+	a.Equal(5, id)
+
+	_, err = storyID(storyUpdates(10, 5), self, 11)
+	a.Error(err)
+
+	id, err = storyID(&tg.UpdateShort{
+		Update: story(&tg.PeerUser{UserID: 1}, 7),
+	}, self, 10)
+	a.NoError(err)
+	a.Equal(7, id)
+
+	// UpdateStory before UpdateStoryID.
+	id, err = storyID(&tg.Updates{
+		Updates: []tg.UpdateClass{
+			story(&tg.PeerChannel{ChannelID: 2}, 3),
+			&tg.UpdateStoryID{ID: 4, RandomID: 11},
+			&tg.UpdateStoryID{ID: 5, RandomID: 10},
+		},
+	}, channel, 10)
+	a.NoError(err)
+	a.Equal(5, id)
+
+	// Story of another peer.
+	_, err = storyID(&tg.UpdateShort{
+		Update: story(&tg.PeerChannel{ChannelID: 3}, 7),
+	}, channel, 10)
+	a.Error(err)
+
+	// Ambiguous stories.
+	_, err = storyID(&tg.Updates{
+		Updates: []tg.UpdateClass{
+			story(&tg.PeerChannel{ChannelID: 2}, 3),
+			story(&tg.PeerChannel{ChannelID: 2}, 4),
+		},
+	}, channel, 10)
+	a.Error(err)
+}
+
+func ebml(id uint32, payload ...[]byte) []byte {
+	b := binary.BigEndian.AppendUint32(nil, id)
+	for b[0] == 0 {
+		b = b[1:]
+	}
+	data := bytes.Join(payload, nil)
+	b = binary.BigEndian.AppendUint64(b, 1<<56|uint64(len(data)))
+	return append(b, data...)
+}
+
+func testWebM() []byte {
+	return bytes.Join([][]byte{
+		ebml(0x1a45dfa3, ebml(0x4282, []byte("webm"))),
+		ebml(0x18538067,
+			ebml(0x1549a966,
+				ebml(0x2ad7b1, []byte{0x0f, 0x42, 0x40}),
+				ebml(0x4489, binary.BigEndian.AppendUint64(nil, math.Float64bits(5000))),
+			),
+			ebml(0x1654ae6b,
+				ebml(0xae,
+					ebml(0x83, []byte{1}),
+					ebml(0xe0,
+						ebml(0xb0, []byte{0x02, 0xd0}),
+						ebml(0xba, []byte{0x05, 0x00}),
+					),
+				),
+			),
+			ebml(0x1f43b675, make([]byte, 16)),
+		),
+	}, nil)
+}
+
+func TestBuilder_UploadVideo(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	s, mock, randomID := testSender(t)
+	s.WithUploader(&mockUploader{})
+
+	data := testWebM()
+	info, err := probe.ProbeBytes(data)
+	a.NoError(err)
+	a.Equal("video/webm", info.MIME)
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.StoriesSendStoryRequest)
+		a.True(ok)
+		a.Equal(&tg.InputMediaUploadedDocument{
+			File:     &tg.InputFile{ID: 1, Name: "story.webm", Parts: 1},
+			MimeType: "video/webm",
+			Attributes: []tg.DocumentAttributeClass{
+				&tg.DocumentAttributeVideo{
+					SupportsStreaming: info.Streaming,
+					Duration:          5,
+					W:                 720,
+					H:                 1280,
+				},
+			},
+		}, req.Media)
+	}).ThenResult(storyUpdates(randomID, 6))
+
+	id, err := s.Self().Upload(ctx, "story.webm", bytes.NewReader(data), int64(len(data)))
+	a.NoError(err)
+	a.Equal(6, id)
+}
+
+func TestBuilder_Video(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	s, mock, randomID := testSender(t)
+	file := &tg.InputFile{ID: 1, Name: "story.mp4", Parts: 1}
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.StoriesSendStoryRequest)
+		a.True(ok)
+		a.Equal(&tg.InputMediaUploadedDocument{
+			File:     file,
+			MimeType: message.DefaultVideoMIME,
+			Attributes: []tg.DocumentAttributeClass{
+				&tg.DocumentAttributeVideo{
+					Duration: 10,
+					W:        720,
+					H:        1280,
+				},
+			},
+		}, req.Media)
+	}).ThenResult(storyUpdates(randomID, 6))
+
+	id, err := s.Self().Video(ctx, file, 10*time.Second, 720, 1280)
+	a.NoError(err)
+	a.Equal(6, id)
+
+	s, mock, randomID = testSender(t)
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.StoriesSendStoryRequest)
+		a.True(ok)
+		media, ok := req.Media.(*tg.InputMediaUploadedDocument)
+		a.True(ok)
+		a.Equal("video/quicktime", media.MimeType)
+		a.Equal(&tg.DocumentAttributeVideo{
+			SupportsStreaming: true,
+			Duration:          10,
+			W:                 720,
+			H:                 1280,
+		}, media.Attributes[0])
+	}).ThenResult(storyUpdates(randomID, 7))
+
+	id, err = s.Self().
+		MIME("video/quicktime").
+		SupportsStreaming().
+		Video(ctx, file, 10*time.Second, 720, 1280)
+	a.NoError(err)
+	a.Equal(7, id)
+}
+
+func TestBuilder_Edit(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock := tgmock.New(t)
+	s := NewSender(tg.NewClient(mock))
+
+	req := &tg.StoriesEditStoryRequest{
+		Peer: &tg.InputPeerSelf{},
+		ID:   5,
+	}
+	req.SetCaption("new")
+	req.SetEntities([]tg.MessageEntityClass{})
+	req.SetPrivacyRules([]tg.InputPrivacyRuleClass{&tg.InputPrivacyValueAllowCloseFriends{}})
+	mock.ExpectCall(req).ThenResult(&tg.Updates{})
+
+	a.NoError(s.Self().Caption("new").CloseFriends().Edit(ctx, 5, nil))
+}
+
+func TestLocation(t *testing.T) {
+	a := require.New(t)
+
+	photo := &tg.StoryItem{
+		ID: 1,
+		Media: &tg.MessageMediaPhoto{
+			Photo: &tg.Photo{
+				ID:            1,
+				AccessHash:    2,
+				FileReference: []byte{3},
+				Sizes: []tg.PhotoSizeClass{
+					&tg.PhotoSize{Type: "m", W: 320, H: 320},
+					&tg.PhotoSizeProgressive{Type: "y", W: 1280, H: 1280},
+					&tg.PhotoStrippedSize{Type: "i"},
+				},
+			},
+		},
+	}
+	loc, ok := Location(photo)
+	a.True(ok)
+	a.Equal(&tg.InputPhotoFileLocation{
+		ID:            1,
+		AccessHash:    2,
+		FileReference: []byte{3},
+		ThumbSize:     "y",
+	}, loc)
+
+	doc := &tg.Document{ID: 1, AccessHash: 2, FileReference: []byte{3}}
+	loc, ok = Location(&tg.StoryItem{
+		ID:    2,
+		Media: &tg.MessageMediaDocument{Document: doc},
+	})
+	a.True(ok)
+	a.Equal(doc.AsInputDocumentFileLocation(), loc)
+
+	_, ok = Location(&tg.StoryItemDeleted{ID: 3})
+	a.False(ok)
+}
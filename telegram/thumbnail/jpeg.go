@@ -0,0 +1,83 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	"github.com/go-faster/errors"
+)
+
+// MaxSize is a maximum size of document thumbnail side.
+//
+// See https://core.telegram.org/api/files#uploading-files.
+const MaxSize = 320
+
+// JPEG creates JPEG thumbnail which fits size x size square, suitable for
+// tg.InputMediaUploadedDocument.Thumb.
+//
+// If size is not positive, MaxSize is used.
+func JPEG(img image.Image, size int) ([]byte, error) {
+	if size <= 0 {
+		size = MaxSize
+	}
+	if img.Bounds().Empty() {
+		return nil, errors.New("empty image")
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, Fit(img, size), &jpeg.Options{Quality: 87}); err != nil {
+		return nil, errors.Wrap(err, "encode")
+	}
+	return buf.Bytes(), nil
+}
+
+// Fit downscales image to fit size x size square using box filter
+// and blends transparent pixels with white background.
+//
+// Image is not upscaled if it already fits.
+func Fit(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		size = max(w, h)
+	}
+
+	tw, th := size, h*size/w
+	if h > w {
+		tw, th = w*size/h, size
+	}
+	if tw < 1 {
+		tw = 1
+	}
+	if th < 1 {
+		th = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := b.Min.Y+y*h/th, b.Min.Y+(y+1)*h/th
+		for x := 0; x < tw; x++ {
+			x0, x1 := b.Min.X+x*w/tw, b.Min.X+(x+1)*w/tw
+
+			var r, g, bl, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					// Colors are alpha-premultiplied, so blend with white background.
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					bg := uint64(0xffff - ca)
+					r, g, bl = r+uint64(cr)+bg, g+uint64(cg)+bg, bl+uint64(cb)+bg
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(bl / n >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return dst
+}
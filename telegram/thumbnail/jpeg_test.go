@@ -0,0 +1,36 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJPEG(t *testing.T) {
+	a := require.New(t)
+
+	data, err := JPEG(testImage(1000, 500), 0)
+	a.NoError(err)
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	a.NoError(err)
+	a.Equal(image.Rect(0, 0, MaxSize, MaxSize/2), img.Bounds())
+
+	_, err = JPEG(image.NewRGBA(image.Rect(0, 0, 0, 0)), 0)
+	a.Error(err)
+}
+
+func TestFit(t *testing.T) {
+	a := require.New(t)
+
+	// Small images are not upscaled.
+	a.Equal(image.Rect(0, 0, 10, 20), Fit(testImage(10, 20), 40).Bounds())
+	a.Equal(image.Rect(0, 0, 1, 40), Fit(testImage(10, 1000), 40).Bounds())
+
+	// Transparent pixels are blended with white.
+	transparent := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	a.Equal(color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, Fit(transparent, 1).RGBAAt(0, 0))
+}
@@ -0,0 +1,388 @@
+package thumbnail
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/go-faster/errors"
+)
+
+// PathSize is a size of vector thumbnail viewBox side.
+//
+// See https://core.telegram.org/api/files#vector-thumbnails.
+const PathSize = 512
+
+// RasterizePath rasterizes vector thumbnail (e.g. tg.PhotoPathSize with
+// type "j") to image of given size.
+//
+// Outline is filled with given color on transparent background and scaled
+// from PathSize x PathSize viewBox to image size.
+func RasterizePath(data []byte, size image.Point, c color.Color) (*image.RGBA, error) {
+	return rasterize(DecodePath(data), size, c)
+}
+
+func rasterize(path []byte, size image.Point, c color.Color) (*image.RGBA, error) {
+	if size.X <= 0 || size.Y <= 0 {
+		return nil, errors.Errorf("invalid size %v", size)
+	}
+
+	polygons, err := parsePath(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse path")
+	}
+
+	scaleX, scaleY := float64(size.X)/PathSize, float64(size.Y)/PathSize
+	for _, poly := range polygons {
+		for i := range poly {
+			poly[i].X *= scaleX
+			poly[i].Y *= scaleY
+		}
+	}
+	coverage := fill(polygons, size.X, size.Y)
+
+	r, g, b, a := c.RGBA()
+	img := image.NewRGBA(image.Rect(0, 0, size.X, size.Y))
+	for i, cov := range coverage {
+		if cov <= 0 {
+			continue
+		}
+		cov = math.Min(cov, 1)
+		// Colors are alpha-premultiplied, so just scale every channel.
+		img.Pix[i*4+0] = uint8(float64(r) * cov / 0x101)
+		img.Pix[i*4+1] = uint8(float64(g) * cov / 0x101)
+		img.Pix[i*4+2] = uint8(float64(b) * cov / 0x101)
+		img.Pix[i*4+3] = uint8(float64(a) * cov / 0x101)
+	}
+	return img, nil
+}
+
+type point struct {
+	X, Y float64
+}
+
+// crossing is an intersection of scanline with polygon edge.
+type crossing struct {
+	x   float64
+	dir int
+}
+
+// fill computes pixel coverage of polygons using non-zero winding rule.
+//
+// Every pixel row is sampled by several scanlines, and horizontal coverage
+// is computed exactly, which gives reasonable anti-aliasing.
+func fill(polygons [][]point, w, h int) []float64 {
+	const samples = 4
+
+	coverage := make([]float64, w*h)
+	var crossings []crossing
+	for sy := 0; sy < h*samples; sy++ {
+		y := (float64(sy) + 0.5) / samples
+		row := coverage[(sy/samples)*w:][:w]
+
+		crossings = crossings[:0]
+		for _, poly := range polygons {
+			for i := range poly {
+				a, b := poly[i], poly[(i+1)%len(poly)]
+				dir := 1
+				if a.Y > b.Y {
+					a, b = b, a
+					dir = -1
+				}
+				if y < a.Y || y >= b.Y {
+					continue
+				}
+				x := a.X + (y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+				if math.IsNaN(x) {
+					continue
+				}
+				crossings = append(crossings, crossing{
+					x:   x,
+					dir: dir,
+				})
+			}
+		}
+		sort.Slice(crossings, func(i, j int) bool {
+			return crossings[i].x < crossings[j].x
+		})
+
+		winding := 0
+		for i, c := range crossings {
+			winding += c.dir
+			if winding == 0 || i+1 >= len(crossings) {
+				continue
+			}
+			fillSpan(row, c.x, crossings[i+1].x, 1.0/samples)
+		}
+	}
+	return coverage
+}
+
+// fillSpan adds weighted coverage of [x0, x1) span to row.
+func fillSpan(row []float64, x0, x1, weight float64) {
+	x0 = math.Max(x0, 0)
+	x1 = math.Min(x1, float64(len(row)))
+	if !(x0 < x1) {
+		return
+	}
+	for px := int(x0); px < len(row) && float64(px) < x1; px++ {
+		overlap := math.Min(x1, float64(px+1)) - math.Max(x0, float64(px))
+		if overlap > 0 {
+			row[px] += overlap * weight
+		}
+	}
+}
+
+// pathParser parses SVG path data and flattens curves to polygons.
+type pathParser struct {
+	data []byte
+	pos  int
+
+	polygons [][]point
+	current  []point
+	// last is the current point, start is the start of current subpath.
+	last, start point
+	// ctrl is the last control point, used by smooth curves.
+	ctrl point
+}
+
+// curveSteps is count of line segments used to approximate curve.
+const curveSteps = 16
+
+// maxCoordinate limits absolute value of path numbers.
+//
+// Valid paths are within PathSize viewBox, so anything much larger is
+// garbage which would overflow during rasterization.
+const maxCoordinate = 1 << 16
+
+func parsePath(data []byte) ([][]point, error) {
+	p := &pathParser{data: data}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	p.flush()
+	return p.polygons, nil
+}
+
+func (p *pathParser) flush() {
+	if len(p.current) > 2 {
+		p.polygons = append(p.polygons, p.current)
+	}
+	p.current = nil
+}
+
+func (p *pathParser) moveTo(to point) {
+	p.flush()
+	p.current = append(p.current, to)
+	p.last, p.start, p.ctrl = to, to, to
+}
+
+func (p *pathParser) lineTo(to point) {
+	if len(p.current) == 0 {
+		p.current = append(p.current, p.last)
+	}
+	p.current = append(p.current, to)
+	p.last, p.ctrl = to, to
+}
+
+func (p *pathParser) cubeTo(c1, c2, to point) {
+	from := p.last
+	for i := 1; i <= curveSteps; i++ {
+		t := float64(i) / curveSteps
+		u := 1 - t
+		p.lineTo(point{
+			X: u*u*u*from.X + 3*u*u*t*c1.X + 3*u*t*t*c2.X + t*t*t*to.X,
+			Y: u*u*u*from.Y + 3*u*u*t*c1.Y + 3*u*t*t*c2.Y + t*t*t*to.Y,
+		})
+	}
+	p.ctrl = c2
+}
+
+func (p *pathParser) quadTo(c, to point) {
+	from := p.last
+	for i := 1; i <= curveSteps; i++ {
+		t := float64(i) / curveSteps
+		u := 1 - t
+		p.lineTo(point{
+			X: u*u*from.X + 2*u*t*c.X + t*t*to.X,
+			Y: u*u*from.Y + 2*u*t*c.Y + t*t*to.Y,
+		})
+	}
+	p.ctrl = c
+}
+
+func (p *pathParser) closePath() {
+	p.flush()
+	p.last, p.ctrl = p.start, p.start
+}
+
+// reflect returns reflection of the last control point relative to the
+// current point.
+func (p *pathParser) reflect() point {
+	return point{X: 2*p.last.X - p.ctrl.X, Y: 2*p.last.Y - p.ctrl.Y}
+}
+
+func (p *pathParser) skipSeparators() {
+	for p.pos < len(p.data) && (p.data[p.pos] == ',' || p.data[p.pos] == ' ') {
+		p.pos++
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (p *pathParser) hasNumber() bool {
+	p.skipSeparators()
+	if p.pos >= len(p.data) {
+		return false
+	}
+	c := p.data[p.pos]
+	return c == '-' || c == '+' || c == '.' || isDigit(c)
+}
+
+func (p *pathParser) number() (float64, error) {
+	p.skipSeparators()
+	start := p.pos
+	if p.pos < len(p.data) && (p.data[p.pos] == '-' || p.data[p.pos] == '+') {
+		p.pos++
+	}
+	for p.pos < len(p.data) && isDigit(p.data[p.pos]) {
+		p.pos++
+	}
+	if p.pos < len(p.data) && p.data[p.pos] == '.' {
+		p.pos++
+		for p.pos < len(p.data) && isDigit(p.data[p.pos]) {
+			p.pos++
+		}
+	}
+
+	v, err := strconv.ParseFloat(string(p.data[start:p.pos]), 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid number at %d", start)
+	}
+	if math.Abs(v) > maxCoordinate {
+		return 0, errors.Errorf("number at %d is out of range", start)
+	}
+	return v, nil
+}
+
+// points reads n coordinate pairs, relative to the current point if rel is true.
+func (p *pathParser) points(rel bool, n int) ([]point, error) {
+	r := make([]point, n)
+	for i := range r {
+		x, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		y, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		if rel {
+			x, y = x+p.last.X, y+p.last.Y
+		}
+		r[i] = point{X: x, Y: y}
+	}
+	return r, nil
+}
+
+func (p *pathParser) parse() error {
+	for {
+		p.skipSeparators()
+		if p.pos >= len(p.data) {
+			return nil
+		}
+		cmd := p.data[p.pos]
+		p.pos++
+
+		switch cmd {
+		case 'L', 'l', 'H', 'h', 'V', 'v', 'C', 'c', 'S', 's', 'Q', 'q', 'T', 't':
+		case 'Z', 'z':
+			p.closePath()
+			continue
+		case 'M', 'm':
+			pts, err := p.points(cmd == 'm', 1)
+			if err != nil {
+				return errors.Wrapf(err, "command %c", cmd)
+			}
+			p.moveTo(pts[0])
+			// Subsequent pairs after moveto are implicit lineto commands.
+			cmd -= 'M' - 'L'
+			if !p.hasNumber() {
+				continue
+			}
+		default:
+			return errors.Errorf("unsupported command %q at %d", cmd, p.pos-1)
+		}
+
+		// Command may be repeated with implicit command letter.
+		for {
+			if err := p.command(cmd); err != nil {
+				return errors.Wrapf(err, "command %c", cmd)
+			}
+			if !p.hasNumber() {
+				break
+			}
+		}
+	}
+}
+
+func (p *pathParser) command(cmd byte) error {
+	rel := cmd >= 'a'
+	switch cmd {
+	case 'L', 'l':
+		pts, err := p.points(rel, 1)
+		if err != nil {
+			return err
+		}
+		p.lineTo(pts[0])
+	case 'H', 'h':
+		x, err := p.number()
+		if err != nil {
+			return err
+		}
+		if rel {
+			x += p.last.X
+		}
+		p.lineTo(point{X: x, Y: p.last.Y})
+	case 'V', 'v':
+		y, err := p.number()
+		if err != nil {
+			return err
+		}
+		if rel {
+			y += p.last.Y
+		}
+		p.lineTo(point{X: p.last.X, Y: y})
+	case 'C', 'c':
+		pts, err := p.points(rel, 3)
+		if err != nil {
+			return err
+		}
+		p.cubeTo(pts[0], pts[1], pts[2])
+	case 'S', 's':
+		c1 := p.reflect()
+		pts, err := p.points(rel, 2)
+		if err != nil {
+			return err
+		}
+		p.cubeTo(c1, pts[0], pts[1])
+	case 'Q', 'q':
+		pts, err := p.points(rel, 2)
+		if err != nil {
+			return err
+		}
+		p.quadTo(pts[0], pts[1])
+	case 'T', 't':
+		c := p.reflect()
+		pts, err := p.points(rel, 1)
+		if err != nil {
+			return err
+		}
+		p.quadTo(c, pts[0])
+	}
+	return nil
+}
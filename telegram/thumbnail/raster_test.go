@@ -0,0 +1,130 @@
+package thumbnail
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRasterize(t *testing.T) {
+	black := color.RGBA{A: 0xff}
+
+	t.Run("Square", func(t *testing.T) {
+		a := require.New(t)
+		img, err := rasterize([]byte("M0,0L512,0L512,256L0,256z"), image.Pt(8, 8), black)
+		a.NoError(err)
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				expected := uint8(0)
+				if y < 4 {
+					expected = 0xff
+				}
+				a.Equal(expected, img.RGBAAt(x, y).A, "(%d, %d)", x, y)
+			}
+		}
+	})
+	t.Run("Relative", func(t *testing.T) {
+		a := require.New(t)
+		// Same as square above, but with relative and implicit commands.
+		img, err := rasterize([]byte("m0,0h512v256h-512z"), image.Pt(8, 8), black)
+		a.NoError(err)
+		a.Equal(uint8(0xff), img.RGBAAt(7, 3).A)
+		a.Equal(uint8(0), img.RGBAAt(7, 4).A)
+	})
+	t.Run("AntiAliasing", func(t *testing.T) {
+		a := require.New(t)
+		// Covers left half of the second pixel column.
+		img, err := rasterize([]byte("M0,0H192V512H0z"), image.Pt(4, 4), color.RGBA{R: 0xff, A: 0xff})
+		a.NoError(err)
+		a.Equal(color.RGBA{R: 0x7f, A: 0x7f}, img.RGBAAt(1, 0))
+		a.Equal(color.RGBA{R: 0xff, A: 0xff}, img.RGBAAt(0, 0))
+		a.Equal(color.RGBA{}, img.RGBAAt(2, 0))
+	})
+	t.Run("Curves", func(t *testing.T) {
+		a := require.New(t)
+		img, err := rasterize([]byte(
+			"M0,256C0,0,512,0,512,256S0,512,0,256zM128,256Q256,0,384,256T128,256z",
+		), image.Pt(16, 16), black)
+		a.NoError(err)
+		a.Equal(uint8(0xff), img.RGBAAt(8, 12).A)
+		a.Equal(uint8(0), img.RGBAAt(0, 0).A)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		for _, path := range []string{
+			"M1",
+			"M1,2L",
+			"X1,2",
+			"M1,2C1,2,3",
+		} {
+			_, err := rasterize([]byte(path), image.Pt(8, 8), black)
+			require.Error(t, err, path)
+		}
+		_, err := rasterize([]byte("M0,0L1,1z"), image.Pt(0, 8), black)
+		require.Error(t, err)
+	})
+	t.Run("Overflow", func(t *testing.T) {
+		huge := "9" + strings.Repeat("0", 307)
+		for _, path := range []string{
+			"M0,0C" + huge + ",0," + huge + "," + huge + ",0," + huge + "z",
+			"M0,0L" + huge + "0,0z",
+			"M0,0L70000,0V1z",
+		} {
+			_, err := rasterize([]byte(path), image.Pt(8, 8), black)
+			require.Error(t, err)
+		}
+	})
+	t.Run("NonFinite", func(t *testing.T) {
+		a := require.New(t)
+		row := make([]float64, 8)
+		fillSpan(row, math.NaN(), 4, 1)
+		fillSpan(row, 4, math.NaN(), 1)
+		fillSpan(row, math.Inf(1), math.Inf(1), 1)
+		fillSpan(row, math.Inf(-1), math.Inf(-1), 1)
+		a.Equal(make([]float64, 8), row)
+
+		fillSpan(row, math.Inf(-1), math.Inf(1), 1)
+		a.Equal([]float64{1, 1, 1, 1, 1, 1, 1, 1}, row)
+
+		inf := math.Inf(1)
+		coverage := fill([][]point{{{0, 0}, {inf, 0}, {inf, inf}, {0, inf}}}, 8, 8)
+		a.Len(coverage, 64)
+	})
+}
+
+func TestRasterizePath(t *testing.T) {
+	a := require.New(t)
+	data := []uint8{
+		0x1a, 0x00, 0xb2, 0x04, 0xdc, 0x47, 0x03, 0x81, 0x73, 0x55, 0x48, 0x01, 0x46, 0x05, 0x44, 0x45,
+		0x4f, 0x8b, 0x52, 0x8d, 0x4e, 0x8a, 0x5d, 0x8b, 0x6e, 0x8b, 0x71, 0x81, 0x4c, 0x4d, 0x07, 0x47,
+		0x50, 0x02, 0x81, 0x46, 0x84, 0x4b, 0x83, 0x50, 0x80, 0x44, 0x67, 0x47, 0x01, 0x6b, 0x47, 0x08,
+		0x43, 0x44, 0x4f, 0x53, 0x43, 0x56, 0x8e, 0x44, 0x97, 0x94, 0x9d, 0x9c, 0x82, 0x84, 0x89, 0x45,
+		0x8b, 0x42, 0x88, 0x8a, 0xa6, 0xb3, 0xa8, 0xbc, 0x81, 0x8a, 0x81, 0xad, 0x82, 0xae, 0x87, 0x88,
+		0xa5, 0x67, 0xab, 0x6a, 0x92, 0x49, 0xaa, 0x42, 0xb6, 0x4e, 0x8d, 0x4e, 0x5e, 0x80, 0x69, 0x43,
+		0x45, 0x41, 0x4a, 0x46, 0x4d, 0x49, 0x52, 0x51, 0x8a, 0x46, 0x89, 0x47, 0x4b, 0x4f, 0x5f, 0x5b,
+		0x6d, 0x67, 0x64, 0x5f, 0x46, 0x06, 0x48, 0x08, 0x63, 0x4d, 0x03, 0xa1, 0x70, 0x89, 0x06, 0x81,
+		0x8b, 0x01, 0x48, 0x87, 0x44, 0x8a, 0x4c, 0x91, 0x51, 0x98, 0x51, 0x87, 0x03, 0x81, 0x89, 0x04,
+		0x8f, 0x88, 0x86, 0x90, 0x8e, 0x95, 0x97, 0x81, 0x81, 0x82, 0x8b, 0x84, 0x8c, 0x98, 0x8d, 0xb2,
+		0x93, 0x87, 0x00, 0xa9, 0xab, 0xad, 0x8e, 0x8a, 0x07, 0x69, 0x8c, 0x05, 0x5a, 0x89, 0x75, 0x84,
+		0x48, 0x00, 0x87, 0x46, 0x80, 0x8c, 0x86, 0x92, 0x86, 0x90, 0x81, 0xa4, 0x4b, 0xb2, 0x81, 0x95,
+		0x91, 0x9b, 0xab, 0xab, 0xbf, 0x95, 0x99, 0x87, 0x03, 0xb9, 0x87, 0x00, 0x89, 0x04, 0x42, 0xac,
+		0x4e, 0x04, 0xb5, 0x51, 0x04, 0xb3, 0x4a, 0x81, 0x46, 0x86, 0x4a, 0x8b, 0x41, 0x81, 0x43, 0x44,
+		0x45, 0x43, 0x51, 0x85, 0x5c, 0x8c, 0x6f, 0x8c,
+	}
+
+	img, err := RasterizePath(data, image.Pt(64, 64), color.Black)
+	a.NoError(err)
+	a.Equal(image.Rect(0, 0, 64, 64), img.Bounds())
+
+	var filled int
+	for i := 3; i < len(img.Pix); i += 4 {
+		if img.Pix[i] == 0xff {
+			filled++
+		}
+	}
+	a.Positive(filled)
+	a.Less(filled, 64*64)
+}
@@ -0,0 +1,116 @@
+package thumbnail
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+
+	"github.com/go-faster/errors"
+)
+
+// StrippedSize is a maximum size of stripped thumbnail side.
+const StrippedSize = 40
+
+// strippedQuality is a JPEG quality which produces quantization tables
+// of strippedHeader.
+const strippedQuality = 20
+
+// Offsets of quantization tables and scan header in strippedHeader.
+const (
+	strippedLumaOffset   = 25
+	strippedChromaOffset = 94
+	strippedTableSize    = 64
+	strippedScanHeader   = 14
+)
+
+// Strip creates stripped thumbnail payload from image, like
+// tg.PhotoStrippedSize.Bytes.
+//
+// See StripTo.
+func Strip(img image.Image) ([]byte, error) {
+	return StripTo(img, nil)
+}
+
+// StripTo appends stripped thumbnail payload created from image to "to"
+// byte slice.
+//
+// Image is downscaled to fit StrippedSize x StrippedSize square. Payload
+// can be expanded back to JPEG using Expand.
+//
+// See https://core.telegram.org/api/files#stripped-thumbnails for reference.
+func StripTo(img image.Image, to []byte) ([]byte, error) {
+	if img.Bounds().Empty() {
+		return nil, errors.New("empty image")
+	}
+
+	var buf bytes.Buffer
+	// Fit always returns RGBA image, so encoder produces YCbCr 4:2:0
+	// JPEG like the one expected by strippedHeader.
+	if err := jpeg.Encode(&buf, Fit(img, StrippedSize), &jpeg.Options{
+		Quality: strippedQuality,
+	}); err != nil {
+		return nil, errors.Wrap(err, "encode")
+	}
+	return stripJPEG(buf.Bytes(), to)
+}
+
+// stripJPEG extracts dimensions and entropy-coded data from JPEG, which
+// must use the same tables as strippedHeader.
+func stripJPEG(data, to []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return nil, errors.New("invalid JPEG header")
+	}
+
+	var (
+		width, height int
+		tables        [2][]byte
+	)
+	for i := 2; ; {
+		if i+4 > len(data) {
+			return nil, errors.New("unexpected end of JPEG")
+		}
+		if data[i] != 0xff {
+			return nil, errors.Errorf("invalid marker at %d", i)
+		}
+		marker := data[i+1]
+		end := i + 2 + int(binary.BigEndian.Uint16(data[i+2:]))
+		if end > len(data) {
+			return nil, errors.Errorf("segment %#x is too big", marker)
+		}
+		segment := data[i+4 : end]
+
+		switch marker {
+		case 0xdb: // DQT
+			for len(segment) >= 1+strippedTableSize {
+				id := segment[0]
+				if id > 1 {
+					return nil, errors.Errorf("unexpected quantization table %#x", id)
+				}
+				tables[id] = segment[1 : 1+strippedTableSize]
+				segment = segment[1+strippedTableSize:]
+			}
+		case 0xc0: // SOF0
+			if len(segment) < 5 {
+				return nil, errors.New("invalid SOF0 segment")
+			}
+			height = int(binary.BigEndian.Uint16(segment[1:]))
+			width = int(binary.BigEndian.Uint16(segment[3:]))
+		case 0xda: // SOS
+			switch {
+			case width < 1 || width > 0xff || height < 1 || height > 0xff:
+				return nil, errors.Errorf("invalid size %dx%d", width, height)
+			case !bytes.Equal(tables[0], []byte(strippedHeader[strippedLumaOffset:][:strippedTableSize])),
+				!bytes.Equal(tables[1], []byte(strippedHeader[strippedChromaOffset:][:strippedTableSize])):
+				return nil, errors.New("unexpected quantization tables")
+			case string(data[i:end]) != strippedHeader[len(strippedHeader)-strippedScanHeader:]:
+				return nil, errors.New("unexpected scan header")
+			}
+
+			scan := bytes.TrimSuffix(data[end:], []byte(strippedFooter))
+			to = append(to, strippedFirstChar, byte(height), byte(width))
+			return append(to, scan...), nil
+		}
+		i = end
+	}
+}
@@ -0,0 +1,85 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetRGBA(x, y, color.RGBA{
+				R: uint8(x * 255 / w),
+				G: uint8(y * 255 / h),
+				B: 0x80,
+				A: 0xff,
+			})
+		}
+	}
+	return img
+}
+
+func TestStrip(t *testing.T) {
+	a := require.New(t)
+	img := testImage(400, 200)
+
+	stripped, err := Strip(img)
+	a.NoError(err)
+	a.Equal([]byte{strippedFirstChar, 20, 40}, stripped[:3])
+
+	expanded, err := Expand(stripped)
+	a.NoError(err)
+	got, err := jpeg.Decode(bytes.NewReader(expanded))
+	a.NoError(err)
+
+	// Expanded image must be exactly the same as encoded one.
+	var buf bytes.Buffer
+	a.NoError(jpeg.Encode(&buf, Fit(img, StrippedSize), &jpeg.Options{Quality: strippedQuality}))
+	expected, err := jpeg.Decode(bytes.NewReader(buf.Bytes()))
+	a.NoError(err)
+	a.Equal(expected, got)
+
+	t.Run("Append", func(t *testing.T) {
+		a := require.New(t)
+		to, err := StripTo(img, []byte{1, 2, 3})
+		a.NoError(err)
+		a.Equal(stripped, to[3:])
+	})
+	t.Run("Empty", func(t *testing.T) {
+		_, err := Strip(image.NewRGBA(image.Rect(0, 0, 0, 0)))
+		require.Error(t, err)
+	})
+	t.Run("Gray", func(t *testing.T) {
+		a := require.New(t)
+		gray := image.NewGray(image.Rect(0, 0, 10, 30))
+		stripped, err := Strip(gray)
+		a.NoError(err)
+		a.Equal([]byte{strippedFirstChar, 30, 10}, stripped[:3])
+		expanded, err := Expand(stripped)
+		a.NoError(err)
+		_, err = jpeg.Decode(bytes.NewReader(expanded))
+		a.NoError(err)
+	})
+}
+
+func TestStripJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, testImage(10, 10), &jpeg.Options{Quality: 90}))
+
+	for _, data := range [][]byte{
+		nil,
+		{0xff, 0xd8},
+		{0xff, 0xd8, 0x00, 0x00, 0x00, 0x00},
+		{0xff, 0xd8, 0xff, 0xdb, 0xff, 0xff},
+		buf.Bytes(),
+	} {
+		_, err := stripJPEG(data, nil)
+		require.Error(t, err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 
@@ -83,7 +84,6 @@ func testManager(t *testing.T, f func(s *server, storage updates.StateStorage) c
 	ctx, cancel := context.WithCancel(context.Background())
 
 	var (
-		log     = zerolog.New(zerolog.NewTestWriter(t))
 		s       = newServer()
 		h       = newHandler()
 		storage = newMemStorage()
@@ -110,7 +110,7 @@ func testManager(t *testing.T, f func(s *server, storage updates.StateStorage) c
 
 	e := updates.New(updates.Config{
 		Handler:      h,
-		Logger:       ulog,
+		Logger:       &ulog,
 		Storage:      storage,
 		AccessHasher: hasher,
 	})
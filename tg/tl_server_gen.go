@@ -31,11 +31,1442 @@ var (
 	_ = tdjson.Encoder{}
 )
 
+// Server is a server-side interface of all RPC methods.
+//
+// Every method has the same signature as the corresponding Client method,
+// so Server can be implemented partially and registered using
+// ServerDispatcher.Register.
+type Server interface {
+	// AuthSendCode handles method auth.sendCode#a677244f.
+	AuthSendCode(ctx context.Context, request *AuthSendCodeRequest) (AuthSentCodeClass, error)
+	// AuthSignUp handles method auth.signUp#aac7b717.
+	AuthSignUp(ctx context.Context, request *AuthSignUpRequest) (AuthAuthorizationClass, error)
+	// AuthSignIn handles method auth.signIn#8d52a951.
+	AuthSignIn(ctx context.Context, request *AuthSignInRequest) (AuthAuthorizationClass, error)
+	// AuthLogOut handles method auth.logOut#3e72ba19.
+	AuthLogOut(ctx context.Context) (*AuthLoggedOut, error)
+	// AuthResetAuthorizations handles method auth.resetAuthorizations#9fab0d1a.
+	AuthResetAuthorizations(ctx context.Context) (bool, error)
+	// AuthExportAuthorization handles method auth.exportAuthorization#e5bfffcd.
+	AuthExportAuthorization(ctx context.Context, dcid int) (*AuthExportedAuthorization, error)
+	// AuthImportAuthorization handles method auth.importAuthorization#a57a7dad.
+	AuthImportAuthorization(ctx context.Context, request *AuthImportAuthorizationRequest) (AuthAuthorizationClass, error)
+	// AuthBindTempAuthKey handles method auth.bindTempAuthKey#cdd42a05.
+	AuthBindTempAuthKey(ctx context.Context, request *AuthBindTempAuthKeyRequest) (bool, error)
+	// AuthImportBotAuthorization handles method auth.importBotAuthorization#67a3ff2c.
+	AuthImportBotAuthorization(ctx context.Context, request *AuthImportBotAuthorizationRequest) (AuthAuthorizationClass, error)
+	// AuthCheckPassword handles method auth.checkPassword#d18b4d16.
+	AuthCheckPassword(ctx context.Context, password InputCheckPasswordSRPClass) (AuthAuthorizationClass, error)
+	// AuthRequestPasswordRecovery handles method auth.requestPasswordRecovery#d897bc66.
+	AuthRequestPasswordRecovery(ctx context.Context) (*AuthPasswordRecovery, error)
+	// AuthRecoverPassword handles method auth.recoverPassword#37096c70.
+	AuthRecoverPassword(ctx context.Context, request *AuthRecoverPasswordRequest) (AuthAuthorizationClass, error)
+	// AuthResendCode handles method auth.resendCode#cae47523.
+	AuthResendCode(ctx context.Context, request *AuthResendCodeRequest) (AuthSentCodeClass, error)
+	// AuthCancelCode handles method auth.cancelCode#1f040578.
+	AuthCancelCode(ctx context.Context, request *AuthCancelCodeRequest) (bool, error)
+	// AuthDropTempAuthKeys handles method auth.dropTempAuthKeys#8e48a188.
+	AuthDropTempAuthKeys(ctx context.Context, exceptauthkeys []int64) (bool, error)
+	// AuthExportLoginToken handles method auth.exportLoginToken#b7e085fe.
+	AuthExportLoginToken(ctx context.Context, request *AuthExportLoginTokenRequest) (AuthLoginTokenClass, error)
+	// AuthImportLoginToken handles method auth.importLoginToken#95ac5ce4.
+	AuthImportLoginToken(ctx context.Context, token []byte) (AuthLoginTokenClass, error)
+	// AuthAcceptLoginToken handles method auth.acceptLoginToken#e894ad4d.
+	AuthAcceptLoginToken(ctx context.Context, token []byte) (*Authorization, error)
+	// AuthCheckRecoveryPassword handles method auth.checkRecoveryPassword#d36bf79.
+	AuthCheckRecoveryPassword(ctx context.Context, code string) (bool, error)
+	// AuthImportWebTokenAuthorization handles method auth.importWebTokenAuthorization#2db873a9.
+	AuthImportWebTokenAuthorization(ctx context.Context, request *AuthImportWebTokenAuthorizationRequest) (AuthAuthorizationClass, error)
+	// AuthRequestFirebaseSMS handles method auth.requestFirebaseSms#8e39261e.
+	AuthRequestFirebaseSMS(ctx context.Context, request *AuthRequestFirebaseSMSRequest) (bool, error)
+	// AuthResetLoginEmail handles method auth.resetLoginEmail#7e960193.
+	AuthResetLoginEmail(ctx context.Context, request *AuthResetLoginEmailRequest) (AuthSentCodeClass, error)
+	// AuthReportMissingCode handles method auth.reportMissingCode#cb9deff6.
+	AuthReportMissingCode(ctx context.Context, request *AuthReportMissingCodeRequest) (bool, error)
+	// AccountRegisterDevice handles method account.registerDevice#ec86017a.
+	AccountRegisterDevice(ctx context.Context, request *AccountRegisterDeviceRequest) (bool, error)
+	// AccountUnregisterDevice handles method account.unregisterDevice#6a0d3206.
+	AccountUnregisterDevice(ctx context.Context, request *AccountUnregisterDeviceRequest) (bool, error)
+	// AccountUpdateNotifySettings handles method account.updateNotifySettings#84be5b93.
+	AccountUpdateNotifySettings(ctx context.Context, request *AccountUpdateNotifySettingsRequest) (bool, error)
+	// AccountGetNotifySettings handles method account.getNotifySettings#12b3ad31.
+	AccountGetNotifySettings(ctx context.Context, peer InputNotifyPeerClass) (*PeerNotifySettings, error)
+	// AccountResetNotifySettings handles method account.resetNotifySettings#db7e1747.
+	AccountResetNotifySettings(ctx context.Context) (bool, error)
+	// AccountUpdateProfile handles method account.updateProfile#78515775.
+	AccountUpdateProfile(ctx context.Context, request *AccountUpdateProfileRequest) (UserClass, error)
+	// AccountUpdateStatus handles method account.updateStatus#6628562c.
+	AccountUpdateStatus(ctx context.Context, offline bool) (bool, error)
+	// AccountGetWallPapers handles method account.getWallPapers#7967d36.
+	AccountGetWallPapers(ctx context.Context, hash int64) (AccountWallPapersClass, error)
+	// AccountReportPeer handles method account.reportPeer#c5ba3d86.
+	AccountReportPeer(ctx context.Context, request *AccountReportPeerRequest) (bool, error)
+	// AccountCheckUsername handles method account.checkUsername#2714d86c.
+	AccountCheckUsername(ctx context.Context, username string) (bool, error)
+	// AccountUpdateUsername handles method account.updateUsername#3e0bdd7c.
+	AccountUpdateUsername(ctx context.Context, username string) (UserClass, error)
+	// AccountGetPrivacy handles method account.getPrivacy#dadbc950.
+	AccountGetPrivacy(ctx context.Context, key InputPrivacyKeyClass) (*AccountPrivacyRules, error)
+	// AccountSetPrivacy handles method account.setPrivacy#c9f81ce8.
+	AccountSetPrivacy(ctx context.Context, request *AccountSetPrivacyRequest) (*AccountPrivacyRules, error)
+	// AccountDeleteAccount handles method account.deleteAccount#a2c0cf74.
+	AccountDeleteAccount(ctx context.Context, request *AccountDeleteAccountRequest) (bool, error)
+	// AccountGetAccountTTL handles method account.getAccountTTL#8fc711d.
+	AccountGetAccountTTL(ctx context.Context) (*AccountDaysTTL, error)
+	// AccountSetAccountTTL handles method account.setAccountTTL#2442485e.
+	AccountSetAccountTTL(ctx context.Context, ttl AccountDaysTTL) (bool, error)
+	// AccountSendChangePhoneCode handles method account.sendChangePhoneCode#82574ae5.
+	AccountSendChangePhoneCode(ctx context.Context, request *AccountSendChangePhoneCodeRequest) (AuthSentCodeClass, error)
+	// AccountChangePhone handles method account.changePhone#70c32edb.
+	AccountChangePhone(ctx context.Context, request *AccountChangePhoneRequest) (UserClass, error)
+	// AccountUpdateDeviceLocked handles method account.updateDeviceLocked#38df3532.
+	AccountUpdateDeviceLocked(ctx context.Context, period int) (bool, error)
+	// AccountGetAuthorizations handles method account.getAuthorizations#e320c158.
+	AccountGetAuthorizations(ctx context.Context) (*AccountAuthorizations, error)
+	// AccountResetAuthorization handles method account.resetAuthorization#df77f3bc.
+	AccountResetAuthorization(ctx context.Context, hash int64) (bool, error)
+	// AccountGetPassword handles method account.getPassword#548a30f5.
+	AccountGetPassword(ctx context.Context) (*AccountPassword, error)
+	// AccountGetPasswordSettings handles method account.getPasswordSettings#9cd4eaf9.
+	AccountGetPasswordSettings(ctx context.Context, password InputCheckPasswordSRPClass) (*AccountPasswordSettings, error)
+	// AccountUpdatePasswordSettings handles method account.updatePasswordSettings#a59b102f.
+	AccountUpdatePasswordSettings(ctx context.Context, request *AccountUpdatePasswordSettingsRequest) (bool, error)
+	// AccountSendConfirmPhoneCode handles method account.sendConfirmPhoneCode#1b3faa88.
+	AccountSendConfirmPhoneCode(ctx context.Context, request *AccountSendConfirmPhoneCodeRequest) (AuthSentCodeClass, error)
+	// AccountConfirmPhone handles method account.confirmPhone#5f2178c3.
+	AccountConfirmPhone(ctx context.Context, request *AccountConfirmPhoneRequest) (bool, error)
+	// AccountGetTmpPassword handles method account.getTmpPassword#449e0b51.
+	AccountGetTmpPassword(ctx context.Context, request *AccountGetTmpPasswordRequest) (*AccountTmpPassword, error)
+	// AccountGetWebAuthorizations handles method account.getWebAuthorizations#182e6d6f.
+	AccountGetWebAuthorizations(ctx context.Context) (*AccountWebAuthorizations, error)
+	// AccountResetWebAuthorization handles method account.resetWebAuthorization#2d01b9ef.
+	AccountResetWebAuthorization(ctx context.Context, hash int64) (bool, error)
+	// AccountResetWebAuthorizations handles method account.resetWebAuthorizations#682d2594.
+	AccountResetWebAuthorizations(ctx context.Context) (bool, error)
+	// AccountGetAllSecureValues handles method account.getAllSecureValues#b288bc7d.
+	AccountGetAllSecureValues(ctx context.Context) ([]SecureValue, error)
+	// AccountGetSecureValue handles method account.getSecureValue#73665bc2.
+	AccountGetSecureValue(ctx context.Context, types []SecureValueTypeClass) ([]SecureValue, error)
+	// AccountSaveSecureValue handles method account.saveSecureValue#899fe31d.
+	AccountSaveSecureValue(ctx context.Context, request *AccountSaveSecureValueRequest) (*SecureValue, error)
+	// AccountDeleteSecureValue handles method account.deleteSecureValue#b880bc4b.
+	AccountDeleteSecureValue(ctx context.Context, types []SecureValueTypeClass) (bool, error)
+	// AccountGetAuthorizationForm handles method account.getAuthorizationForm#a929597a.
+	AccountGetAuthorizationForm(ctx context.Context, request *AccountGetAuthorizationFormRequest) (*AccountAuthorizationForm, error)
+	// AccountAcceptAuthorization handles method account.acceptAuthorization#f3ed4c73.
+	AccountAcceptAuthorization(ctx context.Context, request *AccountAcceptAuthorizationRequest) (bool, error)
+	// AccountSendVerifyPhoneCode handles method account.sendVerifyPhoneCode#a5a356f9.
+	AccountSendVerifyPhoneCode(ctx context.Context, request *AccountSendVerifyPhoneCodeRequest) (AuthSentCodeClass, error)
+	// AccountVerifyPhone handles method account.verifyPhone#4dd3a7f6.
+	AccountVerifyPhone(ctx context.Context, request *AccountVerifyPhoneRequest) (bool, error)
+	// AccountSendVerifyEmailCode handles method account.sendVerifyEmailCode#98e037bb.
+	AccountSendVerifyEmailCode(ctx context.Context, request *AccountSendVerifyEmailCodeRequest) (*AccountSentEmailCode, error)
+	// AccountVerifyEmail handles method account.verifyEmail#32da4cf.
+	AccountVerifyEmail(ctx context.Context, request *AccountVerifyEmailRequest) (AccountEmailVerifiedClass, error)
+	// AccountInitTakeoutSession handles method account.initTakeoutSession#8ef3eab0.
+	AccountInitTakeoutSession(ctx context.Context, request *AccountInitTakeoutSessionRequest) (*AccountTakeout, error)
+	// AccountFinishTakeoutSession handles method account.finishTakeoutSession#1d2652ee.
+	AccountFinishTakeoutSession(ctx context.Context, request *AccountFinishTakeoutSessionRequest) (bool, error)
+	// AccountConfirmPasswordEmail handles method account.confirmPasswordEmail#8fdf1920.
+	AccountConfirmPasswordEmail(ctx context.Context, code string) (bool, error)
+	// AccountResendPasswordEmail handles method account.resendPasswordEmail#7a7f2a15.
+	AccountResendPasswordEmail(ctx context.Context) (bool, error)
+	// AccountCancelPasswordEmail handles method account.cancelPasswordEmail#c1cbd5b6.
+	AccountCancelPasswordEmail(ctx context.Context) (bool, error)
+	// AccountGetContactSignUpNotification handles method account.getContactSignUpNotification#9f07c728.
+	AccountGetContactSignUpNotification(ctx context.Context) (bool, error)
+	// AccountSetContactSignUpNotification handles method account.setContactSignUpNotification#cff43f61.
+	AccountSetContactSignUpNotification(ctx context.Context, silent bool) (bool, error)
+	// AccountGetNotifyExceptions handles method account.getNotifyExceptions#53577479.
+	AccountGetNotifyExceptions(ctx context.Context, request *AccountGetNotifyExceptionsRequest) (UpdatesClass, error)
+	// AccountGetWallPaper handles method account.getWallPaper#fc8ddbea.
+	AccountGetWallPaper(ctx context.Context, wallpaper InputWallPaperClass) (WallPaperClass, error)
+	// AccountUploadWallPaper handles method account.uploadWallPaper#e39a8f03.
+	AccountUploadWallPaper(ctx context.Context, request *AccountUploadWallPaperRequest) (WallPaperClass, error)
+	// AccountSaveWallPaper handles method account.saveWallPaper#6c5a5b37.
+	AccountSaveWallPaper(ctx context.Context, request *AccountSaveWallPaperRequest) (bool, error)
+	// AccountInstallWallPaper handles method account.installWallPaper#feed5769.
+	AccountInstallWallPaper(ctx context.Context, request *AccountInstallWallPaperRequest) (bool, error)
+	// AccountResetWallPapers handles method account.resetWallPapers#bb3b9804.
+	AccountResetWallPapers(ctx context.Context) (bool, error)
+	// AccountGetAutoDownloadSettings handles method account.getAutoDownloadSettings#56da0b3f.
+	AccountGetAutoDownloadSettings(ctx context.Context) (*AccountAutoDownloadSettings, error)
+	// AccountSaveAutoDownloadSettings handles method account.saveAutoDownloadSettings#76f36233.
+	AccountSaveAutoDownloadSettings(ctx context.Context, request *AccountSaveAutoDownloadSettingsRequest) (bool, error)
+	// AccountUploadTheme handles method account.uploadTheme#1c3db333.
+	AccountUploadTheme(ctx context.Context, request *AccountUploadThemeRequest) (DocumentClass, error)
+	// AccountCreateTheme handles method account.createTheme#652e4400.
+	AccountCreateTheme(ctx context.Context, request *AccountCreateThemeRequest) (*Theme, error)
+	// AccountUpdateTheme handles method account.updateTheme#2bf40ccc.
+	AccountUpdateTheme(ctx context.Context, request *AccountUpdateThemeRequest) (*Theme, error)
+	// AccountSaveTheme handles method account.saveTheme#f257106c.
+	AccountSaveTheme(ctx context.Context, request *AccountSaveThemeRequest) (bool, error)
+	// AccountInstallTheme handles method account.installTheme#c727bb3b.
+	AccountInstallTheme(ctx context.Context, request *AccountInstallThemeRequest) (bool, error)
+	// AccountGetTheme handles method account.getTheme#3a5869ec.
+	AccountGetTheme(ctx context.Context, request *AccountGetThemeRequest) (*Theme, error)
+	// AccountGetThemes handles method account.getThemes#7206e458.
+	AccountGetThemes(ctx context.Context, request *AccountGetThemesRequest) (AccountThemesClass, error)
+	// AccountSetContentSettings handles method account.setContentSettings#b574b16b.
+	AccountSetContentSettings(ctx context.Context, request *AccountSetContentSettingsRequest) (bool, error)
+	// AccountGetContentSettings handles method account.getContentSettings#8b9b4dae.
+	AccountGetContentSettings(ctx context.Context) (*AccountContentSettings, error)
+	// AccountGetMultiWallPapers handles method account.getMultiWallPapers#65ad71dc.
+	AccountGetMultiWallPapers(ctx context.Context, wallpapers []InputWallPaperClass) ([]WallPaperClass, error)
+	// AccountGetGlobalPrivacySettings handles method account.getGlobalPrivacySettings#eb2b4cf6.
+	AccountGetGlobalPrivacySettings(ctx context.Context) (*GlobalPrivacySettings, error)
+	// AccountSetGlobalPrivacySettings handles method account.setGlobalPrivacySettings#1edaaac2.
+	AccountSetGlobalPrivacySettings(ctx context.Context, settings GlobalPrivacySettings) (*GlobalPrivacySettings, error)
+	// AccountReportProfilePhoto handles method account.reportProfilePhoto#fa8cc6f5.
+	AccountReportProfilePhoto(ctx context.Context, request *AccountReportProfilePhotoRequest) (bool, error)
+	// AccountResetPassword handles method account.resetPassword#9308ce1b.
+	AccountResetPassword(ctx context.Context) (AccountResetPasswordResultClass, error)
+	// AccountDeclinePasswordReset handles method account.declinePasswordReset#4c9409f6.
+	AccountDeclinePasswordReset(ctx context.Context) (bool, error)
+	// AccountGetChatThemes handles method account.getChatThemes#d638de89.
+	AccountGetChatThemes(ctx context.Context, hash int64) (AccountThemesClass, error)
+	// AccountSetAuthorizationTTL handles method account.setAuthorizationTTL#bf899aa0.
+	AccountSetAuthorizationTTL(ctx context.Context, authorizationttldays int) (bool, error)
+	// AccountChangeAuthorizationSettings handles method account.changeAuthorizationSettings#40f48462.
+	AccountChangeAuthorizationSettings(ctx context.Context, request *AccountChangeAuthorizationSettingsRequest) (bool, error)
+	// AccountGetSavedRingtones handles method account.getSavedRingtones#e1902288.
+	AccountGetSavedRingtones(ctx context.Context, hash int64) (AccountSavedRingtonesClass, error)
+	// AccountSaveRingtone handles method account.saveRingtone#3dea5b03.
+	AccountSaveRingtone(ctx context.Context, request *AccountSaveRingtoneRequest) (AccountSavedRingtoneClass, error)
+	// AccountUploadRingtone handles method account.uploadRingtone#831a83a2.
+	AccountUploadRingtone(ctx context.Context, request *AccountUploadRingtoneRequest) (DocumentClass, error)
+	// AccountUpdateEmojiStatus handles method account.updateEmojiStatus#fbd3de6b.
+	AccountUpdateEmojiStatus(ctx context.Context, emojistatus EmojiStatusClass) (bool, error)
+	// AccountGetDefaultEmojiStatuses handles method account.getDefaultEmojiStatuses#d6753386.
+	AccountGetDefaultEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	// AccountGetRecentEmojiStatuses handles method account.getRecentEmojiStatuses#f578105.
+	AccountGetRecentEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	// AccountClearRecentEmojiStatuses handles method account.clearRecentEmojiStatuses#18201aae.
+	AccountClearRecentEmojiStatuses(ctx context.Context) (bool, error)
+	// AccountReorderUsernames handles method account.reorderUsernames#ef500eab.
+	AccountReorderUsernames(ctx context.Context, order []string) (bool, error)
+	// AccountToggleUsername handles method account.toggleUsername#58d6b376.
+	AccountToggleUsername(ctx context.Context, request *AccountToggleUsernameRequest) (bool, error)
+	// AccountGetDefaultProfilePhotoEmojis handles method account.getDefaultProfilePhotoEmojis#e2750328.
+	AccountGetDefaultProfilePhotoEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	// AccountGetDefaultGroupPhotoEmojis handles method account.getDefaultGroupPhotoEmojis#915860ae.
+	AccountGetDefaultGroupPhotoEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	// AccountGetAutoSaveSettings handles method account.getAutoSaveSettings#adcbbcda.
+	AccountGetAutoSaveSettings(ctx context.Context) (*AccountAutoSaveSettings, error)
+	// AccountSaveAutoSaveSettings handles method account.saveAutoSaveSettings#d69b8361.
+	AccountSaveAutoSaveSettings(ctx context.Context, request *AccountSaveAutoSaveSettingsRequest) (bool, error)
+	// AccountDeleteAutoSaveExceptions handles method account.deleteAutoSaveExceptions#53bc0020.
+	AccountDeleteAutoSaveExceptions(ctx context.Context) (bool, error)
+	// AccountInvalidateSignInCodes handles method account.invalidateSignInCodes#ca8ae8ba.
+	AccountInvalidateSignInCodes(ctx context.Context, codes []string) (bool, error)
+	// AccountUpdateColor handles method account.updateColor#7cefa15d.
+	AccountUpdateColor(ctx context.Context, request *AccountUpdateColorRequest) (bool, error)
+	// AccountGetDefaultBackgroundEmojis handles method account.getDefaultBackgroundEmojis#a60ab9ce.
+	AccountGetDefaultBackgroundEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	// AccountGetChannelDefaultEmojiStatuses handles method account.getChannelDefaultEmojiStatuses#7727a7d5.
+	AccountGetChannelDefaultEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	// AccountGetChannelRestrictedStatusEmojis handles method account.getChannelRestrictedStatusEmojis#35a9e0d5.
+	AccountGetChannelRestrictedStatusEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	// AccountUpdateBusinessWorkHours handles method account.updateBusinessWorkHours#4b00e066.
+	AccountUpdateBusinessWorkHours(ctx context.Context, request *AccountUpdateBusinessWorkHoursRequest) (bool, error)
+	// AccountUpdateBusinessLocation handles method account.updateBusinessLocation#9e6b131a.
+	AccountUpdateBusinessLocation(ctx context.Context, request *AccountUpdateBusinessLocationRequest) (bool, error)
+	// AccountUpdateBusinessGreetingMessage handles method account.updateBusinessGreetingMessage#66cdafc4.
+	AccountUpdateBusinessGreetingMessage(ctx context.Context, request *AccountUpdateBusinessGreetingMessageRequest) (bool, error)
+	// AccountUpdateBusinessAwayMessage handles method account.updateBusinessAwayMessage#a26a7fa5.
+	AccountUpdateBusinessAwayMessage(ctx context.Context, request *AccountUpdateBusinessAwayMessageRequest) (bool, error)
+	// AccountUpdateConnectedBot handles method account.updateConnectedBot#66a08c7e.
+	AccountUpdateConnectedBot(ctx context.Context, request *AccountUpdateConnectedBotRequest) (UpdatesClass, error)
+	// AccountGetConnectedBots handles method account.getConnectedBots#4ea4c80f.
+	AccountGetConnectedBots(ctx context.Context) (*AccountConnectedBots, error)
+	// AccountGetBotBusinessConnection handles method account.getBotBusinessConnection#76a86270.
+	AccountGetBotBusinessConnection(ctx context.Context, connectionid string) (UpdatesClass, error)
+	// AccountUpdateBusinessIntro handles method account.updateBusinessIntro#a614d034.
+	AccountUpdateBusinessIntro(ctx context.Context, request *AccountUpdateBusinessIntroRequest) (bool, error)
+	// AccountToggleConnectedBotPaused handles method account.toggleConnectedBotPaused#646e1097.
+	AccountToggleConnectedBotPaused(ctx context.Context, request *AccountToggleConnectedBotPausedRequest) (bool, error)
+	// AccountDisablePeerConnectedBot handles method account.disablePeerConnectedBot#5e437ed9.
+	AccountDisablePeerConnectedBot(ctx context.Context, peer InputPeerClass) (bool, error)
+	// AccountUpdateBirthday handles method account.updateBirthday#cc6e0c11.
+	AccountUpdateBirthday(ctx context.Context, request *AccountUpdateBirthdayRequest) (bool, error)
+	// AccountCreateBusinessChatLink handles method account.createBusinessChatLink#8851e68e.
+	AccountCreateBusinessChatLink(ctx context.Context, link InputBusinessChatLink) (*BusinessChatLink, error)
+	// AccountEditBusinessChatLink handles method account.editBusinessChatLink#8c3410af.
+	AccountEditBusinessChatLink(ctx context.Context, request *AccountEditBusinessChatLinkRequest) (*BusinessChatLink, error)
+	// AccountDeleteBusinessChatLink handles method account.deleteBusinessChatLink#60073674.
+	AccountDeleteBusinessChatLink(ctx context.Context, slug string) (bool, error)
+	// AccountGetBusinessChatLinks handles method account.getBusinessChatLinks#6f70dde1.
+	AccountGetBusinessChatLinks(ctx context.Context) (*AccountBusinessChatLinks, error)
+	// AccountResolveBusinessChatLink handles method account.resolveBusinessChatLink#5492e5ee.
+	AccountResolveBusinessChatLink(ctx context.Context, slug string) (*AccountResolvedBusinessChatLinks, error)
+	// AccountUpdatePersonalChannel handles method account.updatePersonalChannel#d94305e0.
+	AccountUpdatePersonalChannel(ctx context.Context, channel InputChannelClass) (bool, error)
+	// AccountToggleSponsoredMessages handles method account.toggleSponsoredMessages#b9d9a38d.
+	AccountToggleSponsoredMessages(ctx context.Context, enabled bool) (bool, error)
+	// AccountGetReactionsNotifySettings handles method account.getReactionsNotifySettings#6dd654c.
+	AccountGetReactionsNotifySettings(ctx context.Context) (*ReactionsNotifySettings, error)
+	// AccountSetReactionsNotifySettings handles method account.setReactionsNotifySettings#316ce548.
+	AccountSetReactionsNotifySettings(ctx context.Context, settings ReactionsNotifySettings) (*ReactionsNotifySettings, error)
+	// AccountGetCollectibleEmojiStatuses handles method account.getCollectibleEmojiStatuses#2e7b4543.
+	AccountGetCollectibleEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	// AccountGetPaidMessagesRevenue handles method account.getPaidMessagesRevenue#19ba4a67.
+	AccountGetPaidMessagesRevenue(ctx context.Context, request *AccountGetPaidMessagesRevenueRequest) (*AccountPaidMessagesRevenue, error)
+	// AccountToggleNoPaidMessagesException handles method account.toggleNoPaidMessagesException#fe2eda76.
+	AccountToggleNoPaidMessagesException(ctx context.Context, request *AccountToggleNoPaidMessagesExceptionRequest) (bool, error)
+	// UsersGetUsers handles method users.getUsers#d91a548.
+	UsersGetUsers(ctx context.Context, id []InputUserClass) ([]UserClass, error)
+	// UsersGetFullUser handles method users.getFullUser#b60f5918.
+	UsersGetFullUser(ctx context.Context, id InputUserClass) (*UsersUserFull, error)
+	// UsersSetSecureValueErrors handles method users.setSecureValueErrors#90c894b5.
+	UsersSetSecureValueErrors(ctx context.Context, request *UsersSetSecureValueErrorsRequest) (bool, error)
+	// UsersGetRequirementsToContact handles method users.getRequirementsToContact#d89a83a3.
+	UsersGetRequirementsToContact(ctx context.Context, id []InputUserClass) ([]RequirementToContactClass, error)
+	// ContactsGetContactIDs handles method contacts.getContactIDs#7adc669d.
+	ContactsGetContactIDs(ctx context.Context, hash int64) ([]int, error)
+	// ContactsGetStatuses handles method contacts.getStatuses#c4a353ee.
+	ContactsGetStatuses(ctx context.Context) ([]ContactStatus, error)
+	// ContactsGetContacts handles method contacts.getContacts#5dd69e12.
+	ContactsGetContacts(ctx context.Context, hash int64) (ContactsContactsClass, error)
+	// ContactsImportContacts handles method contacts.importContacts#2c800be5.
+	ContactsImportContacts(ctx context.Context, contacts []InputPhoneContact) (*ContactsImportedContacts, error)
+	// ContactsDeleteContacts handles method contacts.deleteContacts#96a0e00.
+	ContactsDeleteContacts(ctx context.Context, id []InputUserClass) (UpdatesClass, error)
+	// ContactsDeleteByPhones handles method contacts.deleteByPhones#1013fd9e.
+	ContactsDeleteByPhones(ctx context.Context, phones []string) (bool, error)
+	// ContactsBlock handles method contacts.block#2e2e8734.
+	ContactsBlock(ctx context.Context, request *ContactsBlockRequest) (bool, error)
+	// ContactsUnblock handles method contacts.unblock#b550d328.
+	ContactsUnblock(ctx context.Context, request *ContactsUnblockRequest) (bool, error)
+	// ContactsGetBlocked handles method contacts.getBlocked#9a868f80.
+	ContactsGetBlocked(ctx context.Context, request *ContactsGetBlockedRequest) (ContactsBlockedClass, error)
+	// ContactsSearch handles method contacts.search#11f812d8.
+	ContactsSearch(ctx context.Context, request *ContactsSearchRequest) (*ContactsFound, error)
+	// ContactsResolveUsername handles method contacts.resolveUsername#725afbbc.
+	ContactsResolveUsername(ctx context.Context, request *ContactsResolveUsernameRequest) (*ContactsResolvedPeer, error)
+	// ContactsGetTopPeers handles method contacts.getTopPeers#973478b6.
+	ContactsGetTopPeers(ctx context.Context, request *ContactsGetTopPeersRequest) (ContactsTopPeersClass, error)
+	// ContactsResetTopPeerRating handles method contacts.resetTopPeerRating#1ae373ac.
+	ContactsResetTopPeerRating(ctx context.Context, request *ContactsResetTopPeerRatingRequest) (bool, error)
+	// ContactsResetSaved handles method contacts.resetSaved#879537f1.
+	ContactsResetSaved(ctx context.Context) (bool, error)
+	// ContactsGetSaved handles method contacts.getSaved#82f1e39f.
+	ContactsGetSaved(ctx context.Context) ([]SavedPhoneContact, error)
+	// ContactsToggleTopPeers handles method contacts.toggleTopPeers#8514bdda.
+	ContactsToggleTopPeers(ctx context.Context, enabled bool) (bool, error)
+	// ContactsAddContact handles method contacts.addContact#e8f463d0.
+	ContactsAddContact(ctx context.Context, request *ContactsAddContactRequest) (UpdatesClass, error)
+	// ContactsAcceptContact handles method contacts.acceptContact#f831a20f.
+	ContactsAcceptContact(ctx context.Context, id InputUserClass) (UpdatesClass, error)
+	// ContactsGetLocated handles method contacts.getLocated#d348bc44.
+	ContactsGetLocated(ctx context.Context, request *ContactsGetLocatedRequest) (UpdatesClass, error)
+	// ContactsBlockFromReplies handles method contacts.blockFromReplies#29a8962c.
+	ContactsBlockFromReplies(ctx context.Context, request *ContactsBlockFromRepliesRequest) (UpdatesClass, error)
+	// ContactsResolvePhone handles method contacts.resolvePhone#8af94344.
+	ContactsResolvePhone(ctx context.Context, phone string) (*ContactsResolvedPeer, error)
+	// ContactsExportContactToken handles method contacts.exportContactToken#f8654027.
+	ContactsExportContactToken(ctx context.Context) (*ExportedContactToken, error)
+	// ContactsImportContactToken handles method contacts.importContactToken#13005788.
+	ContactsImportContactToken(ctx context.Context, token string) (UserClass, error)
+	// ContactsEditCloseFriends handles method contacts.editCloseFriends#ba6705f0.
+	ContactsEditCloseFriends(ctx context.Context, id []int64) (bool, error)
+	// ContactsSetBlocked handles method contacts.setBlocked#94c65c76.
+	ContactsSetBlocked(ctx context.Context, request *ContactsSetBlockedRequest) (bool, error)
+	// ContactsGetBirthdays handles method contacts.getBirthdays#daeda864.
+	ContactsGetBirthdays(ctx context.Context) (*ContactsContactBirthdays, error)
+	// ContactsGetSponsoredPeers handles method contacts.getSponsoredPeers#b6c8c393.
+	ContactsGetSponsoredPeers(ctx context.Context, q string) (ContactsSponsoredPeersClass, error)
+	// MessagesGetMessages handles method messages.getMessages#63c66506.
+	MessagesGetMessages(ctx context.Context, id []InputMessageClass) (MessagesMessagesClass, error)
+	// MessagesGetDialogs handles method messages.getDialogs#a0f4cb4f.
+	MessagesGetDialogs(ctx context.Context, request *MessagesGetDialogsRequest) (MessagesDialogsClass, error)
+	// MessagesGetHistory handles method messages.getHistory#4423e6c5.
+	MessagesGetHistory(ctx context.Context, request *MessagesGetHistoryRequest) (MessagesMessagesClass, error)
+	// MessagesSearch handles method messages.search#29ee847a.
+	MessagesSearch(ctx context.Context, request *MessagesSearchRequest) (MessagesMessagesClass, error)
+	// MessagesReadHistory handles method messages.readHistory#e306d3a.
+	MessagesReadHistory(ctx context.Context, request *MessagesReadHistoryRequest) (*MessagesAffectedMessages, error)
+	// MessagesDeleteHistory handles method messages.deleteHistory#b08f922a.
+	MessagesDeleteHistory(ctx context.Context, request *MessagesDeleteHistoryRequest) (*MessagesAffectedHistory, error)
+	// MessagesDeleteMessages handles method messages.deleteMessages#e58e95d2.
+	MessagesDeleteMessages(ctx context.Context, request *MessagesDeleteMessagesRequest) (*MessagesAffectedMessages, error)
+	// MessagesReceivedMessages handles method messages.receivedMessages#5a954c0.
+	MessagesReceivedMessages(ctx context.Context, maxid int) ([]ReceivedNotifyMessage, error)
+	// MessagesSetTyping handles method messages.setTyping#58943ee2.
+	MessagesSetTyping(ctx context.Context, request *MessagesSetTypingRequest) (bool, error)
+	// MessagesSendMessage handles method messages.sendMessage#fe05dc9a.
+	MessagesSendMessage(ctx context.Context, request *MessagesSendMessageRequest) (UpdatesClass, error)
+	// MessagesSendMedia handles method messages.sendMedia#ac55d9c1.
+	MessagesSendMedia(ctx context.Context, request *MessagesSendMediaRequest) (UpdatesClass, error)
+	// MessagesForwardMessages handles method messages.forwardMessages#978928ca.
+	MessagesForwardMessages(ctx context.Context, request *MessagesForwardMessagesRequest) (UpdatesClass, error)
+	// MessagesReportSpam handles method messages.reportSpam#cf1592db.
+	MessagesReportSpam(ctx context.Context, peer InputPeerClass) (bool, error)
+	// MessagesGetPeerSettings handles method messages.getPeerSettings#efd9a6a2.
+	MessagesGetPeerSettings(ctx context.Context, peer InputPeerClass) (*MessagesPeerSettings, error)
+	// MessagesReport handles method messages.report#fc78af9b.
+	MessagesReport(ctx context.Context, request *MessagesReportRequest) (ReportResultClass, error)
+	// MessagesGetChats handles method messages.getChats#49e9528f.
+	MessagesGetChats(ctx context.Context, id []int64) (MessagesChatsClass, error)
+	// MessagesGetFullChat handles method messages.getFullChat#aeb00b34.
+	MessagesGetFullChat(ctx context.Context, chatid int64) (*MessagesChatFull, error)
+	// MessagesEditChatTitle handles method messages.editChatTitle#73783ffd.
+	MessagesEditChatTitle(ctx context.Context, request *MessagesEditChatTitleRequest) (UpdatesClass, error)
+	// MessagesEditChatPhoto handles method messages.editChatPhoto#35ddd674.
+	MessagesEditChatPhoto(ctx context.Context, request *MessagesEditChatPhotoRequest) (UpdatesClass, error)
+	// MessagesAddChatUser handles method messages.addChatUser#cbc6d107.
+	MessagesAddChatUser(ctx context.Context, request *MessagesAddChatUserRequest) (*MessagesInvitedUsers, error)
+	// MessagesDeleteChatUser handles method messages.deleteChatUser#a2185cab.
+	MessagesDeleteChatUser(ctx context.Context, request *MessagesDeleteChatUserRequest) (UpdatesClass, error)
+	// MessagesCreateChat handles method messages.createChat#92ceddd4.
+	MessagesCreateChat(ctx context.Context, request *MessagesCreateChatRequest) (*MessagesInvitedUsers, error)
+	// MessagesGetDhConfig handles method messages.getDhConfig#26cf8950.
+	MessagesGetDhConfig(ctx context.Context, request *MessagesGetDhConfigRequest) (MessagesDhConfigClass, error)
+	// MessagesRequestEncryption handles method messages.requestEncryption#f64daf43.
+	MessagesRequestEncryption(ctx context.Context, request *MessagesRequestEncryptionRequest) (EncryptedChatClass, error)
+	// MessagesAcceptEncryption handles method messages.acceptEncryption#3dbc0415.
+	MessagesAcceptEncryption(ctx context.Context, request *MessagesAcceptEncryptionRequest) (EncryptedChatClass, error)
+	// MessagesDiscardEncryption handles method messages.discardEncryption#f393aea0.
+	MessagesDiscardEncryption(ctx context.Context, request *MessagesDiscardEncryptionRequest) (bool, error)
+	// MessagesSetEncryptedTyping handles method messages.setEncryptedTyping#791451ed.
+	MessagesSetEncryptedTyping(ctx context.Context, request *MessagesSetEncryptedTypingRequest) (bool, error)
+	// MessagesReadEncryptedHistory handles method messages.readEncryptedHistory#7f4b690a.
+	MessagesReadEncryptedHistory(ctx context.Context, request *MessagesReadEncryptedHistoryRequest) (bool, error)
+	// MessagesSendEncrypted handles method messages.sendEncrypted#44fa7a15.
+	MessagesSendEncrypted(ctx context.Context, request *MessagesSendEncryptedRequest) (MessagesSentEncryptedMessageClass, error)
+	// MessagesSendEncryptedFile handles method messages.sendEncryptedFile#5559481d.
+	MessagesSendEncryptedFile(ctx context.Context, request *MessagesSendEncryptedFileRequest) (MessagesSentEncryptedMessageClass, error)
+	// MessagesSendEncryptedService handles method messages.sendEncryptedService#32d439a4.
+	MessagesSendEncryptedService(ctx context.Context, request *MessagesSendEncryptedServiceRequest) (MessagesSentEncryptedMessageClass, error)
+	// MessagesReceivedQueue handles method messages.receivedQueue#55a5bb66.
+	MessagesReceivedQueue(ctx context.Context, maxqts int) ([]int64, error)
+	// MessagesReportEncryptedSpam handles method messages.reportEncryptedSpam#4b0c8c0f.
+	MessagesReportEncryptedSpam(ctx context.Context, peer InputEncryptedChat) (bool, error)
+	// MessagesReadMessageContents handles method messages.readMessageContents#36a73f77.
+	MessagesReadMessageContents(ctx context.Context, id []int) (*MessagesAffectedMessages, error)
+	// MessagesGetStickers handles method messages.getStickers#d5a5d3a1.
+	MessagesGetStickers(ctx context.Context, request *MessagesGetStickersRequest) (MessagesStickersClass, error)
+	// MessagesGetAllStickers handles method messages.getAllStickers#b8a0a1a8.
+	MessagesGetAllStickers(ctx context.Context, hash int64) (MessagesAllStickersClass, error)
+	// MessagesGetWebPagePreview handles method messages.getWebPagePreview#570d6f6f.
+	MessagesGetWebPagePreview(ctx context.Context, request *MessagesGetWebPagePreviewRequest) (*MessagesWebPagePreview, error)
+	// MessagesExportChatInvite handles method messages.exportChatInvite#a455de90.
+	MessagesExportChatInvite(ctx context.Context, request *MessagesExportChatInviteRequest) (ExportedChatInviteClass, error)
+	// MessagesCheckChatInvite handles method messages.checkChatInvite#3eadb1bb.
+	MessagesCheckChatInvite(ctx context.Context, hash string) (ChatInviteClass, error)
+	// MessagesImportChatInvite handles method messages.importChatInvite#6c50051c.
+	MessagesImportChatInvite(ctx context.Context, hash string) (UpdatesClass, error)
+	// MessagesGetStickerSet handles method messages.getStickerSet#c8a0ec74.
+	MessagesGetStickerSet(ctx context.Context, request *MessagesGetStickerSetRequest) (MessagesStickerSetClass, error)
+	// MessagesInstallStickerSet handles method messages.installStickerSet#c78fe460.
+	MessagesInstallStickerSet(ctx context.Context, request *MessagesInstallStickerSetRequest) (MessagesStickerSetInstallResultClass, error)
+	// MessagesUninstallStickerSet handles method messages.uninstallStickerSet#f96e55de.
+	MessagesUninstallStickerSet(ctx context.Context, stickerset InputStickerSetClass) (bool, error)
+	// MessagesStartBot handles method messages.startBot#e6df7378.
+	MessagesStartBot(ctx context.Context, request *MessagesStartBotRequest) (UpdatesClass, error)
+	// MessagesGetMessagesViews handles method messages.getMessagesViews#5784d3e1.
+	MessagesGetMessagesViews(ctx context.Context, request *MessagesGetMessagesViewsRequest) (*MessagesMessageViews, error)
+	// MessagesEditChatAdmin handles method messages.editChatAdmin#a85bd1c2.
+	MessagesEditChatAdmin(ctx context.Context, request *MessagesEditChatAdminRequest) (bool, error)
+	// MessagesMigrateChat handles method messages.migrateChat#a2875319.
+	MessagesMigrateChat(ctx context.Context, chatid int64) (UpdatesClass, error)
+	// MessagesSearchGlobal handles method messages.searchGlobal#4bc6589a.
+	MessagesSearchGlobal(ctx context.Context, request *MessagesSearchGlobalRequest) (MessagesMessagesClass, error)
+	// MessagesReorderStickerSets handles method messages.reorderStickerSets#78337739.
+	MessagesReorderStickerSets(ctx context.Context, request *MessagesReorderStickerSetsRequest) (bool, error)
+	// MessagesGetDocumentByHash handles method messages.getDocumentByHash#b1f2061f.
+	MessagesGetDocumentByHash(ctx context.Context, request *MessagesGetDocumentByHashRequest) (DocumentClass, error)
+	// MessagesGetSavedGifs handles method messages.getSavedGifs#5cf09635.
+	MessagesGetSavedGifs(ctx context.Context, hash int64) (MessagesSavedGifsClass, error)
+	// MessagesSaveGif handles method messages.saveGif#327a30cb.
+	MessagesSaveGif(ctx context.Context, request *MessagesSaveGifRequest) (bool, error)
+	// MessagesGetInlineBotResults handles method messages.getInlineBotResults#514e999d.
+	MessagesGetInlineBotResults(ctx context.Context, request *MessagesGetInlineBotResultsRequest) (*MessagesBotResults, error)
+	// MessagesSetInlineBotResults handles method messages.setInlineBotResults#bb12a419.
+	MessagesSetInlineBotResults(ctx context.Context, request *MessagesSetInlineBotResultsRequest) (bool, error)
+	// MessagesSendInlineBotResult handles method messages.sendInlineBotResult#c0cf7646.
+	MessagesSendInlineBotResult(ctx context.Context, request *MessagesSendInlineBotResultRequest) (UpdatesClass, error)
+	// MessagesGetMessageEditData handles method messages.getMessageEditData#fda68d36.
+	MessagesGetMessageEditData(ctx context.Context, request *MessagesGetMessageEditDataRequest) (*MessagesMessageEditData, error)
+	// MessagesEditMessage handles method messages.editMessage#dfd14005.
+	MessagesEditMessage(ctx context.Context, request *MessagesEditMessageRequest) (UpdatesClass, error)
+	// MessagesEditInlineBotMessage handles method messages.editInlineBotMessage#83557dba.
+	MessagesEditInlineBotMessage(ctx context.Context, request *MessagesEditInlineBotMessageRequest) (bool, error)
+	// MessagesGetBotCallbackAnswer handles method messages.getBotCallbackAnswer#9342ca07.
+	MessagesGetBotCallbackAnswer(ctx context.Context, request *MessagesGetBotCallbackAnswerRequest) (*MessagesBotCallbackAnswer, error)
+	// MessagesSetBotCallbackAnswer handles method messages.setBotCallbackAnswer#d58f130a.
+	MessagesSetBotCallbackAnswer(ctx context.Context, request *MessagesSetBotCallbackAnswerRequest) (bool, error)
+	// MessagesGetPeerDialogs handles method messages.getPeerDialogs#e470bcfd.
+	MessagesGetPeerDialogs(ctx context.Context, peers []InputDialogPeerClass) (*MessagesPeerDialogs, error)
+	// MessagesSaveDraft handles method messages.saveDraft#54ae308e.
+	MessagesSaveDraft(ctx context.Context, request *MessagesSaveDraftRequest) (bool, error)
+	// MessagesGetAllDrafts handles method messages.getAllDrafts#6a3f8d65.
+	MessagesGetAllDrafts(ctx context.Context) (UpdatesClass, error)
+	// MessagesGetFeaturedStickers handles method messages.getFeaturedStickers#64780b14.
+	MessagesGetFeaturedStickers(ctx context.Context, hash int64) (MessagesFeaturedStickersClass, error)
+	// MessagesReadFeaturedStickers handles method messages.readFeaturedStickers#5b118126.
+	MessagesReadFeaturedStickers(ctx context.Context, id []int64) (bool, error)
+	// MessagesGetRecentStickers handles method messages.getRecentStickers#9da9403b.
+	MessagesGetRecentStickers(ctx context.Context, request *MessagesGetRecentStickersRequest) (MessagesRecentStickersClass, error)
+	// MessagesSaveRecentSticker handles method messages.saveRecentSticker#392718f8.
+	MessagesSaveRecentSticker(ctx context.Context, request *MessagesSaveRecentStickerRequest) (bool, error)
+	// MessagesClearRecentStickers handles method messages.clearRecentStickers#8999602d.
+	MessagesClearRecentStickers(ctx context.Context, request *MessagesClearRecentStickersRequest) (bool, error)
+	// MessagesGetArchivedStickers handles method messages.getArchivedStickers#57f17692.
+	MessagesGetArchivedStickers(ctx context.Context, request *MessagesGetArchivedStickersRequest) (*MessagesArchivedStickers, error)
+	// MessagesGetMaskStickers handles method messages.getMaskStickers#640f82b8.
+	MessagesGetMaskStickers(ctx context.Context, hash int64) (MessagesAllStickersClass, error)
+	// MessagesGetAttachedStickers handles method messages.getAttachedStickers#cc5b67cc.
+	MessagesGetAttachedStickers(ctx context.Context, media InputStickeredMediaClass) ([]StickerSetCoveredClass, error)
+	// MessagesSetGameScore handles method messages.setGameScore#8ef8ecc0.
+	MessagesSetGameScore(ctx context.Context, request *MessagesSetGameScoreRequest) (UpdatesClass, error)
+	// MessagesSetInlineGameScore handles method messages.setInlineGameScore#15ad9f64.
+	MessagesSetInlineGameScore(ctx context.Context, request *MessagesSetInlineGameScoreRequest) (bool, error)
+	// MessagesGetGameHighScores handles method messages.getGameHighScores#e822649d.
+	MessagesGetGameHighScores(ctx context.Context, request *MessagesGetGameHighScoresRequest) (*MessagesHighScores, error)
+	// MessagesGetInlineGameHighScores handles method messages.getInlineGameHighScores#f635e1b.
+	MessagesGetInlineGameHighScores(ctx context.Context, request *MessagesGetInlineGameHighScoresRequest) (*MessagesHighScores, error)
+	// MessagesGetCommonChats handles method messages.getCommonChats#e40ca104.
+	MessagesGetCommonChats(ctx context.Context, request *MessagesGetCommonChatsRequest) (MessagesChatsClass, error)
+	// MessagesGetWebPage handles method messages.getWebPage#8d9692a3.
+	MessagesGetWebPage(ctx context.Context, request *MessagesGetWebPageRequest) (*MessagesWebPage, error)
+	// MessagesToggleDialogPin handles method messages.toggleDialogPin#a731e257.
+	MessagesToggleDialogPin(ctx context.Context, request *MessagesToggleDialogPinRequest) (bool, error)
+	// MessagesReorderPinnedDialogs handles method messages.reorderPinnedDialogs#3b1adf37.
+	MessagesReorderPinnedDialogs(ctx context.Context, request *MessagesReorderPinnedDialogsRequest) (bool, error)
+	// MessagesGetPinnedDialogs handles method messages.getPinnedDialogs#d6b94df2.
+	MessagesGetPinnedDialogs(ctx context.Context, folderid int) (*MessagesPeerDialogs, error)
+	// MessagesSetBotShippingResults handles method messages.setBotShippingResults#e5f672fa.
+	MessagesSetBotShippingResults(ctx context.Context, request *MessagesSetBotShippingResultsRequest) (bool, error)
+	// MessagesSetBotPrecheckoutResults handles method messages.setBotPrecheckoutResults#9c2dd95.
+	MessagesSetBotPrecheckoutResults(ctx context.Context, request *MessagesSetBotPrecheckoutResultsRequest) (bool, error)
+	// MessagesUploadMedia handles method messages.uploadMedia#14967978.
+	MessagesUploadMedia(ctx context.Context, request *MessagesUploadMediaRequest) (MessageMediaClass, error)
+	// MessagesSendScreenshotNotification handles method messages.sendScreenshotNotification#a1405817.
+	MessagesSendScreenshotNotification(ctx context.Context, request *MessagesSendScreenshotNotificationRequest) (UpdatesClass, error)
+	// MessagesGetFavedStickers handles method messages.getFavedStickers#4f1aaa9.
+	MessagesGetFavedStickers(ctx context.Context, hash int64) (MessagesFavedStickersClass, error)
+	// MessagesFaveSticker handles method messages.faveSticker#b9ffc55b.
+	MessagesFaveSticker(ctx context.Context, request *MessagesFaveStickerRequest) (bool, error)
+	// MessagesGetUnreadMentions handles method messages.getUnreadMentions#f107e790.
+	MessagesGetUnreadMentions(ctx context.Context, request *MessagesGetUnreadMentionsRequest) (MessagesMessagesClass, error)
+	// MessagesReadMentions handles method messages.readMentions#36e5bf4d.
+	MessagesReadMentions(ctx context.Context, request *MessagesReadMentionsRequest) (*MessagesAffectedHistory, error)
+	// MessagesGetRecentLocations handles method messages.getRecentLocations#702a40e0.
+	MessagesGetRecentLocations(ctx context.Context, request *MessagesGetRecentLocationsRequest) (MessagesMessagesClass, error)
+	// MessagesSendMultiMedia handles method messages.sendMultiMedia#1bf89d74.
+	MessagesSendMultiMedia(ctx context.Context, request *MessagesSendMultiMediaRequest) (UpdatesClass, error)
+	// MessagesUploadEncryptedFile handles method messages.uploadEncryptedFile#5057c497.
+	MessagesUploadEncryptedFile(ctx context.Context, request *MessagesUploadEncryptedFileRequest) (EncryptedFileClass, error)
+	// MessagesSearchStickerSets handles method messages.searchStickerSets#35705b8a.
+	MessagesSearchStickerSets(ctx context.Context, request *MessagesSearchStickerSetsRequest) (MessagesFoundStickerSetsClass, error)
+	// MessagesGetSplitRanges handles method messages.getSplitRanges#1cff7e08.
+	MessagesGetSplitRanges(ctx context.Context) ([]MessageRange, error)
+	// MessagesMarkDialogUnread handles method messages.markDialogUnread#8c5006f8.
+	MessagesMarkDialogUnread(ctx context.Context, request *MessagesMarkDialogUnreadRequest) (bool, error)
+	// MessagesGetDialogUnreadMarks handles method messages.getDialogUnreadMarks#21202222.
+	MessagesGetDialogUnreadMarks(ctx context.Context, request *MessagesGetDialogUnreadMarksRequest) ([]DialogPeerClass, error)
+	// MessagesClearAllDrafts handles method messages.clearAllDrafts#7e58ee9c.
+	MessagesClearAllDrafts(ctx context.Context) (bool, error)
+	// MessagesUpdatePinnedMessage handles method messages.updatePinnedMessage#d2aaf7ec.
+	MessagesUpdatePinnedMessage(ctx context.Context, request *MessagesUpdatePinnedMessageRequest) (UpdatesClass, error)
+	// MessagesSendVote handles method messages.sendVote#10ea6184.
+	MessagesSendVote(ctx context.Context, request *MessagesSendVoteRequest) (UpdatesClass, error)
+	// MessagesGetPollResults handles method messages.getPollResults#73bb643b.
+	MessagesGetPollResults(ctx context.Context, request *MessagesGetPollResultsRequest) (UpdatesClass, error)
+	// MessagesGetOnlines handles method messages.getOnlines#6e2be050.
+	MessagesGetOnlines(ctx context.Context, peer InputPeerClass) (*ChatOnlines, error)
+	// MessagesEditChatAbout handles method messages.editChatAbout#def60797.
+	MessagesEditChatAbout(ctx context.Context, request *MessagesEditChatAboutRequest) (bool, error)
+	// MessagesEditChatDefaultBannedRights handles method messages.editChatDefaultBannedRights#a5866b41.
+	MessagesEditChatDefaultBannedRights(ctx context.Context, request *MessagesEditChatDefaultBannedRightsRequest) (UpdatesClass, error)
+	// MessagesGetEmojiKeywords handles method messages.getEmojiKeywords#35a0e062.
+	MessagesGetEmojiKeywords(ctx context.Context, langcode string) (*EmojiKeywordsDifference, error)
+	// MessagesGetEmojiKeywordsDifference handles method messages.getEmojiKeywordsDifference#1508b6af.
+	MessagesGetEmojiKeywordsDifference(ctx context.Context, request *MessagesGetEmojiKeywordsDifferenceRequest) (*EmojiKeywordsDifference, error)
+	// MessagesGetEmojiKeywordsLanguages handles method messages.getEmojiKeywordsLanguages#4e9963b2.
+	MessagesGetEmojiKeywordsLanguages(ctx context.Context, langcodes []string) ([]EmojiLanguage, error)
+	// MessagesGetEmojiURL handles method messages.getEmojiURL#d5b10c26.
+	MessagesGetEmojiURL(ctx context.Context, langcode string) (*EmojiURL, error)
+	// MessagesGetSearchCounters handles method messages.getSearchCounters#1bbcf300.
+	MessagesGetSearchCounters(ctx context.Context, request *MessagesGetSearchCountersRequest) ([]MessagesSearchCounter, error)
+	// MessagesRequestURLAuth handles method messages.requestUrlAuth#198fb446.
+	MessagesRequestURLAuth(ctx context.Context, request *MessagesRequestURLAuthRequest) (URLAuthResultClass, error)
+	// MessagesAcceptURLAuth handles method messages.acceptUrlAuth#b12c7125.
+	MessagesAcceptURLAuth(ctx context.Context, request *MessagesAcceptURLAuthRequest) (URLAuthResultClass, error)
+	// MessagesHidePeerSettingsBar handles method messages.hidePeerSettingsBar#4facb138.
+	MessagesHidePeerSettingsBar(ctx context.Context, peer InputPeerClass) (bool, error)
+	// MessagesGetScheduledHistory handles method messages.getScheduledHistory#f516760b.
+	MessagesGetScheduledHistory(ctx context.Context, request *MessagesGetScheduledHistoryRequest) (MessagesMessagesClass, error)
+	// MessagesGetScheduledMessages handles method messages.getScheduledMessages#bdbb0464.
+	MessagesGetScheduledMessages(ctx context.Context, request *MessagesGetScheduledMessagesRequest) (MessagesMessagesClass, error)
+	// MessagesSendScheduledMessages handles method messages.sendScheduledMessages#bd38850a.
+	MessagesSendScheduledMessages(ctx context.Context, request *MessagesSendScheduledMessagesRequest) (UpdatesClass, error)
+	// MessagesDeleteScheduledMessages handles method messages.deleteScheduledMessages#59ae2b16.
+	MessagesDeleteScheduledMessages(ctx context.Context, request *MessagesDeleteScheduledMessagesRequest) (UpdatesClass, error)
+	// MessagesGetPollVotes handles method messages.getPollVotes#b86e380e.
+	MessagesGetPollVotes(ctx context.Context, request *MessagesGetPollVotesRequest) (*MessagesVotesList, error)
+	// MessagesToggleStickerSets handles method messages.toggleStickerSets#b5052fea.
+	MessagesToggleStickerSets(ctx context.Context, request *MessagesToggleStickerSetsRequest) (bool, error)
+	// MessagesGetDialogFilters handles method messages.getDialogFilters#efd48c89.
+	MessagesGetDialogFilters(ctx context.Context) (*MessagesDialogFilters, error)
+	// MessagesGetSuggestedDialogFilters handles method messages.getSuggestedDialogFilters#a29cd42c.
+	MessagesGetSuggestedDialogFilters(ctx context.Context) ([]DialogFilterSuggested, error)
+	// MessagesUpdateDialogFilter handles method messages.updateDialogFilter#1ad4a04a.
+	MessagesUpdateDialogFilter(ctx context.Context, request *MessagesUpdateDialogFilterRequest) (bool, error)
+	// MessagesUpdateDialogFiltersOrder handles method messages.updateDialogFiltersOrder#c563c1e4.
+	MessagesUpdateDialogFiltersOrder(ctx context.Context, order []int) (bool, error)
+	// MessagesGetOldFeaturedStickers handles method messages.getOldFeaturedStickers#7ed094a1.
+	MessagesGetOldFeaturedStickers(ctx context.Context, request *MessagesGetOldFeaturedStickersRequest) (MessagesFeaturedStickersClass, error)
+	// MessagesGetReplies handles method messages.getReplies#22ddd30c.
+	MessagesGetReplies(ctx context.Context, request *MessagesGetRepliesRequest) (MessagesMessagesClass, error)
+	// MessagesGetDiscussionMessage handles method messages.getDiscussionMessage#446972fd.
+	MessagesGetDiscussionMessage(ctx context.Context, request *MessagesGetDiscussionMessageRequest) (*MessagesDiscussionMessage, error)
+	// MessagesReadDiscussion handles method messages.readDiscussion#f731a9f4.
+	MessagesReadDiscussion(ctx context.Context, request *MessagesReadDiscussionRequest) (bool, error)
+	// MessagesUnpinAllMessages handles method messages.unpinAllMessages#62dd747.
+	MessagesUnpinAllMessages(ctx context.Context, request *MessagesUnpinAllMessagesRequest) (*MessagesAffectedHistory, error)
+	// MessagesDeleteChat handles method messages.deleteChat#5bd0ee50.
+	MessagesDeleteChat(ctx context.Context, chatid int64) (bool, error)
+	// MessagesDeletePhoneCallHistory handles method messages.deletePhoneCallHistory#f9cbe409.
+	MessagesDeletePhoneCallHistory(ctx context.Context, request *MessagesDeletePhoneCallHistoryRequest) (*MessagesAffectedFoundMessages, error)
+	// MessagesCheckHistoryImport handles method messages.checkHistoryImport#43fe19f3.
+	MessagesCheckHistoryImport(ctx context.Context, importhead string) (*MessagesHistoryImportParsed, error)
+	// MessagesInitHistoryImport handles method messages.initHistoryImport#34090c3b.
+	MessagesInitHistoryImport(ctx context.Context, request *MessagesInitHistoryImportRequest) (*MessagesHistoryImport, error)
+	// MessagesUploadImportedMedia handles method messages.uploadImportedMedia#2a862092.
+	MessagesUploadImportedMedia(ctx context.Context, request *MessagesUploadImportedMediaRequest) (MessageMediaClass, error)
+	// MessagesStartHistoryImport handles method messages.startHistoryImport#b43df344.
+	MessagesStartHistoryImport(ctx context.Context, request *MessagesStartHistoryImportRequest) (bool, error)
+	// MessagesGetExportedChatInvites handles method messages.getExportedChatInvites#a2b5a3f6.
+	MessagesGetExportedChatInvites(ctx context.Context, request *MessagesGetExportedChatInvitesRequest) (*MessagesExportedChatInvites, error)
+	// MessagesGetExportedChatInvite handles method messages.getExportedChatInvite#73746f5c.
+	MessagesGetExportedChatInvite(ctx context.Context, request *MessagesGetExportedChatInviteRequest) (MessagesExportedChatInviteClass, error)
+	// MessagesEditExportedChatInvite handles method messages.editExportedChatInvite#bdca2f75.
+	MessagesEditExportedChatInvite(ctx context.Context, request *MessagesEditExportedChatInviteRequest) (MessagesExportedChatInviteClass, error)
+	// MessagesDeleteRevokedExportedChatInvites handles method messages.deleteRevokedExportedChatInvites#56987bd5.
+	MessagesDeleteRevokedExportedChatInvites(ctx context.Context, request *MessagesDeleteRevokedExportedChatInvitesRequest) (bool, error)
+	// MessagesDeleteExportedChatInvite handles method messages.deleteExportedChatInvite#d464a42b.
+	MessagesDeleteExportedChatInvite(ctx context.Context, request *MessagesDeleteExportedChatInviteRequest) (bool, error)
+	// MessagesGetAdminsWithInvites handles method messages.getAdminsWithInvites#3920e6ef.
+	MessagesGetAdminsWithInvites(ctx context.Context, peer InputPeerClass) (*MessagesChatAdminsWithInvites, error)
+	// MessagesGetChatInviteImporters handles method messages.getChatInviteImporters#df04dd4e.
+	MessagesGetChatInviteImporters(ctx context.Context, request *MessagesGetChatInviteImportersRequest) (*MessagesChatInviteImporters, error)
+	// MessagesSetHistoryTTL handles method messages.setHistoryTTL#b80e5fe4.
+	MessagesSetHistoryTTL(ctx context.Context, request *MessagesSetHistoryTTLRequest) (UpdatesClass, error)
+	// MessagesCheckHistoryImportPeer handles method messages.checkHistoryImportPeer#5dc60f03.
+	MessagesCheckHistoryImportPeer(ctx context.Context, peer InputPeerClass) (*MessagesCheckedHistoryImportPeer, error)
+	// MessagesSetChatTheme handles method messages.setChatTheme#e63be13f.
+	MessagesSetChatTheme(ctx context.Context, request *MessagesSetChatThemeRequest) (UpdatesClass, error)
+	// MessagesGetMessageReadParticipants handles method messages.getMessageReadParticipants#31c1c44f.
+	MessagesGetMessageReadParticipants(ctx context.Context, request *MessagesGetMessageReadParticipantsRequest) ([]ReadParticipantDate, error)
+	// MessagesGetSearchResultsCalendar handles method messages.getSearchResultsCalendar#6aa3f6bd.
+	MessagesGetSearchResultsCalendar(ctx context.Context, request *MessagesGetSearchResultsCalendarRequest) (*MessagesSearchResultsCalendar, error)
+	// MessagesGetSearchResultsPositions handles method messages.getSearchResultsPositions#9c7f2f10.
+	MessagesGetSearchResultsPositions(ctx context.Context, request *MessagesGetSearchResultsPositionsRequest) (*MessagesSearchResultsPositions, error)
+	// MessagesHideChatJoinRequest handles method messages.hideChatJoinRequest#7fe7e815.
+	MessagesHideChatJoinRequest(ctx context.Context, request *MessagesHideChatJoinRequestRequest) (UpdatesClass, error)
+	// MessagesHideAllChatJoinRequests handles method messages.hideAllChatJoinRequests#e085f4ea.
+	MessagesHideAllChatJoinRequests(ctx context.Context, request *MessagesHideAllChatJoinRequestsRequest) (UpdatesClass, error)
+	// MessagesToggleNoForwards handles method messages.toggleNoForwards#b11eafa2.
+	MessagesToggleNoForwards(ctx context.Context, request *MessagesToggleNoForwardsRequest) (UpdatesClass, error)
+	// MessagesSaveDefaultSendAs handles method messages.saveDefaultSendAs#ccfddf96.
+	MessagesSaveDefaultSendAs(ctx context.Context, request *MessagesSaveDefaultSendAsRequest) (bool, error)
+	// MessagesSendReaction handles method messages.sendReaction#d30d78d4.
+	MessagesSendReaction(ctx context.Context, request *MessagesSendReactionRequest) (UpdatesClass, error)
+	// MessagesGetMessagesReactions handles method messages.getMessagesReactions#8bba90e6.
+	MessagesGetMessagesReactions(ctx context.Context, request *MessagesGetMessagesReactionsRequest) (UpdatesClass, error)
+	// MessagesGetMessageReactionsList handles method messages.getMessageReactionsList#461b3f48.
+	MessagesGetMessageReactionsList(ctx context.Context, request *MessagesGetMessageReactionsListRequest) (*MessagesMessageReactionsList, error)
+	// MessagesSetChatAvailableReactions handles method messages.setChatAvailableReactions#864b2581.
+	MessagesSetChatAvailableReactions(ctx context.Context, request *MessagesSetChatAvailableReactionsRequest) (UpdatesClass, error)
+	// MessagesGetAvailableReactions handles method messages.getAvailableReactions#18dea0ac.
+	MessagesGetAvailableReactions(ctx context.Context, hash int) (MessagesAvailableReactionsClass, error)
+	// MessagesSetDefaultReaction handles method messages.setDefaultReaction#4f47a016.
+	MessagesSetDefaultReaction(ctx context.Context, reaction ReactionClass) (bool, error)
+	// MessagesTranslateText handles method messages.translateText#63183030.
+	MessagesTranslateText(ctx context.Context, request *MessagesTranslateTextRequest) (*MessagesTranslateResult, error)
+	// MessagesGetUnreadReactions handles method messages.getUnreadReactions#bd7f90ac.
+	MessagesGetUnreadReactions(ctx context.Context, request *MessagesGetUnreadReactionsRequest) (MessagesMessagesClass, error)
+	// MessagesReadReactions handles method messages.readReactions#9ec44f93.
+	MessagesReadReactions(ctx context.Context, request *MessagesReadReactionsRequest) (*MessagesAffectedHistory, error)
+	// MessagesSearchSentMedia handles method messages.searchSentMedia#107e31a0.
+	MessagesSearchSentMedia(ctx context.Context, request *MessagesSearchSentMediaRequest) (MessagesMessagesClass, error)
+	// MessagesGetAttachMenuBots handles method messages.getAttachMenuBots#16fcc2cb.
+	MessagesGetAttachMenuBots(ctx context.Context, hash int64) (AttachMenuBotsClass, error)
+	// MessagesGetAttachMenuBot handles method messages.getAttachMenuBot#77216192.
+	MessagesGetAttachMenuBot(ctx context.Context, bot InputUserClass) (*AttachMenuBotsBot, error)
+	// MessagesToggleBotInAttachMenu handles method messages.toggleBotInAttachMenu#69f59d69.
+	MessagesToggleBotInAttachMenu(ctx context.Context, request *MessagesToggleBotInAttachMenuRequest) (bool, error)
+	// MessagesRequestWebView handles method messages.requestWebView#269dc2c1.
+	MessagesRequestWebView(ctx context.Context, request *MessagesRequestWebViewRequest) (*WebViewResultURL, error)
+	// MessagesProlongWebView handles method messages.prolongWebView#b0d81a83.
+	MessagesProlongWebView(ctx context.Context, request *MessagesProlongWebViewRequest) (bool, error)
+	// MessagesRequestSimpleWebView handles method messages.requestSimpleWebView#413a3e73.
+	MessagesRequestSimpleWebView(ctx context.Context, request *MessagesRequestSimpleWebViewRequest) (*WebViewResultURL, error)
+	// MessagesSendWebViewResultMessage handles method messages.sendWebViewResultMessage#a4314f5.
+	MessagesSendWebViewResultMessage(ctx context.Context, request *MessagesSendWebViewResultMessageRequest) (*WebViewMessageSent, error)
+	// MessagesSendWebViewData handles method messages.sendWebViewData#dc0242c8.
+	MessagesSendWebViewData(ctx context.Context, request *MessagesSendWebViewDataRequest) (UpdatesClass, error)
+	// MessagesTranscribeAudio handles method messages.transcribeAudio#269e9a49.
+	MessagesTranscribeAudio(ctx context.Context, request *MessagesTranscribeAudioRequest) (*MessagesTranscribedAudio, error)
+	// MessagesRateTranscribedAudio handles method messages.rateTranscribedAudio#7f1d072f.
+	MessagesRateTranscribedAudio(ctx context.Context, request *MessagesRateTranscribedAudioRequest) (bool, error)
+	// MessagesGetCustomEmojiDocuments handles method messages.getCustomEmojiDocuments#d9ab0f54.
+	MessagesGetCustomEmojiDocuments(ctx context.Context, documentid []int64) ([]DocumentClass, error)
+	// MessagesGetEmojiStickers handles method messages.getEmojiStickers#fbfca18f.
+	MessagesGetEmojiStickers(ctx context.Context, hash int64) (MessagesAllStickersClass, error)
+	// MessagesGetFeaturedEmojiStickers handles method messages.getFeaturedEmojiStickers#ecf6736.
+	MessagesGetFeaturedEmojiStickers(ctx context.Context, hash int64) (MessagesFeaturedStickersClass, error)
+	// MessagesReportReaction handles method messages.reportReaction#3f64c076.
+	MessagesReportReaction(ctx context.Context, request *MessagesReportReactionRequest) (bool, error)
+	// MessagesGetTopReactions handles method messages.getTopReactions#bb8125ba.
+	MessagesGetTopReactions(ctx context.Context, request *MessagesGetTopReactionsRequest) (MessagesReactionsClass, error)
+	// MessagesGetRecentReactions handles method messages.getRecentReactions#39461db2.
+	MessagesGetRecentReactions(ctx context.Context, request *MessagesGetRecentReactionsRequest) (MessagesReactionsClass, error)
+	// MessagesClearRecentReactions handles method messages.clearRecentReactions#9dfeefb4.
+	MessagesClearRecentReactions(ctx context.Context) (bool, error)
+	// MessagesGetExtendedMedia handles method messages.getExtendedMedia#84f80814.
+	MessagesGetExtendedMedia(ctx context.Context, request *MessagesGetExtendedMediaRequest) (UpdatesClass, error)
+	// MessagesSetDefaultHistoryTTL handles method messages.setDefaultHistoryTTL#9eb51445.
+	MessagesSetDefaultHistoryTTL(ctx context.Context, period int) (bool, error)
+	// MessagesGetDefaultHistoryTTL handles method messages.getDefaultHistoryTTL#658b7188.
+	MessagesGetDefaultHistoryTTL(ctx context.Context) (*DefaultHistoryTTL, error)
+	// MessagesSendBotRequestedPeer handles method messages.sendBotRequestedPeer#91b2d060.
+	MessagesSendBotRequestedPeer(ctx context.Context, request *MessagesSendBotRequestedPeerRequest) (UpdatesClass, error)
+	// MessagesGetEmojiGroups handles method messages.getEmojiGroups#7488ce5b.
+	MessagesGetEmojiGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	// MessagesGetEmojiStatusGroups handles method messages.getEmojiStatusGroups#2ecd56cd.
+	MessagesGetEmojiStatusGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	// MessagesGetEmojiProfilePhotoGroups handles method messages.getEmojiProfilePhotoGroups#21a548f3.
+	MessagesGetEmojiProfilePhotoGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	// MessagesSearchCustomEmoji handles method messages.searchCustomEmoji#2c11c0d7.
+	MessagesSearchCustomEmoji(ctx context.Context, request *MessagesSearchCustomEmojiRequest) (EmojiListClass, error)
+	// MessagesTogglePeerTranslations handles method messages.togglePeerTranslations#e47cb579.
+	MessagesTogglePeerTranslations(ctx context.Context, request *MessagesTogglePeerTranslationsRequest) (bool, error)
+	// MessagesGetBotApp handles method messages.getBotApp#34fdc5c3.
+	MessagesGetBotApp(ctx context.Context, request *MessagesGetBotAppRequest) (*MessagesBotApp, error)
+	// MessagesRequestAppWebView handles method messages.requestAppWebView#53618bce.
+	MessagesRequestAppWebView(ctx context.Context, request *MessagesRequestAppWebViewRequest) (*WebViewResultURL, error)
+	// MessagesSetChatWallPaper handles method messages.setChatWallPaper#8ffacae1.
+	MessagesSetChatWallPaper(ctx context.Context, request *MessagesSetChatWallPaperRequest) (UpdatesClass, error)
+	// MessagesSearchEmojiStickerSets handles method messages.searchEmojiStickerSets#92b4494c.
+	MessagesSearchEmojiStickerSets(ctx context.Context, request *MessagesSearchEmojiStickerSetsRequest) (MessagesFoundStickerSetsClass, error)
+	// MessagesGetSavedDialogs handles method messages.getSavedDialogs#1e91fc99.
+	MessagesGetSavedDialogs(ctx context.Context, request *MessagesGetSavedDialogsRequest) (MessagesSavedDialogsClass, error)
+	// MessagesGetSavedHistory handles method messages.getSavedHistory#998ab009.
+	MessagesGetSavedHistory(ctx context.Context, request *MessagesGetSavedHistoryRequest) (MessagesMessagesClass, error)
+	// MessagesDeleteSavedHistory handles method messages.deleteSavedHistory#4dc5085f.
+	MessagesDeleteSavedHistory(ctx context.Context, request *MessagesDeleteSavedHistoryRequest) (*MessagesAffectedHistory, error)
+	// MessagesGetPinnedSavedDialogs handles method messages.getPinnedSavedDialogs#d63d94e0.
+	MessagesGetPinnedSavedDialogs(ctx context.Context) (MessagesSavedDialogsClass, error)
+	// MessagesToggleSavedDialogPin handles method messages.toggleSavedDialogPin#ac81bbde.
+	MessagesToggleSavedDialogPin(ctx context.Context, request *MessagesToggleSavedDialogPinRequest) (bool, error)
+	// MessagesReorderPinnedSavedDialogs handles method messages.reorderPinnedSavedDialogs#8b716587.
+	MessagesReorderPinnedSavedDialogs(ctx context.Context, request *MessagesReorderPinnedSavedDialogsRequest) (bool, error)
+	// MessagesGetSavedReactionTags handles method messages.getSavedReactionTags#3637e05b.
+	MessagesGetSavedReactionTags(ctx context.Context, request *MessagesGetSavedReactionTagsRequest) (MessagesSavedReactionTagsClass, error)
+	// MessagesUpdateSavedReactionTag handles method messages.updateSavedReactionTag#60297dec.
+	MessagesUpdateSavedReactionTag(ctx context.Context, request *MessagesUpdateSavedReactionTagRequest) (bool, error)
+	// MessagesGetDefaultTagReactions handles method messages.getDefaultTagReactions#bdf93428.
+	MessagesGetDefaultTagReactions(ctx context.Context, hash int64) (MessagesReactionsClass, error)
+	// MessagesGetOutboxReadDate handles method messages.getOutboxReadDate#8c4bfe5d.
+	MessagesGetOutboxReadDate(ctx context.Context, request *MessagesGetOutboxReadDateRequest) (*OutboxReadDate, error)
+	// MessagesGetQuickReplies handles method messages.getQuickReplies#d483f2a8.
+	MessagesGetQuickReplies(ctx context.Context, hash int64) (MessagesQuickRepliesClass, error)
+	// MessagesReorderQuickReplies handles method messages.reorderQuickReplies#60331907.
+	MessagesReorderQuickReplies(ctx context.Context, order []int) (bool, error)
+	// MessagesCheckQuickReplyShortcut handles method messages.checkQuickReplyShortcut#f1d0fbd3.
+	MessagesCheckQuickReplyShortcut(ctx context.Context, shortcut string) (bool, error)
+	// MessagesEditQuickReplyShortcut handles method messages.editQuickReplyShortcut#5c003cef.
+	MessagesEditQuickReplyShortcut(ctx context.Context, request *MessagesEditQuickReplyShortcutRequest) (bool, error)
+	// MessagesDeleteQuickReplyShortcut handles method messages.deleteQuickReplyShortcut#3cc04740.
+	MessagesDeleteQuickReplyShortcut(ctx context.Context, shortcutid int) (bool, error)
+	// MessagesGetQuickReplyMessages handles method messages.getQuickReplyMessages#94a495c3.
+	MessagesGetQuickReplyMessages(ctx context.Context, request *MessagesGetQuickReplyMessagesRequest) (MessagesMessagesClass, error)
+	// MessagesSendQuickReplyMessages handles method messages.sendQuickReplyMessages#6c750de1.
+	MessagesSendQuickReplyMessages(ctx context.Context, request *MessagesSendQuickReplyMessagesRequest) (UpdatesClass, error)
+	// MessagesDeleteQuickReplyMessages handles method messages.deleteQuickReplyMessages#e105e910.
+	MessagesDeleteQuickReplyMessages(ctx context.Context, request *MessagesDeleteQuickReplyMessagesRequest) (UpdatesClass, error)
+	// MessagesToggleDialogFilterTags handles method messages.toggleDialogFilterTags#fd2dda49.
+	MessagesToggleDialogFilterTags(ctx context.Context, enabled bool) (bool, error)
+	// MessagesGetMyStickers handles method messages.getMyStickers#d0b5e1fc.
+	MessagesGetMyStickers(ctx context.Context, request *MessagesGetMyStickersRequest) (*MessagesMyStickers, error)
+	// MessagesGetEmojiStickerGroups handles method messages.getEmojiStickerGroups#1dd840f5.
+	MessagesGetEmojiStickerGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	// MessagesGetAvailableEffects handles method messages.getAvailableEffects#dea20a39.
+	MessagesGetAvailableEffects(ctx context.Context, hash int) (MessagesAvailableEffectsClass, error)
+	// MessagesEditFactCheck handles method messages.editFactCheck#589ee75.
+	MessagesEditFactCheck(ctx context.Context, request *MessagesEditFactCheckRequest) (UpdatesClass, error)
+	// MessagesDeleteFactCheck handles method messages.deleteFactCheck#d1da940c.
+	MessagesDeleteFactCheck(ctx context.Context, request *MessagesDeleteFactCheckRequest) (UpdatesClass, error)
+	// MessagesGetFactCheck handles method messages.getFactCheck#b9cdc5ee.
+	MessagesGetFactCheck(ctx context.Context, request *MessagesGetFactCheckRequest) ([]FactCheck, error)
+	// MessagesRequestMainWebView handles method messages.requestMainWebView#c9e01e7b.
+	MessagesRequestMainWebView(ctx context.Context, request *MessagesRequestMainWebViewRequest) (*WebViewResultURL, error)
+	// MessagesSendPaidReaction handles method messages.sendPaidReaction#58bbcb50.
+	MessagesSendPaidReaction(ctx context.Context, request *MessagesSendPaidReactionRequest) (UpdatesClass, error)
+	// MessagesTogglePaidReactionPrivacy handles method messages.togglePaidReactionPrivacy#435885b5.
+	MessagesTogglePaidReactionPrivacy(ctx context.Context, request *MessagesTogglePaidReactionPrivacyRequest) (bool, error)
+	// MessagesGetPaidReactionPrivacy handles method messages.getPaidReactionPrivacy#472455aa.
+	MessagesGetPaidReactionPrivacy(ctx context.Context) (UpdatesClass, error)
+	// MessagesViewSponsoredMessage handles method messages.viewSponsoredMessage#269e3643.
+	MessagesViewSponsoredMessage(ctx context.Context, randomid []byte) (bool, error)
+	// MessagesClickSponsoredMessage handles method messages.clickSponsoredMessage#8235057e.
+	MessagesClickSponsoredMessage(ctx context.Context, request *MessagesClickSponsoredMessageRequest) (bool, error)
+	// MessagesReportSponsoredMessage handles method messages.reportSponsoredMessage#12cbf0c4.
+	MessagesReportSponsoredMessage(ctx context.Context, request *MessagesReportSponsoredMessageRequest) (ChannelsSponsoredMessageReportResultClass, error)
+	// MessagesGetSponsoredMessages handles method messages.getSponsoredMessages#3d6ce850.
+	MessagesGetSponsoredMessages(ctx context.Context, request *MessagesGetSponsoredMessagesRequest) (MessagesSponsoredMessagesClass, error)
+	// MessagesSavePreparedInlineMessage handles method messages.savePreparedInlineMessage#f21f7f2f.
+	MessagesSavePreparedInlineMessage(ctx context.Context, request *MessagesSavePreparedInlineMessageRequest) (*MessagesBotPreparedInlineMessage, error)
+	// MessagesGetPreparedInlineMessage handles method messages.getPreparedInlineMessage#857ebdb8.
+	MessagesGetPreparedInlineMessage(ctx context.Context, request *MessagesGetPreparedInlineMessageRequest) (*MessagesPreparedInlineMessage, error)
+	// MessagesSearchStickers handles method messages.searchStickers#29b1c66a.
+	MessagesSearchStickers(ctx context.Context, request *MessagesSearchStickersRequest) (MessagesFoundStickersClass, error)
+	// MessagesReportMessagesDelivery handles method messages.reportMessagesDelivery#5a6d7395.
+	MessagesReportMessagesDelivery(ctx context.Context, request *MessagesReportMessagesDeliveryRequest) (bool, error)
+	// MessagesGetSavedDialogsByID handles method messages.getSavedDialogsByID#6f6f9c96.
+	MessagesGetSavedDialogsByID(ctx context.Context, request *MessagesGetSavedDialogsByIDRequest) (MessagesSavedDialogsClass, error)
+	// MessagesReadSavedHistory handles method messages.readSavedHistory#ba4a3b5b.
+	MessagesReadSavedHistory(ctx context.Context, request *MessagesReadSavedHistoryRequest) (bool, error)
+	// MessagesToggleTodoCompleted handles method messages.toggleTodoCompleted#d3e03124.
+	MessagesToggleTodoCompleted(ctx context.Context, request *MessagesToggleTodoCompletedRequest) (UpdatesClass, error)
+	// MessagesAppendTodoList handles method messages.appendTodoList#21a61057.
+	MessagesAppendTodoList(ctx context.Context, request *MessagesAppendTodoListRequest) (UpdatesClass, error)
+	// MessagesToggleSuggestedPostApproval handles method messages.toggleSuggestedPostApproval#8107455c.
+	MessagesToggleSuggestedPostApproval(ctx context.Context, request *MessagesToggleSuggestedPostApprovalRequest) (UpdatesClass, error)
+	// UpdatesGetState handles method updates.getState#edd4882a.
+	UpdatesGetState(ctx context.Context) (*UpdatesState, error)
+	// UpdatesGetDifference handles method updates.getDifference#19c2f763.
+	UpdatesGetDifference(ctx context.Context, request *UpdatesGetDifferenceRequest) (UpdatesDifferenceClass, error)
+	// UpdatesGetChannelDifference handles method updates.getChannelDifference#3173d78.
+	UpdatesGetChannelDifference(ctx context.Context, request *UpdatesGetChannelDifferenceRequest) (UpdatesChannelDifferenceClass, error)
+	// PhotosUpdateProfilePhoto handles method photos.updateProfilePhoto#9e82039.
+	PhotosUpdateProfilePhoto(ctx context.Context, request *PhotosUpdateProfilePhotoRequest) (*PhotosPhoto, error)
+	// PhotosUploadProfilePhoto handles method photos.uploadProfilePhoto#388a3b5.
+	PhotosUploadProfilePhoto(ctx context.Context, request *PhotosUploadProfilePhotoRequest) (*PhotosPhoto, error)
+	// PhotosDeletePhotos handles method photos.deletePhotos#87cf7f2f.
+	PhotosDeletePhotos(ctx context.Context, id []InputPhotoClass) ([]int64, error)
+	// PhotosGetUserPhotos handles method photos.getUserPhotos#91cd32a8.
+	PhotosGetUserPhotos(ctx context.Context, request *PhotosGetUserPhotosRequest) (PhotosPhotosClass, error)
+	// PhotosUploadContactProfilePhoto handles method photos.uploadContactProfilePhoto#e14c4a71.
+	PhotosUploadContactProfilePhoto(ctx context.Context, request *PhotosUploadContactProfilePhotoRequest) (*PhotosPhoto, error)
+	// UploadSaveFilePart handles method upload.saveFilePart#b304a621.
+	UploadSaveFilePart(ctx context.Context, request *UploadSaveFilePartRequest) (bool, error)
+	// UploadGetFile handles method upload.getFile#be5335be.
+	UploadGetFile(ctx context.Context, request *UploadGetFileRequest) (UploadFileClass, error)
+	// UploadSaveBigFilePart handles method upload.saveBigFilePart#de7b673d.
+	UploadSaveBigFilePart(ctx context.Context, request *UploadSaveBigFilePartRequest) (bool, error)
+	// UploadGetWebFile handles method upload.getWebFile#24e6818d.
+	UploadGetWebFile(ctx context.Context, request *UploadGetWebFileRequest) (*UploadWebFile, error)
+	// UploadGetCDNFile handles method upload.getCdnFile#395f69da.
+	UploadGetCDNFile(ctx context.Context, request *UploadGetCDNFileRequest) (UploadCDNFileClass, error)
+	// UploadReuploadCDNFile handles method upload.reuploadCdnFile#9b2754a8.
+	UploadReuploadCDNFile(ctx context.Context, request *UploadReuploadCDNFileRequest) ([]FileHash, error)
+	// UploadGetCDNFileHashes handles method upload.getCdnFileHashes#91dc3f31.
+	UploadGetCDNFileHashes(ctx context.Context, request *UploadGetCDNFileHashesRequest) ([]FileHash, error)
+	// UploadGetFileHashes handles method upload.getFileHashes#9156982a.
+	UploadGetFileHashes(ctx context.Context, request *UploadGetFileHashesRequest) ([]FileHash, error)
+	// HelpGetConfig handles method help.getConfig#c4f9186b.
+	HelpGetConfig(ctx context.Context) (*Config, error)
+	// HelpGetNearestDC handles method help.getNearestDc#1fb33026.
+	HelpGetNearestDC(ctx context.Context) (*NearestDC, error)
+	// HelpGetAppUpdate handles method help.getAppUpdate#522d5a7d.
+	HelpGetAppUpdate(ctx context.Context, source string) (HelpAppUpdateClass, error)
+	// HelpGetInviteText handles method help.getInviteText#4d392343.
+	HelpGetInviteText(ctx context.Context) (*HelpInviteText, error)
+	// HelpGetSupport handles method help.getSupport#9cdf08cd.
+	HelpGetSupport(ctx context.Context) (*HelpSupport, error)
+	// HelpSetBotUpdatesStatus handles method help.setBotUpdatesStatus#ec22cfcd.
+	HelpSetBotUpdatesStatus(ctx context.Context, request *HelpSetBotUpdatesStatusRequest) (bool, error)
+	// HelpGetCDNConfig handles method help.getCdnConfig#52029342.
+	HelpGetCDNConfig(ctx context.Context) (*CDNConfig, error)
+	// HelpGetRecentMeURLs handles method help.getRecentMeUrls#3dc0f114.
+	HelpGetRecentMeURLs(ctx context.Context, referer string) (*HelpRecentMeURLs, error)
+	// HelpGetTermsOfServiceUpdate handles method help.getTermsOfServiceUpdate#2ca51fd1.
+	HelpGetTermsOfServiceUpdate(ctx context.Context) (HelpTermsOfServiceUpdateClass, error)
+	// HelpAcceptTermsOfService handles method help.acceptTermsOfService#ee72f79a.
+	HelpAcceptTermsOfService(ctx context.Context, id DataJSON) (bool, error)
+	// HelpGetDeepLinkInfo handles method help.getDeepLinkInfo#3fedc75f.
+	HelpGetDeepLinkInfo(ctx context.Context, path string) (HelpDeepLinkInfoClass, error)
+	// HelpGetAppConfig handles method help.getAppConfig#61e3f854.
+	HelpGetAppConfig(ctx context.Context, hash int) (HelpAppConfigClass, error)
+	// HelpSaveAppLog handles method help.saveAppLog#6f02f748.
+	HelpSaveAppLog(ctx context.Context, events []InputAppEvent) (bool, error)
+	// HelpGetPassportConfig handles method help.getPassportConfig#c661ad08.
+	HelpGetPassportConfig(ctx context.Context, hash int) (HelpPassportConfigClass, error)
+	// HelpGetSupportName handles method help.getSupportName#d360e72c.
+	HelpGetSupportName(ctx context.Context) (*HelpSupportName, error)
+	// HelpGetUserInfo handles method help.getUserInfo#38a08d3.
+	HelpGetUserInfo(ctx context.Context, userid InputUserClass) (HelpUserInfoClass, error)
+	// HelpEditUserInfo handles method help.editUserInfo#66b91b70.
+	HelpEditUserInfo(ctx context.Context, request *HelpEditUserInfoRequest) (HelpUserInfoClass, error)
+	// HelpGetPromoData handles method help.getPromoData#c0977421.
+	HelpGetPromoData(ctx context.Context) (HelpPromoDataClass, error)
+	// HelpHidePromoData handles method help.hidePromoData#1e251c95.
+	HelpHidePromoData(ctx context.Context, peer InputPeerClass) (bool, error)
+	// HelpDismissSuggestion handles method help.dismissSuggestion#f50dbaa1.
+	HelpDismissSuggestion(ctx context.Context, request *HelpDismissSuggestionRequest) (bool, error)
+	// HelpGetCountriesList handles method help.getCountriesList#735787a8.
+	HelpGetCountriesList(ctx context.Context, request *HelpGetCountriesListRequest) (HelpCountriesListClass, error)
+	// HelpGetPremiumPromo handles method help.getPremiumPromo#b81b93d4.
+	HelpGetPremiumPromo(ctx context.Context) (*HelpPremiumPromo, error)
+	// HelpGetPeerColors handles method help.getPeerColors#da80f42f.
+	HelpGetPeerColors(ctx context.Context, hash int) (HelpPeerColorsClass, error)
+	// HelpGetPeerProfileColors handles method help.getPeerProfileColors#abcfa9fd.
+	HelpGetPeerProfileColors(ctx context.Context, hash int) (HelpPeerColorsClass, error)
+	// HelpGetTimezonesList handles method help.getTimezonesList#49b30240.
+	HelpGetTimezonesList(ctx context.Context, hash int) (HelpTimezonesListClass, error)
+	// ChannelsReadHistory handles method channels.readHistory#cc104937.
+	ChannelsReadHistory(ctx context.Context, request *ChannelsReadHistoryRequest) (bool, error)
+	// ChannelsDeleteMessages handles method channels.deleteMessages#84c1fd4e.
+	ChannelsDeleteMessages(ctx context.Context, request *ChannelsDeleteMessagesRequest) (*MessagesAffectedMessages, error)
+	// ChannelsReportSpam handles method channels.reportSpam#f44a8315.
+	ChannelsReportSpam(ctx context.Context, request *ChannelsReportSpamRequest) (bool, error)
+	// ChannelsGetMessages handles method channels.getMessages#ad8c9a23.
+	ChannelsGetMessages(ctx context.Context, request *ChannelsGetMessagesRequest) (MessagesMessagesClass, error)
+	// ChannelsGetParticipants handles method channels.getParticipants#77ced9d0.
+	ChannelsGetParticipants(ctx context.Context, request *ChannelsGetParticipantsRequest) (ChannelsChannelParticipantsClass, error)
+	// ChannelsGetParticipant handles method channels.getParticipant#a0ab6cc6.
+	ChannelsGetParticipant(ctx context.Context, request *ChannelsGetParticipantRequest) (*ChannelsChannelParticipant, error)
+	// ChannelsGetChannels handles method channels.getChannels#a7f6bbb.
+	ChannelsGetChannels(ctx context.Context, id []InputChannelClass) (MessagesChatsClass, error)
+	// ChannelsGetFullChannel handles method channels.getFullChannel#8736a09.
+	ChannelsGetFullChannel(ctx context.Context, channel InputChannelClass) (*MessagesChatFull, error)
+	// ChannelsCreateChannel handles method channels.createChannel#91006707.
+	ChannelsCreateChannel(ctx context.Context, request *ChannelsCreateChannelRequest) (UpdatesClass, error)
+	// ChannelsEditAdmin handles method channels.editAdmin#d33c8902.
+	ChannelsEditAdmin(ctx context.Context, request *ChannelsEditAdminRequest) (UpdatesClass, error)
+	// ChannelsEditTitle handles method channels.editTitle#566decd0.
+	ChannelsEditTitle(ctx context.Context, request *ChannelsEditTitleRequest) (UpdatesClass, error)
+	// ChannelsEditPhoto handles method channels.editPhoto#f12e57c9.
+	ChannelsEditPhoto(ctx context.Context, request *ChannelsEditPhotoRequest) (UpdatesClass, error)
+	// ChannelsCheckUsername handles method channels.checkUsername#10e6bd2c.
+	ChannelsCheckUsername(ctx context.Context, request *ChannelsCheckUsernameRequest) (bool, error)
+	// ChannelsUpdateUsername handles method channels.updateUsername#3514b3de.
+	ChannelsUpdateUsername(ctx context.Context, request *ChannelsUpdateUsernameRequest) (bool, error)
+	// ChannelsJoinChannel handles method channels.joinChannel#24b524c5.
+	ChannelsJoinChannel(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	// ChannelsLeaveChannel handles method channels.leaveChannel#f836aa95.
+	ChannelsLeaveChannel(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	// ChannelsInviteToChannel handles method channels.inviteToChannel#c9e33d54.
+	ChannelsInviteToChannel(ctx context.Context, request *ChannelsInviteToChannelRequest) (*MessagesInvitedUsers, error)
+	// ChannelsDeleteChannel handles method channels.deleteChannel#c0111fe3.
+	ChannelsDeleteChannel(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	// ChannelsExportMessageLink handles method channels.exportMessageLink#e63fadeb.
+	ChannelsExportMessageLink(ctx context.Context, request *ChannelsExportMessageLinkRequest) (*ExportedMessageLink, error)
+	// ChannelsToggleSignatures handles method channels.toggleSignatures#418d549c.
+	ChannelsToggleSignatures(ctx context.Context, request *ChannelsToggleSignaturesRequest) (UpdatesClass, error)
+	// ChannelsGetAdminedPublicChannels handles method channels.getAdminedPublicChannels#f8b036af.
+	ChannelsGetAdminedPublicChannels(ctx context.Context, request *ChannelsGetAdminedPublicChannelsRequest) (MessagesChatsClass, error)
+	// ChannelsEditBanned handles method channels.editBanned#96e6cd81.
+	ChannelsEditBanned(ctx context.Context, request *ChannelsEditBannedRequest) (UpdatesClass, error)
+	// ChannelsGetAdminLog handles method channels.getAdminLog#33ddf480.
+	ChannelsGetAdminLog(ctx context.Context, request *ChannelsGetAdminLogRequest) (*ChannelsAdminLogResults, error)
+	// ChannelsSetStickers handles method channels.setStickers#ea8ca4f9.
+	ChannelsSetStickers(ctx context.Context, request *ChannelsSetStickersRequest) (bool, error)
+	// ChannelsReadMessageContents handles method channels.readMessageContents#eab5dc38.
+	ChannelsReadMessageContents(ctx context.Context, request *ChannelsReadMessageContentsRequest) (bool, error)
+	// ChannelsDeleteHistory handles method channels.deleteHistory#9baa9647.
+	ChannelsDeleteHistory(ctx context.Context, request *ChannelsDeleteHistoryRequest) (UpdatesClass, error)
+	// ChannelsTogglePreHistoryHidden handles method channels.togglePreHistoryHidden#eabbb94c.
+	ChannelsTogglePreHistoryHidden(ctx context.Context, request *ChannelsTogglePreHistoryHiddenRequest) (UpdatesClass, error)
+	// ChannelsGetLeftChannels handles method channels.getLeftChannels#8341ecc0.
+	ChannelsGetLeftChannels(ctx context.Context, offset int) (MessagesChatsClass, error)
+	// ChannelsGetGroupsForDiscussion handles method channels.getGroupsForDiscussion#f5dad378.
+	ChannelsGetGroupsForDiscussion(ctx context.Context) (MessagesChatsClass, error)
+	// ChannelsSetDiscussionGroup handles method channels.setDiscussionGroup#40582bb2.
+	ChannelsSetDiscussionGroup(ctx context.Context, request *ChannelsSetDiscussionGroupRequest) (bool, error)
+	// ChannelsEditCreator handles method channels.editCreator#8f38cd1f.
+	ChannelsEditCreator(ctx context.Context, request *ChannelsEditCreatorRequest) (UpdatesClass, error)
+	// ChannelsEditLocation handles method channels.editLocation#58e63f6d.
+	ChannelsEditLocation(ctx context.Context, request *ChannelsEditLocationRequest) (bool, error)
+	// ChannelsToggleSlowMode handles method channels.toggleSlowMode#edd49ef0.
+	ChannelsToggleSlowMode(ctx context.Context, request *ChannelsToggleSlowModeRequest) (UpdatesClass, error)
+	// ChannelsGetInactiveChannels handles method channels.getInactiveChannels#11e831ee.
+	ChannelsGetInactiveChannels(ctx context.Context) (*MessagesInactiveChats, error)
+	// ChannelsConvertToGigagroup handles method channels.convertToGigagroup#b290c69.
+	ChannelsConvertToGigagroup(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	// ChannelsGetSendAs handles method channels.getSendAs#e785a43f.
+	ChannelsGetSendAs(ctx context.Context, request *ChannelsGetSendAsRequest) (*ChannelsSendAsPeers, error)
+	// ChannelsDeleteParticipantHistory handles method channels.deleteParticipantHistory#367544db.
+	ChannelsDeleteParticipantHistory(ctx context.Context, request *ChannelsDeleteParticipantHistoryRequest) (*MessagesAffectedHistory, error)
+	// ChannelsToggleJoinToSend handles method channels.toggleJoinToSend#e4cb9580.
+	ChannelsToggleJoinToSend(ctx context.Context, request *ChannelsToggleJoinToSendRequest) (UpdatesClass, error)
+	// ChannelsToggleJoinRequest handles method channels.toggleJoinRequest#4c2985b6.
+	ChannelsToggleJoinRequest(ctx context.Context, request *ChannelsToggleJoinRequestRequest) (UpdatesClass, error)
+	// ChannelsReorderUsernames handles method channels.reorderUsernames#b45ced1d.
+	ChannelsReorderUsernames(ctx context.Context, request *ChannelsReorderUsernamesRequest) (bool, error)
+	// ChannelsToggleUsername handles method channels.toggleUsername#50f24105.
+	ChannelsToggleUsername(ctx context.Context, request *ChannelsToggleUsernameRequest) (bool, error)
+	// ChannelsDeactivateAllUsernames handles method channels.deactivateAllUsernames#a245dd3.
+	ChannelsDeactivateAllUsernames(ctx context.Context, channel InputChannelClass) (bool, error)
+	// ChannelsToggleForum handles method channels.toggleForum#3ff75734.
+	ChannelsToggleForum(ctx context.Context, request *ChannelsToggleForumRequest) (UpdatesClass, error)
+	// ChannelsCreateForumTopic handles method channels.createForumTopic#f40c0224.
+	ChannelsCreateForumTopic(ctx context.Context, request *ChannelsCreateForumTopicRequest) (UpdatesClass, error)
+	// ChannelsGetForumTopics handles method channels.getForumTopics#de560d1.
+	ChannelsGetForumTopics(ctx context.Context, request *ChannelsGetForumTopicsRequest) (*MessagesForumTopics, error)
+	// ChannelsGetForumTopicsByID handles method channels.getForumTopicsByID#b0831eb9.
+	ChannelsGetForumTopicsByID(ctx context.Context, request *ChannelsGetForumTopicsByIDRequest) (*MessagesForumTopics, error)
+	// ChannelsEditForumTopic handles method channels.editForumTopic#f4dfa185.
+	ChannelsEditForumTopic(ctx context.Context, request *ChannelsEditForumTopicRequest) (UpdatesClass, error)
+	// ChannelsUpdatePinnedForumTopic handles method channels.updatePinnedForumTopic#6c2d9026.
+	ChannelsUpdatePinnedForumTopic(ctx context.Context, request *ChannelsUpdatePinnedForumTopicRequest) (UpdatesClass, error)
+	// ChannelsDeleteTopicHistory handles method channels.deleteTopicHistory#34435f2d.
+	ChannelsDeleteTopicHistory(ctx context.Context, request *ChannelsDeleteTopicHistoryRequest) (*MessagesAffectedHistory, error)
+	// ChannelsReorderPinnedForumTopics handles method channels.reorderPinnedForumTopics#2950a18f.
+	ChannelsReorderPinnedForumTopics(ctx context.Context, request *ChannelsReorderPinnedForumTopicsRequest) (UpdatesClass, error)
+	// ChannelsToggleAntiSpam handles method channels.toggleAntiSpam#68f3e4eb.
+	ChannelsToggleAntiSpam(ctx context.Context, request *ChannelsToggleAntiSpamRequest) (UpdatesClass, error)
+	// ChannelsReportAntiSpamFalsePositive handles method channels.reportAntiSpamFalsePositive#a850a693.
+	ChannelsReportAntiSpamFalsePositive(ctx context.Context, request *ChannelsReportAntiSpamFalsePositiveRequest) (bool, error)
+	// ChannelsToggleParticipantsHidden handles method channels.toggleParticipantsHidden#6a6e7854.
+	ChannelsToggleParticipantsHidden(ctx context.Context, request *ChannelsToggleParticipantsHiddenRequest) (UpdatesClass, error)
+	// ChannelsUpdateColor handles method channels.updateColor#d8aa3671.
+	ChannelsUpdateColor(ctx context.Context, request *ChannelsUpdateColorRequest) (UpdatesClass, error)
+	// ChannelsToggleViewForumAsMessages handles method channels.toggleViewForumAsMessages#9738bb15.
+	ChannelsToggleViewForumAsMessages(ctx context.Context, request *ChannelsToggleViewForumAsMessagesRequest) (UpdatesClass, error)
+	// ChannelsGetChannelRecommendations handles method channels.getChannelRecommendations#25a71742.
+	ChannelsGetChannelRecommendations(ctx context.Context, request *ChannelsGetChannelRecommendationsRequest) (MessagesChatsClass, error)
+	// ChannelsUpdateEmojiStatus handles method channels.updateEmojiStatus#f0d3e6a8.
+	ChannelsUpdateEmojiStatus(ctx context.Context, request *ChannelsUpdateEmojiStatusRequest) (UpdatesClass, error)
+	// ChannelsSetBoostsToUnblockRestrictions handles method channels.setBoostsToUnblockRestrictions#ad399cee.
+	ChannelsSetBoostsToUnblockRestrictions(ctx context.Context, request *ChannelsSetBoostsToUnblockRestrictionsRequest) (UpdatesClass, error)
+	// ChannelsSetEmojiStickers handles method channels.setEmojiStickers#3cd930b7.
+	ChannelsSetEmojiStickers(ctx context.Context, request *ChannelsSetEmojiStickersRequest) (bool, error)
+	// ChannelsRestrictSponsoredMessages handles method channels.restrictSponsoredMessages#9ae91519.
+	ChannelsRestrictSponsoredMessages(ctx context.Context, request *ChannelsRestrictSponsoredMessagesRequest) (UpdatesClass, error)
+	// ChannelsSearchPosts handles method channels.searchPosts#f2c4f24d.
+	ChannelsSearchPosts(ctx context.Context, request *ChannelsSearchPostsRequest) (MessagesMessagesClass, error)
+	// ChannelsUpdatePaidMessagesPrice handles method channels.updatePaidMessagesPrice#4b12327b.
+	ChannelsUpdatePaidMessagesPrice(ctx context.Context, request *ChannelsUpdatePaidMessagesPriceRequest) (UpdatesClass, error)
+	// ChannelsToggleAutotranslation handles method channels.toggleAutotranslation#167fc0a1.
+	ChannelsToggleAutotranslation(ctx context.Context, request *ChannelsToggleAutotranslationRequest) (UpdatesClass, error)
+	// ChannelsGetMessageAuthor handles method channels.getMessageAuthor#ece2a0e6.
+	ChannelsGetMessageAuthor(ctx context.Context, request *ChannelsGetMessageAuthorRequest) (UserClass, error)
+	// ChannelsCheckSearchPostsFlood handles method channels.checkSearchPostsFlood#22567115.
+	ChannelsCheckSearchPostsFlood(ctx context.Context, request *ChannelsCheckSearchPostsFloodRequest) (*SearchPostsFlood, error)
+	// BotsSendCustomRequest handles method bots.sendCustomRequest#aa2769ed.
+	BotsSendCustomRequest(ctx context.Context, request *BotsSendCustomRequestRequest) (*DataJSON, error)
+	// BotsAnswerWebhookJSONQuery handles method bots.answerWebhookJSONQuery#e6213f4d.
+	BotsAnswerWebhookJSONQuery(ctx context.Context, request *BotsAnswerWebhookJSONQueryRequest) (bool, error)
+	// BotsSetBotCommands handles method bots.setBotCommands#517165a.
+	BotsSetBotCommands(ctx context.Context, request *BotsSetBotCommandsRequest) (bool, error)
+	// BotsResetBotCommands handles method bots.resetBotCommands#3d8de0f9.
+	BotsResetBotCommands(ctx context.Context, request *BotsResetBotCommandsRequest) (bool, error)
+	// BotsGetBotCommands handles method bots.getBotCommands#e34c0dd6.
+	BotsGetBotCommands(ctx context.Context, request *BotsGetBotCommandsRequest) ([]BotCommand, error)
+	// BotsSetBotMenuButton handles method bots.setBotMenuButton#4504d54f.
+	BotsSetBotMenuButton(ctx context.Context, request *BotsSetBotMenuButtonRequest) (bool, error)
+	// BotsGetBotMenuButton handles method bots.getBotMenuButton#9c60eb28.
+	BotsGetBotMenuButton(ctx context.Context, userid InputUserClass) (BotMenuButtonClass, error)
+	// BotsSetBotBroadcastDefaultAdminRights handles method bots.setBotBroadcastDefaultAdminRights#788464e1.
+	BotsSetBotBroadcastDefaultAdminRights(ctx context.Context, adminrights ChatAdminRights) (bool, error)
+	// BotsSetBotGroupDefaultAdminRights handles method bots.setBotGroupDefaultAdminRights#925ec9ea.
+	BotsSetBotGroupDefaultAdminRights(ctx context.Context, adminrights ChatAdminRights) (bool, error)
+	// BotsSetBotInfo handles method bots.setBotInfo#10cf3123.
+	BotsSetBotInfo(ctx context.Context, request *BotsSetBotInfoRequest) (bool, error)
+	// BotsGetBotInfo handles method bots.getBotInfo#dcd914fd.
+	BotsGetBotInfo(ctx context.Context, request *BotsGetBotInfoRequest) (*BotsBotInfo, error)
+	// BotsReorderUsernames handles method bots.reorderUsernames#9709b1c2.
+	BotsReorderUsernames(ctx context.Context, request *BotsReorderUsernamesRequest) (bool, error)
+	// BotsToggleUsername handles method bots.toggleUsername#53ca973.
+	BotsToggleUsername(ctx context.Context, request *BotsToggleUsernameRequest) (bool, error)
+	// BotsCanSendMessage handles method bots.canSendMessage#1359f4e6.
+	BotsCanSendMessage(ctx context.Context, bot InputUserClass) (bool, error)
+	// BotsAllowSendMessage handles method bots.allowSendMessage#f132e3ef.
+	BotsAllowSendMessage(ctx context.Context, bot InputUserClass) (UpdatesClass, error)
+	// BotsInvokeWebViewCustomMethod handles method bots.invokeWebViewCustomMethod#87fc5e7.
+	BotsInvokeWebViewCustomMethod(ctx context.Context, request *BotsInvokeWebViewCustomMethodRequest) (*DataJSON, error)
+	// BotsGetPopularAppBots handles method bots.getPopularAppBots#c2510192.
+	BotsGetPopularAppBots(ctx context.Context, request *BotsGetPopularAppBotsRequest) (*BotsPopularAppBots, error)
+	// BotsAddPreviewMedia handles method bots.addPreviewMedia#17aeb75a.
+	BotsAddPreviewMedia(ctx context.Context, request *BotsAddPreviewMediaRequest) (*BotPreviewMedia, error)
+	// BotsEditPreviewMedia handles method bots.editPreviewMedia#8525606f.
+	BotsEditPreviewMedia(ctx context.Context, request *BotsEditPreviewMediaRequest) (*BotPreviewMedia, error)
+	// BotsDeletePreviewMedia handles method bots.deletePreviewMedia#2d0135b3.
+	BotsDeletePreviewMedia(ctx context.Context, request *BotsDeletePreviewMediaRequest) (bool, error)
+	// BotsReorderPreviewMedias handles method bots.reorderPreviewMedias#b627f3aa.
+	BotsReorderPreviewMedias(ctx context.Context, request *BotsReorderPreviewMediasRequest) (bool, error)
+	// BotsGetPreviewInfo handles method bots.getPreviewInfo#423ab3ad.
+	BotsGetPreviewInfo(ctx context.Context, request *BotsGetPreviewInfoRequest) (*BotsPreviewInfo, error)
+	// BotsGetPreviewMedias handles method bots.getPreviewMedias#a2a5594d.
+	BotsGetPreviewMedias(ctx context.Context, bot InputUserClass) ([]BotPreviewMedia, error)
+	// BotsUpdateUserEmojiStatus handles method bots.updateUserEmojiStatus#ed9f30c5.
+	BotsUpdateUserEmojiStatus(ctx context.Context, request *BotsUpdateUserEmojiStatusRequest) (bool, error)
+	// BotsToggleUserEmojiStatusPermission handles method bots.toggleUserEmojiStatusPermission#6de6392.
+	BotsToggleUserEmojiStatusPermission(ctx context.Context, request *BotsToggleUserEmojiStatusPermissionRequest) (bool, error)
+	// BotsCheckDownloadFileParams handles method bots.checkDownloadFileParams#50077589.
+	BotsCheckDownloadFileParams(ctx context.Context, request *BotsCheckDownloadFileParamsRequest) (bool, error)
+	// BotsGetAdminedBots handles method bots.getAdminedBots#b0711d83.
+	BotsGetAdminedBots(ctx context.Context) ([]UserClass, error)
+	// BotsUpdateStarRefProgram handles method bots.updateStarRefProgram#778b5ab3.
+	BotsUpdateStarRefProgram(ctx context.Context, request *BotsUpdateStarRefProgramRequest) (*StarRefProgram, error)
+	// BotsSetCustomVerification handles method bots.setCustomVerification#8b89dfbd.
+	BotsSetCustomVerification(ctx context.Context, request *BotsSetCustomVerificationRequest) (bool, error)
+	// BotsGetBotRecommendations handles method bots.getBotRecommendations#a1b70815.
+	BotsGetBotRecommendations(ctx context.Context, bot InputUserClass) (UsersUsersClass, error)
+	// PaymentsGetPaymentForm handles method payments.getPaymentForm#37148dbb.
+	PaymentsGetPaymentForm(ctx context.Context, request *PaymentsGetPaymentFormRequest) (PaymentsPaymentFormClass, error)
+	// PaymentsGetPaymentReceipt handles method payments.getPaymentReceipt#2478d1cc.
+	PaymentsGetPaymentReceipt(ctx context.Context, request *PaymentsGetPaymentReceiptRequest) (PaymentsPaymentReceiptClass, error)
+	// PaymentsValidateRequestedInfo handles method payments.validateRequestedInfo#b6c8f12b.
+	PaymentsValidateRequestedInfo(ctx context.Context, request *PaymentsValidateRequestedInfoRequest) (*PaymentsValidatedRequestedInfo, error)
+	// PaymentsSendPaymentForm handles method payments.sendPaymentForm#2d03522f.
+	PaymentsSendPaymentForm(ctx context.Context, request *PaymentsSendPaymentFormRequest) (PaymentsPaymentResultClass, error)
+	// PaymentsGetSavedInfo handles method payments.getSavedInfo#227d824b.
+	PaymentsGetSavedInfo(ctx context.Context) (*PaymentsSavedInfo, error)
+	// PaymentsClearSavedInfo handles method payments.clearSavedInfo#d83d70c1.
+	PaymentsClearSavedInfo(ctx context.Context, request *PaymentsClearSavedInfoRequest) (bool, error)
+	// PaymentsGetBankCardData handles method payments.getBankCardData#2e79d779.
+	PaymentsGetBankCardData(ctx context.Context, number string) (*PaymentsBankCardData, error)
+	// PaymentsExportInvoice handles method payments.exportInvoice#f91b065.
+	PaymentsExportInvoice(ctx context.Context, invoicemedia InputMediaClass) (*PaymentsExportedInvoice, error)
+	// PaymentsAssignAppStoreTransaction handles method payments.assignAppStoreTransaction#80ed747d.
+	PaymentsAssignAppStoreTransaction(ctx context.Context, request *PaymentsAssignAppStoreTransactionRequest) (UpdatesClass, error)
+	// PaymentsAssignPlayMarketTransaction handles method payments.assignPlayMarketTransaction#dffd50d3.
+	PaymentsAssignPlayMarketTransaction(ctx context.Context, request *PaymentsAssignPlayMarketTransactionRequest) (UpdatesClass, error)
+	// PaymentsGetPremiumGiftCodeOptions handles method payments.getPremiumGiftCodeOptions#2757ba54.
+	PaymentsGetPremiumGiftCodeOptions(ctx context.Context, request *PaymentsGetPremiumGiftCodeOptionsRequest) ([]PremiumGiftCodeOption, error)
+	// PaymentsCheckGiftCode handles method payments.checkGiftCode#8e51b4c1.
+	PaymentsCheckGiftCode(ctx context.Context, slug string) (*PaymentsCheckedGiftCode, error)
+	// PaymentsApplyGiftCode handles method payments.applyGiftCode#f6e26854.
+	PaymentsApplyGiftCode(ctx context.Context, slug string) (UpdatesClass, error)
+	// PaymentsGetGiveawayInfo handles method payments.getGiveawayInfo#f4239425.
+	PaymentsGetGiveawayInfo(ctx context.Context, request *PaymentsGetGiveawayInfoRequest) (PaymentsGiveawayInfoClass, error)
+	// PaymentsLaunchPrepaidGiveaway handles method payments.launchPrepaidGiveaway#5ff58f20.
+	PaymentsLaunchPrepaidGiveaway(ctx context.Context, request *PaymentsLaunchPrepaidGiveawayRequest) (UpdatesClass, error)
+	// PaymentsGetStarsTopupOptions handles method payments.getStarsTopupOptions#c00ec7d3.
+	PaymentsGetStarsTopupOptions(ctx context.Context) ([]StarsTopupOption, error)
+	// PaymentsGetStarsStatus handles method payments.getStarsStatus#4ea9b3bf.
+	PaymentsGetStarsStatus(ctx context.Context, request *PaymentsGetStarsStatusRequest) (*PaymentsStarsStatus, error)
+	// PaymentsGetStarsTransactions handles method payments.getStarsTransactions#69da4557.
+	PaymentsGetStarsTransactions(ctx context.Context, request *PaymentsGetStarsTransactionsRequest) (*PaymentsStarsStatus, error)
+	// PaymentsSendStarsForm handles method payments.sendStarsForm#7998c914.
+	PaymentsSendStarsForm(ctx context.Context, request *PaymentsSendStarsFormRequest) (PaymentsPaymentResultClass, error)
+	// PaymentsRefundStarsCharge handles method payments.refundStarsCharge#25ae8f4a.
+	PaymentsRefundStarsCharge(ctx context.Context, request *PaymentsRefundStarsChargeRequest) (UpdatesClass, error)
+	// PaymentsGetStarsRevenueStats handles method payments.getStarsRevenueStats#d91ffad6.
+	PaymentsGetStarsRevenueStats(ctx context.Context, request *PaymentsGetStarsRevenueStatsRequest) (*PaymentsStarsRevenueStats, error)
+	// PaymentsGetStarsRevenueWithdrawalURL handles method payments.getStarsRevenueWithdrawalUrl#2433dc92.
+	PaymentsGetStarsRevenueWithdrawalURL(ctx context.Context, request *PaymentsGetStarsRevenueWithdrawalURLRequest) (*PaymentsStarsRevenueWithdrawalURL, error)
+	// PaymentsGetStarsRevenueAdsAccountURL handles method payments.getStarsRevenueAdsAccountUrl#d1d7efc5.
+	PaymentsGetStarsRevenueAdsAccountURL(ctx context.Context, peer InputPeerClass) (*PaymentsStarsRevenueAdsAccountURL, error)
+	// PaymentsGetStarsTransactionsByID handles method payments.getStarsTransactionsByID#2dca16b8.
+	PaymentsGetStarsTransactionsByID(ctx context.Context, request *PaymentsGetStarsTransactionsByIDRequest) (*PaymentsStarsStatus, error)
+	// PaymentsGetStarsGiftOptions handles method payments.getStarsGiftOptions#d3c96bc8.
+	PaymentsGetStarsGiftOptions(ctx context.Context, request *PaymentsGetStarsGiftOptionsRequest) ([]StarsGiftOption, error)
+	// PaymentsGetStarsSubscriptions handles method payments.getStarsSubscriptions#32512c5.
+	PaymentsGetStarsSubscriptions(ctx context.Context, request *PaymentsGetStarsSubscriptionsRequest) (*PaymentsStarsStatus, error)
+	// PaymentsChangeStarsSubscription handles method payments.changeStarsSubscription#c7770878.
+	PaymentsChangeStarsSubscription(ctx context.Context, request *PaymentsChangeStarsSubscriptionRequest) (bool, error)
+	// PaymentsFulfillStarsSubscription handles method payments.fulfillStarsSubscription#cc5bebb3.
+	PaymentsFulfillStarsSubscription(ctx context.Context, request *PaymentsFulfillStarsSubscriptionRequest) (bool, error)
+	// PaymentsGetStarsGiveawayOptions handles method payments.getStarsGiveawayOptions#bd1efd3e.
+	PaymentsGetStarsGiveawayOptions(ctx context.Context) ([]StarsGiveawayOption, error)
+	// PaymentsGetStarGifts handles method payments.getStarGifts#c4563590.
+	PaymentsGetStarGifts(ctx context.Context, hash int) (PaymentsStarGiftsClass, error)
+	// PaymentsSaveStarGift handles method payments.saveStarGift#2a2a697c.
+	PaymentsSaveStarGift(ctx context.Context, request *PaymentsSaveStarGiftRequest) (bool, error)
+	// PaymentsConvertStarGift handles method payments.convertStarGift#74bf076b.
+	PaymentsConvertStarGift(ctx context.Context, stargift InputSavedStarGiftClass) (bool, error)
+	// PaymentsBotCancelStarsSubscription handles method payments.botCancelStarsSubscription#6dfa0622.
+	PaymentsBotCancelStarsSubscription(ctx context.Context, request *PaymentsBotCancelStarsSubscriptionRequest) (bool, error)
+	// PaymentsGetConnectedStarRefBots handles method payments.getConnectedStarRefBots#5869a553.
+	PaymentsGetConnectedStarRefBots(ctx context.Context, request *PaymentsGetConnectedStarRefBotsRequest) (*PaymentsConnectedStarRefBots, error)
+	// PaymentsGetConnectedStarRefBot handles method payments.getConnectedStarRefBot#b7d998f0.
+	PaymentsGetConnectedStarRefBot(ctx context.Context, request *PaymentsGetConnectedStarRefBotRequest) (*PaymentsConnectedStarRefBots, error)
+	// PaymentsGetSuggestedStarRefBots handles method payments.getSuggestedStarRefBots#d6b48f7.
+	PaymentsGetSuggestedStarRefBots(ctx context.Context, request *PaymentsGetSuggestedStarRefBotsRequest) (*PaymentsSuggestedStarRefBots, error)
+	// PaymentsConnectStarRefBot handles method payments.connectStarRefBot#7ed5348a.
+	PaymentsConnectStarRefBot(ctx context.Context, request *PaymentsConnectStarRefBotRequest) (*PaymentsConnectedStarRefBots, error)
+	// PaymentsEditConnectedStarRefBot handles method payments.editConnectedStarRefBot#e4fca4a3.
+	PaymentsEditConnectedStarRefBot(ctx context.Context, request *PaymentsEditConnectedStarRefBotRequest) (*PaymentsConnectedStarRefBots, error)
+	// PaymentsGetStarGiftUpgradePreview handles method payments.getStarGiftUpgradePreview#9c9abcb1.
+	PaymentsGetStarGiftUpgradePreview(ctx context.Context, giftid int64) (*PaymentsStarGiftUpgradePreview, error)
+	// PaymentsUpgradeStarGift handles method payments.upgradeStarGift#aed6e4f5.
+	PaymentsUpgradeStarGift(ctx context.Context, request *PaymentsUpgradeStarGiftRequest) (UpdatesClass, error)
+	// PaymentsTransferStarGift handles method payments.transferStarGift#7f18176a.
+	PaymentsTransferStarGift(ctx context.Context, request *PaymentsTransferStarGiftRequest) (UpdatesClass, error)
+	// PaymentsGetUniqueStarGift handles method payments.getUniqueStarGift#a1974d72.
+	PaymentsGetUniqueStarGift(ctx context.Context, slug string) (*PaymentsUniqueStarGift, error)
+	// PaymentsGetSavedStarGifts handles method payments.getSavedStarGifts#a319e569.
+	PaymentsGetSavedStarGifts(ctx context.Context, request *PaymentsGetSavedStarGiftsRequest) (*PaymentsSavedStarGifts, error)
+	// PaymentsGetSavedStarGift handles method payments.getSavedStarGift#b455a106.
+	PaymentsGetSavedStarGift(ctx context.Context, stargift []InputSavedStarGiftClass) (*PaymentsSavedStarGifts, error)
+	// PaymentsGetStarGiftWithdrawalURL handles method payments.getStarGiftWithdrawalUrl#d06e93a8.
+	PaymentsGetStarGiftWithdrawalURL(ctx context.Context, request *PaymentsGetStarGiftWithdrawalURLRequest) (*PaymentsStarGiftWithdrawalURL, error)
+	// PaymentsToggleChatStarGiftNotifications handles method payments.toggleChatStarGiftNotifications#60eaefa1.
+	PaymentsToggleChatStarGiftNotifications(ctx context.Context, request *PaymentsToggleChatStarGiftNotificationsRequest) (bool, error)
+	// PaymentsToggleStarGiftsPinnedToTop handles method payments.toggleStarGiftsPinnedToTop#1513e7b0.
+	PaymentsToggleStarGiftsPinnedToTop(ctx context.Context, request *PaymentsToggleStarGiftsPinnedToTopRequest) (bool, error)
+	// PaymentsCanPurchaseStore handles method payments.canPurchaseStore#4fdc5ea7.
+	PaymentsCanPurchaseStore(ctx context.Context, purpose InputStorePaymentPurposeClass) (bool, error)
+	// PaymentsGetResaleStarGifts handles method payments.getResaleStarGifts#7a5fa236.
+	PaymentsGetResaleStarGifts(ctx context.Context, request *PaymentsGetResaleStarGiftsRequest) (*PaymentsResaleStarGifts, error)
+	// PaymentsUpdateStarGiftPrice handles method payments.updateStarGiftPrice#edbe6ccb.
+	PaymentsUpdateStarGiftPrice(ctx context.Context, request *PaymentsUpdateStarGiftPriceRequest) (UpdatesClass, error)
+	// PaymentsCreateStarGiftCollection handles method payments.createStarGiftCollection#1f4a0e87.
+	PaymentsCreateStarGiftCollection(ctx context.Context, request *PaymentsCreateStarGiftCollectionRequest) (*StarGiftCollection, error)
+	// PaymentsUpdateStarGiftCollection handles method payments.updateStarGiftCollection#4fddbee7.
+	PaymentsUpdateStarGiftCollection(ctx context.Context, request *PaymentsUpdateStarGiftCollectionRequest) (*StarGiftCollection, error)
+	// PaymentsReorderStarGiftCollections handles method payments.reorderStarGiftCollections#c32af4cc.
+	PaymentsReorderStarGiftCollections(ctx context.Context, request *PaymentsReorderStarGiftCollectionsRequest) (bool, error)
+	// PaymentsDeleteStarGiftCollection handles method payments.deleteStarGiftCollection#ad5648e8.
+	PaymentsDeleteStarGiftCollection(ctx context.Context, request *PaymentsDeleteStarGiftCollectionRequest) (bool, error)
+	// PaymentsGetStarGiftCollections handles method payments.getStarGiftCollections#981b91dd.
+	PaymentsGetStarGiftCollections(ctx context.Context, request *PaymentsGetStarGiftCollectionsRequest) (PaymentsStarGiftCollectionsClass, error)
+	// StickersCreateStickerSet handles method stickers.createStickerSet#9021ab67.
+	StickersCreateStickerSet(ctx context.Context, request *StickersCreateStickerSetRequest) (MessagesStickerSetClass, error)
+	// StickersRemoveStickerFromSet handles method stickers.removeStickerFromSet#f7760f51.
+	StickersRemoveStickerFromSet(ctx context.Context, sticker InputDocumentClass) (MessagesStickerSetClass, error)
+	// StickersChangeStickerPosition handles method stickers.changeStickerPosition#ffb6d4ca.
+	StickersChangeStickerPosition(ctx context.Context, request *StickersChangeStickerPositionRequest) (MessagesStickerSetClass, error)
+	// StickersAddStickerToSet handles method stickers.addStickerToSet#8653febe.
+	StickersAddStickerToSet(ctx context.Context, request *StickersAddStickerToSetRequest) (MessagesStickerSetClass, error)
+	// StickersSetStickerSetThumb handles method stickers.setStickerSetThumb#a76a5392.
+	StickersSetStickerSetThumb(ctx context.Context, request *StickersSetStickerSetThumbRequest) (MessagesStickerSetClass, error)
+	// StickersCheckShortName handles method stickers.checkShortName#284b3639.
+	StickersCheckShortName(ctx context.Context, shortname string) (bool, error)
+	// StickersSuggestShortName handles method stickers.suggestShortName#4dafc503.
+	StickersSuggestShortName(ctx context.Context, title string) (*StickersSuggestedShortName, error)
+	// StickersChangeSticker handles method stickers.changeSticker#f5537ebc.
+	StickersChangeSticker(ctx context.Context, request *StickersChangeStickerRequest) (MessagesStickerSetClass, error)
+	// StickersRenameStickerSet handles method stickers.renameStickerSet#124b1c00.
+	StickersRenameStickerSet(ctx context.Context, request *StickersRenameStickerSetRequest) (MessagesStickerSetClass, error)
+	// StickersDeleteStickerSet handles method stickers.deleteStickerSet#87704394.
+	StickersDeleteStickerSet(ctx context.Context, stickerset InputStickerSetClass) (bool, error)
+	// StickersReplaceSticker handles method stickers.replaceSticker#4696459a.
+	StickersReplaceSticker(ctx context.Context, request *StickersReplaceStickerRequest) (MessagesStickerSetClass, error)
+	// PhoneGetCallConfig handles method phone.getCallConfig#55451fa9.
+	PhoneGetCallConfig(ctx context.Context) (*DataJSON, error)
+	// PhoneRequestCall handles method phone.requestCall#42ff96ed.
+	PhoneRequestCall(ctx context.Context, request *PhoneRequestCallRequest) (*PhonePhoneCall, error)
+	// PhoneAcceptCall handles method phone.acceptCall#3bd2b4a0.
+	PhoneAcceptCall(ctx context.Context, request *PhoneAcceptCallRequest) (*PhonePhoneCall, error)
+	// PhoneConfirmCall handles method phone.confirmCall#2efe1722.
+	PhoneConfirmCall(ctx context.Context, request *PhoneConfirmCallRequest) (*PhonePhoneCall, error)
+	// PhoneReceivedCall handles method phone.receivedCall#17d54f61.
+	PhoneReceivedCall(ctx context.Context, peer InputPhoneCall) (bool, error)
+	// PhoneDiscardCall handles method phone.discardCall#b2cbc1c0.
+	PhoneDiscardCall(ctx context.Context, request *PhoneDiscardCallRequest) (UpdatesClass, error)
+	// PhoneSetCallRating handles method phone.setCallRating#59ead627.
+	PhoneSetCallRating(ctx context.Context, request *PhoneSetCallRatingRequest) (UpdatesClass, error)
+	// PhoneSaveCallDebug handles method phone.saveCallDebug#277add7e.
+	PhoneSaveCallDebug(ctx context.Context, request *PhoneSaveCallDebugRequest) (bool, error)
+	// PhoneSendSignalingData handles method phone.sendSignalingData#ff7a9383.
+	PhoneSendSignalingData(ctx context.Context, request *PhoneSendSignalingDataRequest) (bool, error)
+	// PhoneCreateGroupCall handles method phone.createGroupCall#48cdc6d8.
+	PhoneCreateGroupCall(ctx context.Context, request *PhoneCreateGroupCallRequest) (UpdatesClass, error)
+	// PhoneJoinGroupCall handles method phone.joinGroupCall#8fb53057.
+	PhoneJoinGroupCall(ctx context.Context, request *PhoneJoinGroupCallRequest) (UpdatesClass, error)
+	// PhoneLeaveGroupCall handles method phone.leaveGroupCall#500377f9.
+	PhoneLeaveGroupCall(ctx context.Context, request *PhoneLeaveGroupCallRequest) (UpdatesClass, error)
+	// PhoneInviteToGroupCall handles method phone.inviteToGroupCall#7b393160.
+	PhoneInviteToGroupCall(ctx context.Context, request *PhoneInviteToGroupCallRequest) (UpdatesClass, error)
+	// PhoneDiscardGroupCall handles method phone.discardGroupCall#7a777135.
+	PhoneDiscardGroupCall(ctx context.Context, call InputGroupCallClass) (UpdatesClass, error)
+	// PhoneToggleGroupCallSettings handles method phone.toggleGroupCallSettings#74bbb43d.
+	PhoneToggleGroupCallSettings(ctx context.Context, request *PhoneToggleGroupCallSettingsRequest) (UpdatesClass, error)
+	// PhoneGetGroupCall handles method phone.getGroupCall#41845db.
+	PhoneGetGroupCall(ctx context.Context, request *PhoneGetGroupCallRequest) (*PhoneGroupCall, error)
+	// PhoneGetGroupParticipants handles method phone.getGroupParticipants#c558d8ab.
+	PhoneGetGroupParticipants(ctx context.Context, request *PhoneGetGroupParticipantsRequest) (*PhoneGroupParticipants, error)
+	// PhoneCheckGroupCall handles method phone.checkGroupCall#b59cf977.
+	PhoneCheckGroupCall(ctx context.Context, request *PhoneCheckGroupCallRequest) ([]int, error)
+	// PhoneToggleGroupCallRecord handles method phone.toggleGroupCallRecord#f128c708.
+	PhoneToggleGroupCallRecord(ctx context.Context, request *PhoneToggleGroupCallRecordRequest) (UpdatesClass, error)
+	// PhoneEditGroupCallParticipant handles method phone.editGroupCallParticipant#a5273abf.
+	PhoneEditGroupCallParticipant(ctx context.Context, request *PhoneEditGroupCallParticipantRequest) (UpdatesClass, error)
+	// PhoneEditGroupCallTitle handles method phone.editGroupCallTitle#1ca6ac0a.
+	PhoneEditGroupCallTitle(ctx context.Context, request *PhoneEditGroupCallTitleRequest) (UpdatesClass, error)
+	// PhoneGetGroupCallJoinAs handles method phone.getGroupCallJoinAs#ef7c213a.
+	PhoneGetGroupCallJoinAs(ctx context.Context, peer InputPeerClass) (*PhoneJoinAsPeers, error)
+	// PhoneExportGroupCallInvite handles method phone.exportGroupCallInvite#e6aa647f.
+	PhoneExportGroupCallInvite(ctx context.Context, request *PhoneExportGroupCallInviteRequest) (*PhoneExportedGroupCallInvite, error)
+	// PhoneToggleGroupCallStartSubscription handles method phone.toggleGroupCallStartSubscription#219c34e6.
+	PhoneToggleGroupCallStartSubscription(ctx context.Context, request *PhoneToggleGroupCallStartSubscriptionRequest) (UpdatesClass, error)
+	// PhoneStartScheduledGroupCall handles method phone.startScheduledGroupCall#5680e342.
+	PhoneStartScheduledGroupCall(ctx context.Context, call InputGroupCallClass) (UpdatesClass, error)
+	// PhoneSaveDefaultGroupCallJoinAs handles method phone.saveDefaultGroupCallJoinAs#575e1f8c.
+	PhoneSaveDefaultGroupCallJoinAs(ctx context.Context, request *PhoneSaveDefaultGroupCallJoinAsRequest) (bool, error)
+	// PhoneJoinGroupCallPresentation handles method phone.joinGroupCallPresentation#cbea6bc4.
+	PhoneJoinGroupCallPresentation(ctx context.Context, request *PhoneJoinGroupCallPresentationRequest) (UpdatesClass, error)
+	// PhoneLeaveGroupCallPresentation handles method phone.leaveGroupCallPresentation#1c50d144.
+	PhoneLeaveGroupCallPresentation(ctx context.Context, call InputGroupCallClass) (UpdatesClass, error)
+	// PhoneGetGroupCallStreamChannels handles method phone.getGroupCallStreamChannels#1ab21940.
+	PhoneGetGroupCallStreamChannels(ctx context.Context, call InputGroupCallClass) (*PhoneGroupCallStreamChannels, error)
+	// PhoneGetGroupCallStreamRtmpURL handles method phone.getGroupCallStreamRtmpUrl#deb3abbf.
+	PhoneGetGroupCallStreamRtmpURL(ctx context.Context, request *PhoneGetGroupCallStreamRtmpURLRequest) (*PhoneGroupCallStreamRtmpURL, error)
+	// PhoneSaveCallLog handles method phone.saveCallLog#41248786.
+	PhoneSaveCallLog(ctx context.Context, request *PhoneSaveCallLogRequest) (bool, error)
+	// PhoneCreateConferenceCall handles method phone.createConferenceCall#7d0444bb.
+	PhoneCreateConferenceCall(ctx context.Context, request *PhoneCreateConferenceCallRequest) (UpdatesClass, error)
+	// PhoneDeleteConferenceCallParticipants handles method phone.deleteConferenceCallParticipants#8ca60525.
+	PhoneDeleteConferenceCallParticipants(ctx context.Context, request *PhoneDeleteConferenceCallParticipantsRequest) (UpdatesClass, error)
+	// PhoneSendConferenceCallBroadcast handles method phone.sendConferenceCallBroadcast#c6701900.
+	PhoneSendConferenceCallBroadcast(ctx context.Context, request *PhoneSendConferenceCallBroadcastRequest) (UpdatesClass, error)
+	// PhoneInviteConferenceCallParticipant handles method phone.inviteConferenceCallParticipant#bcf22685.
+	PhoneInviteConferenceCallParticipant(ctx context.Context, request *PhoneInviteConferenceCallParticipantRequest) (UpdatesClass, error)
+	// PhoneDeclineConferenceCallInvite handles method phone.declineConferenceCallInvite#3c479971.
+	PhoneDeclineConferenceCallInvite(ctx context.Context, msgid int) (UpdatesClass, error)
+	// PhoneGetGroupCallChainBlocks handles method phone.getGroupCallChainBlocks#ee9f88a6.
+	PhoneGetGroupCallChainBlocks(ctx context.Context, request *PhoneGetGroupCallChainBlocksRequest) (UpdatesClass, error)
+	// LangpackGetLangPack handles method langpack.getLangPack#f2f2330a.
+	LangpackGetLangPack(ctx context.Context, request *LangpackGetLangPackRequest) (*LangPackDifference, error)
+	// LangpackGetStrings handles method langpack.getStrings#efea3803.
+	LangpackGetStrings(ctx context.Context, request *LangpackGetStringsRequest) ([]LangPackStringClass, error)
+	// LangpackGetDifference handles method langpack.getDifference#cd984aa5.
+	LangpackGetDifference(ctx context.Context, request *LangpackGetDifferenceRequest) (*LangPackDifference, error)
+	// LangpackGetLanguages handles method langpack.getLanguages#42c6978f.
+	LangpackGetLanguages(ctx context.Context, langpack string) ([]LangPackLanguage, error)
+	// LangpackGetLanguage handles method langpack.getLanguage#6a596502.
+	LangpackGetLanguage(ctx context.Context, request *LangpackGetLanguageRequest) (*LangPackLanguage, error)
+	// FoldersEditPeerFolders handles method folders.editPeerFolders#6847d0ab.
+	FoldersEditPeerFolders(ctx context.Context, folderpeers []InputFolderPeer) (UpdatesClass, error)
+	// StatsGetBroadcastStats handles method stats.getBroadcastStats#ab42441a.
+	StatsGetBroadcastStats(ctx context.Context, request *StatsGetBroadcastStatsRequest) (*StatsBroadcastStats, error)
+	// StatsLoadAsyncGraph handles method stats.loadAsyncGraph#621d5fa0.
+	StatsLoadAsyncGraph(ctx context.Context, request *StatsLoadAsyncGraphRequest) (StatsGraphClass, error)
+	// StatsGetMegagroupStats handles method stats.getMegagroupStats#dcdf8607.
+	StatsGetMegagroupStats(ctx context.Context, request *StatsGetMegagroupStatsRequest) (*StatsMegagroupStats, error)
+	// StatsGetMessagePublicForwards handles method stats.getMessagePublicForwards#5f150144.
+	StatsGetMessagePublicForwards(ctx context.Context, request *StatsGetMessagePublicForwardsRequest) (*StatsPublicForwards, error)
+	// StatsGetMessageStats handles method stats.getMessageStats#b6e0a3f5.
+	StatsGetMessageStats(ctx context.Context, request *StatsGetMessageStatsRequest) (*StatsMessageStats, error)
+	// StatsGetStoryStats handles method stats.getStoryStats#374fef40.
+	StatsGetStoryStats(ctx context.Context, request *StatsGetStoryStatsRequest) (*StatsStoryStats, error)
+	// StatsGetStoryPublicForwards handles method stats.getStoryPublicForwards#a6437ef6.
+	StatsGetStoryPublicForwards(ctx context.Context, request *StatsGetStoryPublicForwardsRequest) (*StatsPublicForwards, error)
+	// ChatlistsExportChatlistInvite handles method chatlists.exportChatlistInvite#8472478e.
+	ChatlistsExportChatlistInvite(ctx context.Context, request *ChatlistsExportChatlistInviteRequest) (*ChatlistsExportedChatlistInvite, error)
+	// ChatlistsDeleteExportedInvite handles method chatlists.deleteExportedInvite#719c5c5e.
+	ChatlistsDeleteExportedInvite(ctx context.Context, request *ChatlistsDeleteExportedInviteRequest) (bool, error)
+	// ChatlistsEditExportedInvite handles method chatlists.editExportedInvite#653db63d.
+	ChatlistsEditExportedInvite(ctx context.Context, request *ChatlistsEditExportedInviteRequest) (*ExportedChatlistInvite, error)
+	// ChatlistsGetExportedInvites handles method chatlists.getExportedInvites#ce03da83.
+	ChatlistsGetExportedInvites(ctx context.Context, chatlist InputChatlistDialogFilter) (*ChatlistsExportedInvites, error)
+	// ChatlistsCheckChatlistInvite handles method chatlists.checkChatlistInvite#41c10fff.
+	ChatlistsCheckChatlistInvite(ctx context.Context, slug string) (ChatlistsChatlistInviteClass, error)
+	// ChatlistsJoinChatlistInvite handles method chatlists.joinChatlistInvite#a6b1e39a.
+	ChatlistsJoinChatlistInvite(ctx context.Context, request *ChatlistsJoinChatlistInviteRequest) (UpdatesClass, error)
+	// ChatlistsGetChatlistUpdates handles method chatlists.getChatlistUpdates#89419521.
+	ChatlistsGetChatlistUpdates(ctx context.Context, chatlist InputChatlistDialogFilter) (*ChatlistsChatlistUpdates, error)
+	// ChatlistsJoinChatlistUpdates handles method chatlists.joinChatlistUpdates#e089f8f5.
+	ChatlistsJoinChatlistUpdates(ctx context.Context, request *ChatlistsJoinChatlistUpdatesRequest) (UpdatesClass, error)
+	// ChatlistsHideChatlistUpdates handles method chatlists.hideChatlistUpdates#66e486fb.
+	ChatlistsHideChatlistUpdates(ctx context.Context, chatlist InputChatlistDialogFilter) (bool, error)
+	// ChatlistsGetLeaveChatlistSuggestions handles method chatlists.getLeaveChatlistSuggestions#fdbcd714.
+	ChatlistsGetLeaveChatlistSuggestions(ctx context.Context, chatlist InputChatlistDialogFilter) ([]PeerClass, error)
+	// ChatlistsLeaveChatlist handles method chatlists.leaveChatlist#74fae13a.
+	ChatlistsLeaveChatlist(ctx context.Context, request *ChatlistsLeaveChatlistRequest) (UpdatesClass, error)
+	// StoriesCanSendStory handles method stories.canSendStory#30eb63f0.
+	StoriesCanSendStory(ctx context.Context, peer InputPeerClass) (*StoriesCanSendStoryCount, error)
+	// StoriesSendStory handles method stories.sendStory#737fc2ec.
+	StoriesSendStory(ctx context.Context, request *StoriesSendStoryRequest) (UpdatesClass, error)
+	// StoriesEditStory handles method stories.editStory#b583ba46.
+	StoriesEditStory(ctx context.Context, request *StoriesEditStoryRequest) (UpdatesClass, error)
+	// StoriesDeleteStories handles method stories.deleteStories#ae59db5f.
+	StoriesDeleteStories(ctx context.Context, request *StoriesDeleteStoriesRequest) ([]int, error)
+	// StoriesTogglePinned handles method stories.togglePinned#9a75a1ef.
+	StoriesTogglePinned(ctx context.Context, request *StoriesTogglePinnedRequest) ([]int, error)
+	// StoriesGetAllStories handles method stories.getAllStories#eeb0d625.
+	StoriesGetAllStories(ctx context.Context, request *StoriesGetAllStoriesRequest) (StoriesAllStoriesClass, error)
+	// StoriesGetPinnedStories handles method stories.getPinnedStories#5821a5dc.
+	StoriesGetPinnedStories(ctx context.Context, request *StoriesGetPinnedStoriesRequest) (*StoriesStories, error)
+	// StoriesGetStoriesArchive handles method stories.getStoriesArchive#b4352016.
+	StoriesGetStoriesArchive(ctx context.Context, request *StoriesGetStoriesArchiveRequest) (*StoriesStories, error)
+	// StoriesGetStoriesByID handles method stories.getStoriesByID#5774ca74.
+	StoriesGetStoriesByID(ctx context.Context, request *StoriesGetStoriesByIDRequest) (*StoriesStories, error)
+	// StoriesToggleAllStoriesHidden handles method stories.toggleAllStoriesHidden#7c2557c4.
+	StoriesToggleAllStoriesHidden(ctx context.Context, hidden bool) (bool, error)
+	// StoriesReadStories handles method stories.readStories#a556dac8.
+	StoriesReadStories(ctx context.Context, request *StoriesReadStoriesRequest) ([]int, error)
+	// StoriesIncrementStoryViews handles method stories.incrementStoryViews#b2028afb.
+	StoriesIncrementStoryViews(ctx context.Context, request *StoriesIncrementStoryViewsRequest) (bool, error)
+	// StoriesGetStoryViewsList handles method stories.getStoryViewsList#7ed23c57.
+	StoriesGetStoryViewsList(ctx context.Context, request *StoriesGetStoryViewsListRequest) (*StoriesStoryViewsList, error)
+	// StoriesGetStoriesViews handles method stories.getStoriesViews#28e16cc8.
+	StoriesGetStoriesViews(ctx context.Context, request *StoriesGetStoriesViewsRequest) (*StoriesStoryViews, error)
+	// StoriesExportStoryLink handles method stories.exportStoryLink#7b8def20.
+	StoriesExportStoryLink(ctx context.Context, request *StoriesExportStoryLinkRequest) (*ExportedStoryLink, error)
+	// StoriesReport handles method stories.report#19d8eb45.
+	StoriesReport(ctx context.Context, request *StoriesReportRequest) (ReportResultClass, error)
+	// StoriesActivateStealthMode handles method stories.activateStealthMode#57bbd166.
+	StoriesActivateStealthMode(ctx context.Context, request *StoriesActivateStealthModeRequest) (UpdatesClass, error)
+	// StoriesSendReaction handles method stories.sendReaction#7fd736b2.
+	StoriesSendReaction(ctx context.Context, request *StoriesSendReactionRequest) (UpdatesClass, error)
+	// StoriesGetPeerStories handles method stories.getPeerStories#2c4ada50.
+	StoriesGetPeerStories(ctx context.Context, peer InputPeerClass) (*StoriesPeerStories, error)
+	// StoriesGetAllReadPeerStories handles method stories.getAllReadPeerStories#9b5ae7f9.
+	StoriesGetAllReadPeerStories(ctx context.Context) (UpdatesClass, error)
+	// StoriesGetPeerMaxIDs handles method stories.getPeerMaxIDs#535983c3.
+	StoriesGetPeerMaxIDs(ctx context.Context, id []InputPeerClass) ([]int, error)
+	// StoriesGetChatsToSend handles method stories.getChatsToSend#a56a8b60.
+	StoriesGetChatsToSend(ctx context.Context) (MessagesChatsClass, error)
+	// StoriesTogglePeerStoriesHidden handles method stories.togglePeerStoriesHidden#bd0415c4.
+	StoriesTogglePeerStoriesHidden(ctx context.Context, request *StoriesTogglePeerStoriesHiddenRequest) (bool, error)
+	// StoriesGetStoryReactionsList handles method stories.getStoryReactionsList#b9b2881f.
+	StoriesGetStoryReactionsList(ctx context.Context, request *StoriesGetStoryReactionsListRequest) (*StoriesStoryReactionsList, error)
+	// StoriesTogglePinnedToTop handles method stories.togglePinnedToTop#b297e9b.
+	StoriesTogglePinnedToTop(ctx context.Context, request *StoriesTogglePinnedToTopRequest) (bool, error)
+	// StoriesSearchPosts handles method stories.searchPosts#d1810907.
+	StoriesSearchPosts(ctx context.Context, request *StoriesSearchPostsRequest) (*StoriesFoundStories, error)
+	// StoriesCreateAlbum handles method stories.createAlbum#a36396e5.
+	StoriesCreateAlbum(ctx context.Context, request *StoriesCreateAlbumRequest) (*StoryAlbum, error)
+	// StoriesUpdateAlbum handles method stories.updateAlbum#5e5259b6.
+	StoriesUpdateAlbum(ctx context.Context, request *StoriesUpdateAlbumRequest) (*StoryAlbum, error)
+	// StoriesReorderAlbums handles method stories.reorderAlbums#8535fbd9.
+	StoriesReorderAlbums(ctx context.Context, request *StoriesReorderAlbumsRequest) (bool, error)
+	// StoriesDeleteAlbum handles method stories.deleteAlbum#8d3456d0.
+	StoriesDeleteAlbum(ctx context.Context, request *StoriesDeleteAlbumRequest) (bool, error)
+	// StoriesGetAlbums handles method stories.getAlbums#25b3eac7.
+	StoriesGetAlbums(ctx context.Context, request *StoriesGetAlbumsRequest) (StoriesAlbumsClass, error)
+	// StoriesGetAlbumStories handles method stories.getAlbumStories#ac806d61.
+	StoriesGetAlbumStories(ctx context.Context, request *StoriesGetAlbumStoriesRequest) (*StoriesStories, error)
+	// PremiumGetBoostsList handles method premium.getBoostsList#60f67660.
+	PremiumGetBoostsList(ctx context.Context, request *PremiumGetBoostsListRequest) (*PremiumBoostsList, error)
+	// PremiumGetMyBoosts handles method premium.getMyBoosts#be77b4a.
+	PremiumGetMyBoosts(ctx context.Context) (*PremiumMyBoosts, error)
+	// PremiumApplyBoost handles method premium.applyBoost#6b7da746.
+	PremiumApplyBoost(ctx context.Context, request *PremiumApplyBoostRequest) (*PremiumMyBoosts, error)
+	// PremiumGetBoostsStatus handles method premium.getBoostsStatus#42f1f61.
+	PremiumGetBoostsStatus(ctx context.Context, peer InputPeerClass) (*PremiumBoostsStatus, error)
+	// PremiumGetUserBoosts handles method premium.getUserBoosts#39854d1f.
+	PremiumGetUserBoosts(ctx context.Context, request *PremiumGetUserBoostsRequest) (*PremiumBoostsList, error)
+	// SMSJobsIsEligibleToJoin handles method smsjobs.isEligibleToJoin#edc39d0.
+	SMSJobsIsEligibleToJoin(ctx context.Context) (*SMSJobsEligibleToJoin, error)
+	// SMSJobsJoin handles method smsjobs.join#a74ece2d.
+	SMSJobsJoin(ctx context.Context) (bool, error)
+	// SMSJobsLeave handles method smsjobs.leave#9898ad73.
+	SMSJobsLeave(ctx context.Context) (bool, error)
+	// SMSJobsUpdateSettings handles method smsjobs.updateSettings#93fa0bf.
+	SMSJobsUpdateSettings(ctx context.Context, request *SMSJobsUpdateSettingsRequest) (bool, error)
+	// SMSJobsGetStatus handles method smsjobs.getStatus#10a698e8.
+	SMSJobsGetStatus(ctx context.Context) (*SMSJobsStatus, error)
+	// SMSJobsGetSMSJob handles method smsjobs.getSmsJob#778d902f.
+	SMSJobsGetSMSJob(ctx context.Context, jobid string) (*SMSJob, error)
+	// SMSJobsFinishJob handles method smsjobs.finishJob#4f1ebf24.
+	SMSJobsFinishJob(ctx context.Context, request *SMSJobsFinishJobRequest) (bool, error)
+	// FragmentGetCollectibleInfo handles method fragment.getCollectibleInfo#be1e85ba.
+	FragmentGetCollectibleInfo(ctx context.Context, collectible InputCollectibleClass) (*FragmentCollectibleInfo, error)
+	// TestUseError handles method test.useError#ee75af01.
+	TestUseError(ctx context.Context) (*Error, error)
+	// TestUseConfigSimple handles method test.useConfigSimple#f9b7b23d.
+	TestUseConfigSimple(ctx context.Context) (*HelpConfigSimple, error)
+}
+
+// ServerDispatcher dispatches RPC requests to typed handlers.
 type ServerDispatcher struct {
 	fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 	handlers map[uint32]func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 }
 
+// NewServerDispatcher creates new ServerDispatcher.
+//
+// Fallback is called for requests without handler. If fallback is nil,
+// an error is returned for such requests.
 func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)) *ServerDispatcher {
 	return &ServerDispatcher{
 		fallback: fallback,
@@ -43,6 +1474,7 @@ func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.
 	}
 }
 
+// Handle decodes request from buffer and calls its handler.
 func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 	id, err := b.PeekID()
 	if err != nil {
@@ -51,12 +1483,3573 @@ func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encod
 
 	f, ok := s.handlers[id]
 	if !ok {
+		if s.fallback == nil {
+			return nil, fmt.Errorf("no handler for type %#x", id)
+		}
 		return s.fallback(ctx, b)
 	}
 
 	return f(ctx, b)
 }
 
+// Has reports whether handler for given type ID is registered.
+func (s *ServerDispatcher) Has(id uint32) bool {
+	_, ok := s.handlers[id]
+	return ok
+}
+
+// Register sets handlers for all Server methods implemented by impl.
+//
+// Methods are matched by name and signature, so impl may implement
+// only a subset of Server.
+func (s *ServerDispatcher) Register(impl interface{}) {
+	if h, ok := impl.(interface {
+		AuthSendCode(ctx context.Context, request *AuthSendCodeRequest) (AuthSentCodeClass, error)
+	}); ok {
+		s.OnAuthSendCode(h.AuthSendCode)
+	}
+	if h, ok := impl.(interface {
+		AuthSignUp(ctx context.Context, request *AuthSignUpRequest) (AuthAuthorizationClass, error)
+	}); ok {
+		s.OnAuthSignUp(h.AuthSignUp)
+	}
+	if h, ok := impl.(interface {
+		AuthSignIn(ctx context.Context, request *AuthSignInRequest) (AuthAuthorizationClass, error)
+	}); ok {
+		s.OnAuthSignIn(h.AuthSignIn)
+	}
+	if h, ok := impl.(interface {
+		AuthLogOut(ctx context.Context) (*AuthLoggedOut, error)
+	}); ok {
+		s.OnAuthLogOut(h.AuthLogOut)
+	}
+	if h, ok := impl.(interface {
+		AuthResetAuthorizations(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAuthResetAuthorizations(h.AuthResetAuthorizations)
+	}
+	if h, ok := impl.(interface {
+		AuthExportAuthorization(ctx context.Context, dcid int) (*AuthExportedAuthorization, error)
+	}); ok {
+		s.OnAuthExportAuthorization(h.AuthExportAuthorization)
+	}
+	if h, ok := impl.(interface {
+		AuthImportAuthorization(ctx context.Context, request *AuthImportAuthorizationRequest) (AuthAuthorizationClass, error)
+	}); ok {
+		s.OnAuthImportAuthorization(h.AuthImportAuthorization)
+	}
+	if h, ok := impl.(interface {
+		AuthBindTempAuthKey(ctx context.Context, request *AuthBindTempAuthKeyRequest) (bool, error)
+	}); ok {
+		s.OnAuthBindTempAuthKey(h.AuthBindTempAuthKey)
+	}
+	if h, ok := impl.(interface {
+		AuthImportBotAuthorization(ctx context.Context, request *AuthImportBotAuthorizationRequest) (AuthAuthorizationClass, error)
+	}); ok {
+		s.OnAuthImportBotAuthorization(h.AuthImportBotAuthorization)
+	}
+	if h, ok := impl.(interface {
+		AuthCheckPassword(ctx context.Context, password InputCheckPasswordSRPClass) (AuthAuthorizationClass, error)
+	}); ok {
+		s.OnAuthCheckPassword(h.AuthCheckPassword)
+	}
+	if h, ok := impl.(interface {
+		AuthRequestPasswordRecovery(ctx context.Context) (*AuthPasswordRecovery, error)
+	}); ok {
+		s.OnAuthRequestPasswordRecovery(h.AuthRequestPasswordRecovery)
+	}
+	if h, ok := impl.(interface {
+		AuthRecoverPassword(ctx context.Context, request *AuthRecoverPasswordRequest) (AuthAuthorizationClass, error)
+	}); ok {
+		s.OnAuthRecoverPassword(h.AuthRecoverPassword)
+	}
+	if h, ok := impl.(interface {
+		AuthResendCode(ctx context.Context, request *AuthResendCodeRequest) (AuthSentCodeClass, error)
+	}); ok {
+		s.OnAuthResendCode(h.AuthResendCode)
+	}
+	if h, ok := impl.(interface {
+		AuthCancelCode(ctx context.Context, request *AuthCancelCodeRequest) (bool, error)
+	}); ok {
+		s.OnAuthCancelCode(h.AuthCancelCode)
+	}
+	if h, ok := impl.(interface {
+		AuthDropTempAuthKeys(ctx context.Context, exceptauthkeys []int64) (bool, error)
+	}); ok {
+		s.OnAuthDropTempAuthKeys(h.AuthDropTempAuthKeys)
+	}
+	if h, ok := impl.(interface {
+		AuthExportLoginToken(ctx context.Context, request *AuthExportLoginTokenRequest) (AuthLoginTokenClass, error)
+	}); ok {
+		s.OnAuthExportLoginToken(h.AuthExportLoginToken)
+	}
+	if h, ok := impl.(interface {
+		AuthImportLoginToken(ctx context.Context, token []byte) (AuthLoginTokenClass, error)
+	}); ok {
+		s.OnAuthImportLoginToken(h.AuthImportLoginToken)
+	}
+	if h, ok := impl.(interface {
+		AuthAcceptLoginToken(ctx context.Context, token []byte) (*Authorization, error)
+	}); ok {
+		s.OnAuthAcceptLoginToken(h.AuthAcceptLoginToken)
+	}
+	if h, ok := impl.(interface {
+		AuthCheckRecoveryPassword(ctx context.Context, code string) (bool, error)
+	}); ok {
+		s.OnAuthCheckRecoveryPassword(h.AuthCheckRecoveryPassword)
+	}
+	if h, ok := impl.(interface {
+		AuthImportWebTokenAuthorization(ctx context.Context, request *AuthImportWebTokenAuthorizationRequest) (AuthAuthorizationClass, error)
+	}); ok {
+		s.OnAuthImportWebTokenAuthorization(h.AuthImportWebTokenAuthorization)
+	}
+	if h, ok := impl.(interface {
+		AuthRequestFirebaseSMS(ctx context.Context, request *AuthRequestFirebaseSMSRequest) (bool, error)
+	}); ok {
+		s.OnAuthRequestFirebaseSMS(h.AuthRequestFirebaseSMS)
+	}
+	if h, ok := impl.(interface {
+		AuthResetLoginEmail(ctx context.Context, request *AuthResetLoginEmailRequest) (AuthSentCodeClass, error)
+	}); ok {
+		s.OnAuthResetLoginEmail(h.AuthResetLoginEmail)
+	}
+	if h, ok := impl.(interface {
+		AuthReportMissingCode(ctx context.Context, request *AuthReportMissingCodeRequest) (bool, error)
+	}); ok {
+		s.OnAuthReportMissingCode(h.AuthReportMissingCode)
+	}
+	if h, ok := impl.(interface {
+		AccountRegisterDevice(ctx context.Context, request *AccountRegisterDeviceRequest) (bool, error)
+	}); ok {
+		s.OnAccountRegisterDevice(h.AccountRegisterDevice)
+	}
+	if h, ok := impl.(interface {
+		AccountUnregisterDevice(ctx context.Context, request *AccountUnregisterDeviceRequest) (bool, error)
+	}); ok {
+		s.OnAccountUnregisterDevice(h.AccountUnregisterDevice)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateNotifySettings(ctx context.Context, request *AccountUpdateNotifySettingsRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateNotifySettings(h.AccountUpdateNotifySettings)
+	}
+	if h, ok := impl.(interface {
+		AccountGetNotifySettings(ctx context.Context, peer InputNotifyPeerClass) (*PeerNotifySettings, error)
+	}); ok {
+		s.OnAccountGetNotifySettings(h.AccountGetNotifySettings)
+	}
+	if h, ok := impl.(interface {
+		AccountResetNotifySettings(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountResetNotifySettings(h.AccountResetNotifySettings)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateProfile(ctx context.Context, request *AccountUpdateProfileRequest) (UserClass, error)
+	}); ok {
+		s.OnAccountUpdateProfile(h.AccountUpdateProfile)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateStatus(ctx context.Context, offline bool) (bool, error)
+	}); ok {
+		s.OnAccountUpdateStatus(h.AccountUpdateStatus)
+	}
+	if h, ok := impl.(interface {
+		AccountGetWallPapers(ctx context.Context, hash int64) (AccountWallPapersClass, error)
+	}); ok {
+		s.OnAccountGetWallPapers(h.AccountGetWallPapers)
+	}
+	if h, ok := impl.(interface {
+		AccountReportPeer(ctx context.Context, request *AccountReportPeerRequest) (bool, error)
+	}); ok {
+		s.OnAccountReportPeer(h.AccountReportPeer)
+	}
+	if h, ok := impl.(interface {
+		AccountCheckUsername(ctx context.Context, username string) (bool, error)
+	}); ok {
+		s.OnAccountCheckUsername(h.AccountCheckUsername)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateUsername(ctx context.Context, username string) (UserClass, error)
+	}); ok {
+		s.OnAccountUpdateUsername(h.AccountUpdateUsername)
+	}
+	if h, ok := impl.(interface {
+		AccountGetPrivacy(ctx context.Context, key InputPrivacyKeyClass) (*AccountPrivacyRules, error)
+	}); ok {
+		s.OnAccountGetPrivacy(h.AccountGetPrivacy)
+	}
+	if h, ok := impl.(interface {
+		AccountSetPrivacy(ctx context.Context, request *AccountSetPrivacyRequest) (*AccountPrivacyRules, error)
+	}); ok {
+		s.OnAccountSetPrivacy(h.AccountSetPrivacy)
+	}
+	if h, ok := impl.(interface {
+		AccountDeleteAccount(ctx context.Context, request *AccountDeleteAccountRequest) (bool, error)
+	}); ok {
+		s.OnAccountDeleteAccount(h.AccountDeleteAccount)
+	}
+	if h, ok := impl.(interface {
+		AccountGetAccountTTL(ctx context.Context) (*AccountDaysTTL, error)
+	}); ok {
+		s.OnAccountGetAccountTTL(h.AccountGetAccountTTL)
+	}
+	if h, ok := impl.(interface {
+		AccountSetAccountTTL(ctx context.Context, ttl AccountDaysTTL) (bool, error)
+	}); ok {
+		s.OnAccountSetAccountTTL(h.AccountSetAccountTTL)
+	}
+	if h, ok := impl.(interface {
+		AccountSendChangePhoneCode(ctx context.Context, request *AccountSendChangePhoneCodeRequest) (AuthSentCodeClass, error)
+	}); ok {
+		s.OnAccountSendChangePhoneCode(h.AccountSendChangePhoneCode)
+	}
+	if h, ok := impl.(interface {
+		AccountChangePhone(ctx context.Context, request *AccountChangePhoneRequest) (UserClass, error)
+	}); ok {
+		s.OnAccountChangePhone(h.AccountChangePhone)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateDeviceLocked(ctx context.Context, period int) (bool, error)
+	}); ok {
+		s.OnAccountUpdateDeviceLocked(h.AccountUpdateDeviceLocked)
+	}
+	if h, ok := impl.(interface {
+		AccountGetAuthorizations(ctx context.Context) (*AccountAuthorizations, error)
+	}); ok {
+		s.OnAccountGetAuthorizations(h.AccountGetAuthorizations)
+	}
+	if h, ok := impl.(interface {
+		AccountResetAuthorization(ctx context.Context, hash int64) (bool, error)
+	}); ok {
+		s.OnAccountResetAuthorization(h.AccountResetAuthorization)
+	}
+	if h, ok := impl.(interface {
+		AccountGetPassword(ctx context.Context) (*AccountPassword, error)
+	}); ok {
+		s.OnAccountGetPassword(h.AccountGetPassword)
+	}
+	if h, ok := impl.(interface {
+		AccountGetPasswordSettings(ctx context.Context, password InputCheckPasswordSRPClass) (*AccountPasswordSettings, error)
+	}); ok {
+		s.OnAccountGetPasswordSettings(h.AccountGetPasswordSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdatePasswordSettings(ctx context.Context, request *AccountUpdatePasswordSettingsRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdatePasswordSettings(h.AccountUpdatePasswordSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountSendConfirmPhoneCode(ctx context.Context, request *AccountSendConfirmPhoneCodeRequest) (AuthSentCodeClass, error)
+	}); ok {
+		s.OnAccountSendConfirmPhoneCode(h.AccountSendConfirmPhoneCode)
+	}
+	if h, ok := impl.(interface {
+		AccountConfirmPhone(ctx context.Context, request *AccountConfirmPhoneRequest) (bool, error)
+	}); ok {
+		s.OnAccountConfirmPhone(h.AccountConfirmPhone)
+	}
+	if h, ok := impl.(interface {
+		AccountGetTmpPassword(ctx context.Context, request *AccountGetTmpPasswordRequest) (*AccountTmpPassword, error)
+	}); ok {
+		s.OnAccountGetTmpPassword(h.AccountGetTmpPassword)
+	}
+	if h, ok := impl.(interface {
+		AccountGetWebAuthorizations(ctx context.Context) (*AccountWebAuthorizations, error)
+	}); ok {
+		s.OnAccountGetWebAuthorizations(h.AccountGetWebAuthorizations)
+	}
+	if h, ok := impl.(interface {
+		AccountResetWebAuthorization(ctx context.Context, hash int64) (bool, error)
+	}); ok {
+		s.OnAccountResetWebAuthorization(h.AccountResetWebAuthorization)
+	}
+	if h, ok := impl.(interface {
+		AccountResetWebAuthorizations(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountResetWebAuthorizations(h.AccountResetWebAuthorizations)
+	}
+	if h, ok := impl.(interface {
+		AccountGetAllSecureValues(ctx context.Context) ([]SecureValue, error)
+	}); ok {
+		s.OnAccountGetAllSecureValues(h.AccountGetAllSecureValues)
+	}
+	if h, ok := impl.(interface {
+		AccountGetSecureValue(ctx context.Context, types []SecureValueTypeClass) ([]SecureValue, error)
+	}); ok {
+		s.OnAccountGetSecureValue(h.AccountGetSecureValue)
+	}
+	if h, ok := impl.(interface {
+		AccountSaveSecureValue(ctx context.Context, request *AccountSaveSecureValueRequest) (*SecureValue, error)
+	}); ok {
+		s.OnAccountSaveSecureValue(h.AccountSaveSecureValue)
+	}
+	if h, ok := impl.(interface {
+		AccountDeleteSecureValue(ctx context.Context, types []SecureValueTypeClass) (bool, error)
+	}); ok {
+		s.OnAccountDeleteSecureValue(h.AccountDeleteSecureValue)
+	}
+	if h, ok := impl.(interface {
+		AccountGetAuthorizationForm(ctx context.Context, request *AccountGetAuthorizationFormRequest) (*AccountAuthorizationForm, error)
+	}); ok {
+		s.OnAccountGetAuthorizationForm(h.AccountGetAuthorizationForm)
+	}
+	if h, ok := impl.(interface {
+		AccountAcceptAuthorization(ctx context.Context, request *AccountAcceptAuthorizationRequest) (bool, error)
+	}); ok {
+		s.OnAccountAcceptAuthorization(h.AccountAcceptAuthorization)
+	}
+	if h, ok := impl.(interface {
+		AccountSendVerifyPhoneCode(ctx context.Context, request *AccountSendVerifyPhoneCodeRequest) (AuthSentCodeClass, error)
+	}); ok {
+		s.OnAccountSendVerifyPhoneCode(h.AccountSendVerifyPhoneCode)
+	}
+	if h, ok := impl.(interface {
+		AccountVerifyPhone(ctx context.Context, request *AccountVerifyPhoneRequest) (bool, error)
+	}); ok {
+		s.OnAccountVerifyPhone(h.AccountVerifyPhone)
+	}
+	if h, ok := impl.(interface {
+		AccountSendVerifyEmailCode(ctx context.Context, request *AccountSendVerifyEmailCodeRequest) (*AccountSentEmailCode, error)
+	}); ok {
+		s.OnAccountSendVerifyEmailCode(h.AccountSendVerifyEmailCode)
+	}
+	if h, ok := impl.(interface {
+		AccountVerifyEmail(ctx context.Context, request *AccountVerifyEmailRequest) (AccountEmailVerifiedClass, error)
+	}); ok {
+		s.OnAccountVerifyEmail(h.AccountVerifyEmail)
+	}
+	if h, ok := impl.(interface {
+		AccountInitTakeoutSession(ctx context.Context, request *AccountInitTakeoutSessionRequest) (*AccountTakeout, error)
+	}); ok {
+		s.OnAccountInitTakeoutSession(h.AccountInitTakeoutSession)
+	}
+	if h, ok := impl.(interface {
+		AccountFinishTakeoutSession(ctx context.Context, request *AccountFinishTakeoutSessionRequest) (bool, error)
+	}); ok {
+		s.OnAccountFinishTakeoutSession(h.AccountFinishTakeoutSession)
+	}
+	if h, ok := impl.(interface {
+		AccountConfirmPasswordEmail(ctx context.Context, code string) (bool, error)
+	}); ok {
+		s.OnAccountConfirmPasswordEmail(h.AccountConfirmPasswordEmail)
+	}
+	if h, ok := impl.(interface {
+		AccountResendPasswordEmail(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountResendPasswordEmail(h.AccountResendPasswordEmail)
+	}
+	if h, ok := impl.(interface {
+		AccountCancelPasswordEmail(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountCancelPasswordEmail(h.AccountCancelPasswordEmail)
+	}
+	if h, ok := impl.(interface {
+		AccountGetContactSignUpNotification(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountGetContactSignUpNotification(h.AccountGetContactSignUpNotification)
+	}
+	if h, ok := impl.(interface {
+		AccountSetContactSignUpNotification(ctx context.Context, silent bool) (bool, error)
+	}); ok {
+		s.OnAccountSetContactSignUpNotification(h.AccountSetContactSignUpNotification)
+	}
+	if h, ok := impl.(interface {
+		AccountGetNotifyExceptions(ctx context.Context, request *AccountGetNotifyExceptionsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnAccountGetNotifyExceptions(h.AccountGetNotifyExceptions)
+	}
+	if h, ok := impl.(interface {
+		AccountGetWallPaper(ctx context.Context, wallpaper InputWallPaperClass) (WallPaperClass, error)
+	}); ok {
+		s.OnAccountGetWallPaper(h.AccountGetWallPaper)
+	}
+	if h, ok := impl.(interface {
+		AccountUploadWallPaper(ctx context.Context, request *AccountUploadWallPaperRequest) (WallPaperClass, error)
+	}); ok {
+		s.OnAccountUploadWallPaper(h.AccountUploadWallPaper)
+	}
+	if h, ok := impl.(interface {
+		AccountSaveWallPaper(ctx context.Context, request *AccountSaveWallPaperRequest) (bool, error)
+	}); ok {
+		s.OnAccountSaveWallPaper(h.AccountSaveWallPaper)
+	}
+	if h, ok := impl.(interface {
+		AccountInstallWallPaper(ctx context.Context, request *AccountInstallWallPaperRequest) (bool, error)
+	}); ok {
+		s.OnAccountInstallWallPaper(h.AccountInstallWallPaper)
+	}
+	if h, ok := impl.(interface {
+		AccountResetWallPapers(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountResetWallPapers(h.AccountResetWallPapers)
+	}
+	if h, ok := impl.(interface {
+		AccountGetAutoDownloadSettings(ctx context.Context) (*AccountAutoDownloadSettings, error)
+	}); ok {
+		s.OnAccountGetAutoDownloadSettings(h.AccountGetAutoDownloadSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountSaveAutoDownloadSettings(ctx context.Context, request *AccountSaveAutoDownloadSettingsRequest) (bool, error)
+	}); ok {
+		s.OnAccountSaveAutoDownloadSettings(h.AccountSaveAutoDownloadSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountUploadTheme(ctx context.Context, request *AccountUploadThemeRequest) (DocumentClass, error)
+	}); ok {
+		s.OnAccountUploadTheme(h.AccountUploadTheme)
+	}
+	if h, ok := impl.(interface {
+		AccountCreateTheme(ctx context.Context, request *AccountCreateThemeRequest) (*Theme, error)
+	}); ok {
+		s.OnAccountCreateTheme(h.AccountCreateTheme)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateTheme(ctx context.Context, request *AccountUpdateThemeRequest) (*Theme, error)
+	}); ok {
+		s.OnAccountUpdateTheme(h.AccountUpdateTheme)
+	}
+	if h, ok := impl.(interface {
+		AccountSaveTheme(ctx context.Context, request *AccountSaveThemeRequest) (bool, error)
+	}); ok {
+		s.OnAccountSaveTheme(h.AccountSaveTheme)
+	}
+	if h, ok := impl.(interface {
+		AccountInstallTheme(ctx context.Context, request *AccountInstallThemeRequest) (bool, error)
+	}); ok {
+		s.OnAccountInstallTheme(h.AccountInstallTheme)
+	}
+	if h, ok := impl.(interface {
+		AccountGetTheme(ctx context.Context, request *AccountGetThemeRequest) (*Theme, error)
+	}); ok {
+		s.OnAccountGetTheme(h.AccountGetTheme)
+	}
+	if h, ok := impl.(interface {
+		AccountGetThemes(ctx context.Context, request *AccountGetThemesRequest) (AccountThemesClass, error)
+	}); ok {
+		s.OnAccountGetThemes(h.AccountGetThemes)
+	}
+	if h, ok := impl.(interface {
+		AccountSetContentSettings(ctx context.Context, request *AccountSetContentSettingsRequest) (bool, error)
+	}); ok {
+		s.OnAccountSetContentSettings(h.AccountSetContentSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountGetContentSettings(ctx context.Context) (*AccountContentSettings, error)
+	}); ok {
+		s.OnAccountGetContentSettings(h.AccountGetContentSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountGetMultiWallPapers(ctx context.Context, wallpapers []InputWallPaperClass) ([]WallPaperClass, error)
+	}); ok {
+		s.OnAccountGetMultiWallPapers(h.AccountGetMultiWallPapers)
+	}
+	if h, ok := impl.(interface {
+		AccountGetGlobalPrivacySettings(ctx context.Context) (*GlobalPrivacySettings, error)
+	}); ok {
+		s.OnAccountGetGlobalPrivacySettings(h.AccountGetGlobalPrivacySettings)
+	}
+	if h, ok := impl.(interface {
+		AccountSetGlobalPrivacySettings(ctx context.Context, settings GlobalPrivacySettings) (*GlobalPrivacySettings, error)
+	}); ok {
+		s.OnAccountSetGlobalPrivacySettings(h.AccountSetGlobalPrivacySettings)
+	}
+	if h, ok := impl.(interface {
+		AccountReportProfilePhoto(ctx context.Context, request *AccountReportProfilePhotoRequest) (bool, error)
+	}); ok {
+		s.OnAccountReportProfilePhoto(h.AccountReportProfilePhoto)
+	}
+	if h, ok := impl.(interface {
+		AccountResetPassword(ctx context.Context) (AccountResetPasswordResultClass, error)
+	}); ok {
+		s.OnAccountResetPassword(h.AccountResetPassword)
+	}
+	if h, ok := impl.(interface {
+		AccountDeclinePasswordReset(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountDeclinePasswordReset(h.AccountDeclinePasswordReset)
+	}
+	if h, ok := impl.(interface {
+		AccountGetChatThemes(ctx context.Context, hash int64) (AccountThemesClass, error)
+	}); ok {
+		s.OnAccountGetChatThemes(h.AccountGetChatThemes)
+	}
+	if h, ok := impl.(interface {
+		AccountSetAuthorizationTTL(ctx context.Context, authorizationttldays int) (bool, error)
+	}); ok {
+		s.OnAccountSetAuthorizationTTL(h.AccountSetAuthorizationTTL)
+	}
+	if h, ok := impl.(interface {
+		AccountChangeAuthorizationSettings(ctx context.Context, request *AccountChangeAuthorizationSettingsRequest) (bool, error)
+	}); ok {
+		s.OnAccountChangeAuthorizationSettings(h.AccountChangeAuthorizationSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountGetSavedRingtones(ctx context.Context, hash int64) (AccountSavedRingtonesClass, error)
+	}); ok {
+		s.OnAccountGetSavedRingtones(h.AccountGetSavedRingtones)
+	}
+	if h, ok := impl.(interface {
+		AccountSaveRingtone(ctx context.Context, request *AccountSaveRingtoneRequest) (AccountSavedRingtoneClass, error)
+	}); ok {
+		s.OnAccountSaveRingtone(h.AccountSaveRingtone)
+	}
+	if h, ok := impl.(interface {
+		AccountUploadRingtone(ctx context.Context, request *AccountUploadRingtoneRequest) (DocumentClass, error)
+	}); ok {
+		s.OnAccountUploadRingtone(h.AccountUploadRingtone)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateEmojiStatus(ctx context.Context, emojistatus EmojiStatusClass) (bool, error)
+	}); ok {
+		s.OnAccountUpdateEmojiStatus(h.AccountUpdateEmojiStatus)
+	}
+	if h, ok := impl.(interface {
+		AccountGetDefaultEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	}); ok {
+		s.OnAccountGetDefaultEmojiStatuses(h.AccountGetDefaultEmojiStatuses)
+	}
+	if h, ok := impl.(interface {
+		AccountGetRecentEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	}); ok {
+		s.OnAccountGetRecentEmojiStatuses(h.AccountGetRecentEmojiStatuses)
+	}
+	if h, ok := impl.(interface {
+		AccountClearRecentEmojiStatuses(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountClearRecentEmojiStatuses(h.AccountClearRecentEmojiStatuses)
+	}
+	if h, ok := impl.(interface {
+		AccountReorderUsernames(ctx context.Context, order []string) (bool, error)
+	}); ok {
+		s.OnAccountReorderUsernames(h.AccountReorderUsernames)
+	}
+	if h, ok := impl.(interface {
+		AccountToggleUsername(ctx context.Context, request *AccountToggleUsernameRequest) (bool, error)
+	}); ok {
+		s.OnAccountToggleUsername(h.AccountToggleUsername)
+	}
+	if h, ok := impl.(interface {
+		AccountGetDefaultProfilePhotoEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	}); ok {
+		s.OnAccountGetDefaultProfilePhotoEmojis(h.AccountGetDefaultProfilePhotoEmojis)
+	}
+	if h, ok := impl.(interface {
+		AccountGetDefaultGroupPhotoEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	}); ok {
+		s.OnAccountGetDefaultGroupPhotoEmojis(h.AccountGetDefaultGroupPhotoEmojis)
+	}
+	if h, ok := impl.(interface {
+		AccountGetAutoSaveSettings(ctx context.Context) (*AccountAutoSaveSettings, error)
+	}); ok {
+		s.OnAccountGetAutoSaveSettings(h.AccountGetAutoSaveSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountSaveAutoSaveSettings(ctx context.Context, request *AccountSaveAutoSaveSettingsRequest) (bool, error)
+	}); ok {
+		s.OnAccountSaveAutoSaveSettings(h.AccountSaveAutoSaveSettings)
+	}
+	if h, ok := impl.(interface {
+		AccountDeleteAutoSaveExceptions(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnAccountDeleteAutoSaveExceptions(h.AccountDeleteAutoSaveExceptions)
+	}
+	if h, ok := impl.(interface {
+		AccountInvalidateSignInCodes(ctx context.Context, codes []string) (bool, error)
+	}); ok {
+		s.OnAccountInvalidateSignInCodes(h.AccountInvalidateSignInCodes)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateColor(ctx context.Context, request *AccountUpdateColorRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateColor(h.AccountUpdateColor)
+	}
+	if h, ok := impl.(interface {
+		AccountGetDefaultBackgroundEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	}); ok {
+		s.OnAccountGetDefaultBackgroundEmojis(h.AccountGetDefaultBackgroundEmojis)
+	}
+	if h, ok := impl.(interface {
+		AccountGetChannelDefaultEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	}); ok {
+		s.OnAccountGetChannelDefaultEmojiStatuses(h.AccountGetChannelDefaultEmojiStatuses)
+	}
+	if h, ok := impl.(interface {
+		AccountGetChannelRestrictedStatusEmojis(ctx context.Context, hash int64) (EmojiListClass, error)
+	}); ok {
+		s.OnAccountGetChannelRestrictedStatusEmojis(h.AccountGetChannelRestrictedStatusEmojis)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateBusinessWorkHours(ctx context.Context, request *AccountUpdateBusinessWorkHoursRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateBusinessWorkHours(h.AccountUpdateBusinessWorkHours)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateBusinessLocation(ctx context.Context, request *AccountUpdateBusinessLocationRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateBusinessLocation(h.AccountUpdateBusinessLocation)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateBusinessGreetingMessage(ctx context.Context, request *AccountUpdateBusinessGreetingMessageRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateBusinessGreetingMessage(h.AccountUpdateBusinessGreetingMessage)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateBusinessAwayMessage(ctx context.Context, request *AccountUpdateBusinessAwayMessageRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateBusinessAwayMessage(h.AccountUpdateBusinessAwayMessage)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateConnectedBot(ctx context.Context, request *AccountUpdateConnectedBotRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnAccountUpdateConnectedBot(h.AccountUpdateConnectedBot)
+	}
+	if h, ok := impl.(interface {
+		AccountGetConnectedBots(ctx context.Context) (*AccountConnectedBots, error)
+	}); ok {
+		s.OnAccountGetConnectedBots(h.AccountGetConnectedBots)
+	}
+	if h, ok := impl.(interface {
+		AccountGetBotBusinessConnection(ctx context.Context, connectionid string) (UpdatesClass, error)
+	}); ok {
+		s.OnAccountGetBotBusinessConnection(h.AccountGetBotBusinessConnection)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateBusinessIntro(ctx context.Context, request *AccountUpdateBusinessIntroRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateBusinessIntro(h.AccountUpdateBusinessIntro)
+	}
+	if h, ok := impl.(interface {
+		AccountToggleConnectedBotPaused(ctx context.Context, request *AccountToggleConnectedBotPausedRequest) (bool, error)
+	}); ok {
+		s.OnAccountToggleConnectedBotPaused(h.AccountToggleConnectedBotPaused)
+	}
+	if h, ok := impl.(interface {
+		AccountDisablePeerConnectedBot(ctx context.Context, peer InputPeerClass) (bool, error)
+	}); ok {
+		s.OnAccountDisablePeerConnectedBot(h.AccountDisablePeerConnectedBot)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdateBirthday(ctx context.Context, request *AccountUpdateBirthdayRequest) (bool, error)
+	}); ok {
+		s.OnAccountUpdateBirthday(h.AccountUpdateBirthday)
+	}
+	if h, ok := impl.(interface {
+		AccountCreateBusinessChatLink(ctx context.Context, link InputBusinessChatLink) (*BusinessChatLink, error)
+	}); ok {
+		s.OnAccountCreateBusinessChatLink(h.AccountCreateBusinessChatLink)
+	}
+	if h, ok := impl.(interface {
+		AccountEditBusinessChatLink(ctx context.Context, request *AccountEditBusinessChatLinkRequest) (*BusinessChatLink, error)
+	}); ok {
+		s.OnAccountEditBusinessChatLink(h.AccountEditBusinessChatLink)
+	}
+	if h, ok := impl.(interface {
+		AccountDeleteBusinessChatLink(ctx context.Context, slug string) (bool, error)
+	}); ok {
+		s.OnAccountDeleteBusinessChatLink(h.AccountDeleteBusinessChatLink)
+	}
+	if h, ok := impl.(interface {
+		AccountGetBusinessChatLinks(ctx context.Context) (*AccountBusinessChatLinks, error)
+	}); ok {
+		s.OnAccountGetBusinessChatLinks(h.AccountGetBusinessChatLinks)
+	}
+	if h, ok := impl.(interface {
+		AccountResolveBusinessChatLink(ctx context.Context, slug string) (*AccountResolvedBusinessChatLinks, error)
+	}); ok {
+		s.OnAccountResolveBusinessChatLink(h.AccountResolveBusinessChatLink)
+	}
+	if h, ok := impl.(interface {
+		AccountUpdatePersonalChannel(ctx context.Context, channel InputChannelClass) (bool, error)
+	}); ok {
+		s.OnAccountUpdatePersonalChannel(h.AccountUpdatePersonalChannel)
+	}
+	if h, ok := impl.(interface {
+		AccountToggleSponsoredMessages(ctx context.Context, enabled bool) (bool, error)
+	}); ok {
+		s.OnAccountToggleSponsoredMessages(h.AccountToggleSponsoredMessages)
+	}
+	if h, ok := impl.(interface {
+		AccountGetReactionsNotifySettings(ctx context.Context) (*ReactionsNotifySettings, error)
+	}); ok {
+		s.OnAccountGetReactionsNotifySettings(h.AccountGetReactionsNotifySettings)
+	}
+	if h, ok := impl.(interface {
+		AccountSetReactionsNotifySettings(ctx context.Context, settings ReactionsNotifySettings) (*ReactionsNotifySettings, error)
+	}); ok {
+		s.OnAccountSetReactionsNotifySettings(h.AccountSetReactionsNotifySettings)
+	}
+	if h, ok := impl.(interface {
+		AccountGetCollectibleEmojiStatuses(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)
+	}); ok {
+		s.OnAccountGetCollectibleEmojiStatuses(h.AccountGetCollectibleEmojiStatuses)
+	}
+	if h, ok := impl.(interface {
+		AccountGetPaidMessagesRevenue(ctx context.Context, request *AccountGetPaidMessagesRevenueRequest) (*AccountPaidMessagesRevenue, error)
+	}); ok {
+		s.OnAccountGetPaidMessagesRevenue(h.AccountGetPaidMessagesRevenue)
+	}
+	if h, ok := impl.(interface {
+		AccountToggleNoPaidMessagesException(ctx context.Context, request *AccountToggleNoPaidMessagesExceptionRequest) (bool, error)
+	}); ok {
+		s.OnAccountToggleNoPaidMessagesException(h.AccountToggleNoPaidMessagesException)
+	}
+	if h, ok := impl.(interface {
+		UsersGetUsers(ctx context.Context, id []InputUserClass) ([]UserClass, error)
+	}); ok {
+		s.OnUsersGetUsers(h.UsersGetUsers)
+	}
+	if h, ok := impl.(interface {
+		UsersGetFullUser(ctx context.Context, id InputUserClass) (*UsersUserFull, error)
+	}); ok {
+		s.OnUsersGetFullUser(h.UsersGetFullUser)
+	}
+	if h, ok := impl.(interface {
+		UsersSetSecureValueErrors(ctx context.Context, request *UsersSetSecureValueErrorsRequest) (bool, error)
+	}); ok {
+		s.OnUsersSetSecureValueErrors(h.UsersSetSecureValueErrors)
+	}
+	if h, ok := impl.(interface {
+		UsersGetRequirementsToContact(ctx context.Context, id []InputUserClass) ([]RequirementToContactClass, error)
+	}); ok {
+		s.OnUsersGetRequirementsToContact(h.UsersGetRequirementsToContact)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetContactIDs(ctx context.Context, hash int64) ([]int, error)
+	}); ok {
+		s.OnContactsGetContactIDs(h.ContactsGetContactIDs)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetStatuses(ctx context.Context) ([]ContactStatus, error)
+	}); ok {
+		s.OnContactsGetStatuses(h.ContactsGetStatuses)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetContacts(ctx context.Context, hash int64) (ContactsContactsClass, error)
+	}); ok {
+		s.OnContactsGetContacts(h.ContactsGetContacts)
+	}
+	if h, ok := impl.(interface {
+		ContactsImportContacts(ctx context.Context, contacts []InputPhoneContact) (*ContactsImportedContacts, error)
+	}); ok {
+		s.OnContactsImportContacts(h.ContactsImportContacts)
+	}
+	if h, ok := impl.(interface {
+		ContactsDeleteContacts(ctx context.Context, id []InputUserClass) (UpdatesClass, error)
+	}); ok {
+		s.OnContactsDeleteContacts(h.ContactsDeleteContacts)
+	}
+	if h, ok := impl.(interface {
+		ContactsDeleteByPhones(ctx context.Context, phones []string) (bool, error)
+	}); ok {
+		s.OnContactsDeleteByPhones(h.ContactsDeleteByPhones)
+	}
+	if h, ok := impl.(interface {
+		ContactsBlock(ctx context.Context, request *ContactsBlockRequest) (bool, error)
+	}); ok {
+		s.OnContactsBlock(h.ContactsBlock)
+	}
+	if h, ok := impl.(interface {
+		ContactsUnblock(ctx context.Context, request *ContactsUnblockRequest) (bool, error)
+	}); ok {
+		s.OnContactsUnblock(h.ContactsUnblock)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetBlocked(ctx context.Context, request *ContactsGetBlockedRequest) (ContactsBlockedClass, error)
+	}); ok {
+		s.OnContactsGetBlocked(h.ContactsGetBlocked)
+	}
+	if h, ok := impl.(interface {
+		ContactsSearch(ctx context.Context, request *ContactsSearchRequest) (*ContactsFound, error)
+	}); ok {
+		s.OnContactsSearch(h.ContactsSearch)
+	}
+	if h, ok := impl.(interface {
+		ContactsResolveUsername(ctx context.Context, request *ContactsResolveUsernameRequest) (*ContactsResolvedPeer, error)
+	}); ok {
+		s.OnContactsResolveUsername(h.ContactsResolveUsername)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetTopPeers(ctx context.Context, request *ContactsGetTopPeersRequest) (ContactsTopPeersClass, error)
+	}); ok {
+		s.OnContactsGetTopPeers(h.ContactsGetTopPeers)
+	}
+	if h, ok := impl.(interface {
+		ContactsResetTopPeerRating(ctx context.Context, request *ContactsResetTopPeerRatingRequest) (bool, error)
+	}); ok {
+		s.OnContactsResetTopPeerRating(h.ContactsResetTopPeerRating)
+	}
+	if h, ok := impl.(interface {
+		ContactsResetSaved(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnContactsResetSaved(h.ContactsResetSaved)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetSaved(ctx context.Context) ([]SavedPhoneContact, error)
+	}); ok {
+		s.OnContactsGetSaved(h.ContactsGetSaved)
+	}
+	if h, ok := impl.(interface {
+		ContactsToggleTopPeers(ctx context.Context, enabled bool) (bool, error)
+	}); ok {
+		s.OnContactsToggleTopPeers(h.ContactsToggleTopPeers)
+	}
+	if h, ok := impl.(interface {
+		ContactsAddContact(ctx context.Context, request *ContactsAddContactRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnContactsAddContact(h.ContactsAddContact)
+	}
+	if h, ok := impl.(interface {
+		ContactsAcceptContact(ctx context.Context, id InputUserClass) (UpdatesClass, error)
+	}); ok {
+		s.OnContactsAcceptContact(h.ContactsAcceptContact)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetLocated(ctx context.Context, request *ContactsGetLocatedRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnContactsGetLocated(h.ContactsGetLocated)
+	}
+	if h, ok := impl.(interface {
+		ContactsBlockFromReplies(ctx context.Context, request *ContactsBlockFromRepliesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnContactsBlockFromReplies(h.ContactsBlockFromReplies)
+	}
+	if h, ok := impl.(interface {
+		ContactsResolvePhone(ctx context.Context, phone string) (*ContactsResolvedPeer, error)
+	}); ok {
+		s.OnContactsResolvePhone(h.ContactsResolvePhone)
+	}
+	if h, ok := impl.(interface {
+		ContactsExportContactToken(ctx context.Context) (*ExportedContactToken, error)
+	}); ok {
+		s.OnContactsExportContactToken(h.ContactsExportContactToken)
+	}
+	if h, ok := impl.(interface {
+		ContactsImportContactToken(ctx context.Context, token string) (UserClass, error)
+	}); ok {
+		s.OnContactsImportContactToken(h.ContactsImportContactToken)
+	}
+	if h, ok := impl.(interface {
+		ContactsEditCloseFriends(ctx context.Context, id []int64) (bool, error)
+	}); ok {
+		s.OnContactsEditCloseFriends(h.ContactsEditCloseFriends)
+	}
+	if h, ok := impl.(interface {
+		ContactsSetBlocked(ctx context.Context, request *ContactsSetBlockedRequest) (bool, error)
+	}); ok {
+		s.OnContactsSetBlocked(h.ContactsSetBlocked)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetBirthdays(ctx context.Context) (*ContactsContactBirthdays, error)
+	}); ok {
+		s.OnContactsGetBirthdays(h.ContactsGetBirthdays)
+	}
+	if h, ok := impl.(interface {
+		ContactsGetSponsoredPeers(ctx context.Context, q string) (ContactsSponsoredPeersClass, error)
+	}); ok {
+		s.OnContactsGetSponsoredPeers(h.ContactsGetSponsoredPeers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMessages(ctx context.Context, id []InputMessageClass) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetMessages(h.MessagesGetMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDialogs(ctx context.Context, request *MessagesGetDialogsRequest) (MessagesDialogsClass, error)
+	}); ok {
+		s.OnMessagesGetDialogs(h.MessagesGetDialogs)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetHistory(ctx context.Context, request *MessagesGetHistoryRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetHistory(h.MessagesGetHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesSearch(ctx context.Context, request *MessagesSearchRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesSearch(h.MessagesSearch)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadHistory(ctx context.Context, request *MessagesReadHistoryRequest) (*MessagesAffectedMessages, error)
+	}); ok {
+		s.OnMessagesReadHistory(h.MessagesReadHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteHistory(ctx context.Context, request *MessagesDeleteHistoryRequest) (*MessagesAffectedHistory, error)
+	}); ok {
+		s.OnMessagesDeleteHistory(h.MessagesDeleteHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteMessages(ctx context.Context, request *MessagesDeleteMessagesRequest) (*MessagesAffectedMessages, error)
+	}); ok {
+		s.OnMessagesDeleteMessages(h.MessagesDeleteMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesReceivedMessages(ctx context.Context, maxid int) ([]ReceivedNotifyMessage, error)
+	}); ok {
+		s.OnMessagesReceivedMessages(h.MessagesReceivedMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetTyping(ctx context.Context, request *MessagesSetTypingRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSetTyping(h.MessagesSetTyping)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendMessage(ctx context.Context, request *MessagesSendMessageRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendMessage(h.MessagesSendMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendMedia(ctx context.Context, request *MessagesSendMediaRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendMedia(h.MessagesSendMedia)
+	}
+	if h, ok := impl.(interface {
+		MessagesForwardMessages(ctx context.Context, request *MessagesForwardMessagesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesForwardMessages(h.MessagesForwardMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesReportSpam(ctx context.Context, peer InputPeerClass) (bool, error)
+	}); ok {
+		s.OnMessagesReportSpam(h.MessagesReportSpam)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPeerSettings(ctx context.Context, peer InputPeerClass) (*MessagesPeerSettings, error)
+	}); ok {
+		s.OnMessagesGetPeerSettings(h.MessagesGetPeerSettings)
+	}
+	if h, ok := impl.(interface {
+		MessagesReport(ctx context.Context, request *MessagesReportRequest) (ReportResultClass, error)
+	}); ok {
+		s.OnMessagesReport(h.MessagesReport)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetChats(ctx context.Context, id []int64) (MessagesChatsClass, error)
+	}); ok {
+		s.OnMessagesGetChats(h.MessagesGetChats)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetFullChat(ctx context.Context, chatid int64) (*MessagesChatFull, error)
+	}); ok {
+		s.OnMessagesGetFullChat(h.MessagesGetFullChat)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditChatTitle(ctx context.Context, request *MessagesEditChatTitleRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesEditChatTitle(h.MessagesEditChatTitle)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditChatPhoto(ctx context.Context, request *MessagesEditChatPhotoRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesEditChatPhoto(h.MessagesEditChatPhoto)
+	}
+	if h, ok := impl.(interface {
+		MessagesAddChatUser(ctx context.Context, request *MessagesAddChatUserRequest) (*MessagesInvitedUsers, error)
+	}); ok {
+		s.OnMessagesAddChatUser(h.MessagesAddChatUser)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteChatUser(ctx context.Context, request *MessagesDeleteChatUserRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesDeleteChatUser(h.MessagesDeleteChatUser)
+	}
+	if h, ok := impl.(interface {
+		MessagesCreateChat(ctx context.Context, request *MessagesCreateChatRequest) (*MessagesInvitedUsers, error)
+	}); ok {
+		s.OnMessagesCreateChat(h.MessagesCreateChat)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDhConfig(ctx context.Context, request *MessagesGetDhConfigRequest) (MessagesDhConfigClass, error)
+	}); ok {
+		s.OnMessagesGetDhConfig(h.MessagesGetDhConfig)
+	}
+	if h, ok := impl.(interface {
+		MessagesRequestEncryption(ctx context.Context, request *MessagesRequestEncryptionRequest) (EncryptedChatClass, error)
+	}); ok {
+		s.OnMessagesRequestEncryption(h.MessagesRequestEncryption)
+	}
+	if h, ok := impl.(interface {
+		MessagesAcceptEncryption(ctx context.Context, request *MessagesAcceptEncryptionRequest) (EncryptedChatClass, error)
+	}); ok {
+		s.OnMessagesAcceptEncryption(h.MessagesAcceptEncryption)
+	}
+	if h, ok := impl.(interface {
+		MessagesDiscardEncryption(ctx context.Context, request *MessagesDiscardEncryptionRequest) (bool, error)
+	}); ok {
+		s.OnMessagesDiscardEncryption(h.MessagesDiscardEncryption)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetEncryptedTyping(ctx context.Context, request *MessagesSetEncryptedTypingRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSetEncryptedTyping(h.MessagesSetEncryptedTyping)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadEncryptedHistory(ctx context.Context, request *MessagesReadEncryptedHistoryRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReadEncryptedHistory(h.MessagesReadEncryptedHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendEncrypted(ctx context.Context, request *MessagesSendEncryptedRequest) (MessagesSentEncryptedMessageClass, error)
+	}); ok {
+		s.OnMessagesSendEncrypted(h.MessagesSendEncrypted)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendEncryptedFile(ctx context.Context, request *MessagesSendEncryptedFileRequest) (MessagesSentEncryptedMessageClass, error)
+	}); ok {
+		s.OnMessagesSendEncryptedFile(h.MessagesSendEncryptedFile)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendEncryptedService(ctx context.Context, request *MessagesSendEncryptedServiceRequest) (MessagesSentEncryptedMessageClass, error)
+	}); ok {
+		s.OnMessagesSendEncryptedService(h.MessagesSendEncryptedService)
+	}
+	if h, ok := impl.(interface {
+		MessagesReceivedQueue(ctx context.Context, maxqts int) ([]int64, error)
+	}); ok {
+		s.OnMessagesReceivedQueue(h.MessagesReceivedQueue)
+	}
+	if h, ok := impl.(interface {
+		MessagesReportEncryptedSpam(ctx context.Context, peer InputEncryptedChat) (bool, error)
+	}); ok {
+		s.OnMessagesReportEncryptedSpam(h.MessagesReportEncryptedSpam)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadMessageContents(ctx context.Context, id []int) (*MessagesAffectedMessages, error)
+	}); ok {
+		s.OnMessagesReadMessageContents(h.MessagesReadMessageContents)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetStickers(ctx context.Context, request *MessagesGetStickersRequest) (MessagesStickersClass, error)
+	}); ok {
+		s.OnMessagesGetStickers(h.MessagesGetStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAllStickers(ctx context.Context, hash int64) (MessagesAllStickersClass, error)
+	}); ok {
+		s.OnMessagesGetAllStickers(h.MessagesGetAllStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetWebPagePreview(ctx context.Context, request *MessagesGetWebPagePreviewRequest) (*MessagesWebPagePreview, error)
+	}); ok {
+		s.OnMessagesGetWebPagePreview(h.MessagesGetWebPagePreview)
+	}
+	if h, ok := impl.(interface {
+		MessagesExportChatInvite(ctx context.Context, request *MessagesExportChatInviteRequest) (ExportedChatInviteClass, error)
+	}); ok {
+		s.OnMessagesExportChatInvite(h.MessagesExportChatInvite)
+	}
+	if h, ok := impl.(interface {
+		MessagesCheckChatInvite(ctx context.Context, hash string) (ChatInviteClass, error)
+	}); ok {
+		s.OnMessagesCheckChatInvite(h.MessagesCheckChatInvite)
+	}
+	if h, ok := impl.(interface {
+		MessagesImportChatInvite(ctx context.Context, hash string) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesImportChatInvite(h.MessagesImportChatInvite)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetStickerSet(ctx context.Context, request *MessagesGetStickerSetRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnMessagesGetStickerSet(h.MessagesGetStickerSet)
+	}
+	if h, ok := impl.(interface {
+		MessagesInstallStickerSet(ctx context.Context, request *MessagesInstallStickerSetRequest) (MessagesStickerSetInstallResultClass, error)
+	}); ok {
+		s.OnMessagesInstallStickerSet(h.MessagesInstallStickerSet)
+	}
+	if h, ok := impl.(interface {
+		MessagesUninstallStickerSet(ctx context.Context, stickerset InputStickerSetClass) (bool, error)
+	}); ok {
+		s.OnMessagesUninstallStickerSet(h.MessagesUninstallStickerSet)
+	}
+	if h, ok := impl.(interface {
+		MessagesStartBot(ctx context.Context, request *MessagesStartBotRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesStartBot(h.MessagesStartBot)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMessagesViews(ctx context.Context, request *MessagesGetMessagesViewsRequest) (*MessagesMessageViews, error)
+	}); ok {
+		s.OnMessagesGetMessagesViews(h.MessagesGetMessagesViews)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditChatAdmin(ctx context.Context, request *MessagesEditChatAdminRequest) (bool, error)
+	}); ok {
+		s.OnMessagesEditChatAdmin(h.MessagesEditChatAdmin)
+	}
+	if h, ok := impl.(interface {
+		MessagesMigrateChat(ctx context.Context, chatid int64) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesMigrateChat(h.MessagesMigrateChat)
+	}
+	if h, ok := impl.(interface {
+		MessagesSearchGlobal(ctx context.Context, request *MessagesSearchGlobalRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesSearchGlobal(h.MessagesSearchGlobal)
+	}
+	if h, ok := impl.(interface {
+		MessagesReorderStickerSets(ctx context.Context, request *MessagesReorderStickerSetsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReorderStickerSets(h.MessagesReorderStickerSets)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDocumentByHash(ctx context.Context, request *MessagesGetDocumentByHashRequest) (DocumentClass, error)
+	}); ok {
+		s.OnMessagesGetDocumentByHash(h.MessagesGetDocumentByHash)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSavedGifs(ctx context.Context, hash int64) (MessagesSavedGifsClass, error)
+	}); ok {
+		s.OnMessagesGetSavedGifs(h.MessagesGetSavedGifs)
+	}
+	if h, ok := impl.(interface {
+		MessagesSaveGif(ctx context.Context, request *MessagesSaveGifRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSaveGif(h.MessagesSaveGif)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetInlineBotResults(ctx context.Context, request *MessagesGetInlineBotResultsRequest) (*MessagesBotResults, error)
+	}); ok {
+		s.OnMessagesGetInlineBotResults(h.MessagesGetInlineBotResults)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetInlineBotResults(ctx context.Context, request *MessagesSetInlineBotResultsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSetInlineBotResults(h.MessagesSetInlineBotResults)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendInlineBotResult(ctx context.Context, request *MessagesSendInlineBotResultRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendInlineBotResult(h.MessagesSendInlineBotResult)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMessageEditData(ctx context.Context, request *MessagesGetMessageEditDataRequest) (*MessagesMessageEditData, error)
+	}); ok {
+		s.OnMessagesGetMessageEditData(h.MessagesGetMessageEditData)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditMessage(ctx context.Context, request *MessagesEditMessageRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesEditMessage(h.MessagesEditMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditInlineBotMessage(ctx context.Context, request *MessagesEditInlineBotMessageRequest) (bool, error)
+	}); ok {
+		s.OnMessagesEditInlineBotMessage(h.MessagesEditInlineBotMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetBotCallbackAnswer(ctx context.Context, request *MessagesGetBotCallbackAnswerRequest) (*MessagesBotCallbackAnswer, error)
+	}); ok {
+		s.OnMessagesGetBotCallbackAnswer(h.MessagesGetBotCallbackAnswer)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetBotCallbackAnswer(ctx context.Context, request *MessagesSetBotCallbackAnswerRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSetBotCallbackAnswer(h.MessagesSetBotCallbackAnswer)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPeerDialogs(ctx context.Context, peers []InputDialogPeerClass) (*MessagesPeerDialogs, error)
+	}); ok {
+		s.OnMessagesGetPeerDialogs(h.MessagesGetPeerDialogs)
+	}
+	if h, ok := impl.(interface {
+		MessagesSaveDraft(ctx context.Context, request *MessagesSaveDraftRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSaveDraft(h.MessagesSaveDraft)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAllDrafts(ctx context.Context) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesGetAllDrafts(h.MessagesGetAllDrafts)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetFeaturedStickers(ctx context.Context, hash int64) (MessagesFeaturedStickersClass, error)
+	}); ok {
+		s.OnMessagesGetFeaturedStickers(h.MessagesGetFeaturedStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadFeaturedStickers(ctx context.Context, id []int64) (bool, error)
+	}); ok {
+		s.OnMessagesReadFeaturedStickers(h.MessagesReadFeaturedStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetRecentStickers(ctx context.Context, request *MessagesGetRecentStickersRequest) (MessagesRecentStickersClass, error)
+	}); ok {
+		s.OnMessagesGetRecentStickers(h.MessagesGetRecentStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesSaveRecentSticker(ctx context.Context, request *MessagesSaveRecentStickerRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSaveRecentSticker(h.MessagesSaveRecentSticker)
+	}
+	if h, ok := impl.(interface {
+		MessagesClearRecentStickers(ctx context.Context, request *MessagesClearRecentStickersRequest) (bool, error)
+	}); ok {
+		s.OnMessagesClearRecentStickers(h.MessagesClearRecentStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetArchivedStickers(ctx context.Context, request *MessagesGetArchivedStickersRequest) (*MessagesArchivedStickers, error)
+	}); ok {
+		s.OnMessagesGetArchivedStickers(h.MessagesGetArchivedStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMaskStickers(ctx context.Context, hash int64) (MessagesAllStickersClass, error)
+	}); ok {
+		s.OnMessagesGetMaskStickers(h.MessagesGetMaskStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAttachedStickers(ctx context.Context, media InputStickeredMediaClass) ([]StickerSetCoveredClass, error)
+	}); ok {
+		s.OnMessagesGetAttachedStickers(h.MessagesGetAttachedStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetGameScore(ctx context.Context, request *MessagesSetGameScoreRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSetGameScore(h.MessagesSetGameScore)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetInlineGameScore(ctx context.Context, request *MessagesSetInlineGameScoreRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSetInlineGameScore(h.MessagesSetInlineGameScore)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetGameHighScores(ctx context.Context, request *MessagesGetGameHighScoresRequest) (*MessagesHighScores, error)
+	}); ok {
+		s.OnMessagesGetGameHighScores(h.MessagesGetGameHighScores)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetInlineGameHighScores(ctx context.Context, request *MessagesGetInlineGameHighScoresRequest) (*MessagesHighScores, error)
+	}); ok {
+		s.OnMessagesGetInlineGameHighScores(h.MessagesGetInlineGameHighScores)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetCommonChats(ctx context.Context, request *MessagesGetCommonChatsRequest) (MessagesChatsClass, error)
+	}); ok {
+		s.OnMessagesGetCommonChats(h.MessagesGetCommonChats)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetWebPage(ctx context.Context, request *MessagesGetWebPageRequest) (*MessagesWebPage, error)
+	}); ok {
+		s.OnMessagesGetWebPage(h.MessagesGetWebPage)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleDialogPin(ctx context.Context, request *MessagesToggleDialogPinRequest) (bool, error)
+	}); ok {
+		s.OnMessagesToggleDialogPin(h.MessagesToggleDialogPin)
+	}
+	if h, ok := impl.(interface {
+		MessagesReorderPinnedDialogs(ctx context.Context, request *MessagesReorderPinnedDialogsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReorderPinnedDialogs(h.MessagesReorderPinnedDialogs)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPinnedDialogs(ctx context.Context, folderid int) (*MessagesPeerDialogs, error)
+	}); ok {
+		s.OnMessagesGetPinnedDialogs(h.MessagesGetPinnedDialogs)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetBotShippingResults(ctx context.Context, request *MessagesSetBotShippingResultsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSetBotShippingResults(h.MessagesSetBotShippingResults)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetBotPrecheckoutResults(ctx context.Context, request *MessagesSetBotPrecheckoutResultsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSetBotPrecheckoutResults(h.MessagesSetBotPrecheckoutResults)
+	}
+	if h, ok := impl.(interface {
+		MessagesUploadMedia(ctx context.Context, request *MessagesUploadMediaRequest) (MessageMediaClass, error)
+	}); ok {
+		s.OnMessagesUploadMedia(h.MessagesUploadMedia)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendScreenshotNotification(ctx context.Context, request *MessagesSendScreenshotNotificationRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendScreenshotNotification(h.MessagesSendScreenshotNotification)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetFavedStickers(ctx context.Context, hash int64) (MessagesFavedStickersClass, error)
+	}); ok {
+		s.OnMessagesGetFavedStickers(h.MessagesGetFavedStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesFaveSticker(ctx context.Context, request *MessagesFaveStickerRequest) (bool, error)
+	}); ok {
+		s.OnMessagesFaveSticker(h.MessagesFaveSticker)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetUnreadMentions(ctx context.Context, request *MessagesGetUnreadMentionsRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetUnreadMentions(h.MessagesGetUnreadMentions)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadMentions(ctx context.Context, request *MessagesReadMentionsRequest) (*MessagesAffectedHistory, error)
+	}); ok {
+		s.OnMessagesReadMentions(h.MessagesReadMentions)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetRecentLocations(ctx context.Context, request *MessagesGetRecentLocationsRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetRecentLocations(h.MessagesGetRecentLocations)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendMultiMedia(ctx context.Context, request *MessagesSendMultiMediaRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendMultiMedia(h.MessagesSendMultiMedia)
+	}
+	if h, ok := impl.(interface {
+		MessagesUploadEncryptedFile(ctx context.Context, request *MessagesUploadEncryptedFileRequest) (EncryptedFileClass, error)
+	}); ok {
+		s.OnMessagesUploadEncryptedFile(h.MessagesUploadEncryptedFile)
+	}
+	if h, ok := impl.(interface {
+		MessagesSearchStickerSets(ctx context.Context, request *MessagesSearchStickerSetsRequest) (MessagesFoundStickerSetsClass, error)
+	}); ok {
+		s.OnMessagesSearchStickerSets(h.MessagesSearchStickerSets)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSplitRanges(ctx context.Context) ([]MessageRange, error)
+	}); ok {
+		s.OnMessagesGetSplitRanges(h.MessagesGetSplitRanges)
+	}
+	if h, ok := impl.(interface {
+		MessagesMarkDialogUnread(ctx context.Context, request *MessagesMarkDialogUnreadRequest) (bool, error)
+	}); ok {
+		s.OnMessagesMarkDialogUnread(h.MessagesMarkDialogUnread)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDialogUnreadMarks(ctx context.Context, request *MessagesGetDialogUnreadMarksRequest) ([]DialogPeerClass, error)
+	}); ok {
+		s.OnMessagesGetDialogUnreadMarks(h.MessagesGetDialogUnreadMarks)
+	}
+	if h, ok := impl.(interface {
+		MessagesClearAllDrafts(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnMessagesClearAllDrafts(h.MessagesClearAllDrafts)
+	}
+	if h, ok := impl.(interface {
+		MessagesUpdatePinnedMessage(ctx context.Context, request *MessagesUpdatePinnedMessageRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesUpdatePinnedMessage(h.MessagesUpdatePinnedMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendVote(ctx context.Context, request *MessagesSendVoteRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendVote(h.MessagesSendVote)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPollResults(ctx context.Context, request *MessagesGetPollResultsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesGetPollResults(h.MessagesGetPollResults)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetOnlines(ctx context.Context, peer InputPeerClass) (*ChatOnlines, error)
+	}); ok {
+		s.OnMessagesGetOnlines(h.MessagesGetOnlines)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditChatAbout(ctx context.Context, request *MessagesEditChatAboutRequest) (bool, error)
+	}); ok {
+		s.OnMessagesEditChatAbout(h.MessagesEditChatAbout)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditChatDefaultBannedRights(ctx context.Context, request *MessagesEditChatDefaultBannedRightsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesEditChatDefaultBannedRights(h.MessagesEditChatDefaultBannedRights)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiKeywords(ctx context.Context, langcode string) (*EmojiKeywordsDifference, error)
+	}); ok {
+		s.OnMessagesGetEmojiKeywords(h.MessagesGetEmojiKeywords)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiKeywordsDifference(ctx context.Context, request *MessagesGetEmojiKeywordsDifferenceRequest) (*EmojiKeywordsDifference, error)
+	}); ok {
+		s.OnMessagesGetEmojiKeywordsDifference(h.MessagesGetEmojiKeywordsDifference)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiKeywordsLanguages(ctx context.Context, langcodes []string) ([]EmojiLanguage, error)
+	}); ok {
+		s.OnMessagesGetEmojiKeywordsLanguages(h.MessagesGetEmojiKeywordsLanguages)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiURL(ctx context.Context, langcode string) (*EmojiURL, error)
+	}); ok {
+		s.OnMessagesGetEmojiURL(h.MessagesGetEmojiURL)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSearchCounters(ctx context.Context, request *MessagesGetSearchCountersRequest) ([]MessagesSearchCounter, error)
+	}); ok {
+		s.OnMessagesGetSearchCounters(h.MessagesGetSearchCounters)
+	}
+	if h, ok := impl.(interface {
+		MessagesRequestURLAuth(ctx context.Context, request *MessagesRequestURLAuthRequest) (URLAuthResultClass, error)
+	}); ok {
+		s.OnMessagesRequestURLAuth(h.MessagesRequestURLAuth)
+	}
+	if h, ok := impl.(interface {
+		MessagesAcceptURLAuth(ctx context.Context, request *MessagesAcceptURLAuthRequest) (URLAuthResultClass, error)
+	}); ok {
+		s.OnMessagesAcceptURLAuth(h.MessagesAcceptURLAuth)
+	}
+	if h, ok := impl.(interface {
+		MessagesHidePeerSettingsBar(ctx context.Context, peer InputPeerClass) (bool, error)
+	}); ok {
+		s.OnMessagesHidePeerSettingsBar(h.MessagesHidePeerSettingsBar)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetScheduledHistory(ctx context.Context, request *MessagesGetScheduledHistoryRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetScheduledHistory(h.MessagesGetScheduledHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetScheduledMessages(ctx context.Context, request *MessagesGetScheduledMessagesRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetScheduledMessages(h.MessagesGetScheduledMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendScheduledMessages(ctx context.Context, request *MessagesSendScheduledMessagesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendScheduledMessages(h.MessagesSendScheduledMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteScheduledMessages(ctx context.Context, request *MessagesDeleteScheduledMessagesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesDeleteScheduledMessages(h.MessagesDeleteScheduledMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPollVotes(ctx context.Context, request *MessagesGetPollVotesRequest) (*MessagesVotesList, error)
+	}); ok {
+		s.OnMessagesGetPollVotes(h.MessagesGetPollVotes)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleStickerSets(ctx context.Context, request *MessagesToggleStickerSetsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesToggleStickerSets(h.MessagesToggleStickerSets)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDialogFilters(ctx context.Context) (*MessagesDialogFilters, error)
+	}); ok {
+		s.OnMessagesGetDialogFilters(h.MessagesGetDialogFilters)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSuggestedDialogFilters(ctx context.Context) ([]DialogFilterSuggested, error)
+	}); ok {
+		s.OnMessagesGetSuggestedDialogFilters(h.MessagesGetSuggestedDialogFilters)
+	}
+	if h, ok := impl.(interface {
+		MessagesUpdateDialogFilter(ctx context.Context, request *MessagesUpdateDialogFilterRequest) (bool, error)
+	}); ok {
+		s.OnMessagesUpdateDialogFilter(h.MessagesUpdateDialogFilter)
+	}
+	if h, ok := impl.(interface {
+		MessagesUpdateDialogFiltersOrder(ctx context.Context, order []int) (bool, error)
+	}); ok {
+		s.OnMessagesUpdateDialogFiltersOrder(h.MessagesUpdateDialogFiltersOrder)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetOldFeaturedStickers(ctx context.Context, request *MessagesGetOldFeaturedStickersRequest) (MessagesFeaturedStickersClass, error)
+	}); ok {
+		s.OnMessagesGetOldFeaturedStickers(h.MessagesGetOldFeaturedStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetReplies(ctx context.Context, request *MessagesGetRepliesRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetReplies(h.MessagesGetReplies)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDiscussionMessage(ctx context.Context, request *MessagesGetDiscussionMessageRequest) (*MessagesDiscussionMessage, error)
+	}); ok {
+		s.OnMessagesGetDiscussionMessage(h.MessagesGetDiscussionMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadDiscussion(ctx context.Context, request *MessagesReadDiscussionRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReadDiscussion(h.MessagesReadDiscussion)
+	}
+	if h, ok := impl.(interface {
+		MessagesUnpinAllMessages(ctx context.Context, request *MessagesUnpinAllMessagesRequest) (*MessagesAffectedHistory, error)
+	}); ok {
+		s.OnMessagesUnpinAllMessages(h.MessagesUnpinAllMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteChat(ctx context.Context, chatid int64) (bool, error)
+	}); ok {
+		s.OnMessagesDeleteChat(h.MessagesDeleteChat)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeletePhoneCallHistory(ctx context.Context, request *MessagesDeletePhoneCallHistoryRequest) (*MessagesAffectedFoundMessages, error)
+	}); ok {
+		s.OnMessagesDeletePhoneCallHistory(h.MessagesDeletePhoneCallHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesCheckHistoryImport(ctx context.Context, importhead string) (*MessagesHistoryImportParsed, error)
+	}); ok {
+		s.OnMessagesCheckHistoryImport(h.MessagesCheckHistoryImport)
+	}
+	if h, ok := impl.(interface {
+		MessagesInitHistoryImport(ctx context.Context, request *MessagesInitHistoryImportRequest) (*MessagesHistoryImport, error)
+	}); ok {
+		s.OnMessagesInitHistoryImport(h.MessagesInitHistoryImport)
+	}
+	if h, ok := impl.(interface {
+		MessagesUploadImportedMedia(ctx context.Context, request *MessagesUploadImportedMediaRequest) (MessageMediaClass, error)
+	}); ok {
+		s.OnMessagesUploadImportedMedia(h.MessagesUploadImportedMedia)
+	}
+	if h, ok := impl.(interface {
+		MessagesStartHistoryImport(ctx context.Context, request *MessagesStartHistoryImportRequest) (bool, error)
+	}); ok {
+		s.OnMessagesStartHistoryImport(h.MessagesStartHistoryImport)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetExportedChatInvites(ctx context.Context, request *MessagesGetExportedChatInvitesRequest) (*MessagesExportedChatInvites, error)
+	}); ok {
+		s.OnMessagesGetExportedChatInvites(h.MessagesGetExportedChatInvites)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetExportedChatInvite(ctx context.Context, request *MessagesGetExportedChatInviteRequest) (MessagesExportedChatInviteClass, error)
+	}); ok {
+		s.OnMessagesGetExportedChatInvite(h.MessagesGetExportedChatInvite)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditExportedChatInvite(ctx context.Context, request *MessagesEditExportedChatInviteRequest) (MessagesExportedChatInviteClass, error)
+	}); ok {
+		s.OnMessagesEditExportedChatInvite(h.MessagesEditExportedChatInvite)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteRevokedExportedChatInvites(ctx context.Context, request *MessagesDeleteRevokedExportedChatInvitesRequest) (bool, error)
+	}); ok {
+		s.OnMessagesDeleteRevokedExportedChatInvites(h.MessagesDeleteRevokedExportedChatInvites)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteExportedChatInvite(ctx context.Context, request *MessagesDeleteExportedChatInviteRequest) (bool, error)
+	}); ok {
+		s.OnMessagesDeleteExportedChatInvite(h.MessagesDeleteExportedChatInvite)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAdminsWithInvites(ctx context.Context, peer InputPeerClass) (*MessagesChatAdminsWithInvites, error)
+	}); ok {
+		s.OnMessagesGetAdminsWithInvites(h.MessagesGetAdminsWithInvites)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetChatInviteImporters(ctx context.Context, request *MessagesGetChatInviteImportersRequest) (*MessagesChatInviteImporters, error)
+	}); ok {
+		s.OnMessagesGetChatInviteImporters(h.MessagesGetChatInviteImporters)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetHistoryTTL(ctx context.Context, request *MessagesSetHistoryTTLRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSetHistoryTTL(h.MessagesSetHistoryTTL)
+	}
+	if h, ok := impl.(interface {
+		MessagesCheckHistoryImportPeer(ctx context.Context, peer InputPeerClass) (*MessagesCheckedHistoryImportPeer, error)
+	}); ok {
+		s.OnMessagesCheckHistoryImportPeer(h.MessagesCheckHistoryImportPeer)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetChatTheme(ctx context.Context, request *MessagesSetChatThemeRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSetChatTheme(h.MessagesSetChatTheme)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMessageReadParticipants(ctx context.Context, request *MessagesGetMessageReadParticipantsRequest) ([]ReadParticipantDate, error)
+	}); ok {
+		s.OnMessagesGetMessageReadParticipants(h.MessagesGetMessageReadParticipants)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSearchResultsCalendar(ctx context.Context, request *MessagesGetSearchResultsCalendarRequest) (*MessagesSearchResultsCalendar, error)
+	}); ok {
+		s.OnMessagesGetSearchResultsCalendar(h.MessagesGetSearchResultsCalendar)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSearchResultsPositions(ctx context.Context, request *MessagesGetSearchResultsPositionsRequest) (*MessagesSearchResultsPositions, error)
+	}); ok {
+		s.OnMessagesGetSearchResultsPositions(h.MessagesGetSearchResultsPositions)
+	}
+	if h, ok := impl.(interface {
+		MessagesHideChatJoinRequest(ctx context.Context, request *MessagesHideChatJoinRequestRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesHideChatJoinRequest(h.MessagesHideChatJoinRequest)
+	}
+	if h, ok := impl.(interface {
+		MessagesHideAllChatJoinRequests(ctx context.Context, request *MessagesHideAllChatJoinRequestsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesHideAllChatJoinRequests(h.MessagesHideAllChatJoinRequests)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleNoForwards(ctx context.Context, request *MessagesToggleNoForwardsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesToggleNoForwards(h.MessagesToggleNoForwards)
+	}
+	if h, ok := impl.(interface {
+		MessagesSaveDefaultSendAs(ctx context.Context, request *MessagesSaveDefaultSendAsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesSaveDefaultSendAs(h.MessagesSaveDefaultSendAs)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendReaction(ctx context.Context, request *MessagesSendReactionRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendReaction(h.MessagesSendReaction)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMessagesReactions(ctx context.Context, request *MessagesGetMessagesReactionsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesGetMessagesReactions(h.MessagesGetMessagesReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMessageReactionsList(ctx context.Context, request *MessagesGetMessageReactionsListRequest) (*MessagesMessageReactionsList, error)
+	}); ok {
+		s.OnMessagesGetMessageReactionsList(h.MessagesGetMessageReactionsList)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetChatAvailableReactions(ctx context.Context, request *MessagesSetChatAvailableReactionsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSetChatAvailableReactions(h.MessagesSetChatAvailableReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAvailableReactions(ctx context.Context, hash int) (MessagesAvailableReactionsClass, error)
+	}); ok {
+		s.OnMessagesGetAvailableReactions(h.MessagesGetAvailableReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetDefaultReaction(ctx context.Context, reaction ReactionClass) (bool, error)
+	}); ok {
+		s.OnMessagesSetDefaultReaction(h.MessagesSetDefaultReaction)
+	}
+	if h, ok := impl.(interface {
+		MessagesTranslateText(ctx context.Context, request *MessagesTranslateTextRequest) (*MessagesTranslateResult, error)
+	}); ok {
+		s.OnMessagesTranslateText(h.MessagesTranslateText)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetUnreadReactions(ctx context.Context, request *MessagesGetUnreadReactionsRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetUnreadReactions(h.MessagesGetUnreadReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadReactions(ctx context.Context, request *MessagesReadReactionsRequest) (*MessagesAffectedHistory, error)
+	}); ok {
+		s.OnMessagesReadReactions(h.MessagesReadReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesSearchSentMedia(ctx context.Context, request *MessagesSearchSentMediaRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesSearchSentMedia(h.MessagesSearchSentMedia)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAttachMenuBots(ctx context.Context, hash int64) (AttachMenuBotsClass, error)
+	}); ok {
+		s.OnMessagesGetAttachMenuBots(h.MessagesGetAttachMenuBots)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAttachMenuBot(ctx context.Context, bot InputUserClass) (*AttachMenuBotsBot, error)
+	}); ok {
+		s.OnMessagesGetAttachMenuBot(h.MessagesGetAttachMenuBot)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleBotInAttachMenu(ctx context.Context, request *MessagesToggleBotInAttachMenuRequest) (bool, error)
+	}); ok {
+		s.OnMessagesToggleBotInAttachMenu(h.MessagesToggleBotInAttachMenu)
+	}
+	if h, ok := impl.(interface {
+		MessagesRequestWebView(ctx context.Context, request *MessagesRequestWebViewRequest) (*WebViewResultURL, error)
+	}); ok {
+		s.OnMessagesRequestWebView(h.MessagesRequestWebView)
+	}
+	if h, ok := impl.(interface {
+		MessagesProlongWebView(ctx context.Context, request *MessagesProlongWebViewRequest) (bool, error)
+	}); ok {
+		s.OnMessagesProlongWebView(h.MessagesProlongWebView)
+	}
+	if h, ok := impl.(interface {
+		MessagesRequestSimpleWebView(ctx context.Context, request *MessagesRequestSimpleWebViewRequest) (*WebViewResultURL, error)
+	}); ok {
+		s.OnMessagesRequestSimpleWebView(h.MessagesRequestSimpleWebView)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendWebViewResultMessage(ctx context.Context, request *MessagesSendWebViewResultMessageRequest) (*WebViewMessageSent, error)
+	}); ok {
+		s.OnMessagesSendWebViewResultMessage(h.MessagesSendWebViewResultMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendWebViewData(ctx context.Context, request *MessagesSendWebViewDataRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendWebViewData(h.MessagesSendWebViewData)
+	}
+	if h, ok := impl.(interface {
+		MessagesTranscribeAudio(ctx context.Context, request *MessagesTranscribeAudioRequest) (*MessagesTranscribedAudio, error)
+	}); ok {
+		s.OnMessagesTranscribeAudio(h.MessagesTranscribeAudio)
+	}
+	if h, ok := impl.(interface {
+		MessagesRateTranscribedAudio(ctx context.Context, request *MessagesRateTranscribedAudioRequest) (bool, error)
+	}); ok {
+		s.OnMessagesRateTranscribedAudio(h.MessagesRateTranscribedAudio)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetCustomEmojiDocuments(ctx context.Context, documentid []int64) ([]DocumentClass, error)
+	}); ok {
+		s.OnMessagesGetCustomEmojiDocuments(h.MessagesGetCustomEmojiDocuments)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiStickers(ctx context.Context, hash int64) (MessagesAllStickersClass, error)
+	}); ok {
+		s.OnMessagesGetEmojiStickers(h.MessagesGetEmojiStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetFeaturedEmojiStickers(ctx context.Context, hash int64) (MessagesFeaturedStickersClass, error)
+	}); ok {
+		s.OnMessagesGetFeaturedEmojiStickers(h.MessagesGetFeaturedEmojiStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesReportReaction(ctx context.Context, request *MessagesReportReactionRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReportReaction(h.MessagesReportReaction)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetTopReactions(ctx context.Context, request *MessagesGetTopReactionsRequest) (MessagesReactionsClass, error)
+	}); ok {
+		s.OnMessagesGetTopReactions(h.MessagesGetTopReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetRecentReactions(ctx context.Context, request *MessagesGetRecentReactionsRequest) (MessagesReactionsClass, error)
+	}); ok {
+		s.OnMessagesGetRecentReactions(h.MessagesGetRecentReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesClearRecentReactions(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnMessagesClearRecentReactions(h.MessagesClearRecentReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetExtendedMedia(ctx context.Context, request *MessagesGetExtendedMediaRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesGetExtendedMedia(h.MessagesGetExtendedMedia)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetDefaultHistoryTTL(ctx context.Context, period int) (bool, error)
+	}); ok {
+		s.OnMessagesSetDefaultHistoryTTL(h.MessagesSetDefaultHistoryTTL)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDefaultHistoryTTL(ctx context.Context) (*DefaultHistoryTTL, error)
+	}); ok {
+		s.OnMessagesGetDefaultHistoryTTL(h.MessagesGetDefaultHistoryTTL)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendBotRequestedPeer(ctx context.Context, request *MessagesSendBotRequestedPeerRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendBotRequestedPeer(h.MessagesSendBotRequestedPeer)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	}); ok {
+		s.OnMessagesGetEmojiGroups(h.MessagesGetEmojiGroups)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiStatusGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	}); ok {
+		s.OnMessagesGetEmojiStatusGroups(h.MessagesGetEmojiStatusGroups)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiProfilePhotoGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	}); ok {
+		s.OnMessagesGetEmojiProfilePhotoGroups(h.MessagesGetEmojiProfilePhotoGroups)
+	}
+	if h, ok := impl.(interface {
+		MessagesSearchCustomEmoji(ctx context.Context, request *MessagesSearchCustomEmojiRequest) (EmojiListClass, error)
+	}); ok {
+		s.OnMessagesSearchCustomEmoji(h.MessagesSearchCustomEmoji)
+	}
+	if h, ok := impl.(interface {
+		MessagesTogglePeerTranslations(ctx context.Context, request *MessagesTogglePeerTranslationsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesTogglePeerTranslations(h.MessagesTogglePeerTranslations)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetBotApp(ctx context.Context, request *MessagesGetBotAppRequest) (*MessagesBotApp, error)
+	}); ok {
+		s.OnMessagesGetBotApp(h.MessagesGetBotApp)
+	}
+	if h, ok := impl.(interface {
+		MessagesRequestAppWebView(ctx context.Context, request *MessagesRequestAppWebViewRequest) (*WebViewResultURL, error)
+	}); ok {
+		s.OnMessagesRequestAppWebView(h.MessagesRequestAppWebView)
+	}
+	if h, ok := impl.(interface {
+		MessagesSetChatWallPaper(ctx context.Context, request *MessagesSetChatWallPaperRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSetChatWallPaper(h.MessagesSetChatWallPaper)
+	}
+	if h, ok := impl.(interface {
+		MessagesSearchEmojiStickerSets(ctx context.Context, request *MessagesSearchEmojiStickerSetsRequest) (MessagesFoundStickerSetsClass, error)
+	}); ok {
+		s.OnMessagesSearchEmojiStickerSets(h.MessagesSearchEmojiStickerSets)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSavedDialogs(ctx context.Context, request *MessagesGetSavedDialogsRequest) (MessagesSavedDialogsClass, error)
+	}); ok {
+		s.OnMessagesGetSavedDialogs(h.MessagesGetSavedDialogs)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSavedHistory(ctx context.Context, request *MessagesGetSavedHistoryRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetSavedHistory(h.MessagesGetSavedHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteSavedHistory(ctx context.Context, request *MessagesDeleteSavedHistoryRequest) (*MessagesAffectedHistory, error)
+	}); ok {
+		s.OnMessagesDeleteSavedHistory(h.MessagesDeleteSavedHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPinnedSavedDialogs(ctx context.Context) (MessagesSavedDialogsClass, error)
+	}); ok {
+		s.OnMessagesGetPinnedSavedDialogs(h.MessagesGetPinnedSavedDialogs)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleSavedDialogPin(ctx context.Context, request *MessagesToggleSavedDialogPinRequest) (bool, error)
+	}); ok {
+		s.OnMessagesToggleSavedDialogPin(h.MessagesToggleSavedDialogPin)
+	}
+	if h, ok := impl.(interface {
+		MessagesReorderPinnedSavedDialogs(ctx context.Context, request *MessagesReorderPinnedSavedDialogsRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReorderPinnedSavedDialogs(h.MessagesReorderPinnedSavedDialogs)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSavedReactionTags(ctx context.Context, request *MessagesGetSavedReactionTagsRequest) (MessagesSavedReactionTagsClass, error)
+	}); ok {
+		s.OnMessagesGetSavedReactionTags(h.MessagesGetSavedReactionTags)
+	}
+	if h, ok := impl.(interface {
+		MessagesUpdateSavedReactionTag(ctx context.Context, request *MessagesUpdateSavedReactionTagRequest) (bool, error)
+	}); ok {
+		s.OnMessagesUpdateSavedReactionTag(h.MessagesUpdateSavedReactionTag)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetDefaultTagReactions(ctx context.Context, hash int64) (MessagesReactionsClass, error)
+	}); ok {
+		s.OnMessagesGetDefaultTagReactions(h.MessagesGetDefaultTagReactions)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetOutboxReadDate(ctx context.Context, request *MessagesGetOutboxReadDateRequest) (*OutboxReadDate, error)
+	}); ok {
+		s.OnMessagesGetOutboxReadDate(h.MessagesGetOutboxReadDate)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetQuickReplies(ctx context.Context, hash int64) (MessagesQuickRepliesClass, error)
+	}); ok {
+		s.OnMessagesGetQuickReplies(h.MessagesGetQuickReplies)
+	}
+	if h, ok := impl.(interface {
+		MessagesReorderQuickReplies(ctx context.Context, order []int) (bool, error)
+	}); ok {
+		s.OnMessagesReorderQuickReplies(h.MessagesReorderQuickReplies)
+	}
+	if h, ok := impl.(interface {
+		MessagesCheckQuickReplyShortcut(ctx context.Context, shortcut string) (bool, error)
+	}); ok {
+		s.OnMessagesCheckQuickReplyShortcut(h.MessagesCheckQuickReplyShortcut)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditQuickReplyShortcut(ctx context.Context, request *MessagesEditQuickReplyShortcutRequest) (bool, error)
+	}); ok {
+		s.OnMessagesEditQuickReplyShortcut(h.MessagesEditQuickReplyShortcut)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteQuickReplyShortcut(ctx context.Context, shortcutid int) (bool, error)
+	}); ok {
+		s.OnMessagesDeleteQuickReplyShortcut(h.MessagesDeleteQuickReplyShortcut)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetQuickReplyMessages(ctx context.Context, request *MessagesGetQuickReplyMessagesRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetQuickReplyMessages(h.MessagesGetQuickReplyMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendQuickReplyMessages(ctx context.Context, request *MessagesSendQuickReplyMessagesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendQuickReplyMessages(h.MessagesSendQuickReplyMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteQuickReplyMessages(ctx context.Context, request *MessagesDeleteQuickReplyMessagesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesDeleteQuickReplyMessages(h.MessagesDeleteQuickReplyMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleDialogFilterTags(ctx context.Context, enabled bool) (bool, error)
+	}); ok {
+		s.OnMessagesToggleDialogFilterTags(h.MessagesToggleDialogFilterTags)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetMyStickers(ctx context.Context, request *MessagesGetMyStickersRequest) (*MessagesMyStickers, error)
+	}); ok {
+		s.OnMessagesGetMyStickers(h.MessagesGetMyStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetEmojiStickerGroups(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)
+	}); ok {
+		s.OnMessagesGetEmojiStickerGroups(h.MessagesGetEmojiStickerGroups)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetAvailableEffects(ctx context.Context, hash int) (MessagesAvailableEffectsClass, error)
+	}); ok {
+		s.OnMessagesGetAvailableEffects(h.MessagesGetAvailableEffects)
+	}
+	if h, ok := impl.(interface {
+		MessagesEditFactCheck(ctx context.Context, request *MessagesEditFactCheckRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesEditFactCheck(h.MessagesEditFactCheck)
+	}
+	if h, ok := impl.(interface {
+		MessagesDeleteFactCheck(ctx context.Context, request *MessagesDeleteFactCheckRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesDeleteFactCheck(h.MessagesDeleteFactCheck)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetFactCheck(ctx context.Context, request *MessagesGetFactCheckRequest) ([]FactCheck, error)
+	}); ok {
+		s.OnMessagesGetFactCheck(h.MessagesGetFactCheck)
+	}
+	if h, ok := impl.(interface {
+		MessagesRequestMainWebView(ctx context.Context, request *MessagesRequestMainWebViewRequest) (*WebViewResultURL, error)
+	}); ok {
+		s.OnMessagesRequestMainWebView(h.MessagesRequestMainWebView)
+	}
+	if h, ok := impl.(interface {
+		MessagesSendPaidReaction(ctx context.Context, request *MessagesSendPaidReactionRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesSendPaidReaction(h.MessagesSendPaidReaction)
+	}
+	if h, ok := impl.(interface {
+		MessagesTogglePaidReactionPrivacy(ctx context.Context, request *MessagesTogglePaidReactionPrivacyRequest) (bool, error)
+	}); ok {
+		s.OnMessagesTogglePaidReactionPrivacy(h.MessagesTogglePaidReactionPrivacy)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPaidReactionPrivacy(ctx context.Context) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesGetPaidReactionPrivacy(h.MessagesGetPaidReactionPrivacy)
+	}
+	if h, ok := impl.(interface {
+		MessagesViewSponsoredMessage(ctx context.Context, randomid []byte) (bool, error)
+	}); ok {
+		s.OnMessagesViewSponsoredMessage(h.MessagesViewSponsoredMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesClickSponsoredMessage(ctx context.Context, request *MessagesClickSponsoredMessageRequest) (bool, error)
+	}); ok {
+		s.OnMessagesClickSponsoredMessage(h.MessagesClickSponsoredMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesReportSponsoredMessage(ctx context.Context, request *MessagesReportSponsoredMessageRequest) (ChannelsSponsoredMessageReportResultClass, error)
+	}); ok {
+		s.OnMessagesReportSponsoredMessage(h.MessagesReportSponsoredMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSponsoredMessages(ctx context.Context, request *MessagesGetSponsoredMessagesRequest) (MessagesSponsoredMessagesClass, error)
+	}); ok {
+		s.OnMessagesGetSponsoredMessages(h.MessagesGetSponsoredMessages)
+	}
+	if h, ok := impl.(interface {
+		MessagesSavePreparedInlineMessage(ctx context.Context, request *MessagesSavePreparedInlineMessageRequest) (*MessagesBotPreparedInlineMessage, error)
+	}); ok {
+		s.OnMessagesSavePreparedInlineMessage(h.MessagesSavePreparedInlineMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetPreparedInlineMessage(ctx context.Context, request *MessagesGetPreparedInlineMessageRequest) (*MessagesPreparedInlineMessage, error)
+	}); ok {
+		s.OnMessagesGetPreparedInlineMessage(h.MessagesGetPreparedInlineMessage)
+	}
+	if h, ok := impl.(interface {
+		MessagesSearchStickers(ctx context.Context, request *MessagesSearchStickersRequest) (MessagesFoundStickersClass, error)
+	}); ok {
+		s.OnMessagesSearchStickers(h.MessagesSearchStickers)
+	}
+	if h, ok := impl.(interface {
+		MessagesReportMessagesDelivery(ctx context.Context, request *MessagesReportMessagesDeliveryRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReportMessagesDelivery(h.MessagesReportMessagesDelivery)
+	}
+	if h, ok := impl.(interface {
+		MessagesGetSavedDialogsByID(ctx context.Context, request *MessagesGetSavedDialogsByIDRequest) (MessagesSavedDialogsClass, error)
+	}); ok {
+		s.OnMessagesGetSavedDialogsByID(h.MessagesGetSavedDialogsByID)
+	}
+	if h, ok := impl.(interface {
+		MessagesReadSavedHistory(ctx context.Context, request *MessagesReadSavedHistoryRequest) (bool, error)
+	}); ok {
+		s.OnMessagesReadSavedHistory(h.MessagesReadSavedHistory)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleTodoCompleted(ctx context.Context, request *MessagesToggleTodoCompletedRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesToggleTodoCompleted(h.MessagesToggleTodoCompleted)
+	}
+	if h, ok := impl.(interface {
+		MessagesAppendTodoList(ctx context.Context, request *MessagesAppendTodoListRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesAppendTodoList(h.MessagesAppendTodoList)
+	}
+	if h, ok := impl.(interface {
+		MessagesToggleSuggestedPostApproval(ctx context.Context, request *MessagesToggleSuggestedPostApprovalRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnMessagesToggleSuggestedPostApproval(h.MessagesToggleSuggestedPostApproval)
+	}
+	if h, ok := impl.(interface {
+		UpdatesGetState(ctx context.Context) (*UpdatesState, error)
+	}); ok {
+		s.OnUpdatesGetState(h.UpdatesGetState)
+	}
+	if h, ok := impl.(interface {
+		UpdatesGetDifference(ctx context.Context, request *UpdatesGetDifferenceRequest) (UpdatesDifferenceClass, error)
+	}); ok {
+		s.OnUpdatesGetDifference(h.UpdatesGetDifference)
+	}
+	if h, ok := impl.(interface {
+		UpdatesGetChannelDifference(ctx context.Context, request *UpdatesGetChannelDifferenceRequest) (UpdatesChannelDifferenceClass, error)
+	}); ok {
+		s.OnUpdatesGetChannelDifference(h.UpdatesGetChannelDifference)
+	}
+	if h, ok := impl.(interface {
+		PhotosUpdateProfilePhoto(ctx context.Context, request *PhotosUpdateProfilePhotoRequest) (*PhotosPhoto, error)
+	}); ok {
+		s.OnPhotosUpdateProfilePhoto(h.PhotosUpdateProfilePhoto)
+	}
+	if h, ok := impl.(interface {
+		PhotosUploadProfilePhoto(ctx context.Context, request *PhotosUploadProfilePhotoRequest) (*PhotosPhoto, error)
+	}); ok {
+		s.OnPhotosUploadProfilePhoto(h.PhotosUploadProfilePhoto)
+	}
+	if h, ok := impl.(interface {
+		PhotosDeletePhotos(ctx context.Context, id []InputPhotoClass) ([]int64, error)
+	}); ok {
+		s.OnPhotosDeletePhotos(h.PhotosDeletePhotos)
+	}
+	if h, ok := impl.(interface {
+		PhotosGetUserPhotos(ctx context.Context, request *PhotosGetUserPhotosRequest) (PhotosPhotosClass, error)
+	}); ok {
+		s.OnPhotosGetUserPhotos(h.PhotosGetUserPhotos)
+	}
+	if h, ok := impl.(interface {
+		PhotosUploadContactProfilePhoto(ctx context.Context, request *PhotosUploadContactProfilePhotoRequest) (*PhotosPhoto, error)
+	}); ok {
+		s.OnPhotosUploadContactProfilePhoto(h.PhotosUploadContactProfilePhoto)
+	}
+	if h, ok := impl.(interface {
+		UploadSaveFilePart(ctx context.Context, request *UploadSaveFilePartRequest) (bool, error)
+	}); ok {
+		s.OnUploadSaveFilePart(h.UploadSaveFilePart)
+	}
+	if h, ok := impl.(interface {
+		UploadGetFile(ctx context.Context, request *UploadGetFileRequest) (UploadFileClass, error)
+	}); ok {
+		s.OnUploadGetFile(h.UploadGetFile)
+	}
+	if h, ok := impl.(interface {
+		UploadSaveBigFilePart(ctx context.Context, request *UploadSaveBigFilePartRequest) (bool, error)
+	}); ok {
+		s.OnUploadSaveBigFilePart(h.UploadSaveBigFilePart)
+	}
+	if h, ok := impl.(interface {
+		UploadGetWebFile(ctx context.Context, request *UploadGetWebFileRequest) (*UploadWebFile, error)
+	}); ok {
+		s.OnUploadGetWebFile(h.UploadGetWebFile)
+	}
+	if h, ok := impl.(interface {
+		UploadGetCDNFile(ctx context.Context, request *UploadGetCDNFileRequest) (UploadCDNFileClass, error)
+	}); ok {
+		s.OnUploadGetCDNFile(h.UploadGetCDNFile)
+	}
+	if h, ok := impl.(interface {
+		UploadReuploadCDNFile(ctx context.Context, request *UploadReuploadCDNFileRequest) ([]FileHash, error)
+	}); ok {
+		s.OnUploadReuploadCDNFile(h.UploadReuploadCDNFile)
+	}
+	if h, ok := impl.(interface {
+		UploadGetCDNFileHashes(ctx context.Context, request *UploadGetCDNFileHashesRequest) ([]FileHash, error)
+	}); ok {
+		s.OnUploadGetCDNFileHashes(h.UploadGetCDNFileHashes)
+	}
+	if h, ok := impl.(interface {
+		UploadGetFileHashes(ctx context.Context, request *UploadGetFileHashesRequest) ([]FileHash, error)
+	}); ok {
+		s.OnUploadGetFileHashes(h.UploadGetFileHashes)
+	}
+	if h, ok := impl.(interface {
+		HelpGetConfig(ctx context.Context) (*Config, error)
+	}); ok {
+		s.OnHelpGetConfig(h.HelpGetConfig)
+	}
+	if h, ok := impl.(interface {
+		HelpGetNearestDC(ctx context.Context) (*NearestDC, error)
+	}); ok {
+		s.OnHelpGetNearestDC(h.HelpGetNearestDC)
+	}
+	if h, ok := impl.(interface {
+		HelpGetAppUpdate(ctx context.Context, source string) (HelpAppUpdateClass, error)
+	}); ok {
+		s.OnHelpGetAppUpdate(h.HelpGetAppUpdate)
+	}
+	if h, ok := impl.(interface {
+		HelpGetInviteText(ctx context.Context) (*HelpInviteText, error)
+	}); ok {
+		s.OnHelpGetInviteText(h.HelpGetInviteText)
+	}
+	if h, ok := impl.(interface {
+		HelpGetSupport(ctx context.Context) (*HelpSupport, error)
+	}); ok {
+		s.OnHelpGetSupport(h.HelpGetSupport)
+	}
+	if h, ok := impl.(interface {
+		HelpSetBotUpdatesStatus(ctx context.Context, request *HelpSetBotUpdatesStatusRequest) (bool, error)
+	}); ok {
+		s.OnHelpSetBotUpdatesStatus(h.HelpSetBotUpdatesStatus)
+	}
+	if h, ok := impl.(interface {
+		HelpGetCDNConfig(ctx context.Context) (*CDNConfig, error)
+	}); ok {
+		s.OnHelpGetCDNConfig(h.HelpGetCDNConfig)
+	}
+	if h, ok := impl.(interface {
+		HelpGetRecentMeURLs(ctx context.Context, referer string) (*HelpRecentMeURLs, error)
+	}); ok {
+		s.OnHelpGetRecentMeURLs(h.HelpGetRecentMeURLs)
+	}
+	if h, ok := impl.(interface {
+		HelpGetTermsOfServiceUpdate(ctx context.Context) (HelpTermsOfServiceUpdateClass, error)
+	}); ok {
+		s.OnHelpGetTermsOfServiceUpdate(h.HelpGetTermsOfServiceUpdate)
+	}
+	if h, ok := impl.(interface {
+		HelpAcceptTermsOfService(ctx context.Context, id DataJSON) (bool, error)
+	}); ok {
+		s.OnHelpAcceptTermsOfService(h.HelpAcceptTermsOfService)
+	}
+	if h, ok := impl.(interface {
+		HelpGetDeepLinkInfo(ctx context.Context, path string) (HelpDeepLinkInfoClass, error)
+	}); ok {
+		s.OnHelpGetDeepLinkInfo(h.HelpGetDeepLinkInfo)
+	}
+	if h, ok := impl.(interface {
+		HelpGetAppConfig(ctx context.Context, hash int) (HelpAppConfigClass, error)
+	}); ok {
+		s.OnHelpGetAppConfig(h.HelpGetAppConfig)
+	}
+	if h, ok := impl.(interface {
+		HelpSaveAppLog(ctx context.Context, events []InputAppEvent) (bool, error)
+	}); ok {
+		s.OnHelpSaveAppLog(h.HelpSaveAppLog)
+	}
+	if h, ok := impl.(interface {
+		HelpGetPassportConfig(ctx context.Context, hash int) (HelpPassportConfigClass, error)
+	}); ok {
+		s.OnHelpGetPassportConfig(h.HelpGetPassportConfig)
+	}
+	if h, ok := impl.(interface {
+		HelpGetSupportName(ctx context.Context) (*HelpSupportName, error)
+	}); ok {
+		s.OnHelpGetSupportName(h.HelpGetSupportName)
+	}
+	if h, ok := impl.(interface {
+		HelpGetUserInfo(ctx context.Context, userid InputUserClass) (HelpUserInfoClass, error)
+	}); ok {
+		s.OnHelpGetUserInfo(h.HelpGetUserInfo)
+	}
+	if h, ok := impl.(interface {
+		HelpEditUserInfo(ctx context.Context, request *HelpEditUserInfoRequest) (HelpUserInfoClass, error)
+	}); ok {
+		s.OnHelpEditUserInfo(h.HelpEditUserInfo)
+	}
+	if h, ok := impl.(interface {
+		HelpGetPromoData(ctx context.Context) (HelpPromoDataClass, error)
+	}); ok {
+		s.OnHelpGetPromoData(h.HelpGetPromoData)
+	}
+	if h, ok := impl.(interface {
+		HelpHidePromoData(ctx context.Context, peer InputPeerClass) (bool, error)
+	}); ok {
+		s.OnHelpHidePromoData(h.HelpHidePromoData)
+	}
+	if h, ok := impl.(interface {
+		HelpDismissSuggestion(ctx context.Context, request *HelpDismissSuggestionRequest) (bool, error)
+	}); ok {
+		s.OnHelpDismissSuggestion(h.HelpDismissSuggestion)
+	}
+	if h, ok := impl.(interface {
+		HelpGetCountriesList(ctx context.Context, request *HelpGetCountriesListRequest) (HelpCountriesListClass, error)
+	}); ok {
+		s.OnHelpGetCountriesList(h.HelpGetCountriesList)
+	}
+	if h, ok := impl.(interface {
+		HelpGetPremiumPromo(ctx context.Context) (*HelpPremiumPromo, error)
+	}); ok {
+		s.OnHelpGetPremiumPromo(h.HelpGetPremiumPromo)
+	}
+	if h, ok := impl.(interface {
+		HelpGetPeerColors(ctx context.Context, hash int) (HelpPeerColorsClass, error)
+	}); ok {
+		s.OnHelpGetPeerColors(h.HelpGetPeerColors)
+	}
+	if h, ok := impl.(interface {
+		HelpGetPeerProfileColors(ctx context.Context, hash int) (HelpPeerColorsClass, error)
+	}); ok {
+		s.OnHelpGetPeerProfileColors(h.HelpGetPeerProfileColors)
+	}
+	if h, ok := impl.(interface {
+		HelpGetTimezonesList(ctx context.Context, hash int) (HelpTimezonesListClass, error)
+	}); ok {
+		s.OnHelpGetTimezonesList(h.HelpGetTimezonesList)
+	}
+	if h, ok := impl.(interface {
+		ChannelsReadHistory(ctx context.Context, request *ChannelsReadHistoryRequest) (bool, error)
+	}); ok {
+		s.OnChannelsReadHistory(h.ChannelsReadHistory)
+	}
+	if h, ok := impl.(interface {
+		ChannelsDeleteMessages(ctx context.Context, request *ChannelsDeleteMessagesRequest) (*MessagesAffectedMessages, error)
+	}); ok {
+		s.OnChannelsDeleteMessages(h.ChannelsDeleteMessages)
+	}
+	if h, ok := impl.(interface {
+		ChannelsReportSpam(ctx context.Context, request *ChannelsReportSpamRequest) (bool, error)
+	}); ok {
+		s.OnChannelsReportSpam(h.ChannelsReportSpam)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetMessages(ctx context.Context, request *ChannelsGetMessagesRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnChannelsGetMessages(h.ChannelsGetMessages)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetParticipants(ctx context.Context, request *ChannelsGetParticipantsRequest) (ChannelsChannelParticipantsClass, error)
+	}); ok {
+		s.OnChannelsGetParticipants(h.ChannelsGetParticipants)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetParticipant(ctx context.Context, request *ChannelsGetParticipantRequest) (*ChannelsChannelParticipant, error)
+	}); ok {
+		s.OnChannelsGetParticipant(h.ChannelsGetParticipant)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetChannels(ctx context.Context, id []InputChannelClass) (MessagesChatsClass, error)
+	}); ok {
+		s.OnChannelsGetChannels(h.ChannelsGetChannels)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetFullChannel(ctx context.Context, channel InputChannelClass) (*MessagesChatFull, error)
+	}); ok {
+		s.OnChannelsGetFullChannel(h.ChannelsGetFullChannel)
+	}
+	if h, ok := impl.(interface {
+		ChannelsCreateChannel(ctx context.Context, request *ChannelsCreateChannelRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsCreateChannel(h.ChannelsCreateChannel)
+	}
+	if h, ok := impl.(interface {
+		ChannelsEditAdmin(ctx context.Context, request *ChannelsEditAdminRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsEditAdmin(h.ChannelsEditAdmin)
+	}
+	if h, ok := impl.(interface {
+		ChannelsEditTitle(ctx context.Context, request *ChannelsEditTitleRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsEditTitle(h.ChannelsEditTitle)
+	}
+	if h, ok := impl.(interface {
+		ChannelsEditPhoto(ctx context.Context, request *ChannelsEditPhotoRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsEditPhoto(h.ChannelsEditPhoto)
+	}
+	if h, ok := impl.(interface {
+		ChannelsCheckUsername(ctx context.Context, request *ChannelsCheckUsernameRequest) (bool, error)
+	}); ok {
+		s.OnChannelsCheckUsername(h.ChannelsCheckUsername)
+	}
+	if h, ok := impl.(interface {
+		ChannelsUpdateUsername(ctx context.Context, request *ChannelsUpdateUsernameRequest) (bool, error)
+	}); ok {
+		s.OnChannelsUpdateUsername(h.ChannelsUpdateUsername)
+	}
+	if h, ok := impl.(interface {
+		ChannelsJoinChannel(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsJoinChannel(h.ChannelsJoinChannel)
+	}
+	if h, ok := impl.(interface {
+		ChannelsLeaveChannel(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsLeaveChannel(h.ChannelsLeaveChannel)
+	}
+	if h, ok := impl.(interface {
+		ChannelsInviteToChannel(ctx context.Context, request *ChannelsInviteToChannelRequest) (*MessagesInvitedUsers, error)
+	}); ok {
+		s.OnChannelsInviteToChannel(h.ChannelsInviteToChannel)
+	}
+	if h, ok := impl.(interface {
+		ChannelsDeleteChannel(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsDeleteChannel(h.ChannelsDeleteChannel)
+	}
+	if h, ok := impl.(interface {
+		ChannelsExportMessageLink(ctx context.Context, request *ChannelsExportMessageLinkRequest) (*ExportedMessageLink, error)
+	}); ok {
+		s.OnChannelsExportMessageLink(h.ChannelsExportMessageLink)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleSignatures(ctx context.Context, request *ChannelsToggleSignaturesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleSignatures(h.ChannelsToggleSignatures)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetAdminedPublicChannels(ctx context.Context, request *ChannelsGetAdminedPublicChannelsRequest) (MessagesChatsClass, error)
+	}); ok {
+		s.OnChannelsGetAdminedPublicChannels(h.ChannelsGetAdminedPublicChannels)
+	}
+	if h, ok := impl.(interface {
+		ChannelsEditBanned(ctx context.Context, request *ChannelsEditBannedRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsEditBanned(h.ChannelsEditBanned)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetAdminLog(ctx context.Context, request *ChannelsGetAdminLogRequest) (*ChannelsAdminLogResults, error)
+	}); ok {
+		s.OnChannelsGetAdminLog(h.ChannelsGetAdminLog)
+	}
+	if h, ok := impl.(interface {
+		ChannelsSetStickers(ctx context.Context, request *ChannelsSetStickersRequest) (bool, error)
+	}); ok {
+		s.OnChannelsSetStickers(h.ChannelsSetStickers)
+	}
+	if h, ok := impl.(interface {
+		ChannelsReadMessageContents(ctx context.Context, request *ChannelsReadMessageContentsRequest) (bool, error)
+	}); ok {
+		s.OnChannelsReadMessageContents(h.ChannelsReadMessageContents)
+	}
+	if h, ok := impl.(interface {
+		ChannelsDeleteHistory(ctx context.Context, request *ChannelsDeleteHistoryRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsDeleteHistory(h.ChannelsDeleteHistory)
+	}
+	if h, ok := impl.(interface {
+		ChannelsTogglePreHistoryHidden(ctx context.Context, request *ChannelsTogglePreHistoryHiddenRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsTogglePreHistoryHidden(h.ChannelsTogglePreHistoryHidden)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetLeftChannels(ctx context.Context, offset int) (MessagesChatsClass, error)
+	}); ok {
+		s.OnChannelsGetLeftChannels(h.ChannelsGetLeftChannels)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetGroupsForDiscussion(ctx context.Context) (MessagesChatsClass, error)
+	}); ok {
+		s.OnChannelsGetGroupsForDiscussion(h.ChannelsGetGroupsForDiscussion)
+	}
+	if h, ok := impl.(interface {
+		ChannelsSetDiscussionGroup(ctx context.Context, request *ChannelsSetDiscussionGroupRequest) (bool, error)
+	}); ok {
+		s.OnChannelsSetDiscussionGroup(h.ChannelsSetDiscussionGroup)
+	}
+	if h, ok := impl.(interface {
+		ChannelsEditCreator(ctx context.Context, request *ChannelsEditCreatorRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsEditCreator(h.ChannelsEditCreator)
+	}
+	if h, ok := impl.(interface {
+		ChannelsEditLocation(ctx context.Context, request *ChannelsEditLocationRequest) (bool, error)
+	}); ok {
+		s.OnChannelsEditLocation(h.ChannelsEditLocation)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleSlowMode(ctx context.Context, request *ChannelsToggleSlowModeRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleSlowMode(h.ChannelsToggleSlowMode)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetInactiveChannels(ctx context.Context) (*MessagesInactiveChats, error)
+	}); ok {
+		s.OnChannelsGetInactiveChannels(h.ChannelsGetInactiveChannels)
+	}
+	if h, ok := impl.(interface {
+		ChannelsConvertToGigagroup(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsConvertToGigagroup(h.ChannelsConvertToGigagroup)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetSendAs(ctx context.Context, request *ChannelsGetSendAsRequest) (*ChannelsSendAsPeers, error)
+	}); ok {
+		s.OnChannelsGetSendAs(h.ChannelsGetSendAs)
+	}
+	if h, ok := impl.(interface {
+		ChannelsDeleteParticipantHistory(ctx context.Context, request *ChannelsDeleteParticipantHistoryRequest) (*MessagesAffectedHistory, error)
+	}); ok {
+		s.OnChannelsDeleteParticipantHistory(h.ChannelsDeleteParticipantHistory)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleJoinToSend(ctx context.Context, request *ChannelsToggleJoinToSendRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleJoinToSend(h.ChannelsToggleJoinToSend)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleJoinRequest(ctx context.Context, request *ChannelsToggleJoinRequestRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleJoinRequest(h.ChannelsToggleJoinRequest)
+	}
+	if h, ok := impl.(interface {
+		ChannelsReorderUsernames(ctx context.Context, request *ChannelsReorderUsernamesRequest) (bool, error)
+	}); ok {
+		s.OnChannelsReorderUsernames(h.ChannelsReorderUsernames)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleUsername(ctx context.Context, request *ChannelsToggleUsernameRequest) (bool, error)
+	}); ok {
+		s.OnChannelsToggleUsername(h.ChannelsToggleUsername)
+	}
+	if h, ok := impl.(interface {
+		ChannelsDeactivateAllUsernames(ctx context.Context, channel InputChannelClass) (bool, error)
+	}); ok {
+		s.OnChannelsDeactivateAllUsernames(h.ChannelsDeactivateAllUsernames)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleForum(ctx context.Context, request *ChannelsToggleForumRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleForum(h.ChannelsToggleForum)
+	}
+	if h, ok := impl.(interface {
+		ChannelsCreateForumTopic(ctx context.Context, request *ChannelsCreateForumTopicRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsCreateForumTopic(h.ChannelsCreateForumTopic)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetForumTopics(ctx context.Context, request *ChannelsGetForumTopicsRequest) (*MessagesForumTopics, error)
+	}); ok {
+		s.OnChannelsGetForumTopics(h.ChannelsGetForumTopics)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetForumTopicsByID(ctx context.Context, request *ChannelsGetForumTopicsByIDRequest) (*MessagesForumTopics, error)
+	}); ok {
+		s.OnChannelsGetForumTopicsByID(h.ChannelsGetForumTopicsByID)
+	}
+	if h, ok := impl.(interface {
+		ChannelsEditForumTopic(ctx context.Context, request *ChannelsEditForumTopicRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsEditForumTopic(h.ChannelsEditForumTopic)
+	}
+	if h, ok := impl.(interface {
+		ChannelsUpdatePinnedForumTopic(ctx context.Context, request *ChannelsUpdatePinnedForumTopicRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsUpdatePinnedForumTopic(h.ChannelsUpdatePinnedForumTopic)
+	}
+	if h, ok := impl.(interface {
+		ChannelsDeleteTopicHistory(ctx context.Context, request *ChannelsDeleteTopicHistoryRequest) (*MessagesAffectedHistory, error)
+	}); ok {
+		s.OnChannelsDeleteTopicHistory(h.ChannelsDeleteTopicHistory)
+	}
+	if h, ok := impl.(interface {
+		ChannelsReorderPinnedForumTopics(ctx context.Context, request *ChannelsReorderPinnedForumTopicsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsReorderPinnedForumTopics(h.ChannelsReorderPinnedForumTopics)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleAntiSpam(ctx context.Context, request *ChannelsToggleAntiSpamRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleAntiSpam(h.ChannelsToggleAntiSpam)
+	}
+	if h, ok := impl.(interface {
+		ChannelsReportAntiSpamFalsePositive(ctx context.Context, request *ChannelsReportAntiSpamFalsePositiveRequest) (bool, error)
+	}); ok {
+		s.OnChannelsReportAntiSpamFalsePositive(h.ChannelsReportAntiSpamFalsePositive)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleParticipantsHidden(ctx context.Context, request *ChannelsToggleParticipantsHiddenRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleParticipantsHidden(h.ChannelsToggleParticipantsHidden)
+	}
+	if h, ok := impl.(interface {
+		ChannelsUpdateColor(ctx context.Context, request *ChannelsUpdateColorRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsUpdateColor(h.ChannelsUpdateColor)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleViewForumAsMessages(ctx context.Context, request *ChannelsToggleViewForumAsMessagesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleViewForumAsMessages(h.ChannelsToggleViewForumAsMessages)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetChannelRecommendations(ctx context.Context, request *ChannelsGetChannelRecommendationsRequest) (MessagesChatsClass, error)
+	}); ok {
+		s.OnChannelsGetChannelRecommendations(h.ChannelsGetChannelRecommendations)
+	}
+	if h, ok := impl.(interface {
+		ChannelsUpdateEmojiStatus(ctx context.Context, request *ChannelsUpdateEmojiStatusRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsUpdateEmojiStatus(h.ChannelsUpdateEmojiStatus)
+	}
+	if h, ok := impl.(interface {
+		ChannelsSetBoostsToUnblockRestrictions(ctx context.Context, request *ChannelsSetBoostsToUnblockRestrictionsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsSetBoostsToUnblockRestrictions(h.ChannelsSetBoostsToUnblockRestrictions)
+	}
+	if h, ok := impl.(interface {
+		ChannelsSetEmojiStickers(ctx context.Context, request *ChannelsSetEmojiStickersRequest) (bool, error)
+	}); ok {
+		s.OnChannelsSetEmojiStickers(h.ChannelsSetEmojiStickers)
+	}
+	if h, ok := impl.(interface {
+		ChannelsRestrictSponsoredMessages(ctx context.Context, request *ChannelsRestrictSponsoredMessagesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsRestrictSponsoredMessages(h.ChannelsRestrictSponsoredMessages)
+	}
+	if h, ok := impl.(interface {
+		ChannelsSearchPosts(ctx context.Context, request *ChannelsSearchPostsRequest) (MessagesMessagesClass, error)
+	}); ok {
+		s.OnChannelsSearchPosts(h.ChannelsSearchPosts)
+	}
+	if h, ok := impl.(interface {
+		ChannelsUpdatePaidMessagesPrice(ctx context.Context, request *ChannelsUpdatePaidMessagesPriceRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsUpdatePaidMessagesPrice(h.ChannelsUpdatePaidMessagesPrice)
+	}
+	if h, ok := impl.(interface {
+		ChannelsToggleAutotranslation(ctx context.Context, request *ChannelsToggleAutotranslationRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChannelsToggleAutotranslation(h.ChannelsToggleAutotranslation)
+	}
+	if h, ok := impl.(interface {
+		ChannelsGetMessageAuthor(ctx context.Context, request *ChannelsGetMessageAuthorRequest) (UserClass, error)
+	}); ok {
+		s.OnChannelsGetMessageAuthor(h.ChannelsGetMessageAuthor)
+	}
+	if h, ok := impl.(interface {
+		ChannelsCheckSearchPostsFlood(ctx context.Context, request *ChannelsCheckSearchPostsFloodRequest) (*SearchPostsFlood, error)
+	}); ok {
+		s.OnChannelsCheckSearchPostsFlood(h.ChannelsCheckSearchPostsFlood)
+	}
+	if h, ok := impl.(interface {
+		BotsSendCustomRequest(ctx context.Context, request *BotsSendCustomRequestRequest) (*DataJSON, error)
+	}); ok {
+		s.OnBotsSendCustomRequest(h.BotsSendCustomRequest)
+	}
+	if h, ok := impl.(interface {
+		BotsAnswerWebhookJSONQuery(ctx context.Context, request *BotsAnswerWebhookJSONQueryRequest) (bool, error)
+	}); ok {
+		s.OnBotsAnswerWebhookJSONQuery(h.BotsAnswerWebhookJSONQuery)
+	}
+	if h, ok := impl.(interface {
+		BotsSetBotCommands(ctx context.Context, request *BotsSetBotCommandsRequest) (bool, error)
+	}); ok {
+		s.OnBotsSetBotCommands(h.BotsSetBotCommands)
+	}
+	if h, ok := impl.(interface {
+		BotsResetBotCommands(ctx context.Context, request *BotsResetBotCommandsRequest) (bool, error)
+	}); ok {
+		s.OnBotsResetBotCommands(h.BotsResetBotCommands)
+	}
+	if h, ok := impl.(interface {
+		BotsGetBotCommands(ctx context.Context, request *BotsGetBotCommandsRequest) ([]BotCommand, error)
+	}); ok {
+		s.OnBotsGetBotCommands(h.BotsGetBotCommands)
+	}
+	if h, ok := impl.(interface {
+		BotsSetBotMenuButton(ctx context.Context, request *BotsSetBotMenuButtonRequest) (bool, error)
+	}); ok {
+		s.OnBotsSetBotMenuButton(h.BotsSetBotMenuButton)
+	}
+	if h, ok := impl.(interface {
+		BotsGetBotMenuButton(ctx context.Context, userid InputUserClass) (BotMenuButtonClass, error)
+	}); ok {
+		s.OnBotsGetBotMenuButton(h.BotsGetBotMenuButton)
+	}
+	if h, ok := impl.(interface {
+		BotsSetBotBroadcastDefaultAdminRights(ctx context.Context, adminrights ChatAdminRights) (bool, error)
+	}); ok {
+		s.OnBotsSetBotBroadcastDefaultAdminRights(h.BotsSetBotBroadcastDefaultAdminRights)
+	}
+	if h, ok := impl.(interface {
+		BotsSetBotGroupDefaultAdminRights(ctx context.Context, adminrights ChatAdminRights) (bool, error)
+	}); ok {
+		s.OnBotsSetBotGroupDefaultAdminRights(h.BotsSetBotGroupDefaultAdminRights)
+	}
+	if h, ok := impl.(interface {
+		BotsSetBotInfo(ctx context.Context, request *BotsSetBotInfoRequest) (bool, error)
+	}); ok {
+		s.OnBotsSetBotInfo(h.BotsSetBotInfo)
+	}
+	if h, ok := impl.(interface {
+		BotsGetBotInfo(ctx context.Context, request *BotsGetBotInfoRequest) (*BotsBotInfo, error)
+	}); ok {
+		s.OnBotsGetBotInfo(h.BotsGetBotInfo)
+	}
+	if h, ok := impl.(interface {
+		BotsReorderUsernames(ctx context.Context, request *BotsReorderUsernamesRequest) (bool, error)
+	}); ok {
+		s.OnBotsReorderUsernames(h.BotsReorderUsernames)
+	}
+	if h, ok := impl.(interface {
+		BotsToggleUsername(ctx context.Context, request *BotsToggleUsernameRequest) (bool, error)
+	}); ok {
+		s.OnBotsToggleUsername(h.BotsToggleUsername)
+	}
+	if h, ok := impl.(interface {
+		BotsCanSendMessage(ctx context.Context, bot InputUserClass) (bool, error)
+	}); ok {
+		s.OnBotsCanSendMessage(h.BotsCanSendMessage)
+	}
+	if h, ok := impl.(interface {
+		BotsAllowSendMessage(ctx context.Context, bot InputUserClass) (UpdatesClass, error)
+	}); ok {
+		s.OnBotsAllowSendMessage(h.BotsAllowSendMessage)
+	}
+	if h, ok := impl.(interface {
+		BotsInvokeWebViewCustomMethod(ctx context.Context, request *BotsInvokeWebViewCustomMethodRequest) (*DataJSON, error)
+	}); ok {
+		s.OnBotsInvokeWebViewCustomMethod(h.BotsInvokeWebViewCustomMethod)
+	}
+	if h, ok := impl.(interface {
+		BotsGetPopularAppBots(ctx context.Context, request *BotsGetPopularAppBotsRequest) (*BotsPopularAppBots, error)
+	}); ok {
+		s.OnBotsGetPopularAppBots(h.BotsGetPopularAppBots)
+	}
+	if h, ok := impl.(interface {
+		BotsAddPreviewMedia(ctx context.Context, request *BotsAddPreviewMediaRequest) (*BotPreviewMedia, error)
+	}); ok {
+		s.OnBotsAddPreviewMedia(h.BotsAddPreviewMedia)
+	}
+	if h, ok := impl.(interface {
+		BotsEditPreviewMedia(ctx context.Context, request *BotsEditPreviewMediaRequest) (*BotPreviewMedia, error)
+	}); ok {
+		s.OnBotsEditPreviewMedia(h.BotsEditPreviewMedia)
+	}
+	if h, ok := impl.(interface {
+		BotsDeletePreviewMedia(ctx context.Context, request *BotsDeletePreviewMediaRequest) (bool, error)
+	}); ok {
+		s.OnBotsDeletePreviewMedia(h.BotsDeletePreviewMedia)
+	}
+	if h, ok := impl.(interface {
+		BotsReorderPreviewMedias(ctx context.Context, request *BotsReorderPreviewMediasRequest) (bool, error)
+	}); ok {
+		s.OnBotsReorderPreviewMedias(h.BotsReorderPreviewMedias)
+	}
+	if h, ok := impl.(interface {
+		BotsGetPreviewInfo(ctx context.Context, request *BotsGetPreviewInfoRequest) (*BotsPreviewInfo, error)
+	}); ok {
+		s.OnBotsGetPreviewInfo(h.BotsGetPreviewInfo)
+	}
+	if h, ok := impl.(interface {
+		BotsGetPreviewMedias(ctx context.Context, bot InputUserClass) ([]BotPreviewMedia, error)
+	}); ok {
+		s.OnBotsGetPreviewMedias(h.BotsGetPreviewMedias)
+	}
+	if h, ok := impl.(interface {
+		BotsUpdateUserEmojiStatus(ctx context.Context, request *BotsUpdateUserEmojiStatusRequest) (bool, error)
+	}); ok {
+		s.OnBotsUpdateUserEmojiStatus(h.BotsUpdateUserEmojiStatus)
+	}
+	if h, ok := impl.(interface {
+		BotsToggleUserEmojiStatusPermission(ctx context.Context, request *BotsToggleUserEmojiStatusPermissionRequest) (bool, error)
+	}); ok {
+		s.OnBotsToggleUserEmojiStatusPermission(h.BotsToggleUserEmojiStatusPermission)
+	}
+	if h, ok := impl.(interface {
+		BotsCheckDownloadFileParams(ctx context.Context, request *BotsCheckDownloadFileParamsRequest) (bool, error)
+	}); ok {
+		s.OnBotsCheckDownloadFileParams(h.BotsCheckDownloadFileParams)
+	}
+	if h, ok := impl.(interface {
+		BotsGetAdminedBots(ctx context.Context) ([]UserClass, error)
+	}); ok {
+		s.OnBotsGetAdminedBots(h.BotsGetAdminedBots)
+	}
+	if h, ok := impl.(interface {
+		BotsUpdateStarRefProgram(ctx context.Context, request *BotsUpdateStarRefProgramRequest) (*StarRefProgram, error)
+	}); ok {
+		s.OnBotsUpdateStarRefProgram(h.BotsUpdateStarRefProgram)
+	}
+	if h, ok := impl.(interface {
+		BotsSetCustomVerification(ctx context.Context, request *BotsSetCustomVerificationRequest) (bool, error)
+	}); ok {
+		s.OnBotsSetCustomVerification(h.BotsSetCustomVerification)
+	}
+	if h, ok := impl.(interface {
+		BotsGetBotRecommendations(ctx context.Context, bot InputUserClass) (UsersUsersClass, error)
+	}); ok {
+		s.OnBotsGetBotRecommendations(h.BotsGetBotRecommendations)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetPaymentForm(ctx context.Context, request *PaymentsGetPaymentFormRequest) (PaymentsPaymentFormClass, error)
+	}); ok {
+		s.OnPaymentsGetPaymentForm(h.PaymentsGetPaymentForm)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetPaymentReceipt(ctx context.Context, request *PaymentsGetPaymentReceiptRequest) (PaymentsPaymentReceiptClass, error)
+	}); ok {
+		s.OnPaymentsGetPaymentReceipt(h.PaymentsGetPaymentReceipt)
+	}
+	if h, ok := impl.(interface {
+		PaymentsValidateRequestedInfo(ctx context.Context, request *PaymentsValidateRequestedInfoRequest) (*PaymentsValidatedRequestedInfo, error)
+	}); ok {
+		s.OnPaymentsValidateRequestedInfo(h.PaymentsValidateRequestedInfo)
+	}
+	if h, ok := impl.(interface {
+		PaymentsSendPaymentForm(ctx context.Context, request *PaymentsSendPaymentFormRequest) (PaymentsPaymentResultClass, error)
+	}); ok {
+		s.OnPaymentsSendPaymentForm(h.PaymentsSendPaymentForm)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetSavedInfo(ctx context.Context) (*PaymentsSavedInfo, error)
+	}); ok {
+		s.OnPaymentsGetSavedInfo(h.PaymentsGetSavedInfo)
+	}
+	if h, ok := impl.(interface {
+		PaymentsClearSavedInfo(ctx context.Context, request *PaymentsClearSavedInfoRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsClearSavedInfo(h.PaymentsClearSavedInfo)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetBankCardData(ctx context.Context, number string) (*PaymentsBankCardData, error)
+	}); ok {
+		s.OnPaymentsGetBankCardData(h.PaymentsGetBankCardData)
+	}
+	if h, ok := impl.(interface {
+		PaymentsExportInvoice(ctx context.Context, invoicemedia InputMediaClass) (*PaymentsExportedInvoice, error)
+	}); ok {
+		s.OnPaymentsExportInvoice(h.PaymentsExportInvoice)
+	}
+	if h, ok := impl.(interface {
+		PaymentsAssignAppStoreTransaction(ctx context.Context, request *PaymentsAssignAppStoreTransactionRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsAssignAppStoreTransaction(h.PaymentsAssignAppStoreTransaction)
+	}
+	if h, ok := impl.(interface {
+		PaymentsAssignPlayMarketTransaction(ctx context.Context, request *PaymentsAssignPlayMarketTransactionRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsAssignPlayMarketTransaction(h.PaymentsAssignPlayMarketTransaction)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetPremiumGiftCodeOptions(ctx context.Context, request *PaymentsGetPremiumGiftCodeOptionsRequest) ([]PremiumGiftCodeOption, error)
+	}); ok {
+		s.OnPaymentsGetPremiumGiftCodeOptions(h.PaymentsGetPremiumGiftCodeOptions)
+	}
+	if h, ok := impl.(interface {
+		PaymentsCheckGiftCode(ctx context.Context, slug string) (*PaymentsCheckedGiftCode, error)
+	}); ok {
+		s.OnPaymentsCheckGiftCode(h.PaymentsCheckGiftCode)
+	}
+	if h, ok := impl.(interface {
+		PaymentsApplyGiftCode(ctx context.Context, slug string) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsApplyGiftCode(h.PaymentsApplyGiftCode)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetGiveawayInfo(ctx context.Context, request *PaymentsGetGiveawayInfoRequest) (PaymentsGiveawayInfoClass, error)
+	}); ok {
+		s.OnPaymentsGetGiveawayInfo(h.PaymentsGetGiveawayInfo)
+	}
+	if h, ok := impl.(interface {
+		PaymentsLaunchPrepaidGiveaway(ctx context.Context, request *PaymentsLaunchPrepaidGiveawayRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsLaunchPrepaidGiveaway(h.PaymentsLaunchPrepaidGiveaway)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsTopupOptions(ctx context.Context) ([]StarsTopupOption, error)
+	}); ok {
+		s.OnPaymentsGetStarsTopupOptions(h.PaymentsGetStarsTopupOptions)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsStatus(ctx context.Context, request *PaymentsGetStarsStatusRequest) (*PaymentsStarsStatus, error)
+	}); ok {
+		s.OnPaymentsGetStarsStatus(h.PaymentsGetStarsStatus)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsTransactions(ctx context.Context, request *PaymentsGetStarsTransactionsRequest) (*PaymentsStarsStatus, error)
+	}); ok {
+		s.OnPaymentsGetStarsTransactions(h.PaymentsGetStarsTransactions)
+	}
+	if h, ok := impl.(interface {
+		PaymentsSendStarsForm(ctx context.Context, request *PaymentsSendStarsFormRequest) (PaymentsPaymentResultClass, error)
+	}); ok {
+		s.OnPaymentsSendStarsForm(h.PaymentsSendStarsForm)
+	}
+	if h, ok := impl.(interface {
+		PaymentsRefundStarsCharge(ctx context.Context, request *PaymentsRefundStarsChargeRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsRefundStarsCharge(h.PaymentsRefundStarsCharge)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsRevenueStats(ctx context.Context, request *PaymentsGetStarsRevenueStatsRequest) (*PaymentsStarsRevenueStats, error)
+	}); ok {
+		s.OnPaymentsGetStarsRevenueStats(h.PaymentsGetStarsRevenueStats)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsRevenueWithdrawalURL(ctx context.Context, request *PaymentsGetStarsRevenueWithdrawalURLRequest) (*PaymentsStarsRevenueWithdrawalURL, error)
+	}); ok {
+		s.OnPaymentsGetStarsRevenueWithdrawalURL(h.PaymentsGetStarsRevenueWithdrawalURL)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsRevenueAdsAccountURL(ctx context.Context, peer InputPeerClass) (*PaymentsStarsRevenueAdsAccountURL, error)
+	}); ok {
+		s.OnPaymentsGetStarsRevenueAdsAccountURL(h.PaymentsGetStarsRevenueAdsAccountURL)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsTransactionsByID(ctx context.Context, request *PaymentsGetStarsTransactionsByIDRequest) (*PaymentsStarsStatus, error)
+	}); ok {
+		s.OnPaymentsGetStarsTransactionsByID(h.PaymentsGetStarsTransactionsByID)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsGiftOptions(ctx context.Context, request *PaymentsGetStarsGiftOptionsRequest) ([]StarsGiftOption, error)
+	}); ok {
+		s.OnPaymentsGetStarsGiftOptions(h.PaymentsGetStarsGiftOptions)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsSubscriptions(ctx context.Context, request *PaymentsGetStarsSubscriptionsRequest) (*PaymentsStarsStatus, error)
+	}); ok {
+		s.OnPaymentsGetStarsSubscriptions(h.PaymentsGetStarsSubscriptions)
+	}
+	if h, ok := impl.(interface {
+		PaymentsChangeStarsSubscription(ctx context.Context, request *PaymentsChangeStarsSubscriptionRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsChangeStarsSubscription(h.PaymentsChangeStarsSubscription)
+	}
+	if h, ok := impl.(interface {
+		PaymentsFulfillStarsSubscription(ctx context.Context, request *PaymentsFulfillStarsSubscriptionRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsFulfillStarsSubscription(h.PaymentsFulfillStarsSubscription)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarsGiveawayOptions(ctx context.Context) ([]StarsGiveawayOption, error)
+	}); ok {
+		s.OnPaymentsGetStarsGiveawayOptions(h.PaymentsGetStarsGiveawayOptions)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarGifts(ctx context.Context, hash int) (PaymentsStarGiftsClass, error)
+	}); ok {
+		s.OnPaymentsGetStarGifts(h.PaymentsGetStarGifts)
+	}
+	if h, ok := impl.(interface {
+		PaymentsSaveStarGift(ctx context.Context, request *PaymentsSaveStarGiftRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsSaveStarGift(h.PaymentsSaveStarGift)
+	}
+	if h, ok := impl.(interface {
+		PaymentsConvertStarGift(ctx context.Context, stargift InputSavedStarGiftClass) (bool, error)
+	}); ok {
+		s.OnPaymentsConvertStarGift(h.PaymentsConvertStarGift)
+	}
+	if h, ok := impl.(interface {
+		PaymentsBotCancelStarsSubscription(ctx context.Context, request *PaymentsBotCancelStarsSubscriptionRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsBotCancelStarsSubscription(h.PaymentsBotCancelStarsSubscription)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetConnectedStarRefBots(ctx context.Context, request *PaymentsGetConnectedStarRefBotsRequest) (*PaymentsConnectedStarRefBots, error)
+	}); ok {
+		s.OnPaymentsGetConnectedStarRefBots(h.PaymentsGetConnectedStarRefBots)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetConnectedStarRefBot(ctx context.Context, request *PaymentsGetConnectedStarRefBotRequest) (*PaymentsConnectedStarRefBots, error)
+	}); ok {
+		s.OnPaymentsGetConnectedStarRefBot(h.PaymentsGetConnectedStarRefBot)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetSuggestedStarRefBots(ctx context.Context, request *PaymentsGetSuggestedStarRefBotsRequest) (*PaymentsSuggestedStarRefBots, error)
+	}); ok {
+		s.OnPaymentsGetSuggestedStarRefBots(h.PaymentsGetSuggestedStarRefBots)
+	}
+	if h, ok := impl.(interface {
+		PaymentsConnectStarRefBot(ctx context.Context, request *PaymentsConnectStarRefBotRequest) (*PaymentsConnectedStarRefBots, error)
+	}); ok {
+		s.OnPaymentsConnectStarRefBot(h.PaymentsConnectStarRefBot)
+	}
+	if h, ok := impl.(interface {
+		PaymentsEditConnectedStarRefBot(ctx context.Context, request *PaymentsEditConnectedStarRefBotRequest) (*PaymentsConnectedStarRefBots, error)
+	}); ok {
+		s.OnPaymentsEditConnectedStarRefBot(h.PaymentsEditConnectedStarRefBot)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarGiftUpgradePreview(ctx context.Context, giftid int64) (*PaymentsStarGiftUpgradePreview, error)
+	}); ok {
+		s.OnPaymentsGetStarGiftUpgradePreview(h.PaymentsGetStarGiftUpgradePreview)
+	}
+	if h, ok := impl.(interface {
+		PaymentsUpgradeStarGift(ctx context.Context, request *PaymentsUpgradeStarGiftRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsUpgradeStarGift(h.PaymentsUpgradeStarGift)
+	}
+	if h, ok := impl.(interface {
+		PaymentsTransferStarGift(ctx context.Context, request *PaymentsTransferStarGiftRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsTransferStarGift(h.PaymentsTransferStarGift)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetUniqueStarGift(ctx context.Context, slug string) (*PaymentsUniqueStarGift, error)
+	}); ok {
+		s.OnPaymentsGetUniqueStarGift(h.PaymentsGetUniqueStarGift)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetSavedStarGifts(ctx context.Context, request *PaymentsGetSavedStarGiftsRequest) (*PaymentsSavedStarGifts, error)
+	}); ok {
+		s.OnPaymentsGetSavedStarGifts(h.PaymentsGetSavedStarGifts)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetSavedStarGift(ctx context.Context, stargift []InputSavedStarGiftClass) (*PaymentsSavedStarGifts, error)
+	}); ok {
+		s.OnPaymentsGetSavedStarGift(h.PaymentsGetSavedStarGift)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarGiftWithdrawalURL(ctx context.Context, request *PaymentsGetStarGiftWithdrawalURLRequest) (*PaymentsStarGiftWithdrawalURL, error)
+	}); ok {
+		s.OnPaymentsGetStarGiftWithdrawalURL(h.PaymentsGetStarGiftWithdrawalURL)
+	}
+	if h, ok := impl.(interface {
+		PaymentsToggleChatStarGiftNotifications(ctx context.Context, request *PaymentsToggleChatStarGiftNotificationsRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsToggleChatStarGiftNotifications(h.PaymentsToggleChatStarGiftNotifications)
+	}
+	if h, ok := impl.(interface {
+		PaymentsToggleStarGiftsPinnedToTop(ctx context.Context, request *PaymentsToggleStarGiftsPinnedToTopRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsToggleStarGiftsPinnedToTop(h.PaymentsToggleStarGiftsPinnedToTop)
+	}
+	if h, ok := impl.(interface {
+		PaymentsCanPurchaseStore(ctx context.Context, purpose InputStorePaymentPurposeClass) (bool, error)
+	}); ok {
+		s.OnPaymentsCanPurchaseStore(h.PaymentsCanPurchaseStore)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetResaleStarGifts(ctx context.Context, request *PaymentsGetResaleStarGiftsRequest) (*PaymentsResaleStarGifts, error)
+	}); ok {
+		s.OnPaymentsGetResaleStarGifts(h.PaymentsGetResaleStarGifts)
+	}
+	if h, ok := impl.(interface {
+		PaymentsUpdateStarGiftPrice(ctx context.Context, request *PaymentsUpdateStarGiftPriceRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPaymentsUpdateStarGiftPrice(h.PaymentsUpdateStarGiftPrice)
+	}
+	if h, ok := impl.(interface {
+		PaymentsCreateStarGiftCollection(ctx context.Context, request *PaymentsCreateStarGiftCollectionRequest) (*StarGiftCollection, error)
+	}); ok {
+		s.OnPaymentsCreateStarGiftCollection(h.PaymentsCreateStarGiftCollection)
+	}
+	if h, ok := impl.(interface {
+		PaymentsUpdateStarGiftCollection(ctx context.Context, request *PaymentsUpdateStarGiftCollectionRequest) (*StarGiftCollection, error)
+	}); ok {
+		s.OnPaymentsUpdateStarGiftCollection(h.PaymentsUpdateStarGiftCollection)
+	}
+	if h, ok := impl.(interface {
+		PaymentsReorderStarGiftCollections(ctx context.Context, request *PaymentsReorderStarGiftCollectionsRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsReorderStarGiftCollections(h.PaymentsReorderStarGiftCollections)
+	}
+	if h, ok := impl.(interface {
+		PaymentsDeleteStarGiftCollection(ctx context.Context, request *PaymentsDeleteStarGiftCollectionRequest) (bool, error)
+	}); ok {
+		s.OnPaymentsDeleteStarGiftCollection(h.PaymentsDeleteStarGiftCollection)
+	}
+	if h, ok := impl.(interface {
+		PaymentsGetStarGiftCollections(ctx context.Context, request *PaymentsGetStarGiftCollectionsRequest) (PaymentsStarGiftCollectionsClass, error)
+	}); ok {
+		s.OnPaymentsGetStarGiftCollections(h.PaymentsGetStarGiftCollections)
+	}
+	if h, ok := impl.(interface {
+		StickersCreateStickerSet(ctx context.Context, request *StickersCreateStickerSetRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersCreateStickerSet(h.StickersCreateStickerSet)
+	}
+	if h, ok := impl.(interface {
+		StickersRemoveStickerFromSet(ctx context.Context, sticker InputDocumentClass) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersRemoveStickerFromSet(h.StickersRemoveStickerFromSet)
+	}
+	if h, ok := impl.(interface {
+		StickersChangeStickerPosition(ctx context.Context, request *StickersChangeStickerPositionRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersChangeStickerPosition(h.StickersChangeStickerPosition)
+	}
+	if h, ok := impl.(interface {
+		StickersAddStickerToSet(ctx context.Context, request *StickersAddStickerToSetRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersAddStickerToSet(h.StickersAddStickerToSet)
+	}
+	if h, ok := impl.(interface {
+		StickersSetStickerSetThumb(ctx context.Context, request *StickersSetStickerSetThumbRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersSetStickerSetThumb(h.StickersSetStickerSetThumb)
+	}
+	if h, ok := impl.(interface {
+		StickersCheckShortName(ctx context.Context, shortname string) (bool, error)
+	}); ok {
+		s.OnStickersCheckShortName(h.StickersCheckShortName)
+	}
+	if h, ok := impl.(interface {
+		StickersSuggestShortName(ctx context.Context, title string) (*StickersSuggestedShortName, error)
+	}); ok {
+		s.OnStickersSuggestShortName(h.StickersSuggestShortName)
+	}
+	if h, ok := impl.(interface {
+		StickersChangeSticker(ctx context.Context, request *StickersChangeStickerRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersChangeSticker(h.StickersChangeSticker)
+	}
+	if h, ok := impl.(interface {
+		StickersRenameStickerSet(ctx context.Context, request *StickersRenameStickerSetRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersRenameStickerSet(h.StickersRenameStickerSet)
+	}
+	if h, ok := impl.(interface {
+		StickersDeleteStickerSet(ctx context.Context, stickerset InputStickerSetClass) (bool, error)
+	}); ok {
+		s.OnStickersDeleteStickerSet(h.StickersDeleteStickerSet)
+	}
+	if h, ok := impl.(interface {
+		StickersReplaceSticker(ctx context.Context, request *StickersReplaceStickerRequest) (MessagesStickerSetClass, error)
+	}); ok {
+		s.OnStickersReplaceSticker(h.StickersReplaceSticker)
+	}
+	if h, ok := impl.(interface {
+		PhoneGetCallConfig(ctx context.Context) (*DataJSON, error)
+	}); ok {
+		s.OnPhoneGetCallConfig(h.PhoneGetCallConfig)
+	}
+	if h, ok := impl.(interface {
+		PhoneRequestCall(ctx context.Context, request *PhoneRequestCallRequest) (*PhonePhoneCall, error)
+	}); ok {
+		s.OnPhoneRequestCall(h.PhoneRequestCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneAcceptCall(ctx context.Context, request *PhoneAcceptCallRequest) (*PhonePhoneCall, error)
+	}); ok {
+		s.OnPhoneAcceptCall(h.PhoneAcceptCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneConfirmCall(ctx context.Context, request *PhoneConfirmCallRequest) (*PhonePhoneCall, error)
+	}); ok {
+		s.OnPhoneConfirmCall(h.PhoneConfirmCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneReceivedCall(ctx context.Context, peer InputPhoneCall) (bool, error)
+	}); ok {
+		s.OnPhoneReceivedCall(h.PhoneReceivedCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneDiscardCall(ctx context.Context, request *PhoneDiscardCallRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneDiscardCall(h.PhoneDiscardCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneSetCallRating(ctx context.Context, request *PhoneSetCallRatingRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneSetCallRating(h.PhoneSetCallRating)
+	}
+	if h, ok := impl.(interface {
+		PhoneSaveCallDebug(ctx context.Context, request *PhoneSaveCallDebugRequest) (bool, error)
+	}); ok {
+		s.OnPhoneSaveCallDebug(h.PhoneSaveCallDebug)
+	}
+	if h, ok := impl.(interface {
+		PhoneSendSignalingData(ctx context.Context, request *PhoneSendSignalingDataRequest) (bool, error)
+	}); ok {
+		s.OnPhoneSendSignalingData(h.PhoneSendSignalingData)
+	}
+	if h, ok := impl.(interface {
+		PhoneCreateGroupCall(ctx context.Context, request *PhoneCreateGroupCallRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneCreateGroupCall(h.PhoneCreateGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneJoinGroupCall(ctx context.Context, request *PhoneJoinGroupCallRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneJoinGroupCall(h.PhoneJoinGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneLeaveGroupCall(ctx context.Context, request *PhoneLeaveGroupCallRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneLeaveGroupCall(h.PhoneLeaveGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneInviteToGroupCall(ctx context.Context, request *PhoneInviteToGroupCallRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneInviteToGroupCall(h.PhoneInviteToGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneDiscardGroupCall(ctx context.Context, call InputGroupCallClass) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneDiscardGroupCall(h.PhoneDiscardGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneToggleGroupCallSettings(ctx context.Context, request *PhoneToggleGroupCallSettingsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneToggleGroupCallSettings(h.PhoneToggleGroupCallSettings)
+	}
+	if h, ok := impl.(interface {
+		PhoneGetGroupCall(ctx context.Context, request *PhoneGetGroupCallRequest) (*PhoneGroupCall, error)
+	}); ok {
+		s.OnPhoneGetGroupCall(h.PhoneGetGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneGetGroupParticipants(ctx context.Context, request *PhoneGetGroupParticipantsRequest) (*PhoneGroupParticipants, error)
+	}); ok {
+		s.OnPhoneGetGroupParticipants(h.PhoneGetGroupParticipants)
+	}
+	if h, ok := impl.(interface {
+		PhoneCheckGroupCall(ctx context.Context, request *PhoneCheckGroupCallRequest) ([]int, error)
+	}); ok {
+		s.OnPhoneCheckGroupCall(h.PhoneCheckGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneToggleGroupCallRecord(ctx context.Context, request *PhoneToggleGroupCallRecordRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneToggleGroupCallRecord(h.PhoneToggleGroupCallRecord)
+	}
+	if h, ok := impl.(interface {
+		PhoneEditGroupCallParticipant(ctx context.Context, request *PhoneEditGroupCallParticipantRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneEditGroupCallParticipant(h.PhoneEditGroupCallParticipant)
+	}
+	if h, ok := impl.(interface {
+		PhoneEditGroupCallTitle(ctx context.Context, request *PhoneEditGroupCallTitleRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneEditGroupCallTitle(h.PhoneEditGroupCallTitle)
+	}
+	if h, ok := impl.(interface {
+		PhoneGetGroupCallJoinAs(ctx context.Context, peer InputPeerClass) (*PhoneJoinAsPeers, error)
+	}); ok {
+		s.OnPhoneGetGroupCallJoinAs(h.PhoneGetGroupCallJoinAs)
+	}
+	if h, ok := impl.(interface {
+		PhoneExportGroupCallInvite(ctx context.Context, request *PhoneExportGroupCallInviteRequest) (*PhoneExportedGroupCallInvite, error)
+	}); ok {
+		s.OnPhoneExportGroupCallInvite(h.PhoneExportGroupCallInvite)
+	}
+	if h, ok := impl.(interface {
+		PhoneToggleGroupCallStartSubscription(ctx context.Context, request *PhoneToggleGroupCallStartSubscriptionRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneToggleGroupCallStartSubscription(h.PhoneToggleGroupCallStartSubscription)
+	}
+	if h, ok := impl.(interface {
+		PhoneStartScheduledGroupCall(ctx context.Context, call InputGroupCallClass) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneStartScheduledGroupCall(h.PhoneStartScheduledGroupCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneSaveDefaultGroupCallJoinAs(ctx context.Context, request *PhoneSaveDefaultGroupCallJoinAsRequest) (bool, error)
+	}); ok {
+		s.OnPhoneSaveDefaultGroupCallJoinAs(h.PhoneSaveDefaultGroupCallJoinAs)
+	}
+	if h, ok := impl.(interface {
+		PhoneJoinGroupCallPresentation(ctx context.Context, request *PhoneJoinGroupCallPresentationRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneJoinGroupCallPresentation(h.PhoneJoinGroupCallPresentation)
+	}
+	if h, ok := impl.(interface {
+		PhoneLeaveGroupCallPresentation(ctx context.Context, call InputGroupCallClass) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneLeaveGroupCallPresentation(h.PhoneLeaveGroupCallPresentation)
+	}
+	if h, ok := impl.(interface {
+		PhoneGetGroupCallStreamChannels(ctx context.Context, call InputGroupCallClass) (*PhoneGroupCallStreamChannels, error)
+	}); ok {
+		s.OnPhoneGetGroupCallStreamChannels(h.PhoneGetGroupCallStreamChannels)
+	}
+	if h, ok := impl.(interface {
+		PhoneGetGroupCallStreamRtmpURL(ctx context.Context, request *PhoneGetGroupCallStreamRtmpURLRequest) (*PhoneGroupCallStreamRtmpURL, error)
+	}); ok {
+		s.OnPhoneGetGroupCallStreamRtmpURL(h.PhoneGetGroupCallStreamRtmpURL)
+	}
+	if h, ok := impl.(interface {
+		PhoneSaveCallLog(ctx context.Context, request *PhoneSaveCallLogRequest) (bool, error)
+	}); ok {
+		s.OnPhoneSaveCallLog(h.PhoneSaveCallLog)
+	}
+	if h, ok := impl.(interface {
+		PhoneCreateConferenceCall(ctx context.Context, request *PhoneCreateConferenceCallRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneCreateConferenceCall(h.PhoneCreateConferenceCall)
+	}
+	if h, ok := impl.(interface {
+		PhoneDeleteConferenceCallParticipants(ctx context.Context, request *PhoneDeleteConferenceCallParticipantsRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneDeleteConferenceCallParticipants(h.PhoneDeleteConferenceCallParticipants)
+	}
+	if h, ok := impl.(interface {
+		PhoneSendConferenceCallBroadcast(ctx context.Context, request *PhoneSendConferenceCallBroadcastRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneSendConferenceCallBroadcast(h.PhoneSendConferenceCallBroadcast)
+	}
+	if h, ok := impl.(interface {
+		PhoneInviteConferenceCallParticipant(ctx context.Context, request *PhoneInviteConferenceCallParticipantRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneInviteConferenceCallParticipant(h.PhoneInviteConferenceCallParticipant)
+	}
+	if h, ok := impl.(interface {
+		PhoneDeclineConferenceCallInvite(ctx context.Context, msgid int) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneDeclineConferenceCallInvite(h.PhoneDeclineConferenceCallInvite)
+	}
+	if h, ok := impl.(interface {
+		PhoneGetGroupCallChainBlocks(ctx context.Context, request *PhoneGetGroupCallChainBlocksRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnPhoneGetGroupCallChainBlocks(h.PhoneGetGroupCallChainBlocks)
+	}
+	if h, ok := impl.(interface {
+		LangpackGetLangPack(ctx context.Context, request *LangpackGetLangPackRequest) (*LangPackDifference, error)
+	}); ok {
+		s.OnLangpackGetLangPack(h.LangpackGetLangPack)
+	}
+	if h, ok := impl.(interface {
+		LangpackGetStrings(ctx context.Context, request *LangpackGetStringsRequest) ([]LangPackStringClass, error)
+	}); ok {
+		s.OnLangpackGetStrings(h.LangpackGetStrings)
+	}
+	if h, ok := impl.(interface {
+		LangpackGetDifference(ctx context.Context, request *LangpackGetDifferenceRequest) (*LangPackDifference, error)
+	}); ok {
+		s.OnLangpackGetDifference(h.LangpackGetDifference)
+	}
+	if h, ok := impl.(interface {
+		LangpackGetLanguages(ctx context.Context, langpack string) ([]LangPackLanguage, error)
+	}); ok {
+		s.OnLangpackGetLanguages(h.LangpackGetLanguages)
+	}
+	if h, ok := impl.(interface {
+		LangpackGetLanguage(ctx context.Context, request *LangpackGetLanguageRequest) (*LangPackLanguage, error)
+	}); ok {
+		s.OnLangpackGetLanguage(h.LangpackGetLanguage)
+	}
+	if h, ok := impl.(interface {
+		FoldersEditPeerFolders(ctx context.Context, folderpeers []InputFolderPeer) (UpdatesClass, error)
+	}); ok {
+		s.OnFoldersEditPeerFolders(h.FoldersEditPeerFolders)
+	}
+	if h, ok := impl.(interface {
+		StatsGetBroadcastStats(ctx context.Context, request *StatsGetBroadcastStatsRequest) (*StatsBroadcastStats, error)
+	}); ok {
+		s.OnStatsGetBroadcastStats(h.StatsGetBroadcastStats)
+	}
+	if h, ok := impl.(interface {
+		StatsLoadAsyncGraph(ctx context.Context, request *StatsLoadAsyncGraphRequest) (StatsGraphClass, error)
+	}); ok {
+		s.OnStatsLoadAsyncGraph(h.StatsLoadAsyncGraph)
+	}
+	if h, ok := impl.(interface {
+		StatsGetMegagroupStats(ctx context.Context, request *StatsGetMegagroupStatsRequest) (*StatsMegagroupStats, error)
+	}); ok {
+		s.OnStatsGetMegagroupStats(h.StatsGetMegagroupStats)
+	}
+	if h, ok := impl.(interface {
+		StatsGetMessagePublicForwards(ctx context.Context, request *StatsGetMessagePublicForwardsRequest) (*StatsPublicForwards, error)
+	}); ok {
+		s.OnStatsGetMessagePublicForwards(h.StatsGetMessagePublicForwards)
+	}
+	if h, ok := impl.(interface {
+		StatsGetMessageStats(ctx context.Context, request *StatsGetMessageStatsRequest) (*StatsMessageStats, error)
+	}); ok {
+		s.OnStatsGetMessageStats(h.StatsGetMessageStats)
+	}
+	if h, ok := impl.(interface {
+		StatsGetStoryStats(ctx context.Context, request *StatsGetStoryStatsRequest) (*StatsStoryStats, error)
+	}); ok {
+		s.OnStatsGetStoryStats(h.StatsGetStoryStats)
+	}
+	if h, ok := impl.(interface {
+		StatsGetStoryPublicForwards(ctx context.Context, request *StatsGetStoryPublicForwardsRequest) (*StatsPublicForwards, error)
+	}); ok {
+		s.OnStatsGetStoryPublicForwards(h.StatsGetStoryPublicForwards)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsExportChatlistInvite(ctx context.Context, request *ChatlistsExportChatlistInviteRequest) (*ChatlistsExportedChatlistInvite, error)
+	}); ok {
+		s.OnChatlistsExportChatlistInvite(h.ChatlistsExportChatlistInvite)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsDeleteExportedInvite(ctx context.Context, request *ChatlistsDeleteExportedInviteRequest) (bool, error)
+	}); ok {
+		s.OnChatlistsDeleteExportedInvite(h.ChatlistsDeleteExportedInvite)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsEditExportedInvite(ctx context.Context, request *ChatlistsEditExportedInviteRequest) (*ExportedChatlistInvite, error)
+	}); ok {
+		s.OnChatlistsEditExportedInvite(h.ChatlistsEditExportedInvite)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsGetExportedInvites(ctx context.Context, chatlist InputChatlistDialogFilter) (*ChatlistsExportedInvites, error)
+	}); ok {
+		s.OnChatlistsGetExportedInvites(h.ChatlistsGetExportedInvites)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsCheckChatlistInvite(ctx context.Context, slug string) (ChatlistsChatlistInviteClass, error)
+	}); ok {
+		s.OnChatlistsCheckChatlistInvite(h.ChatlistsCheckChatlistInvite)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsJoinChatlistInvite(ctx context.Context, request *ChatlistsJoinChatlistInviteRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChatlistsJoinChatlistInvite(h.ChatlistsJoinChatlistInvite)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsGetChatlistUpdates(ctx context.Context, chatlist InputChatlistDialogFilter) (*ChatlistsChatlistUpdates, error)
+	}); ok {
+		s.OnChatlistsGetChatlistUpdates(h.ChatlistsGetChatlistUpdates)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsJoinChatlistUpdates(ctx context.Context, request *ChatlistsJoinChatlistUpdatesRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChatlistsJoinChatlistUpdates(h.ChatlistsJoinChatlistUpdates)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsHideChatlistUpdates(ctx context.Context, chatlist InputChatlistDialogFilter) (bool, error)
+	}); ok {
+		s.OnChatlistsHideChatlistUpdates(h.ChatlistsHideChatlistUpdates)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsGetLeaveChatlistSuggestions(ctx context.Context, chatlist InputChatlistDialogFilter) ([]PeerClass, error)
+	}); ok {
+		s.OnChatlistsGetLeaveChatlistSuggestions(h.ChatlistsGetLeaveChatlistSuggestions)
+	}
+	if h, ok := impl.(interface {
+		ChatlistsLeaveChatlist(ctx context.Context, request *ChatlistsLeaveChatlistRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnChatlistsLeaveChatlist(h.ChatlistsLeaveChatlist)
+	}
+	if h, ok := impl.(interface {
+		StoriesCanSendStory(ctx context.Context, peer InputPeerClass) (*StoriesCanSendStoryCount, error)
+	}); ok {
+		s.OnStoriesCanSendStory(h.StoriesCanSendStory)
+	}
+	if h, ok := impl.(interface {
+		StoriesSendStory(ctx context.Context, request *StoriesSendStoryRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnStoriesSendStory(h.StoriesSendStory)
+	}
+	if h, ok := impl.(interface {
+		StoriesEditStory(ctx context.Context, request *StoriesEditStoryRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnStoriesEditStory(h.StoriesEditStory)
+	}
+	if h, ok := impl.(interface {
+		StoriesDeleteStories(ctx context.Context, request *StoriesDeleteStoriesRequest) ([]int, error)
+	}); ok {
+		s.OnStoriesDeleteStories(h.StoriesDeleteStories)
+	}
+	if h, ok := impl.(interface {
+		StoriesTogglePinned(ctx context.Context, request *StoriesTogglePinnedRequest) ([]int, error)
+	}); ok {
+		s.OnStoriesTogglePinned(h.StoriesTogglePinned)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetAllStories(ctx context.Context, request *StoriesGetAllStoriesRequest) (StoriesAllStoriesClass, error)
+	}); ok {
+		s.OnStoriesGetAllStories(h.StoriesGetAllStories)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetPinnedStories(ctx context.Context, request *StoriesGetPinnedStoriesRequest) (*StoriesStories, error)
+	}); ok {
+		s.OnStoriesGetPinnedStories(h.StoriesGetPinnedStories)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetStoriesArchive(ctx context.Context, request *StoriesGetStoriesArchiveRequest) (*StoriesStories, error)
+	}); ok {
+		s.OnStoriesGetStoriesArchive(h.StoriesGetStoriesArchive)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetStoriesByID(ctx context.Context, request *StoriesGetStoriesByIDRequest) (*StoriesStories, error)
+	}); ok {
+		s.OnStoriesGetStoriesByID(h.StoriesGetStoriesByID)
+	}
+	if h, ok := impl.(interface {
+		StoriesToggleAllStoriesHidden(ctx context.Context, hidden bool) (bool, error)
+	}); ok {
+		s.OnStoriesToggleAllStoriesHidden(h.StoriesToggleAllStoriesHidden)
+	}
+	if h, ok := impl.(interface {
+		StoriesReadStories(ctx context.Context, request *StoriesReadStoriesRequest) ([]int, error)
+	}); ok {
+		s.OnStoriesReadStories(h.StoriesReadStories)
+	}
+	if h, ok := impl.(interface {
+		StoriesIncrementStoryViews(ctx context.Context, request *StoriesIncrementStoryViewsRequest) (bool, error)
+	}); ok {
+		s.OnStoriesIncrementStoryViews(h.StoriesIncrementStoryViews)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetStoryViewsList(ctx context.Context, request *StoriesGetStoryViewsListRequest) (*StoriesStoryViewsList, error)
+	}); ok {
+		s.OnStoriesGetStoryViewsList(h.StoriesGetStoryViewsList)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetStoriesViews(ctx context.Context, request *StoriesGetStoriesViewsRequest) (*StoriesStoryViews, error)
+	}); ok {
+		s.OnStoriesGetStoriesViews(h.StoriesGetStoriesViews)
+	}
+	if h, ok := impl.(interface {
+		StoriesExportStoryLink(ctx context.Context, request *StoriesExportStoryLinkRequest) (*ExportedStoryLink, error)
+	}); ok {
+		s.OnStoriesExportStoryLink(h.StoriesExportStoryLink)
+	}
+	if h, ok := impl.(interface {
+		StoriesReport(ctx context.Context, request *StoriesReportRequest) (ReportResultClass, error)
+	}); ok {
+		s.OnStoriesReport(h.StoriesReport)
+	}
+	if h, ok := impl.(interface {
+		StoriesActivateStealthMode(ctx context.Context, request *StoriesActivateStealthModeRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnStoriesActivateStealthMode(h.StoriesActivateStealthMode)
+	}
+	if h, ok := impl.(interface {
+		StoriesSendReaction(ctx context.Context, request *StoriesSendReactionRequest) (UpdatesClass, error)
+	}); ok {
+		s.OnStoriesSendReaction(h.StoriesSendReaction)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetPeerStories(ctx context.Context, peer InputPeerClass) (*StoriesPeerStories, error)
+	}); ok {
+		s.OnStoriesGetPeerStories(h.StoriesGetPeerStories)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetAllReadPeerStories(ctx context.Context) (UpdatesClass, error)
+	}); ok {
+		s.OnStoriesGetAllReadPeerStories(h.StoriesGetAllReadPeerStories)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetPeerMaxIDs(ctx context.Context, id []InputPeerClass) ([]int, error)
+	}); ok {
+		s.OnStoriesGetPeerMaxIDs(h.StoriesGetPeerMaxIDs)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetChatsToSend(ctx context.Context) (MessagesChatsClass, error)
+	}); ok {
+		s.OnStoriesGetChatsToSend(h.StoriesGetChatsToSend)
+	}
+	if h, ok := impl.(interface {
+		StoriesTogglePeerStoriesHidden(ctx context.Context, request *StoriesTogglePeerStoriesHiddenRequest) (bool, error)
+	}); ok {
+		s.OnStoriesTogglePeerStoriesHidden(h.StoriesTogglePeerStoriesHidden)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetStoryReactionsList(ctx context.Context, request *StoriesGetStoryReactionsListRequest) (*StoriesStoryReactionsList, error)
+	}); ok {
+		s.OnStoriesGetStoryReactionsList(h.StoriesGetStoryReactionsList)
+	}
+	if h, ok := impl.(interface {
+		StoriesTogglePinnedToTop(ctx context.Context, request *StoriesTogglePinnedToTopRequest) (bool, error)
+	}); ok {
+		s.OnStoriesTogglePinnedToTop(h.StoriesTogglePinnedToTop)
+	}
+	if h, ok := impl.(interface {
+		StoriesSearchPosts(ctx context.Context, request *StoriesSearchPostsRequest) (*StoriesFoundStories, error)
+	}); ok {
+		s.OnStoriesSearchPosts(h.StoriesSearchPosts)
+	}
+	if h, ok := impl.(interface {
+		StoriesCreateAlbum(ctx context.Context, request *StoriesCreateAlbumRequest) (*StoryAlbum, error)
+	}); ok {
+		s.OnStoriesCreateAlbum(h.StoriesCreateAlbum)
+	}
+	if h, ok := impl.(interface {
+		StoriesUpdateAlbum(ctx context.Context, request *StoriesUpdateAlbumRequest) (*StoryAlbum, error)
+	}); ok {
+		s.OnStoriesUpdateAlbum(h.StoriesUpdateAlbum)
+	}
+	if h, ok := impl.(interface {
+		StoriesReorderAlbums(ctx context.Context, request *StoriesReorderAlbumsRequest) (bool, error)
+	}); ok {
+		s.OnStoriesReorderAlbums(h.StoriesReorderAlbums)
+	}
+	if h, ok := impl.(interface {
+		StoriesDeleteAlbum(ctx context.Context, request *StoriesDeleteAlbumRequest) (bool, error)
+	}); ok {
+		s.OnStoriesDeleteAlbum(h.StoriesDeleteAlbum)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetAlbums(ctx context.Context, request *StoriesGetAlbumsRequest) (StoriesAlbumsClass, error)
+	}); ok {
+		s.OnStoriesGetAlbums(h.StoriesGetAlbums)
+	}
+	if h, ok := impl.(interface {
+		StoriesGetAlbumStories(ctx context.Context, request *StoriesGetAlbumStoriesRequest) (*StoriesStories, error)
+	}); ok {
+		s.OnStoriesGetAlbumStories(h.StoriesGetAlbumStories)
+	}
+	if h, ok := impl.(interface {
+		PremiumGetBoostsList(ctx context.Context, request *PremiumGetBoostsListRequest) (*PremiumBoostsList, error)
+	}); ok {
+		s.OnPremiumGetBoostsList(h.PremiumGetBoostsList)
+	}
+	if h, ok := impl.(interface {
+		PremiumGetMyBoosts(ctx context.Context) (*PremiumMyBoosts, error)
+	}); ok {
+		s.OnPremiumGetMyBoosts(h.PremiumGetMyBoosts)
+	}
+	if h, ok := impl.(interface {
+		PremiumApplyBoost(ctx context.Context, request *PremiumApplyBoostRequest) (*PremiumMyBoosts, error)
+	}); ok {
+		s.OnPremiumApplyBoost(h.PremiumApplyBoost)
+	}
+	if h, ok := impl.(interface {
+		PremiumGetBoostsStatus(ctx context.Context, peer InputPeerClass) (*PremiumBoostsStatus, error)
+	}); ok {
+		s.OnPremiumGetBoostsStatus(h.PremiumGetBoostsStatus)
+	}
+	if h, ok := impl.(interface {
+		PremiumGetUserBoosts(ctx context.Context, request *PremiumGetUserBoostsRequest) (*PremiumBoostsList, error)
+	}); ok {
+		s.OnPremiumGetUserBoosts(h.PremiumGetUserBoosts)
+	}
+	if h, ok := impl.(interface {
+		SMSJobsIsEligibleToJoin(ctx context.Context) (*SMSJobsEligibleToJoin, error)
+	}); ok {
+		s.OnSMSJobsIsEligibleToJoin(h.SMSJobsIsEligibleToJoin)
+	}
+	if h, ok := impl.(interface {
+		SMSJobsJoin(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnSMSJobsJoin(h.SMSJobsJoin)
+	}
+	if h, ok := impl.(interface {
+		SMSJobsLeave(ctx context.Context) (bool, error)
+	}); ok {
+		s.OnSMSJobsLeave(h.SMSJobsLeave)
+	}
+	if h, ok := impl.(interface {
+		SMSJobsUpdateSettings(ctx context.Context, request *SMSJobsUpdateSettingsRequest) (bool, error)
+	}); ok {
+		s.OnSMSJobsUpdateSettings(h.SMSJobsUpdateSettings)
+	}
+	if h, ok := impl.(interface {
+		SMSJobsGetStatus(ctx context.Context) (*SMSJobsStatus, error)
+	}); ok {
+		s.OnSMSJobsGetStatus(h.SMSJobsGetStatus)
+	}
+	if h, ok := impl.(interface {
+		SMSJobsGetSMSJob(ctx context.Context, jobid string) (*SMSJob, error)
+	}); ok {
+		s.OnSMSJobsGetSMSJob(h.SMSJobsGetSMSJob)
+	}
+	if h, ok := impl.(interface {
+		SMSJobsFinishJob(ctx context.Context, request *SMSJobsFinishJobRequest) (bool, error)
+	}); ok {
+		s.OnSMSJobsFinishJob(h.SMSJobsFinishJob)
+	}
+	if h, ok := impl.(interface {
+		FragmentGetCollectibleInfo(ctx context.Context, collectible InputCollectibleClass) (*FragmentCollectibleInfo, error)
+	}); ok {
+		s.OnFragmentGetCollectibleInfo(h.FragmentGetCollectibleInfo)
+	}
+	if h, ok := impl.(interface {
+		TestUseError(ctx context.Context) (*Error, error)
+	}); ok {
+		s.OnTestUseError(h.TestUseError)
+	}
+	if h, ok := impl.(interface {
+		TestUseConfigSimple(ctx context.Context) (*HelpConfigSimple, error)
+	}); ok {
+		s.OnTestUseConfigSimple(h.TestUseConfigSimple)
+	}
+}
+
 func (s *ServerDispatcher) OnAuthSendCode(f func(ctx context.Context, request *AuthSendCodeRequest) (AuthSentCodeClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthSendCodeRequest
@@ -2,7 +2,10 @@
 package cluster
 
 import (
+	"fmt"
 	"io"
+	"net"
+	"strconv"
 
 	"github.com/gotd/td/exchange"
 	"github.com/gotd/td/tdsync"
@@ -22,6 +25,8 @@ type setup struct {
 type Cluster struct {
 	// denotes to use websocket listener
 	web bool
+	// denotes to use HTTP listener
+	http bool
 
 	setups map[int]setup
 	keys   []exchange.PublicKey
@@ -48,6 +53,7 @@ func NewCluster(opts Options) *Cluster {
 
 	q := &Cluster{
 		web:      opts.Web,
+		http:     opts.HTTP,
 		setups:   map[int]setup{},
 		keys:     nil,
 		cfg:      opts.Config,
@@ -78,6 +84,13 @@ func (c *Cluster) Resolver() dcs.Resolver {
 	if c.web {
 		return dcs.Websocket(dcs.WebsocketOptions{})
 	}
+	if c.http {
+		return dcs.HTTP(dcs.HTTPOptions{
+			Endpoint: func(dc tg.DCOption) string {
+				return fmt.Sprintf("http://%s/api", net.JoinHostPort(dc.IPAddress, strconv.Itoa(dc.Port)))
+			},
+		})
+	}
 
 	return dcs.Plain(dcs.PlainOptions{
 		Protocol: c.protocol,
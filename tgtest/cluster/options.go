@@ -14,6 +14,8 @@ import (
 type Options struct {
 	// Web denotes to use websocket listener.
 	Web bool
+	// HTTP denotes to use MTProto over HTTP listener.
+	HTTP bool
 	// Random is random source. Used to generate RSA keys.
 	// Defaults to rand.Reader.
 	Random io.Reader
@@ -30,6 +32,7 @@ type Options struct {
 
 func (opt *Options) setDefaults() {
 	// It's okay to use zero value Web.
+	// It's okay to use zero value HTTP.
 	if opt.Random == nil {
 		opt.Random = crypto.DefaultRand()
 	}
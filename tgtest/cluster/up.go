@@ -19,20 +19,10 @@ import (
 func (c *Cluster) Up(ctx context.Context) error {
 	g := tdsync.NewCancellableGroup(ctx)
 
-	listen := func(ctx context.Context, _ int) (net.Listener, error) {
-		return newLocalListener(ctx)
-	}
-	if c.web {
-		// Create local random listener
-		l, err := newLocalListener(ctx)
-		if err != nil {
-			return err
-		}
-
-		mux := http.NewServeMux()
+	serveHTTP := func(l net.Listener, handler http.Handler) {
 		srv := http.Server{
 			ReadHeaderTimeout: time.Second * 10,
-			Handler:           mux,
+			Handler:           handler,
 			BaseContext: func(net.Listener) context.Context {
 				return ctx
 			},
@@ -48,6 +38,21 @@ func (c *Cluster) Up(ctx context.Context) error {
 
 			return srv.Close()
 		})
+	}
+
+	listen := func(ctx context.Context, _ int) (net.Listener, error) {
+		return newLocalListener(ctx)
+	}
+	switch {
+	case c.web:
+		// Create local random listener
+		l, err := newLocalListener(ctx)
+		if err != nil {
+			return err
+		}
+
+		mux := http.NewServeMux()
+		serveHTTP(l, mux)
 
 		baseURL := url.URL{
 			Scheme: "http",
@@ -62,6 +67,22 @@ func (c *Cluster) Up(ctx context.Context) error {
 			dcURL := baseURL
 			dcURL.Path = path
 			c.domains[dc] = dcURL.String()
+			return listener, nil
+		}
+	case c.http:
+		listen = func(ctx context.Context, dc int) (net.Listener, error) {
+			// Create local random listener per DC, every DC
+			// serves MTProto over HTTP on /api path.
+			l, err := newLocalListener(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			listener, handler := transport.HTTPListener(l.Addr())
+			mux := http.NewServeMux()
+			mux.Handle("/api", handler)
+			serveHTTP(l, mux)
+
 			return listener, nil
 		}
 	}
@@ -73,7 +94,7 @@ func (c *Cluster) Up(ctx context.Context) error {
 		}
 
 		if !c.web {
-			// Add TCP listeners to config.
+			// Add TCP or HTTP listeners to config.
 			if addr, ok := l.Addr().(*net.TCPAddr); ok {
 				c.cfg.DCOptions = append(c.cfg.DCOptions, tg.DCOption{
 					Ipv6:      addr.IP.To16() != nil,
@@ -44,9 +44,10 @@ func (e exchangeConn) Recv(ctx context.Context, b *bin.Buffer) error {
 
 // exchange starts MTProto key exchange.
 func (s *Server) exchange(ctx context.Context, conn transport.Conn) (crypto.AuthKey, error) {
+	lg := s.log.With().Str("logger", "exchange").Logger()
 	r, err := exchange.NewExchanger(conn, s.dcID).
 		WithClock(s.clock).
-		WithLogger(s.log.Named("exchange")).
+		WithLogger(&lg).
 		WithRand(s.cipher.Rand()).
 		Server(s.key).Run(ctx)
 	if err != nil {
@@ -98,6 +98,10 @@ func (s *Server) handle(req *Request) error {
 
 		return s.SendEternalSalt(req)
 
+	case mt.HTTPWaitRequestTypeID:
+		// Long polling is handled by transport listener.
+		return nil
+
 	case mt.RPCDropAnswerRequestTypeID:
 		drop := mt.RPCDropAnswerRequest{}
 		if err := drop.Decode(in); err != nil {
@@ -0,0 +1,113 @@
+package tgtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+)
+
+type requestKey struct{}
+
+type requestValue struct {
+	server *Server
+	req    *Request
+}
+
+// RequestFromContext returns Server and Request of RPC call handled by Router.
+func RequestFromContext(ctx context.Context) (*Server, *Request, bool) {
+	v, ok := ctx.Value(requestKey{}).(requestValue)
+	if !ok {
+		return nil, nil, false
+	}
+	return v.server, v.req, true
+}
+
+// errNoRoute is returned by tg.ServerDispatcher fallback.
+var errNoRoute = errors.New("no route")
+
+// Router is a Handler which routes RPC requests to typed handlers.
+//
+// Handlers are plain Go methods with the same signature as tg.Client
+// methods, see tg.Server. Use RequestFromContext to get request info
+// like DC or Session in handler.
+type Router struct {
+	dispatcher *tg.ServerDispatcher
+	mux        sync.RWMutex
+	fallback   Handler
+}
+
+// NewRouter creates new Router and registers handlers for all tg.Server
+// methods implemented by given implementations.
+func NewRouter(impls ...interface{}) *Router {
+	r := &Router{
+		dispatcher: tg.NewServerDispatcher(func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
+			return nil, errNoRoute
+		}),
+	}
+	for _, impl := range impls {
+		r.Register(impl)
+	}
+	return r
+}
+
+// Dispatcher returns underlying tg.ServerDispatcher to set handlers
+// directly, like
+//
+//	router.Dispatcher().OnHelpGetNearestDC(func(ctx context.Context) (*tg.NearestDC, error) {
+//		// ...
+//	})
+func (r *Router) Dispatcher() *tg.ServerDispatcher {
+	return r.dispatcher
+}
+
+// Register registers handlers for all tg.Server methods implemented by impl.
+//
+// Handlers registered later override previous ones.
+func (r *Router) Register(impl interface{}) *Router {
+	r.mux.Lock()
+	r.dispatcher.Register(impl)
+	r.mux.Unlock()
+	return r
+}
+
+// Fallback sets handler for requests without route.
+func (r *Router) Fallback(h Handler) *Router {
+	r.mux.Lock()
+	r.fallback = h
+	r.mux.Unlock()
+	return r
+}
+
+// OnMessage implements Handler.
+func (r *Router) OnMessage(server *Server, req *Request) error {
+	ctx := req.RequestCtx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = context.WithValue(ctx, requestKey{}, requestValue{
+		server: server,
+		req:    req,
+	})
+
+	r.mux.RLock()
+	result, err := r.dispatcher.Handle(ctx, req.Buf)
+	fallback := r.fallback
+	r.mux.RUnlock()
+	if errors.Is(err, errNoRoute) {
+		if fallback != nil {
+			return fallback.OnMessage(server, req)
+		}
+
+		id, _ := req.Buf.PeekID()
+		return errors.Errorf("unexpected type %#x", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	return server.SendResult(req, result)
+}
@@ -0,0 +1,133 @@
+package tgtest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgtest"
+	"github.com/gotd/td/tgtest/cluster"
+)
+
+type routerService struct{}
+
+func (routerService) HelpGetNearestDC(ctx context.Context) (*tg.NearestDC, error) {
+	_, req, ok := tgtest.RequestFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no request in context")
+	}
+	return &tg.NearestDC{
+		Country:   "NL",
+		ThisDC:    req.DC,
+		NearestDC: req.DC,
+	}, nil
+}
+
+func (routerService) UsersGetUsers(ctx context.Context, id []tg.InputUserClass) ([]tg.UserClass, error) {
+	users := make([]tg.UserClass, 0, len(id))
+	for _, u := range id {
+		user, ok := u.(*tg.InputUser)
+		if !ok {
+			return nil, tgerr.New(400, "USER_ID_INVALID")
+		}
+		users = append(users, &tg.User{ID: user.UserID, AccessHash: user.AccessHash})
+	}
+	return users, nil
+}
+
+func TestRouter(t *testing.T) {
+	a := require.New(t)
+	log := zerolog.New(zerolog.NewTestWriter(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	lg := log.With().Str("logger", "cluster").Logger()
+	g := tdsync.NewCancellableGroup(ctx)
+	c := cluster.NewCluster(cluster.Options{
+		Logger: &lg,
+	})
+	// Dispatch in order: DC handlers, router, common handlers.
+	router := tgtest.NewRouter(routerService{}).Fallback(c.Common())
+	c.Dispatch(2, "server").Fallback(router)
+
+	g.Go(c.Up)
+	clog := log.With().Str("logger", "client").Logger()
+	g.Go(func(ctx context.Context) error {
+		select {
+		case <-c.Ready():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		defer g.Cancel()
+
+		client := telegram.NewClient(telegram.TestAppID, telegram.TestAppHash, telegram.Options{
+			PublicKeys:    c.Keys(),
+			DC:            2,
+			DCList:        c.List(),
+			Resolver:      c.Resolver(),
+			NoUpdates:     true,
+			Logger:        &clog,
+			RetryInterval: 100 * time.Millisecond,
+		})
+
+		return client.Run(ctx, func(ctx context.Context) error {
+			raw := client.API()
+
+			nearest, err := raw.HelpGetNearestDC(ctx)
+			if err != nil {
+				return errors.Wrap(err, "get nearest DC")
+			}
+			a.Equal(2, nearest.ThisDC)
+
+			users, err := raw.UsersGetUsers(ctx, []tg.InputUserClass{
+				&tg.InputUser{UserID: 10, AccessHash: 20},
+			})
+			if err != nil {
+				return errors.Wrap(err, "get users")
+			}
+			a.Len(users, 1)
+			a.Equal(int64(10), users[0].GetID())
+
+			_, err = raw.UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+			a.True(tgerr.Is(err, "USER_ID_INVALID"), err)
+
+			// Not implemented method.
+			_, err = raw.HelpGetSupport(ctx)
+			a.True(tgerr.Is(err, "INPUT_METHOD_INVALID"), err)
+
+			// Unrouted requests are passed to fallback.
+			cfg, err := raw.HelpGetConfig(ctx)
+			if err != nil {
+				return errors.Wrap(err, "get config")
+			}
+			a.Equal(2, cfg.ThisDC)
+			return nil
+		})
+	})
+
+	a.NoError(g.Wait())
+}
+
+func TestRouterRegister(t *testing.T) {
+	a := require.New(t)
+	var _ tg.Server = (*tg.Client)(nil)
+
+	r := tgtest.NewRouter(routerService{})
+	d := r.Dispatcher()
+	a.True(d.Has(tg.HelpGetNearestDCRequestTypeID))
+	a.True(d.Has(tg.UsersGetUsersRequestTypeID))
+	a.False(d.Has(tg.HelpGetSupportRequestTypeID))
+
+	d.OnHelpGetSupport(func(ctx context.Context) (*tg.HelpSupport, error) {
+		return &tg.HelpSupport{}, nil
+	})
+	a.True(d.Has(tg.HelpGetSupportRequestTypeID))
+}
@@ -4,7 +4,6 @@ package config
 import (
 	"context"
 
-	"github.com/gotd/td/bin"
 	"github.com/gotd/td/tg"
 	"github.com/gotd/td/tgtest"
 	"github.com/gotd/td/tgtest/services"
@@ -14,55 +13,33 @@ import (
 type Service struct {
 	cfg    *tg.Config
 	cdnCfg *tg.CDNConfig
+	router *tgtest.Router
 }
 
 // NewService creates new Service.
 func NewService(cfg *tg.Config, cdnCfg *tg.CDNConfig) *Service {
-	return &Service{cfg: cfg, cdnCfg: cdnCfg}
+	s := &Service{cfg: cfg, cdnCfg: cdnCfg}
+	s.router = tgtest.NewRouter(s).Fallback(services.NotImplemented)
+	return s
 }
 
-func (c *Service) HelpGetCDNConfig(ctx context.Context, req *tg.HelpGetCDNConfigRequest) (*tg.CDNConfig, error) {
-	cfg := c.cdnCfg
-	return cfg, nil
+// HelpGetCDNConfig implements tg.Server.
+func (c *Service) HelpGetCDNConfig(ctx context.Context) (*tg.CDNConfig, error) {
+	return c.cdnCfg, nil
 }
 
-func (c *Service) HelpGetConfig(ctx context.Context, dc int, req *tg.HelpGetConfigRequest) (*tg.Config, error) {
+// HelpGetConfig implements tg.Server.
+func (c *Service) HelpGetConfig(ctx context.Context) (*tg.Config, error) {
 	cfg := *c.cfg
-	cfg.ThisDC = dc
+	if _, req, ok := tgtest.RequestFromContext(ctx); ok {
+		cfg.ThisDC = req.DC
+	}
 	return &cfg, nil
 }
 
 // OnMessage implements tgtest.Handler.
 func (c *Service) OnMessage(server *tgtest.Server, req *tgtest.Request) error {
-	id, err := req.Buf.PeekID()
-	if err != nil {
-		return err
-	}
-
-	var (
-		decode bin.Decoder
-		result bin.Encoder
-	)
-	switch id {
-	case tg.HelpGetCDNConfigRequestTypeID:
-		cfg := c.cdnCfg
-
-		decode = &tg.HelpGetCDNConfigRequest{}
-		result = cfg
-	case tg.HelpGetConfigRequestTypeID:
-		cfg := *c.cfg
-		cfg.ThisDC = req.DC
-
-		decode = &tg.HelpGetConfigRequest{}
-		result = &cfg
-	default:
-		return services.ErrMethodNotImplemented
-	}
-
-	if err := decode.Decode(req.Buf); err != nil {
-		return err
-	}
-	return server.SendResult(req, result)
+	return c.router.OnMessage(server, req)
 }
 
 // Register registers service handlers.
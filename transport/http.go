@@ -0,0 +1,237 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/tdsync"
+)
+
+// httpConnIDHeader is a header to distinguish virtual connections of the same
+// client. Telegram servers ignore it, but tgtest HTTP listener uses it to
+// route responses to the right connection.
+const httpConnIDHeader = "X-Mtproto-Conn-Id"
+
+// maxHTTPResponseSize is a limit of HTTP response body.
+const maxHTTPResponseSize = 1 << 24 // 16 MB
+
+// errHTTPBodyTooLarge is returned by readHTTPBody if body exceeds
+// maxHTTPResponseSize.
+var errHTTPBodyTooLarge = errors.New("body too large")
+
+// readHTTPBody reads whole body, failing if it exceeds maxHTTPResponseSize.
+func readHTTPBody(r io.Reader) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r, maxHTTPResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxHTTPResponseSize {
+		return nil, errHTTPBodyTooLarge
+	}
+	return body, nil
+}
+
+// Poller is a connection which can receive messages only as
+// responses to sent requests, like HTTP transport.
+//
+// MTProto connection should send http_wait message every time when
+// Poll channel is signaled to keep long polling request in flight.
+type Poller interface {
+	Conn
+	// Poll returns channel which is signaled when there are no requests in flight.
+	Poll() <-chan struct{}
+}
+
+var _ Poller = (*httpConn)(nil)
+
+type httpResult struct {
+	data []byte
+	err  error
+}
+
+// httpConn is MTProto over HTTP connection.
+//
+// See https://core.telegram.org/mtproto/transports#http.
+type httpConn struct {
+	client *http.Client
+	url    string
+	id     string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	closed *tdsync.Ready
+
+	results chan httpResult
+	poll    chan struct{}
+
+	inflight    int
+	inflightMux sync.Mutex
+}
+
+// HTTP creates new MTProto over HTTP connection using given client and URL.
+//
+// Every Send makes a POST request in the background, response bodies
+// are returned by Recv.
+//
+// See https://core.telegram.org/mtproto/transports#http.
+func HTTP(client *http.Client, url string) (Conn, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	id, err := crypto.RandInt64(crypto.DefaultRand())
+	if err != nil {
+		return nil, errors.Wrap(err, "generate connection id")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &httpConn{
+		client:  client,
+		url:     url,
+		id:      strconv.FormatUint(uint64(id), 16),
+		ctx:     ctx,
+		cancel:  cancel,
+		closed:  tdsync.NewReady(),
+		results: make(chan httpResult, 16),
+		poll:    make(chan struct{}, 1),
+	}, nil
+}
+
+func (c *httpConn) push(r httpResult) {
+	select {
+	case c.results <- r:
+	case <-c.closed.Ready():
+	}
+}
+
+func (c *httpConn) done() {
+	c.inflightMux.Lock()
+	defer c.inflightMux.Unlock()
+
+	c.inflight--
+	if c.inflight > 0 {
+		return
+	}
+
+	select {
+	case c.poll <- struct{}{}:
+	default:
+	}
+}
+
+func (c *httpConn) post(data []byte) {
+	defer c.done()
+
+	data, err := c.do(data)
+	if err != nil {
+		c.push(httpResult{err: err})
+		return
+	}
+	// Server may return empty body if there are no messages.
+	if len(data) == 0 {
+		return
+	}
+	c.push(httpResult{data: data})
+}
+
+func (c *httpConn) do(data []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(c.ctx, http.MethodPost, c.url, bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "create request")
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set(httpConnIDHeader, c.id)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send request")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case codec.CodeAuthKeyNotFound, codec.CodeTransportFlood, codec.CodeWrongDC:
+		return nil, &codec.ProtocolErr{Code: int32(resp.StatusCode)}
+	default:
+		return nil, errors.Errorf("unexpected status %q", resp.Status)
+	}
+
+	body, err := readHTTPBody(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+	return body, nil
+}
+
+// Send sends message from buffer using HTTP connection.
+//
+// Request is made in the background, so given context is used only
+// to interrupt waiting for connection close.
+func (c *httpConn) Send(ctx context.Context, b *bin.Buffer) error {
+	if b.Len() == 0 {
+		return errors.New("empty message")
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.closed.Ready():
+		return errors.Wrap(io.ErrClosedPipe, "send")
+	default:
+	}
+
+	c.inflightMux.Lock()
+	c.inflight++
+	c.inflightMux.Unlock()
+
+	data := append([]byte(nil), b.Buf...)
+	go c.post(data)
+	return nil
+}
+
+// Recv reads message to buffer using HTTP connection.
+func (c *httpConn) Recv(ctx context.Context, b *bin.Buffer) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.closed.Ready():
+		return errors.Wrap(io.EOF, "recv")
+	case r := <-c.results:
+		if r.err != nil {
+			return errors.Wrap(r.err, "read")
+		}
+		b.ResetTo(r.data)
+		// Server may send protocol error code in the body too.
+		if b.Len() == bin.Word {
+			code, err := b.Int32()
+			if err != nil {
+				return errors.Wrap(err, "read code")
+			}
+			return &codec.ProtocolErr{Code: -code}
+		}
+		return nil
+	}
+}
+
+// Poll returns channel which is signaled when there are no requests in flight.
+func (c *httpConn) Poll() <-chan struct{} {
+	return c.poll
+}
+
+// Close closes HTTP connection and cancels all requests in flight.
+func (c *httpConn) Close() error {
+	c.closed.Signal()
+	c.cancel()
+	return nil
+}
@@ -0,0 +1,306 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/tdsync"
+)
+
+// defaultHTTPWait is a default long polling timeout.
+//
+// See https://core.telegram.org/mtproto/service_messages#long-poll.
+const defaultHTTPWait = 25 * time.Second
+
+type httpListener struct {
+	addr   net.Addr
+	wait   time.Duration
+	ch     chan *httpServerConn
+	closed *tdsync.Ready
+
+	conns    map[string]*httpServerConn
+	connsMux sync.Mutex
+}
+
+// HTTPListener creates new MTProto over HTTP listener.
+//
+// Returned listener emits virtual intermediate codec connections, one per
+// client connection, so it can be used with Listen.
+func HTTPListener(addr net.Addr) (net.Listener, http.Handler) {
+	l := &httpListener{
+		addr:   addr,
+		wait:   defaultHTTPWait,
+		ch:     make(chan *httpServerConn, 1),
+		closed: tdsync.NewReady(),
+		conns:  map[string]*httpServerConn{},
+	}
+	return l, l
+}
+
+func (l *httpListener) getConn(id string) (*httpServerConn, bool) {
+	l.connsMux.Lock()
+	defer l.connsMux.Unlock()
+
+	if c, ok := l.conns[id]; ok {
+		return c, false
+	}
+
+	c := newHTTPServerConn(l.addr, func() {
+		l.connsMux.Lock()
+		delete(l.conns, id)
+		l.connsMux.Unlock()
+	})
+	l.conns[id] = c
+	return c, true
+}
+
+func (l *httpListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := readHTTPBody(r.Body)
+	if errors.Is(err, errHTTPBodyTooLarge) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil || len(body) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	conn, created := l.getConn(r.Header.Get(httpConnIDHeader))
+	if created {
+		// Pass connection to the Accept().
+		select {
+		case <-r.Context().Done():
+			_ = conn.Close()
+			return
+		case <-l.closed.Ready():
+			_ = conn.Close()
+			return
+		case l.ch <- conn:
+		}
+	}
+
+	// Park request before pushing the payload to catch the response.
+	kick := conn.park()
+	if err := conn.push(r.Context(), body); err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	timer := time.NewTimer(l.wait)
+	defer timer.Stop()
+
+	select {
+	case <-r.Context().Done():
+	case <-l.closed.Ready():
+	case <-conn.closed.Ready():
+	case <-kick:
+		// Newer request took over long polling.
+	case <-timer.C:
+	case msg := <-conn.out:
+		if len(msg) == bin.Word {
+			// Protocol error, like auth key not found.
+			code := int32(binary.LittleEndian.Uint32(msg))
+			w.WriteHeader(int(-code))
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(msg)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (l *httpListener) Accept() (net.Conn, error) {
+	select {
+	case <-l.closed.Ready():
+		return nil, net.ErrClosed
+	case conn := <-l.ch:
+		return conn, nil
+	}
+}
+
+func (l *httpListener) Close() error {
+	l.closed.Signal()
+
+	l.connsMux.Lock()
+	conns := make([]*httpServerConn, 0, len(l.conns))
+	for _, c := range l.conns {
+		conns = append(conns, c)
+	}
+	l.connsMux.Unlock()
+
+	for _, c := range conns {
+		_ = c.Close()
+	}
+	return nil
+}
+
+func (l *httpListener) Addr() net.Addr {
+	return l.addr
+}
+
+// httpServerConn is a virtual net.Conn, which emulates intermediate codec stream
+// over HTTP requests.
+type httpServerConn struct {
+	addr net.Addr
+
+	// Incoming stream, written by HTTP handler.
+	in      chan []byte
+	inBuf   bytes.Reader
+	inMux   sync.Mutex
+	started bool
+
+	// Outgoing stream, written by server.
+	outReader *io.PipeReader
+	outWriter *io.PipeWriter
+	out       chan []byte
+
+	kick    chan struct{}
+	kickMux sync.Mutex
+
+	closed  *tdsync.Ready
+	onClose func()
+}
+
+func newHTTPServerConn(addr net.Addr, onClose func()) *httpServerConn {
+	outReader, outWriter := io.Pipe()
+
+	c := &httpServerConn{
+		addr:      addr,
+		in:        make(chan []byte, 16),
+		outReader: outReader,
+		outWriter: outWriter,
+		out:       make(chan []byte, 16),
+		closed:    tdsync.NewReady(),
+		onClose:   onClose,
+	}
+	go c.readOutgoing()
+	return c
+}
+
+// readOutgoing splits outgoing stream to messages.
+func (c *httpServerConn) readOutgoing() {
+	defer func() {
+		_ = c.Close()
+	}()
+
+	for {
+		var b bin.Buffer
+		if err := (codec.Intermediate{}).Read(c.outReader, &b); err != nil {
+			var protoErr *codec.ProtocolErr
+			if !errors.As(err, &protoErr) {
+				return
+			}
+			b.Reset()
+			b.PutInt32(-protoErr.Code)
+		}
+
+		select {
+		case c.out <- b.Buf:
+		case <-c.closed.Ready():
+			return
+		}
+	}
+}
+
+// park registers new long polling request and returns channel which is
+// closed when newer request is parked.
+func (c *httpServerConn) park() <-chan struct{} {
+	c.kickMux.Lock()
+	defer c.kickMux.Unlock()
+
+	if c.kick != nil {
+		close(c.kick)
+	}
+	c.kick = make(chan struct{})
+	return c.kick
+}
+
+func (c *httpServerConn) push(ctx context.Context, data []byte) error {
+	c.inMux.Lock()
+	defer c.inMux.Unlock()
+
+	var (
+		cdc codec.Intermediate
+		buf bytes.Buffer
+	)
+	if !c.started {
+		// Add codec tag in the begin of stream to emulate TCP fully.
+		if err := cdc.WriteHeader(&buf); err != nil {
+			return err
+		}
+		c.started = true
+	}
+	if err := cdc.Write(&buf, &bin.Buffer{Buf: data}); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.closed.Ready():
+		return net.ErrClosed
+	case c.in <- buf.Bytes():
+		return nil
+	}
+}
+
+func (c *httpServerConn) Read(p []byte) (int, error) {
+	if c.inBuf.Len() == 0 {
+		select {
+		case <-c.closed.Ready():
+			return 0, io.EOF
+		case data := <-c.in:
+			c.inBuf.Reset(data)
+		}
+	}
+	return c.inBuf.Read(p)
+}
+
+func (c *httpServerConn) Write(p []byte) (int, error) {
+	return c.outWriter.Write(p)
+}
+
+func (c *httpServerConn) Close() error {
+	c.closed.Signal()
+	_ = c.outReader.Close()
+	if c.onClose != nil {
+		c.onClose()
+	}
+	return nil
+}
+
+func (c *httpServerConn) LocalAddr() net.Addr {
+	return c.addr
+}
+
+func (c *httpServerConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+func (c *httpServerConn) SetDeadline(time.Time) error {
+	return nil
+}
+
+func (c *httpServerConn) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (c *httpServerConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
@@ -0,0 +1,138 @@
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/telegram/dcs"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/transport"
+)
+
+func TestHTTPListener(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	var handler http.Handler
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	listener, h := transport.HTTPListener(srv.Listener.Addr())
+	handler = h
+
+	server := transport.Listen(listener)
+	defer server.Close()
+
+	grp, ctx := errgroup.WithContext(ctx)
+	grp.Go(func() error {
+		conn, err := server.Accept()
+		if err != nil {
+			return errors.Wrap(err, "accept")
+		}
+
+		var b bin.Buffer
+		for i := 0; i < 2; i++ {
+			if err := conn.Recv(ctx, &b); err != nil {
+				return errors.Wrap(err, "recv")
+			}
+
+			if err := conn.Send(ctx, &b); err != nil {
+				return errors.Wrap(err, "send")
+			}
+		}
+
+		b.Reset()
+		b.PutInt32(-codec.CodeAuthKeyNotFound)
+		if err := conn.Send(ctx, &b); err != nil {
+			return errors.Wrap(err, "send")
+		}
+
+		return nil
+	})
+
+	rs := dcs.HTTP(dcs.HTTPOptions{
+		Endpoint: func(dc tg.DCOption) string {
+			return srv.URL + "/api"
+		},
+	})
+	conn, err := rs.Primary(ctx, 2, dcs.List{
+		Options: []tg.DCOption{
+			{ID: 2, IPAddress: "127.0.0.1"},
+		},
+	})
+	a.NoError(err)
+	defer conn.Close()
+
+	for i := 0; i < 2; i++ {
+		data, err := io.ReadAll(io.LimitReader(rand.Reader, 1024))
+		a.NoError(err)
+		a.NoError(conn.Send(ctx, &bin.Buffer{Buf: data}))
+
+		var b bin.Buffer
+		a.NoError(conn.Recv(ctx, &b))
+		a.Equal(data, b.Buf)
+	}
+
+	// Long polling request, which receives protocol error.
+	a.NoError(conn.Send(ctx, &bin.Buffer{Buf: make([]byte, 16)}))
+	var b bin.Buffer
+	var protoErr *codec.ProtocolErr
+	a.ErrorAs(conn.Recv(ctx, &b), &protoErr)
+	a.Equal(int32(codec.CodeAuthKeyNotFound), protoErr.Code)
+
+	a.NoError(grp.Wait())
+}
+
+func TestHTTPTooLarge(t *testing.T) {
+	const tooLarge = 1<<24 + 1
+
+	t.Run("Request", func(t *testing.T) {
+		a := require.New(t)
+		listener, h := transport.HTTPListener(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		defer listener.Close()
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", bytes.NewReader(make([]byte, tooLarge))))
+		a.Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	})
+	t.Run("Response", func(t *testing.T) {
+		a := require.New(t)
+		ctx := context.Background()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(make([]byte, tooLarge))
+		}))
+		defer srv.Close()
+
+		rs := dcs.HTTP(dcs.HTTPOptions{
+			Endpoint: func(dc tg.DCOption) string {
+				return srv.URL + "/api"
+			},
+		})
+		conn, err := rs.Primary(ctx, 2, dcs.List{
+			Options: []tg.DCOption{
+				{ID: 2, IPAddress: "127.0.0.1"},
+			},
+		})
+		a.NoError(err)
+		defer conn.Close()
+
+		a.NoError(conn.Send(ctx, &bin.Buffer{Buf: make([]byte, 16)}))
+		var b bin.Buffer
+		err = conn.Recv(ctx, &b)
+		a.ErrorContains(err, "too large")
+	})
+}